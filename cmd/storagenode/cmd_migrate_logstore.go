@@ -0,0 +1,68 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/private/cfgstruct"
+	"storj.io/private/process"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/blobstore/filestore"
+	"storj.io/storj/storagenode/blobstore/logstore"
+)
+
+type migrateLogstoreCfg struct {
+	storagenode.Config
+
+	KeepSource bool `help:"keep the piece files after they have been copied to the logs" default:"false"`
+}
+
+func newMigrateLogstoreCmd(f *Factory) *cobra.Command {
+	var cfg migrateLogstoreCfg
+
+	cmd := &cobra.Command{
+		Use:   "migrate-logstore",
+		Short: "Move the pieces from piece files to the log store; the node must be stopped",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmdMigrateLogstore(cmd, &cfg)
+		},
+		Annotations: map[string]string{"type": "helper"},
+	}
+
+	process.Bind(cmd, &cfg, f.Defaults, cfgstruct.ConfDir(f.ConfDir), cfgstruct.IdentityDir(f.IdentityDir))
+
+	return cmd
+}
+
+func cmdMigrateLogstore(cmd *cobra.Command, cfg *migrateLogstoreCfg) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L().Named("migrate-logstore")
+
+	dir, err := filestore.OpenDir(log, cfg.Storage.Path)
+	if err != nil {
+		return errs.New("unable to open the storage directory: %v", err)
+	}
+
+	from := filestore.New(log.Named("filestore"), dir, cfg.Filestore)
+	to := logstore.New(log.Named("logstore"), dir, cfg.Logstore)
+	defer func() { err = errs.Combine(err, from.Close(), to.Close()) }()
+
+	stats, err := logstore.Migrate(ctx, log, from, to, cfg.KeepSource)
+	fmt.Printf("migrated %d pieces (%s), skipped %d already migrated pieces\n",
+		stats.Blobs, memory.Size(stats.Bytes).Base10String(), stats.Skipped)
+	if err != nil {
+		return err
+	}
+
+	if !cfg.Logstore.Enabled {
+		fmt.Println("set logstore.enabled to true before starting the node")
+	}
+	return nil
+}
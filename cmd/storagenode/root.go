@@ -54,6 +54,7 @@ func newRootCmd(setDefaults bool) (*cobra.Command, *Factory) {
 		newSetupCmd(factory),
 		newDashboardCmd(factory),
 		newDiagCmd(factory),
+		newMigrateLogstoreCmd(factory),
 		newRunCmd(factory),
 		newNodeInfoCmd(factory),
 		newIssueAPIKeyCmd(factory),
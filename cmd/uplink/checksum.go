@@ -0,0 +1,100 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"hash/crc32"
+	"strings"
+
+	"github.com/zeebo/blake3"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/cmd/uplink/ulfs"
+	"storj.io/storj/cmd/uplink/ulloc"
+)
+
+// checksumMetadataKey is the custom metadata key that holds the checksum
+// of the object contents in the form "algorithm:hexdigest".
+const checksumMetadataKey = "uplink-checksum"
+
+// parseChecksumAlgorithm validates the name of a checksum algorithm.
+func parseChecksumAlgorithm(algorithm string) (string, error) {
+	if _, err := newChecksumHash(algorithm); err != nil {
+		return "", err
+	}
+	return algorithm, nil
+}
+
+func newChecksumHash(algorithm string) (hash.Hash, error) {
+	switch algorithm {
+	case "sha256":
+		return sha256.New(), nil
+	case "blake3":
+		return blake3.New(), nil
+	case "crc32c":
+		return crc32.New(crc32.MakeTable(crc32.Castagnoli)), nil
+	default:
+		return nil, errs.New("unknown checksum algorithm %q (sha256, blake3, crc32c)", algorithm)
+	}
+}
+
+// checksummer hashes the data written to it while it is copied.
+type checksummer struct {
+	algorithm string
+	hash      hash.Hash
+}
+
+func newChecksummer(algorithm string) (*checksummer, error) {
+	h, err := newChecksumHash(algorithm)
+	if err != nil {
+		return nil, err
+	}
+	return &checksummer{algorithm: algorithm, hash: h}, nil
+}
+
+// newVerifyingChecksummer returns a checksummer using the algorithm recorded
+// in the expected checksum.
+func newVerifyingChecksummer(expected string) (*checksummer, error) {
+	algorithm, _, ok := strings.Cut(expected, ":")
+	if !ok {
+		return nil, errs.New("invalid checksum %q", expected)
+	}
+	return newChecksummer(algorithm)
+}
+
+func (c *checksummer) Write(p []byte) (int, error) { return c.hash.Write(p) }
+
+// Sum returns the checksum of the written data in the form stored in the
+// object metadata.
+func (c *checksummer) Sum() string {
+	return c.algorithm + ":" + hex.EncodeToString(c.hash.Sum(nil))
+}
+
+// Verify returns an error if the checksum of the written data differs from
+// expected.
+func (c *checksummer) Verify(loc ulloc.Location, expected string) error {
+	if actual := c.Sum(); actual != expected {
+		return errs.New("checksum mismatch for %s: expected %s, got %s", loc, expected, actual)
+	}
+	return nil
+}
+
+// objectChecksum returns the checksum stored in the metadata of the object
+// at the location.
+func objectChecksum(ctx context.Context, mrh ulfs.MultiReadHandle, loc ulloc.Location) (string, error) {
+	info, err := mrh.Info(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	expected, ok := info.Metadata[checksumMetadataKey]
+	if !ok {
+		return "", errs.New("%s has no checksum to verify", loc)
+	}
+	return expected, nil
+}
@@ -44,6 +44,10 @@ type cmdCp struct {
 	expires   time.Time
 	metadata  map[string]string
 
+	checksum   string
+	verifyOnly bool
+	resume     bool
+
 	parallelism          int
 	parallelismChunkSize memory.Size
 
@@ -169,6 +173,17 @@ func (c *cmdCp) Setup(params clingy.Parameters) {
 		"optional metadata for the object. Please use a single level JSON object of string to string only",
 		nil, clingy.Transform(parseJSON), clingy.Type("string")).(map[string]string)
 
+	c.checksum = params.Flag("checksum",
+		"Store a checksum of uploaded data in the object metadata and verify it on download (sha256, blake3, crc32c)",
+		"", clingy.Transform(parseChecksumAlgorithm)).(string)
+	c.verifyOnly = params.Flag("verify-only", "Verify the stored checksum of remote objects without writing anything locally", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+
+	c.resume = params.Flag("resume", "Record the progress of transfers so that an interrupted copy can continue where it left off", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+
 	c.locs = params.Arg("locations", "Locations to copy (at least one source and one destination). Use - for standard input/output",
 		clingy.Transform(ulloc.Parse),
 		clingy.Repeated,
@@ -176,9 +191,22 @@ func (c *cmdCp) Setup(params clingy.Parameters) {
 }
 
 func (c *cmdCp) Execute(ctx context.Context) error {
+	if c.verifyOnly {
+		return c.verifyAll(ctx)
+	}
+
 	if len(c.locs) < 2 {
 		return errs.New("must have at least one source and destination path")
 	}
+	if c.checksum != "" && c.byteRange != "" {
+		return errs.New("unable to verify checksum of a byte range")
+	}
+	if c.resume && c.byteRange != "" {
+		return errs.New("unable to resume a copy of a byte range")
+	}
+	if c.resume && c.checksum != "" {
+		return errs.New("unable to checksum a resumed copy")
+	}
 
 	if c.uploadLogFile != "" {
 		fh, err := os.OpenFile(c.uploadLogFile, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
@@ -219,6 +247,12 @@ func (c *cmdCp) dispatchCopy(ctx context.Context, fs ulfs.Filesystem, source, de
 	if !source.Remote() && !dest.Remote() {
 		return errs.New("at least one location must be a remote sj:// location")
 	}
+	if c.checksum != "" && (source.Std() || dest.Std()) {
+		return errs.New("unable to checksum data from stdin or to stdout")
+	}
+	if c.resume && (source.Std() || dest.Std()) {
+		return errs.New("unable to resume a copy from stdin or to stdout")
+	}
 
 	// we ensure the source and destination are lexically directoryish
 	// if they map to directories. the destination is always converted to be
@@ -410,23 +444,55 @@ func (c *cmdCp) copyFile(ctx context.Context, fs ulfs.Filesystem, source, dest u
 	}
 	defer func() { _ = mrh.Close() }()
 
-	mwh, err := fs.Create(ctx, dest, &ulfs.CreateOptions{
+	// the checksum is computed from the copied data, so downloads verify the
+	// stored checksum and uploads store it without reading anything twice.
+	var sum *checksumCopy
+	if c.checksum != "" {
+		sum, err = c.newChecksumCopy(ctx, source, dest, mrh)
+		if err != nil {
+			return err
+		}
+	}
+
+	var resume *resumeTracker
+	if c.resume {
+		resume, length, err = c.loadResume(ctx, fs, source, dest, mrh)
+		if err != nil {
+			return err
+		}
+	}
+
+	opts := &ulfs.CreateOptions{
 		Expires:  c.expires,
 		Metadata: c.metadata,
-	})
+	}
+	if resume != nil {
+		opts.Resume = true
+		opts.UploadID = resume.UploadID()
+	}
+
+	mwh, err := fs.Create(ctx, dest, opts)
 	if err != nil {
 		return err
 	}
 	defer func() { _ = mwh.Abort(ctx) }()
 
-	// if we're uploading, do a single part of maximum size
-	if dest.Remote() {
+	if pending, ok := mwh.(ulfs.PendingUpload); ok && resume != nil && pending.UploadID() != resume.UploadID() {
+		if err := resume.SetUploadID(ctx, pending.UploadID()); err != nil {
+			return err
+		}
+	}
+
+	// if we're uploading, do a single part of maximum size unless we
+	// need parts to be able to resume. the data is also copied as a single
+	// part when computing a checksum, so that it's hashed in order.
+	if (dest.Remote() && resume == nil) || sum != nil {
 		return errs.Wrap(c.singleCopy(
 			ctx,
 			source, dest,
 			mrh, mwh,
 			offset, length,
-			bar,
+			bar, sum,
 		))
 	}
 
@@ -435,14 +501,231 @@ func (c *cmdCp) copyFile(ctx context.Context, fs ulfs.Filesystem, source, dest u
 		return err
 	}
 
-	return errs.Wrap(c.parallelCopy(
+	err = c.parallelCopy(
 		ctx,
 		source, dest,
 		mrh, mwh,
 		c.parallelism, partSize,
 		offset, length,
-		bar,
-	))
+		bar, resume,
+	)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	if resume != nil {
+		if err := resume.Finish(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checksumCopy computes the checksum of the data while it's copied.
+type checksumCopy struct {
+	*checksummer
+	loc      ulloc.Location
+	expected string
+}
+
+func (c *cmdCp) newChecksumCopy(ctx context.Context, source, dest ulloc.Location, mrh ulfs.MultiReadHandle) (*checksumCopy, error) {
+	if !source.Remote() {
+		sum, err := newChecksummer(c.checksum)
+		if err != nil {
+			return nil, err
+		}
+		return &checksumCopy{checksummer: sum, loc: dest}, nil
+	}
+
+	expected, err := objectChecksum(ctx, mrh, source)
+	if err != nil {
+		return nil, err
+	}
+	sum, err := newVerifyingChecksummer(expected)
+	if err != nil {
+		return nil, err
+	}
+	return &checksumCopy{checksummer: sum, loc: source, expected: expected}, nil
+}
+
+// finish verifies the checksum of downloaded data or stores the checksum of
+// uploaded data. It must be called before the destination is committed, so
+// that corrupt data is never committed.
+func (sum *checksumCopy) finish(dst ulfs.MultiWriteHandle) error {
+	if sum.expected != "" {
+		return sum.Verify(sum.loc, sum.expected)
+	}
+
+	mdh, ok := dst.(ulfs.MetadataWriteHandle)
+	if !ok {
+		return errs.New("unable to store the checksum of %s", sum.loc)
+	}
+	mdh.SetMetadata(checksumMetadataKey, sum.Sum())
+	return nil
+}
+
+// verifyAll checks the stored checksum of every remote source location
+// without writing any data locally.
+func (c *cmdCp) verifyAll(ctx context.Context) error {
+	if len(c.locs) == 0 {
+		return errs.New("must have at least one location to verify")
+	}
+
+	fs, err := c.ex.OpenFilesystem(ctx, c.access)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = fs.Close() }()
+
+	var locs []ulloc.Location
+	for _, loc := range c.locs {
+		if !loc.Remote() {
+			return errs.New("can only verify remote sj:// locations, got %s", loc)
+		}
+		if !c.recursive {
+			locs = append(locs, loc)
+			continue
+		}
+
+		iter, err := fs.List(ctx, loc.AsDirectoryish(), &ulfs.ListOptions{Recursive: true})
+		if err != nil {
+			return err
+		}
+		for iter.Next() {
+			locs = append(locs, iter.Item().Loc)
+		}
+		if err := iter.Err(); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+
+	var (
+		limiter = sync2.NewLimiter(c.transfers)
+		es      errs.Group
+		mu      sync.Mutex
+	)
+
+	for _, loc := range locs {
+		loc := loc
+
+		ok := limiter.Go(ctx, func() {
+			err := c.verifyObject(ctx, fs, loc)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				es.Add(err)
+				fmt.Fprintln(clingy.Stdout(ctx), "verify", loc, "failed:", err)
+				return
+			}
+			fmt.Fprintln(clingy.Stdout(ctx), "verified", loc)
+		})
+		if !ok {
+			break
+		}
+	}
+
+	limiter.Wait()
+
+	if len(es) > 0 {
+		return errs.New("verification failed (%d of %d)", len(es), len(locs))
+	}
+	return nil
+}
+
+func (c *cmdCp) verifyObject(ctx context.Context, fs ulfs.Filesystem, loc ulloc.Location) error {
+	mrh, err := fs.Open(ctx, loc)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = mrh.Close() }()
+
+	expected, err := objectChecksum(ctx, mrh, loc)
+	if err != nil {
+		return err
+	}
+	sum, err := newVerifyingChecksummer(expected)
+	if err != nil {
+		return err
+	}
+
+	rh, err := mrh.NextPart(ctx, -1)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() { _ = rh.Close() }()
+
+	if _, err := sync2.Copy(ctx, sum, rh); err != nil {
+		return errs.Wrap(err)
+	}
+	return sum.Verify(loc, expected)
+}
+
+// loadResume returns the tracker for a resumable copy and the length of the
+// source. Progress from an earlier attempt is discarded if the source changed
+// or the pending upload no longer exists.
+func (c *cmdCp) loadResume(ctx context.Context, fs ulfs.Filesystem, source, dest ulloc.Location, mrh ulfs.MultiReadHandle) (*resumeTracker, int64, error) {
+	loc, ok := resumeStateLocation(source, dest)
+	if !ok {
+		return nil, 0, errs.New("unable to resume a copy without a local file")
+	}
+
+	info, err := mrh.Info(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	partSize, err := c.calculatePartSize(info.ContentLength, c.parallelismChunkSize.Int64())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	resume, err := loadResumeTracker(ctx, fs, loc, resumeState{
+		Source:   source.String(),
+		Dest:     dest.String(),
+		Size:     info.ContentLength,
+		Modified: info.Created,
+		PartSize: partSize,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if uploadID := resume.UploadID(); uploadID != "" {
+		pending, err := hasPendingUpload(ctx, fs, dest, uploadID)
+		if err != nil {
+			return nil, 0, err
+		}
+		if !pending {
+			resume.Reset()
+		}
+	}
+
+	if dest.Local() && !resume.Resumed() {
+		// start from an empty file so that no stale data is left behind.
+		_ = fs.Remove(ctx, dest, nil)
+	}
+
+	return resume, info.ContentLength, nil
+}
+
+// hasPendingUpload returns true if the upload with the id is still pending
+// for the location.
+func hasPendingUpload(ctx context.Context, fs ulfs.Filesystem, loc ulloc.Location, uploadID string) (bool, error) {
+	iter, err := fs.List(ctx, loc, &ulfs.ListOptions{
+		Recursive: true,
+		Pending:   true,
+	})
+	if err != nil {
+		return false, err
+	}
+	for iter.Next() {
+		if item := iter.Item(); item.Loc == loc && item.UploadID == uploadID {
+			return true, nil
+		}
+	}
+	return false, errs.Wrap(iter.Err())
 }
 
 // calculatePartSize returns the needed part size in order to upload the file with size of 'length'.
@@ -495,7 +778,7 @@ func (c *cmdCp) parallelCopy(
 	dst ulfs.MultiWriteHandle,
 	p int, chunkSize int64,
 	offset, length int64,
-	bar *mpb.Bar) error {
+	bar *mpb.Bar, resume *resumeTracker) error {
 
 	if offset != 0 {
 		if err := src.SetOffset(offset); err != nil {
@@ -540,6 +823,7 @@ func (c *cmdCp) parallelCopy(
 		readBufs = ulfs.NewBytesPool(int(chunkSize))
 	}
 
+	var pos int64
 	for i := 0; length != 0; i++ {
 		i := i
 
@@ -549,6 +833,21 @@ func (c *cmdCp) parallelCopy(
 		}
 		length -= chunk
 
+		// parts finished by an earlier attempt are skipped on both sides.
+		if resume != nil && resume.Completed(i) {
+			pos += chunk
+			if err := src.SetOffset(offset + pos); err != nil {
+				addError(errs.New("error skipping part %d: %v", i, err))
+				break
+			}
+			if err := dst.SkipPart(ctx, chunk); err != nil {
+				addError(errs.New("error skipping part %d: %v", i, err))
+				break
+			}
+			continue
+		}
+		pos += chunk
+
 		rh, err := src.NextPart(ctx, chunk)
 		if err != nil {
 			if !errors.Is(err, io.EOF) {
@@ -591,6 +890,9 @@ func (c *cmdCp) parallelCopy(
 			if err == nil {
 				err = wh.Commit()
 			}
+			if err == nil && resume != nil {
+				err = resume.MarkCompleted(ctx, i)
+			}
 
 			if err != nil {
 				// TODO: it would be also nice to use wh.Abort and rh.Close directly
@@ -625,7 +927,7 @@ func (c *cmdCp) singleCopy(
 	src ulfs.MultiReadHandle,
 	dst ulfs.MultiWriteHandle,
 	offset, length int64,
-	bar *mpb.Bar) error {
+	bar *mpb.Bar, sum *checksumCopy) error {
 
 	if offset != 0 {
 		if err := src.SetOffset(offset); err != nil {
@@ -656,11 +958,20 @@ func (c *cmdCp) singleCopy(
 		defer func() { _ = pw.Close() }()
 		w = pw
 	}
+	if sum != nil {
+		w = io.MultiWriter(w, sum)
+	}
 
 	if _, err := sync2.Copy(ctx, w, rh); err != nil {
 		return errs.Wrap(err)
 	}
 
+	if sum != nil {
+		if err := sum.finish(dst); err != nil {
+			return err
+		}
+	}
+
 	if err := wh.Commit(); err != nil {
 		return errs.Wrap(err)
 	}
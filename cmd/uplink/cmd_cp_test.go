@@ -4,11 +4,16 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"storj.io/common/memory"
+	"storj.io/storj/cmd/uplink/ulfs"
+	"storj.io/storj/cmd/uplink/ulloc"
 	"storj.io/storj/cmd/uplink/ultest"
 )
 
@@ -578,3 +583,162 @@ func TestCpMultipleSourcePaths(t *testing.T) {
 		)
 	})
 }
+
+func TestCpChecksum(t *testing.T) {
+	const dataSHA256 = "sha256:3a6eb0790f39ac87c94f3856b2dd2c5d110e6811602261a9a923d3bb23adc8b7"
+
+	state := ultest.Setup(commands,
+		ultest.WithFile("/home/user/file.txt", "data"),
+		ultest.WithFileMetadata("sj://user/good.txt", map[string]string{"uplink-checksum": dataSHA256}, "data"),
+		ultest.WithFileMetadata("sj://user/bad.txt", map[string]string{"uplink-checksum": dataSHA256}, "corrupt"),
+		ultest.WithFile("sj://user/none.txt", "data"),
+	)
+
+	t.Run("Upload", func(t *testing.T) {
+		state.Succeed(t, "cp", "/home/user/file.txt", "sj://user/uploaded.txt", "--checksum", "sha256").RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/bad.txt", Contents: "corrupt", Metadata: map[string]string{"uplink-checksum": dataSHA256}},
+			ultest.File{Loc: "sj://user/good.txt", Contents: "data", Metadata: map[string]string{"uplink-checksum": dataSHA256}},
+			ultest.File{Loc: "sj://user/none.txt", Contents: "data"},
+			ultest.File{Loc: "sj://user/uploaded.txt", Contents: "data", Metadata: map[string]string{"uplink-checksum": dataSHA256}},
+		)
+	})
+
+	t.Run("Download", func(t *testing.T) {
+		state.Succeed(t, "cp", "sj://user/good.txt", "/home/user/good.txt", "--checksum", "sha256").RequireLocalFiles(t,
+			ultest.File{Loc: "/home/user/file.txt", Contents: "data"},
+			ultest.File{Loc: "/home/user/good.txt", Contents: "data"},
+		)
+
+		state.Fail(t, "cp", "sj://user/bad.txt", "/home/user/bad.txt", "--checksum", "sha256").RequireLocalFiles(t,
+			ultest.File{Loc: "/home/user/file.txt", Contents: "data"},
+		)
+
+		state.Fail(t, "cp", "sj://user/none.txt", "/home/user/none.txt", "--checksum", "sha256")
+	})
+
+	t.Run("VerifyOnly", func(t *testing.T) {
+		state.Succeed(t, "cp", "--verify-only", "sj://user/good.txt").RequireStdout(t, `
+			verified sj://user/good.txt
+		`)
+
+		state.Fail(t, "cp", "--verify-only", "sj://user/bad.txt")
+		state.Fail(t, "cp", "--verify-only", "/home/user/file.txt")
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		state.Fail(t, "cp", "/home/user/file.txt", "sj://user/uploaded.txt", "--checksum", "md5")
+		state.Fail(t, "cp", "-", "sj://user/uploaded.txt", "--checksum", "sha256")
+		state.Fail(t, "cp", "sj://user/good.txt", "/home/user/good.txt", "--checksum", "sha256", "--range", "0-1")
+		state.Fail(t, "cp", "/home/user/file.txt", "sj://user/uploaded.txt", "--checksum", "sha256", "--resume")
+	})
+}
+
+func TestCpResume(t *testing.T) {
+	partSize := (64 * memory.MiB).Int64()
+
+	writeState := func(t *testing.T, ctx context.Context, fs ulfs.Filesystem, loc string, state resumeState) {
+		data, err := json.Marshal(state)
+		require.NoError(t, err)
+
+		mwh, err := fs.Create(ctx, ulloc.NewLocal(loc), nil)
+		require.NoError(t, err)
+		wh, err := mwh.NextPart(ctx, int64(len(data)))
+		require.NoError(t, err)
+		_, err = wh.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, wh.Commit())
+		require.NoError(t, mwh.Commit(ctx))
+	}
+
+	t.Run("Fresh", func(t *testing.T) {
+		state := ultest.Setup(commands,
+			ultest.WithFile("/home/user/file.txt", "hello"),
+			ultest.WithBucket("user"),
+		)
+
+		state.Succeed(t, "cp", "/home/user/file.txt", "sj://user/file.txt", "--resume").RequireFiles(t,
+			ultest.File{Loc: "/home/user/file.txt", Contents: "hello"},
+			ultest.File{Loc: "sj://user/file.txt", Contents: "hello"},
+		).RequirePending(t)
+	})
+
+	t.Run("Upload", func(t *testing.T) {
+		state := ultest.Setup(commands,
+			ultest.WithFile("/home/user/file.txt", "hello"),
+			ultest.WithBucket("user"),
+			ultest.WithFilesystem(func(t *testing.T, ctx context.Context, fs ulfs.Filesystem) {
+				// an earlier attempt uploaded the only part before being interrupted.
+				mwh, err := fs.Create(ctx, ulloc.NewRemote("user", "file.txt"), &ulfs.CreateOptions{Resume: true})
+				require.NoError(t, err)
+				wh, err := mwh.NextPart(ctx, 5)
+				require.NoError(t, err)
+				_, err = wh.Write([]byte("HELLO"))
+				require.NoError(t, err)
+				require.NoError(t, wh.Commit())
+
+				writeState(t, ctx, fs, "/home/user/.file.txt.uplink-resume", resumeState{
+					Source:    "/home/user/file.txt",
+					Dest:      "sj://user/file.txt",
+					Size:      5,
+					PartSize:  partSize,
+					UploadID:  mwh.(ulfs.PendingUpload).UploadID(),
+					Completed: []int{0},
+				})
+			}),
+		)
+
+		state.Succeed(t, "cp", "/home/user/file.txt", "sj://user/file.txt", "--resume").RequireFiles(t,
+			ultest.File{Loc: "/home/user/file.txt", Contents: "hello"},
+			ultest.File{Loc: "sj://user/file.txt", Contents: "HELLO"},
+		).RequirePending(t)
+	})
+
+	t.Run("Download", func(t *testing.T) {
+		state := ultest.Setup(commands,
+			ultest.WithFile("sj://user/file.txt", "hello"),
+			ultest.WithFile("/home/user/file.txt", "HELLO"),
+			ultest.WithFilesystem(func(t *testing.T, ctx context.Context, fs ulfs.Filesystem) {
+				writeState(t, ctx, fs, "/home/user/.file.txt.uplink-resume", resumeState{
+					Source:    "sj://user/file.txt",
+					Dest:      "/home/user/file.txt",
+					Size:      5,
+					Modified:  time.Unix(1, 0),
+					PartSize:  partSize,
+					Completed: []int{0},
+				})
+			}),
+		)
+
+		state.Succeed(t, "cp", "sj://user/file.txt", "/home/user/file.txt", "--resume").RequireLocalFiles(t,
+			ultest.File{Loc: "/home/user/file.txt", Contents: "HELLO"},
+		)
+	})
+
+	t.Run("SourceChanged", func(t *testing.T) {
+		state := ultest.Setup(commands,
+			ultest.WithFile("sj://user/file.txt", "hello world"),
+			ultest.WithFile("/home/user/file.txt", "HELLO"),
+			ultest.WithFilesystem(func(t *testing.T, ctx context.Context, fs ulfs.Filesystem) {
+				writeState(t, ctx, fs, "/home/user/.file.txt.uplink-resume", resumeState{
+					Source:    "sj://user/file.txt",
+					Dest:      "/home/user/file.txt",
+					Size:      5,
+					Modified:  time.Unix(1, 0),
+					PartSize:  partSize,
+					Completed: []int{0},
+				})
+			}),
+		)
+
+		state.Succeed(t, "cp", "sj://user/file.txt", "/home/user/file.txt", "--resume").RequireLocalFiles(t,
+			ultest.File{Loc: "/home/user/file.txt", Contents: "hello world"},
+		)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		state := ultest.Setup(commands, ultest.WithFile("sj://user/file.txt", "hello"))
+
+		state.Fail(t, "cp", "sj://user/file.txt", "-", "--resume")
+		state.Fail(t, "cp", "sj://user/file.txt", "/home/user/file.txt", "--resume", "--range", "0-1")
+	})
+}
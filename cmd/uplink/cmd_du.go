@@ -0,0 +1,218 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/memory"
+	"storj.io/storj/cmd/uplink/ulext"
+	"storj.io/storj/cmd/uplink/ulfs"
+	"storj.io/storj/cmd/uplink/ulloc"
+)
+
+type cmdDu struct {
+	ex ulext.External
+
+	access    string
+	encrypted bool
+	pending   bool
+	depth     int
+	human     bool
+	output    string
+
+	prefix *ulloc.Location
+}
+
+func newCmdDu(ex ulext.External) *cmdDu {
+	return &cmdDu{ex: ex}
+}
+
+func (c *cmdDu) Setup(params clingy.Parameters) {
+	c.access = params.Flag("access", "Access name or value to use", "").(string)
+	c.encrypted = params.Flag("encrypted", "Shows keys base64 encoded without decrypting", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+	c.pending = params.Flag("pending", "Summarize pending object uploads instead", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+	c.depth = params.Flag("depth", "Summarize prefixes up to this many levels below the given prefix", 0,
+		clingy.Short('d'),
+		clingy.Transform(strconv.Atoi),
+		clingy.Transform(func(n int) (int, error) {
+			if n < 0 {
+				return 0, errs.New("depth cannot be below 0")
+			}
+			return n, nil
+		}),
+	).(int)
+	c.human = params.Flag("human-readable", "Print sizes in human readable units", false,
+		clingy.Short('H'),
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+	c.output = params.Flag("output", "Output Format (tabbed, json)", "tabbed",
+		clingy.Short('o'),
+	).(string)
+
+	c.prefix = params.Arg("prefix", "Prefix to summarize (sj://BUCKET[/KEY]), or every bucket if omitted", clingy.Optional,
+		clingy.Transform(ulloc.Parse),
+	).(*ulloc.Location)
+}
+
+// duUsage is the space used by the objects under some prefix.
+type duUsage struct {
+	Prefix  string `json:"prefix"`
+	Objects int64  `json:"objects"`
+	Bytes   int64  `json:"bytes"`
+}
+
+func (c *cmdDu) Execute(ctx context.Context) error {
+	switch c.output {
+	case "tabbed", "json":
+	default:
+		return errs.New("unknown output format, got %s", c.output)
+	}
+
+	fs, err := c.ex.OpenFilesystem(ctx, c.access, ulext.BypassEncryption(c.encrypted))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = fs.Close() }()
+
+	var prefixes []ulloc.Location
+	if c.prefix != nil {
+		if !c.prefix.Remote() {
+			return errs.New("prefix must be remote")
+		}
+		prefixes = append(prefixes, c.prefix.AsDirectoryish())
+	} else {
+		prefixes, err = c.bucketPrefixes(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	var usages []duUsage
+	for _, prefix := range prefixes {
+		summary, err := c.summarize(ctx, fs, prefix)
+		if err != nil {
+			return err
+		}
+		usages = append(usages, summary...)
+	}
+
+	if c.output == "json" {
+		return c.printJSON(ctx, usages)
+	}
+	return c.printTabbed(ctx, usages)
+}
+
+// bucketPrefixes returns a location for the root of every bucket.
+func (c *cmdDu) bucketPrefixes(ctx context.Context) (prefixes []ulloc.Location, err error) {
+	project, err := c.ex.OpenProject(ctx, c.access)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = project.Close() }()
+
+	iter := project.ListBuckets(ctx, nil)
+	for iter.Next() {
+		prefixes = append(prefixes, ulloc.NewRemote(iter.Item().Name, ""))
+	}
+	return prefixes, errs.Wrap(iter.Err())
+}
+
+// summarize walks every object under prefix and returns the usage of each
+// sub-prefix up to the configured depth followed by the total for prefix.
+func (c *cmdDu) summarize(ctx context.Context, fs ulfs.Filesystem, prefix ulloc.Location) ([]duUsage, error) {
+	iter, err := fs.List(ctx, prefix, &ulfs.ListOptions{
+		Recursive: true,
+		Pending:   c.pending,
+		Expanded:  true,
+		PartSizes: c.pending,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var total duUsage
+	nested := make(map[string]*duUsage)
+
+	for iter.Next() {
+		item := iter.Item()
+		if item.IsPrefix {
+			continue
+		}
+
+		total.Objects++
+		total.Bytes += item.ContentLength
+
+		rel, err := prefix.RelativeTo(item.Loc)
+		if err != nil {
+			return nil, err
+		}
+
+		// every component but the last is a prefix that contains the object
+		parts := strings.Split(rel, "/")
+		for d := 1; d <= c.depth && d < len(parts); d++ {
+			key := strings.Join(parts[:d], "/") + "/"
+			usage, ok := nested[key]
+			if !ok {
+				usage = &duUsage{Prefix: prefix.AppendKey(key).String()}
+				nested[key] = usage
+			}
+			usage.Objects++
+			usage.Bytes += item.ContentLength
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	keys := make([]string, 0, len(nested))
+	for key := range nested {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	usages := make([]duUsage, 0, len(keys)+1)
+	for _, key := range keys {
+		usages = append(usages, *nested[key])
+	}
+
+	total.Prefix = prefix.String()
+	return append(usages, total), nil
+}
+
+func (c *cmdDu) printTabbed(ctx context.Context, usages []duUsage) error {
+	tw := newTabbedWriter(clingy.Stdout(ctx), "OBJECTS", "SIZE", "PREFIX")
+	defer tw.Done()
+
+	for _, usage := range usages {
+		var size interface{} = usage.Bytes
+		if c.human {
+			size = memory.Size(usage.Bytes).String()
+		}
+		tw.WriteLine(usage.Objects, size, usage.Prefix)
+	}
+	return nil
+}
+
+func (c *cmdDu) printJSON(ctx context.Context, usages []duUsage) error {
+	jw := json.NewEncoder(clingy.Stdout(ctx))
+
+	for _, usage := range usages {
+		if err := jw.Encode(usage); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"testing"
+
+	"storj.io/storj/cmd/uplink/ultest"
+)
+
+func TestDu(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFile("sj://user/a/1", "1"),
+		ultest.WithFile("sj://user/a/b/2", "22"),
+		ultest.WithFile("sj://user/a/b/3", "333"),
+		ultest.WithFile("sj://user/c/4", "4444"),
+		ultest.WithFile("sj://user/5", "55555"),
+		ultest.WithFile("/home/user/6", "666666"),
+
+		ultest.WithPendingFile("sj://user/a/pending"),
+		ultest.WithPendingFile("sj://user/c/pending", "abc", "de"),
+	)
+
+	t.Run("Errors", func(t *testing.T) {
+		state.Fail(t, "du", "/home/user")
+		state.Fail(t, "du", "sj://user", "--output", "xml")
+	})
+
+	t.Run("Total", func(t *testing.T) {
+		state.Succeed(t, "du", "sj://user").RequireStdout(t, `
+			OBJECTS    SIZE    PREFIX
+			5          15      sj://user/
+		`)
+
+		state.Succeed(t, "du", "sj://user/a").RequireStdout(t, `
+			OBJECTS    SIZE    PREFIX
+			3          6       sj://user/a/
+		`)
+	})
+
+	t.Run("Depth", func(t *testing.T) {
+		state.Succeed(t, "du", "sj://user", "--depth", "1").RequireStdout(t, `
+			OBJECTS    SIZE    PREFIX
+			3          6       sj://user/a/
+			1          4       sj://user/c/
+			5          15      sj://user/
+		`)
+
+		state.Succeed(t, "du", "sj://user", "--depth", "2").RequireStdout(t, `
+			OBJECTS    SIZE    PREFIX
+			3          6       sj://user/a/
+			2          5       sj://user/a/b/
+			1          4       sj://user/c/
+			5          15      sj://user/
+		`)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		state.Succeed(t, "du", "sj://user/a", "--depth", "1", "--output", "json").RequireStdout(t, `
+			{"prefix":"sj://user/a/b/","objects":2,"bytes":5}
+			{"prefix":"sj://user/a/","objects":3,"bytes":6}
+		`)
+	})
+
+	t.Run("Pending", func(t *testing.T) {
+		// the size of a pending upload is the size of its uploaded parts.
+		state.Succeed(t, "du", "sj://user", "--pending", "--depth", "1").RequireStdout(t, `
+			OBJECTS    SIZE    PREFIX
+			1          0       sj://user/a/
+			1          5       sj://user/c/
+			2          5       sj://user/
+		`)
+	})
+}
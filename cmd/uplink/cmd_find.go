@@ -0,0 +1,269 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/memory"
+	"storj.io/storj/cmd/uplink/ulext"
+	"storj.io/storj/cmd/uplink/ulfs"
+	"storj.io/storj/cmd/uplink/ulloc"
+)
+
+type cmdFind struct {
+	ex ulext.External
+
+	access    string
+	encrypted bool
+	pending   bool
+	utc       bool
+	output    string
+	print0    bool
+
+	filter objectFilter
+
+	prefix ulloc.Location
+}
+
+func newCmdFind(ex ulext.External) *cmdFind {
+	return &cmdFind{ex: ex}
+}
+
+func (c *cmdFind) Setup(params clingy.Parameters) {
+	c.access = params.Flag("access", "Access name or value to use", "").(string)
+	c.encrypted = params.Flag("encrypted", "Shows keys base64 encoded without decrypting", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+	c.pending = params.Flag("pending", "Find pending object uploads instead", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+	c.utc = params.Flag("utc", "Show all timestamps in UTC instead of local time", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+	c.output = params.Flag("output", "Output Format (tabbed, json)", "tabbed",
+		clingy.Short('o'),
+	).(string)
+	c.print0 = params.Flag("print0", "Print only the locations separated by NUL characters, for use with xargs -0", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+
+	params.Break()
+
+	c.filter.name = params.Flag("name", "Only objects whose base name matches the glob pattern", "").(string)
+	c.filter.regex = params.Flag("regex", "Only objects whose key matches the regular expression", (*regexp.Regexp)(nil),
+		clingy.Transform(regexp.Compile),
+	).(*regexp.Regexp)
+	c.filter.minSize = params.Flag("min-size", "Only objects at least this large (e.g. 1GiB)", nil,
+		clingy.Transform(memory.ParseString), clingy.Optional,
+	).(*int64)
+	c.filter.maxSize = params.Flag("max-size", "Only objects at most this large (e.g. 1GiB)", nil,
+		clingy.Transform(memory.ParseString), clingy.Optional,
+	).(*int64)
+	c.filter.createdAfter = params.Flag("created-after",
+		"Only objects created after this time (e.g. '-30d', '2020-01-02T15:04:05Z0700')",
+		nil, clingy.Transform(parseHumanDate), clingy.Type("relative_date"), clingy.Optional).(*time.Time)
+	c.filter.createdBefore = params.Flag("created-before",
+		"Only objects created before this time (e.g. '-30d', '2020-01-02T15:04:05Z0700')",
+		nil, clingy.Transform(parseHumanDate), clingy.Type("relative_date"), clingy.Optional).(*time.Time)
+	c.filter.expiresAfter = params.Flag("expires-after",
+		"Only objects that expire after this time (e.g. '+7d', '2020-01-02T15:04:05Z0700')",
+		nil, clingy.Transform(parseHumanDate), clingy.Type("relative_date"), clingy.Optional).(*time.Time)
+	c.filter.expiresBefore = params.Flag("expires-before",
+		"Only objects that expire before this time (e.g. '+7d', '2020-01-02T15:04:05Z0700')",
+		nil, clingy.Transform(parseHumanDate), clingy.Type("relative_date"), clingy.Optional).(*time.Time)
+	c.filter.meta = params.Flag("meta", "Only objects with a metadata entry KEY=VALUE, where VALUE may be a glob pattern", nil,
+		clingy.Transform(parseMetaEntry), clingy.Repeated,
+	).([]metaEntry)
+
+	c.prefix = params.Arg("prefix", "Prefix to search (sj://BUCKET[/KEY])",
+		clingy.Transform(ulloc.Parse),
+	).(ulloc.Location)
+}
+
+func (c *cmdFind) Execute(ctx context.Context) error {
+	switch c.output {
+	case "tabbed", "json":
+	default:
+		return errs.New("unknown output format, got %s", c.output)
+	}
+	if err := c.filter.validate(); err != nil {
+		return err
+	}
+
+	fs, err := c.ex.OpenFilesystem(ctx, c.access, ulext.BypassEncryption(c.encrypted))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = fs.Close() }()
+
+	prefix := c.prefix
+	if fs.IsLocalDir(ctx, prefix) {
+		prefix = prefix.AsDirectoryish()
+	}
+
+	iter, err := fs.List(ctx, prefix, &ulfs.ListOptions{
+		Recursive: true,
+		Pending:   c.pending,
+		Expanded:  true,
+	})
+	if err != nil {
+		return err
+	}
+	iter = &filteredIterator{ObjectIterator: iter, filter: &c.filter}
+
+	switch {
+	case c.print0:
+		return c.printNull(ctx, iter)
+	case c.output == "json":
+		return c.printJSON(ctx, iter)
+	default:
+		return c.printTabbed(ctx, iter)
+	}
+}
+
+func (c *cmdFind) printNull(ctx context.Context, iter ulfs.ObjectIterator) error {
+	for iter.Next() {
+		if _, err := fmt.Fprintf(clingy.Stdout(ctx), "%s\x00", iter.Item().Loc); err != nil {
+			return err
+		}
+	}
+	return iter.Err()
+}
+
+func (c *cmdFind) printTabbed(ctx context.Context, iter ulfs.ObjectIterator) error {
+	tw := newTabbedWriter(clingy.Stdout(ctx), "CREATED", "SIZE", "EXPIRES", "LOCATION")
+	defer tw.Done()
+
+	for iter.Next() {
+		obj := iter.Item()
+		tw.WriteLine(formatTime(c.utc, obj.Created), obj.ContentLength, formatTime(c.utc, obj.Expires), obj.Loc)
+	}
+	return iter.Err()
+}
+
+func (c *cmdFind) printJSON(ctx context.Context, iter ulfs.ObjectIterator) error {
+	jw := json.NewEncoder(clingy.Stdout(ctx))
+
+	for iter.Next() {
+		obj := iter.Item()
+
+		err := jw.Encode(struct {
+			Location string            `json:"location"`
+			Created  string            `json:"created"`
+			Size     int64             `json:"size"`
+			Expires  string            `json:"expires,omitempty"`
+			Metadata map[string]string `json:"metadata,omitempty"`
+		}{obj.Loc.String(), formatTime(c.utc, obj.Created), obj.ContentLength, formatTime(c.utc, obj.Expires), obj.Metadata})
+		if err != nil {
+			return err
+		}
+	}
+	return iter.Err()
+}
+
+// objectFilter is a set of predicates that an object must all satisfy.
+// Unset predicates match every object.
+type objectFilter struct {
+	name  string
+	regex *regexp.Regexp
+
+	minSize *int64
+	maxSize *int64
+
+	createdAfter  *time.Time
+	createdBefore *time.Time
+	expiresAfter  *time.Time
+	expiresBefore *time.Time
+
+	meta []metaEntry
+}
+
+// validate checks that the glob patterns of the filter are well formed.
+func (f *objectFilter) validate() error {
+	if _, err := path.Match(f.name, ""); err != nil {
+		return errs.New("invalid name pattern %q: %v", f.name, err)
+	}
+	for _, entry := range f.meta {
+		if _, err := path.Match(entry.value, ""); err != nil {
+			return errs.New("invalid metadata pattern %q: %v", entry.value, err)
+		}
+	}
+	return nil
+}
+
+// match returns true if the object satisfies every predicate.
+func (f *objectFilter) match(obj ulfs.ObjectInfo) bool {
+	if obj.IsPrefix {
+		return false
+	}
+
+	if f.name != "" {
+		base, _ := obj.Loc.Base()
+		if ok, _ := path.Match(f.name, base); !ok {
+			return false
+		}
+	}
+	if f.regex != nil && !f.regex.MatchString(obj.Loc.Loc()) {
+		return false
+	}
+
+	if f.minSize != nil && obj.ContentLength < *f.minSize {
+		return false
+	}
+	if f.maxSize != nil && obj.ContentLength > *f.maxSize {
+		return false
+	}
+
+	if f.createdAfter != nil && !obj.Created.After(*f.createdAfter) {
+		return false
+	}
+	if f.createdBefore != nil && !obj.Created.Before(*f.createdBefore) {
+		return false
+	}
+	// objects without an expiration never expire, so they are after any time.
+	if f.expiresAfter != nil && !obj.Expires.IsZero() && !obj.Expires.After(*f.expiresAfter) {
+		return false
+	}
+	if f.expiresBefore != nil && (obj.Expires.IsZero() || !obj.Expires.Before(*f.expiresBefore)) {
+		return false
+	}
+
+	for _, entry := range f.meta {
+		value, ok := obj.Metadata[entry.key]
+		if !ok {
+			return false
+		}
+		if ok, _ := path.Match(entry.value, value); !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// filteredIterator only returns the objects of the wrapped iterator that
+// match the filter.
+type filteredIterator struct {
+	ulfs.ObjectIterator
+	filter *objectFilter
+}
+
+func (it *filteredIterator) Next() bool {
+	for it.ObjectIterator.Next() {
+		if it.filter.match(it.ObjectIterator.Item()) {
+			return true
+		}
+	}
+	return false
+}
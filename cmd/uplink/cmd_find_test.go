@@ -0,0 +1,64 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"testing"
+
+	"storj.io/storj/cmd/uplink/ultest"
+)
+
+func TestFind(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFileMetadata("sj://user/logs/a.log", map[string]string{"owner": "alice"}, "1"),
+		ultest.WithFileMetadata("sj://user/logs/b.log", map[string]string{"owner": "bob"}, "22"),
+		ultest.WithFile("sj://user/logs/c.txt", "333"),
+		ultest.WithFile("sj://user/data/d.bin", "4444"),
+	)
+
+	t.Run("Errors", func(t *testing.T) {
+		state.Fail(t, "find", "sj://user", "--output", "xml")
+		state.Fail(t, "find", "sj://user", "--name", "[")
+		state.Fail(t, "find", "sj://user", "--regex", "(")
+		state.Fail(t, "find", "sj://user", "--meta", "owner")
+	})
+
+	t.Run("All", func(t *testing.T) {
+		state.Succeed(t, "find", "sj://user", "--print0").RequireStdout(t,
+			"sj://user/data/d.bin\x00sj://user/logs/a.log\x00sj://user/logs/b.log\x00sj://user/logs/c.txt\x00",
+		)
+	})
+
+	t.Run("Name", func(t *testing.T) {
+		state.Succeed(t, "find", "sj://user", "--name", "*.log", "--print0").RequireStdout(t,
+			"sj://user/logs/a.log\x00sj://user/logs/b.log\x00",
+		)
+		state.Succeed(t, "find", "sj://user", "--regex", "^data/", "--print0").RequireStdout(t,
+			"sj://user/data/d.bin\x00",
+		)
+	})
+
+	t.Run("Size", func(t *testing.T) {
+		state.Succeed(t, "find", "sj://user", "--min-size", "2B", "--max-size", "3B", "--print0").RequireStdout(t,
+			"sj://user/logs/b.log\x00sj://user/logs/c.txt\x00",
+		)
+	})
+
+	t.Run("Created", func(t *testing.T) {
+		state.Succeed(t, "find", "sj://user", "--created-after", "-1d", "--print0").RequireStdout(t, "")
+		state.Succeed(t, "find", "sj://user", "--created-before", "-1d", "--name", "*.bin", "--print0").RequireStdout(t,
+			"sj://user/data/d.bin\x00",
+		)
+	})
+
+	t.Run("Metadata", func(t *testing.T) {
+		state.Succeed(t, "find", "sj://user", "--meta", "owner=b*", "--print0").RequireStdout(t,
+			"sj://user/logs/b.log\x00",
+		)
+		state.Succeed(t, "find", "sj://user", "--meta", "owner=*", "--output", "json", "--utc").RequireStdout(t, `
+			{"location":"sj://user/logs/a.log","created":"1970-01-01 00:00:01","size":1,"metadata":{"owner":"alice"}}
+			{"location":"sj://user/logs/b.log","created":"1970-01-01 00:00:02","size":2,"metadata":{"owner":"bob"}}
+		`)
+	})
+}
@@ -0,0 +1,48 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/cmd/uplink/ulext"
+	"storj.io/storj/cmd/uplink/ulloc"
+	"storj.io/uplink"
+)
+
+type cmdMetaReplace struct {
+	metaUpdater
+
+	metadata map[string]string
+	location ulloc.Location
+}
+
+func newCmdMetaReplace(ex ulext.External) *cmdMetaReplace {
+	return &cmdMetaReplace{metaUpdater: metaUpdater{ex: ex}}
+}
+
+func (c *cmdMetaReplace) Setup(params clingy.Parameters) {
+	c.metaUpdater.setup(params)
+
+	c.metadata = params.Flag("from-json",
+		"New metadata for the object. Please use a single level JSON object of string to string only",
+		nil, clingy.Transform(parseJSON), clingy.Type("string")).(map[string]string)
+
+	c.location = params.Arg("location", "Location of object or prefix (sj://BUCKET/KEY)",
+		clingy.Transform(ulloc.Parse),
+	).(ulloc.Location)
+}
+
+func (c *cmdMetaReplace) Execute(ctx context.Context) error {
+	if c.metadata == nil {
+		return errs.New("--from-json is required")
+	}
+
+	return c.update(ctx, c.location, func(uplink.CustomMetadata) uplink.CustomMetadata {
+		return uplink.CustomMetadata(c.metadata).Clone()
+	})
+}
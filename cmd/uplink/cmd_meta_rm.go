@@ -0,0 +1,45 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+
+	"github.com/zeebo/clingy"
+
+	"storj.io/storj/cmd/uplink/ulext"
+	"storj.io/storj/cmd/uplink/ulloc"
+	"storj.io/uplink"
+)
+
+type cmdMetaRm struct {
+	metaUpdater
+
+	location ulloc.Location
+	keys     []string
+}
+
+func newCmdMetaRm(ex ulext.External) *cmdMetaRm {
+	return &cmdMetaRm{metaUpdater: metaUpdater{ex: ex}}
+}
+
+func (c *cmdMetaRm) Setup(params clingy.Parameters) {
+	c.metaUpdater.setup(params)
+
+	c.location = params.Arg("location", "Location of object or prefix (sj://BUCKET/KEY)",
+		clingy.Transform(ulloc.Parse),
+	).(ulloc.Location)
+	c.keys = params.Arg("keys", "Metadata entries to remove",
+		clingy.Repeated,
+	).([]string)
+}
+
+func (c *cmdMetaRm) Execute(ctx context.Context) error {
+	return c.update(ctx, c.location, func(metadata uplink.CustomMetadata) uplink.CustomMetadata {
+		for _, key := range c.keys {
+			delete(metadata, key)
+		}
+		return metadata
+	})
+}
@@ -0,0 +1,47 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+
+	"github.com/zeebo/clingy"
+
+	"storj.io/storj/cmd/uplink/ulext"
+	"storj.io/storj/cmd/uplink/ulloc"
+	"storj.io/uplink"
+)
+
+type cmdMetaSet struct {
+	metaUpdater
+
+	location ulloc.Location
+	entries  []metaEntry
+}
+
+func newCmdMetaSet(ex ulext.External) *cmdMetaSet {
+	return &cmdMetaSet{metaUpdater: metaUpdater{ex: ex}}
+}
+
+func (c *cmdMetaSet) Setup(params clingy.Parameters) {
+	c.metaUpdater.setup(params)
+
+	c.location = params.Arg("location", "Location of object or prefix (sj://BUCKET/KEY)",
+		clingy.Transform(ulloc.Parse),
+	).(ulloc.Location)
+
+	c.entries = params.Arg("entries", "Metadata entries to set (KEY=VALUE)",
+		clingy.Transform(parseMetaEntry),
+		clingy.Repeated,
+	).([]metaEntry)
+}
+
+func (c *cmdMetaSet) Execute(ctx context.Context) error {
+	return c.update(ctx, c.location, func(metadata uplink.CustomMetadata) uplink.CustomMetadata {
+		for _, entry := range c.entries {
+			metadata[entry.key] = entry.value
+		}
+		return metadata
+	})
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/cmd/uplink/ultest"
+)
+
+func TestMetaSetErrors(t *testing.T) {
+	state := ultest.Setup(commands)
+
+	// metadata can only be set on remote objects
+	state.Fail(t, "meta", "set", "/home/user/file.txt", "key=value")
+
+	// entries must be of the form KEY=VALUE
+	state.Fail(t, "meta", "set", "sj://user/file.txt", "key")
+	state.Fail(t, "meta", "set", "sj://user/file.txt", "=value")
+
+	// replace requires the new metadata
+	state.Fail(t, "meta", "replace", "sj://user/file.txt")
+}
+
+func TestMetaSet(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFileMetadata("sj://user/file.txt", map[string]string{"keep": "1", "change": "old"}, "data"),
+	)
+
+	state.Succeed(t, "meta", "set", "sj://user/file.txt", "change=new", "add=2").RequireRemoteFiles(t,
+		ultest.File{Loc: "sj://user/file.txt", Contents: "data", Metadata: map[string]string{"keep": "1", "change": "new", "add": "2"}},
+	)
+}
+
+func TestMetaRm(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFileMetadata("sj://user/file.txt", map[string]string{"keep": "1", "remove": "2"}, "data"),
+	)
+
+	state.Succeed(t, "meta", "rm", "sj://user/file.txt", "remove", "missing").RequireRemoteFiles(t,
+		ultest.File{Loc: "sj://user/file.txt", Contents: "data", Metadata: map[string]string{"keep": "1"}},
+	)
+}
+
+func TestMetaReplace(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFileMetadata("sj://user/file.txt", map[string]string{"old": "1"}, "data"),
+	)
+
+	state.Succeed(t, "meta", "replace", "sj://user/file.txt", "--from-json", `{"new":"2"}`).RequireRemoteFiles(t,
+		ultest.File{Loc: "sj://user/file.txt", Contents: "data", Metadata: map[string]string{"new": "2"}},
+	)
+}
+
+func TestMetaSetRecursive(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFileMetadata("sj://user/dir/a.txt", map[string]string{"keep": "a"}, "a"),
+		ultest.WithFile("sj://user/dir/sub/b.txt", "b"),
+		ultest.WithFile("sj://user/other.txt", "c"),
+	)
+
+	state.Succeed(t, "meta", "set", "--recursive", "sj://user/dir", "key=value").RequireRemoteFiles(t,
+		ultest.File{Loc: "sj://user/dir/a.txt", Contents: "a", Metadata: map[string]string{"keep": "a", "key": "value"}},
+		ultest.File{Loc: "sj://user/dir/sub/b.txt", Contents: "b", Metadata: map[string]string{"key": "value"}},
+		ultest.File{Loc: "sj://user/other.txt", Contents: "c"},
+	)
+}
+
+func TestParseMetaEntry(t *testing.T) {
+	entry, err := parseMetaEntry("key=value=more")
+	require.NoError(t, err)
+	require.Equal(t, metaEntry{key: "key", value: "value=more"}, entry)
+
+	entry, err = parseMetaEntry("key=")
+	require.NoError(t, err)
+	require.Equal(t, metaEntry{key: "key"}, entry)
+
+	_, err = parseMetaEntry("key")
+	require.Error(t, err)
+}
@@ -0,0 +1,199 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+	"golang.org/x/net/webdav"
+
+	"storj.io/storj/cmd/uplink/ulext"
+	"storj.io/storj/cmd/uplink/ulfs"
+	"storj.io/storj/cmd/uplink/ulloc"
+)
+
+type cmdServe struct {
+	ex       ulext.External
+	protocol string
+
+	access    string
+	address   string
+	readWrite bool
+
+	root ulloc.Location
+}
+
+func newCmdServeHTTP(ex ulext.External) *cmdServe {
+	return &cmdServe{ex: ex, protocol: "http"}
+}
+
+func newCmdServeWebDAV(ex ulext.External) *cmdServe {
+	return &cmdServe{ex: ex, protocol: "webdav"}
+}
+
+func (c *cmdServe) Setup(params clingy.Parameters) {
+	c.access = params.Flag("access", "Access name or value to use", "").(string)
+	c.address = params.Flag("address", "Address to listen on", "127.0.0.1:7778").(string)
+	c.readWrite = params.Flag("read-write", "Allow clients to upload, move and delete objects", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+
+	c.root = params.Arg("root", "Bucket or prefix to serve (sj://BUCKET[/PREFIX])",
+		clingy.Transform(ulloc.Parse),
+	).(ulloc.Location)
+}
+
+func (c *cmdServe) Execute(ctx context.Context) error {
+	if !c.root.Remote() {
+		return errs.New("root must be remote")
+	}
+
+	fs, err := c.ex.OpenFilesystem(ctx, c.access)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = fs.Close() }()
+
+	lis, err := net.Listen("tcp", c.address)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	server := &http.Server{
+		Handler:           c.handler(fs),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	mode := "read-only"
+	if c.readWrite {
+		mode = "read-write"
+	}
+	fmt.Fprintf(clingy.Stdout(ctx), "serving %s over %s (%s) at http://%s/\n", c.root, c.protocol, mode, lis.Addr())
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- server.Serve(lis) }()
+
+	select {
+	case err := <-errCh:
+		return errs.Wrap(err)
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return errs.Wrap(server.Shutdown(shutdownCtx))
+	}
+}
+
+// handler returns the http.Handler that serves the root of fs with the
+// configured protocol.
+func (c *cmdServe) handler(fs ulfs.Filesystem) http.Handler {
+	sfs := &serveFilesystem{fs: fs, root: c.root, writable: c.readWrite}
+
+	switch c.protocol {
+	case "webdav":
+		return c.readOnly(&webdav.Handler{
+			FileSystem: sfs,
+			LockSystem: webdav.NewMemLS(),
+		}, "PROPFIND")
+	default:
+		return c.readOnly(&serveHTTPHandler{fs: sfs})
+	}
+}
+
+// readOnly rejects every request that could modify objects unless the
+// server is read-write. Besides GET, HEAD and OPTIONS, the extra methods
+// are allowed because they only read.
+func (c *cmdServe) readOnly(next http.Handler, extra ...string) http.Handler {
+	if c.readWrite {
+		return next
+	}
+
+	allowed := map[string]bool{
+		http.MethodGet:     true,
+		http.MethodHead:    true,
+		http.MethodOptions: true,
+	}
+	for _, method := range extra {
+		allowed[method] = true
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !allowed[req.Method] {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
+// serveHTTPHandler serves objects over plain http. Reads support ranges and
+// directory listings, and PUT and DELETE upload and remove single objects.
+type serveHTTPHandler struct {
+	fs *serveFilesystem
+}
+
+func (h *serveHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	switch req.Method {
+	case http.MethodPut:
+		upload, err := h.fs.upload(ctx, req.URL.Path)
+		if err != nil {
+			serveError(w, err)
+			return
+		}
+		if _, err := io.Copy(upload, req.Body); err != nil {
+			_ = upload.Abort()
+			serveError(w, err)
+			return
+		}
+		if err := upload.Close(); err != nil {
+			serveError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+
+	case http.MethodDelete:
+		if err := h.fs.RemoveAll(ctx, req.URL.Path); err != nil {
+			serveError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+
+	case http.MethodGet, http.MethodHead:
+		http.FileServer(serveHTTPFilesystem{ctx: ctx, fs: h.fs}).ServeHTTP(w, req)
+
+	default:
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+	}
+}
+
+// serveHTTPFilesystem adapts a serveFilesystem to an http.FileSystem.
+type serveHTTPFilesystem struct {
+	ctx context.Context
+	fs  *serveFilesystem
+}
+
+func (s serveHTTPFilesystem) Open(name string) (http.File, error) {
+	return s.fs.OpenFile(s.ctx, name, os.O_RDONLY, 0)
+}
+
+func serveError(w http.ResponseWriter, err error) {
+	switch {
+	case errs.Is(err, os.ErrNotExist):
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+	case errs.Is(err, os.ErrPermission):
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/cmd/uplink/ulfs"
+	"storj.io/storj/cmd/uplink/ulloc"
+	"storj.io/storj/cmd/uplink/ultest"
+)
+
+func TestServe(t *testing.T) {
+	ctx := context.Background()
+
+	newHandler := func(t *testing.T, protocol string, readWrite bool) (ulfs.Filesystem, http.Handler) {
+		fs := ultest.NewFilesystem("bucket")
+		writeServeFile(ctx, t, fs, "sj://bucket/root/a/1.txt", "hello world")
+		writeServeFile(ctx, t, fs, "sj://bucket/root/b", "b")
+		writeServeFile(ctx, t, fs, "sj://bucket/other", "other")
+
+		c := &cmdServe{protocol: protocol, readWrite: readWrite, root: ulloc.NewRemote("bucket", "root")}
+		return fs, c.handler(fs)
+	}
+
+	newServer := func(t *testing.T, protocol string, readWrite bool) (ulfs.Filesystem, *httptest.Server) {
+		fs, handler := newHandler(t, protocol, readWrite)
+		server := httptest.NewServer(handler)
+		t.Cleanup(server.Close)
+		return fs, server
+	}
+
+	do := func(t *testing.T, method, url, body string, header ...string) (int, string) {
+		req, err := http.NewRequestWithContext(ctx, method, url, strings.NewReader(body))
+		require.NoError(t, err)
+		for i := 0; i+1 < len(header); i += 2 {
+			req.Header.Set(header[i], header[i+1])
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+		data, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, string(data)
+	}
+
+	t.Run("HTTP", func(t *testing.T) {
+		_, server := newServer(t, "http", false)
+
+		code, body := do(t, "GET", server.URL+"/a/1.txt", "")
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, "hello world", body)
+
+		code, body = do(t, "GET", server.URL+"/a/1.txt", "", "Range", "bytes=6-")
+		require.Equal(t, http.StatusPartialContent, code)
+		require.Equal(t, "world", body)
+
+		code, body = do(t, "GET", server.URL+"/", "")
+		require.Equal(t, http.StatusOK, code)
+		require.Contains(t, body, `href="a/"`)
+		require.Contains(t, body, `href="b"`)
+		require.NotContains(t, body, "other")
+
+		code, _ = do(t, "PUT", server.URL+"/c", "c")
+		require.Equal(t, http.StatusMethodNotAllowed, code)
+	})
+
+	t.Run("HTTPReadWrite", func(t *testing.T) {
+		fs, server := newServer(t, "http", true)
+
+		code, _ := do(t, "PUT", server.URL+"/c/d", "uploaded")
+		require.Equal(t, http.StatusCreated, code)
+		require.Equal(t, "uploaded", readServeFile(ctx, t, fs, "sj://bucket/root/c/d"))
+
+		code, _ = do(t, "DELETE", server.URL+"/b", "")
+		require.Equal(t, http.StatusNoContent, code)
+		_, err := fs.Stat(ctx, ulloc.NewRemote("bucket", "root/b"))
+		require.Error(t, err)
+	})
+
+	t.Run("WebDAV", func(t *testing.T) {
+		_, server := newServer(t, "webdav", false)
+
+		code, body := do(t, "PROPFIND", server.URL+"/a/", "", "Depth", "1")
+		require.Equal(t, http.StatusMultiStatus, code)
+		require.Contains(t, body, "/a/1.txt")
+		require.Contains(t, body, "text/plain")
+
+		code, body = do(t, "GET", server.URL+"/a/1.txt", "", "Range", "bytes=0-4")
+		require.Equal(t, http.StatusPartialContent, code)
+		require.Equal(t, "hello", body)
+
+		code, _ = do(t, "PUT", server.URL+"/c", "c")
+		require.Equal(t, http.StatusMethodNotAllowed, code)
+		code, _ = do(t, "DELETE", server.URL+"/b", "")
+		require.Equal(t, http.StatusMethodNotAllowed, code)
+	})
+
+	t.Run("WebDAVReadWrite", func(t *testing.T) {
+		fs, server := newServer(t, "webdav", true)
+
+		code, _ := do(t, "PUT", server.URL+"/c", "uploaded")
+		require.Equal(t, http.StatusCreated, code)
+		require.Equal(t, "uploaded", readServeFile(ctx, t, fs, "sj://bucket/root/c"))
+
+		code, _ = do(t, "MOVE", server.URL+"/c", "", "Destination", server.URL+"/d")
+		require.Equal(t, http.StatusCreated, code)
+		require.Equal(t, "uploaded", readServeFile(ctx, t, fs, "sj://bucket/root/d"))
+	})
+
+	// an upload isn't committed, when reading the request body fails.
+	for _, protocol := range []string{"http", "webdav"} {
+		t.Run("FailedUpload/"+protocol, func(t *testing.T) {
+			fs, handler := newHandler(t, protocol, true)
+
+			body := io.MultiReader(strings.NewReader("partial"), iotest.ErrReader(errs.New("connection reset")))
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest("PUT", "/c", body))
+			require.NotEqual(t, http.StatusCreated, recorder.Code)
+
+			_, err := fs.Stat(ctx, ulloc.NewRemote("bucket", "root/c"))
+			require.Error(t, err)
+		})
+	}
+}
+
+func writeServeFile(ctx context.Context, t *testing.T, fs ulfs.Filesystem, path, contents string) {
+	loc, err := ulloc.Parse(path)
+	require.NoError(t, err)
+	mwh, err := fs.Create(ctx, loc, nil)
+	require.NoError(t, err)
+	wh, err := mwh.NextPart(ctx, int64(len(contents)))
+	require.NoError(t, err)
+	_, err = wh.Write([]byte(contents))
+	require.NoError(t, err)
+	require.NoError(t, wh.Commit())
+	require.NoError(t, mwh.Commit(ctx))
+}
+
+func readServeFile(ctx context.Context, t *testing.T, fs ulfs.Filesystem, path string) string {
+	loc, err := ulloc.Parse(path)
+	require.NoError(t, err)
+	mrh, err := fs.Open(ctx, loc)
+	require.NoError(t, err)
+	defer func() { _ = mrh.Close() }()
+	rh, err := mrh.NextPart(ctx, -1)
+	require.NoError(t, err)
+	defer func() { _ = rh.Close() }()
+	data, err := io.ReadAll(rh)
+	require.NoError(t, err)
+	return string(data)
+}
@@ -0,0 +1,416 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vbauerster/mpb/v8"
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/memory"
+	"storj.io/common/sync2"
+	"storj.io/storj/cmd/uplink/ulext"
+	"storj.io/storj/cmd/uplink/ulfs"
+	"storj.io/storj/cmd/uplink/ulloc"
+)
+
+// syncMtimeKey is the custom metadata key that sync uses to record the
+// modification time of the source file when uploading.
+const syncMtimeKey = "uplink-mtime"
+
+type cmdSync struct {
+	ex ulext.External
+
+	access    string
+	transfers int
+	dryrun    bool
+	progress  bool
+	delete    bool
+	compare   string
+	include   []string
+	exclude   []string
+
+	parallelism          int
+	parallelismChunkSize memory.Size
+
+	source ulloc.Location
+	dest   ulloc.Location
+}
+
+func newCmdSync(ex ulext.External) *cmdSync {
+	return &cmdSync{ex: ex}
+}
+
+func (c *cmdSync) Setup(params clingy.Parameters) {
+	c.access = params.Flag("access", "Access name or value to use", "").(string)
+	c.transfers = params.Flag("transfers", "Controls how many uploads/downloads to perform in parallel", 1,
+		clingy.Short('t'),
+		clingy.Transform(strconv.Atoi),
+		clingy.Transform(func(n int) (int, error) {
+			if n <= 0 {
+				return 0, errs.New("transfers must be at least 1")
+			}
+			return n, nil
+		}),
+	).(int)
+	c.dryrun = params.Flag("dry-run", "Print what operations would happen but don't execute them", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+	c.progress = params.Flag("progress", "Show a progress bar when possible", true,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+	c.delete = params.Flag("delete", "Delete files at the destination that do not exist at the source", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+	c.compare = params.Flag("compare", "How to detect changed files (size, mtime, metadata)", "mtime",
+		clingy.Transform(func(s string) (string, error) {
+			switch s {
+			case "size", "mtime", "metadata":
+				return s, nil
+			default:
+				return "", errs.New("unknown compare mode %q", s)
+			}
+		}),
+	).(string)
+	c.include = params.Flag("include", "Only sync files whose relative path or name matches the glob pattern", []string{},
+		clingy.Repeated,
+	).([]string)
+	c.exclude = params.Flag("exclude", "Skip files whose relative path or name matches the glob pattern", []string{},
+		clingy.Repeated,
+	).([]string)
+
+	c.parallelism = params.Flag("parallelism", "Controls how many parallel chunks to upload/download from a file", 1,
+		clingy.Short('p'),
+		clingy.Transform(strconv.Atoi),
+		clingy.Transform(func(n int) (int, error) {
+			if n <= 0 {
+				return 0, errs.New("parallelism must be at least 1")
+			}
+			return n, nil
+		}),
+	).(int)
+	c.parallelismChunkSize = params.Flag("parallelism-chunk-size", "Set the size of the chunks for parallelism, 0 means automatic adjustment", memory.Size(0),
+		clingy.Transform(memory.ParseString),
+		clingy.Transform(func(n int64) (memory.Size, error) {
+			if n < 0 {
+				return 0, errs.New("parallelism-chunk-size cannot be below 0")
+			}
+			return memory.Size(n), nil
+		}),
+	).(memory.Size)
+
+	c.source = params.Arg("source", "Source to sync from (sj://BUCKET[/KEY] or local directory)",
+		clingy.Transform(ulloc.Parse),
+	).(ulloc.Location)
+	c.dest = params.Arg("dest", "Destination to sync to (sj://BUCKET[/KEY] or local directory)",
+		clingy.Transform(ulloc.Parse),
+	).(ulloc.Location)
+}
+
+// syncOp is a single operation that sync has planned to perform.
+type syncOp struct {
+	src  ulloc.Location
+	dest ulloc.Location
+	info ulfs.ObjectInfo
+}
+
+func (c *cmdSync) Execute(ctx context.Context) error {
+	if !c.source.Remote() && !c.dest.Remote() {
+		return errs.New("at least one location must be a remote sj:// location")
+	}
+	if c.source.Std() || c.dest.Std() {
+		return errs.New("cannot sync to or from stdin/stdout")
+	}
+	if c.compare == "metadata" && !c.dest.Remote() {
+		return errs.New("compare mode metadata requires a remote destination")
+	}
+	for _, pattern := range append(append([]string(nil), c.include...), c.exclude...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return errs.New("invalid pattern %q: %w", pattern, err)
+		}
+	}
+
+	fs, err := c.ex.OpenFilesystem(ctx, c.access)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = fs.Close() }()
+
+	// sync always mirrors one directory into another, so both sides are
+	// treated as directoryish.
+	source, dest := c.source.AsDirectoryish(), c.dest.AsDirectoryish()
+
+	sources, err := c.listRelative(ctx, fs, source)
+	if err != nil {
+		return err
+	}
+	dests, err := c.listRelative(ctx, fs, dest)
+	if err != nil {
+		return err
+	}
+
+	copies, deletes, skipped := c.plan(dest, sources, dests)
+
+	stdout := clingy.Stdout(ctx)
+	if c.dryrun {
+		for _, op := range copies {
+			fmt.Fprintln(stdout, "would", copyVerb(op.src, op.dest), op.src, "to", op.dest)
+		}
+		for _, op := range deletes {
+			fmt.Fprintln(stdout, "would remove", op.dest)
+		}
+		fmt.Fprintf(stdout, "%d to copy, %d to remove, %d unchanged\n", len(copies), len(deletes), skipped)
+		return nil
+	}
+
+	fmt.Fprintf(stdout, "%d to copy, %d to remove, %d unchanged\n", len(copies), len(deletes), skipped)
+
+	var eg errs.Group
+	if err := c.copyAll(ctx, fs, copies); err != nil {
+		eg.Add(err)
+	}
+	if err := c.removeAll(ctx, fs, deletes); err != nil {
+		eg.Add(err)
+	}
+	return combineErrs(eg)
+}
+
+// listRelative lists every object under prefix and returns them keyed by
+// their path relative to the prefix.
+func (c *cmdSync) listRelative(ctx context.Context, fs ulfs.Filesystem, prefix ulloc.Location) (map[string]ulfs.ObjectInfo, error) {
+	iter, err := fs.List(ctx, prefix, &ulfs.ListOptions{
+		Recursive: true,
+		Expanded:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make(map[string]ulfs.ObjectInfo)
+	for iter.Next() {
+		item := iter.Item()
+		if item.IsPrefix {
+			continue
+		}
+		rel, err := prefix.RelativeTo(item.Loc)
+		if err != nil {
+			return nil, err
+		}
+		infos[rel] = item
+	}
+	if err := iter.Err(); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return infos, nil
+}
+
+// plan compares the source and destination listings and returns the files
+// that need to be copied, the files that need to be removed, and how many
+// files were already up to date.
+func (c *cmdSync) plan(dest ulloc.Location, sources, dests map[string]ulfs.ObjectInfo) (copies, deletes []syncOp, skipped int) {
+	for _, rel := range sortedKeys(sources) {
+		if !c.selected(rel) {
+			continue
+		}
+		info := sources[rel]
+		if existing, ok := dests[rel]; ok && !c.changed(info, existing) {
+			skipped++
+			continue
+		}
+		copies = append(copies, syncOp{
+			src:  info.Loc,
+			dest: joinDestWith(dest, rel),
+			info: info,
+		})
+	}
+
+	if c.delete {
+		for _, rel := range sortedKeys(dests) {
+			if _, ok := sources[rel]; ok || !c.selected(rel) {
+				continue
+			}
+			deletes = append(deletes, syncOp{
+				dest: dests[rel].Loc,
+				info: dests[rel],
+			})
+		}
+	}
+
+	return copies, deletes, skipped
+}
+
+// selected returns true if the relative path passes the include and exclude
+// filters. A pattern without a slash is also matched against the base name.
+func (c *cmdSync) selected(rel string) bool {
+	match := func(patterns []string) bool {
+		for _, pattern := range patterns {
+			if ok, _ := path.Match(pattern, rel); ok {
+				return true
+			}
+			if strings.Contains(pattern, "/") {
+				continue
+			}
+			if ok, _ := path.Match(pattern, path.Base(rel)); ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(c.include) > 0 && !match(c.include) {
+		return false
+	}
+	return !match(c.exclude)
+}
+
+// changed returns true if the destination differs from the source according
+// to the configured compare mode.
+func (c *cmdSync) changed(src, dst ulfs.ObjectInfo) bool {
+	if src.ContentLength != dst.ContentLength {
+		return true
+	}
+
+	switch c.compare {
+	case "size":
+		return false
+	case "metadata":
+		recorded, ok := dst.Metadata[syncMtimeKey]
+		return !ok || recorded != formatSyncMtime(syncMtime(src))
+	default:
+		return syncMtime(src).After(syncMtime(dst))
+	}
+}
+
+func (c *cmdSync) copyAll(ctx context.Context, fs ulfs.Filesystem, ops []syncOp) error {
+	if len(ops) == 0 {
+		return nil
+	}
+
+	var (
+		limiter = sync2.NewLimiter(c.transfers)
+		es      errs.Group
+		mu      sync.Mutex
+	)
+
+	addError := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		es.Add(err)
+	}
+
+	var namer barNamer
+	for _, op := range ops {
+		namer.Preview(op.src, op.dest)
+	}
+
+	var progress *mpb.Progress
+	if c.progress {
+		progress = mpb.New(mpb.WithOutput(clingy.Stdout(ctx)))
+		defer progress.Wait()
+	}
+
+	for i, op := range ops {
+		i := i
+		op := op
+
+		ok := limiter.Go(ctx, func() {
+			var bar *mpb.Bar
+			if progress != nil {
+				bar = newProgressBar(progress, namer.NameFor(op.src, op.dest), i+1, len(ops))
+				defer func() {
+					bar.Abort(true)
+					bar.Wait()
+				}()
+			} else {
+				mu.Lock()
+				fmt.Fprintf(clingy.Stdout(ctx), "%s %s to %s (%d of %d)\n", copyVerb(op.src, op.dest), op.src, op.dest, i+1, len(ops))
+				mu.Unlock()
+			}
+			if err := c.copier(op).copyFile(ctx, fs, op.src, op.dest, bar); err != nil {
+				addError(errs.New("%s %s to %s failed: %w", copyVerb(op.src, op.dest), op.src, op.dest, err))
+			}
+		})
+		if !ok {
+			break
+		}
+	}
+
+	limiter.Wait()
+
+	if progress != nil {
+		progress.Wait()
+	}
+
+	if len(es) > 0 {
+		for _, e := range es {
+			fmt.Fprintln(clingy.Stdout(ctx), e)
+		}
+		return errs.New("sync failed to copy %d of %d files", len(es), len(ops))
+	}
+	return nil
+}
+
+func (c *cmdSync) removeAll(ctx context.Context, fs ulfs.Filesystem, ops []syncOp) error {
+	var es errs.Group
+	for _, op := range ops {
+		if err := fs.Remove(ctx, op.dest, nil); err != nil {
+			es.Add(errs.New("remove %s failed: %w", op.dest, err))
+			continue
+		}
+		fmt.Fprintln(clingy.Stdout(ctx), "removed", op.dest)
+	}
+	return combineErrs(es)
+}
+
+// copier returns a cmdCp configured to perform the copy for the operation
+// using the same code path as the cp command.
+func (c *cmdSync) copier(op syncOp) *cmdCp {
+	cp := &cmdCp{
+		ex:                   c.ex,
+		parallelism:          c.parallelism,
+		parallelismChunkSize: c.parallelismChunkSize,
+	}
+
+	if op.dest.Remote() && !op.src.Remote() {
+		if mtime := syncMtime(op.info); !mtime.IsZero() {
+			cp.metadata = map[string]string{syncMtimeKey: formatSyncMtime(mtime)}
+		}
+	}
+
+	return cp
+}
+
+// syncMtime returns the modification time of the object, preferring the
+// time recorded in its metadata by a previous sync.
+func syncMtime(info ulfs.ObjectInfo) time.Time {
+	if recorded, ok := info.Metadata[syncMtimeKey]; ok {
+		if mtime, err := time.Parse(time.RFC3339Nano, recorded); err == nil {
+			return mtime
+		}
+	}
+	return info.Created
+}
+
+func formatSyncMtime(mtime time.Time) string {
+	return mtime.UTC().Format(time.RFC3339Nano)
+}
+
+func sortedKeys(infos map[string]ulfs.ObjectInfo) []string {
+	keys := make([]string, 0, len(infos))
+	for key := range infos {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
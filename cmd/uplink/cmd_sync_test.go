@@ -0,0 +1,99 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"testing"
+
+	"storj.io/storj/cmd/uplink/ultest"
+)
+
+func TestSyncErrors(t *testing.T) {
+	state := ultest.Setup(commands)
+
+	// both locations are local
+	state.Fail(t, "sync", "/home/user/a", "/home/user/b")
+
+	// unknown compare mode
+	state.Fail(t, "sync", "/home/user/a", "sj://user/b", "--compare", "color")
+
+	// metadata compare mode needs a remote destination
+	state.Fail(t, "sync", "sj://user/a", "/home/user/b", "--compare", "metadata")
+}
+
+func TestSyncUpload(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFile("/home/user/src/same.txt", "same"),
+		ultest.WithFile("/home/user/src/changed.txt", "changed"),
+		ultest.WithFile("/home/user/src/new.txt", "new"),
+		ultest.WithFile("/home/user/src/dir/nested.log", "nested"),
+		ultest.WithFile("sj://user/dst/same.txt", "same"),
+		ultest.WithFile("sj://user/dst/changed.txt", "old"),
+		ultest.WithFile("sj://user/dst/extra.txt", "extra"),
+	)
+
+	t.Run("Basic", func(t *testing.T) {
+		state.Succeed(t, "sync", "/home/user/src", "sj://user/dst", "--progress=false").RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/dst/changed.txt", Contents: "changed"},
+			ultest.File{Loc: "sj://user/dst/dir/nested.log", Contents: "nested"},
+			ultest.File{Loc: "sj://user/dst/extra.txt", Contents: "extra"},
+			ultest.File{Loc: "sj://user/dst/new.txt", Contents: "new"},
+			ultest.File{Loc: "sj://user/dst/same.txt", Contents: "same"},
+		)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		state.Succeed(t, "sync", "/home/user/src", "sj://user/dst", "--progress=false", "--delete").RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/dst/changed.txt", Contents: "changed"},
+			ultest.File{Loc: "sj://user/dst/dir/nested.log", Contents: "nested"},
+			ultest.File{Loc: "sj://user/dst/new.txt", Contents: "new"},
+			ultest.File{Loc: "sj://user/dst/same.txt", Contents: "same"},
+		)
+	})
+
+	t.Run("DryRun", func(t *testing.T) {
+		state.Succeed(t, "sync", "/home/user/src", "sj://user/dst", "--dry-run", "--delete").RequireStdout(t, `
+			would upload /home/user/src/changed.txt to sj://user/dst/changed.txt
+			would upload /home/user/src/dir/nested.log to sj://user/dst/dir/nested.log
+			would upload /home/user/src/new.txt to sj://user/dst/new.txt
+			would remove sj://user/dst/extra.txt
+			3 to copy, 1 to remove, 1 unchanged
+		`).RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/dst/changed.txt", Contents: "old"},
+			ultest.File{Loc: "sj://user/dst/extra.txt", Contents: "extra"},
+			ultest.File{Loc: "sj://user/dst/same.txt", Contents: "same"},
+		)
+	})
+
+	t.Run("Filters", func(t *testing.T) {
+		state.Succeed(t, "sync", "/home/user/src", "sj://user/dst", "--dry-run", "--delete",
+			"--include", "*.txt", "--exclude", "new.txt",
+		).RequireStdout(t, `
+			would upload /home/user/src/changed.txt to sj://user/dst/changed.txt
+			would remove sj://user/dst/extra.txt
+			1 to copy, 1 to remove, 1 unchanged
+		`)
+
+		state.Succeed(t, "sync", "/home/user/src", "sj://user/dst", "--dry-run",
+			"--include", "dir/*",
+		).RequireStdout(t, `
+			would upload /home/user/src/dir/nested.log to sj://user/dst/dir/nested.log
+			1 to copy, 0 to remove, 0 unchanged
+		`)
+	})
+}
+
+func TestSyncDownload(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFile("sj://user/src/a.txt", "a"),
+		ultest.WithFile("sj://user/src/b/c.txt", "c"),
+		ultest.WithFile("/home/user/dst/a.txt", "a"),
+		ultest.WithFile("/home/user/dst/stale.txt", "stale"),
+	)
+
+	state.Succeed(t, "sync", "sj://user/src", "/home/user/dst", "--progress=false", "--delete", "--compare", "size").RequireLocalFiles(t,
+		ultest.File{Loc: "/home/user/dst/a.txt", Contents: "a"},
+		ultest.File{Loc: "/home/user/dst/b/c.txt", Contents: "c"},
+	)
+}
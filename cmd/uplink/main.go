@@ -80,10 +80,20 @@ func commands(cmds clingy.Commands, ex ulext.External) {
 	cmds.New("rb", "Remove a bucket bucket", newCmdRb(ex))
 	cmds.New("cp", "Copies files or objects into or out of storj", newCmdCp(ex))
 	cmds.New("mv", "Moves files or objects", newCmdMv(ex))
+	cmds.New("sync", "Mirrors changed files or objects from a source to a destination", newCmdSync(ex))
 	cmds.New("ls", "Lists buckets, prefixes, or objects", newCmdLs(ex))
+	cmds.New("find", "Finds objects under a prefix that match filters", newCmdFind(ex))
 	cmds.New("rm", "Remove an object", newCmdRm(ex))
+	cmds.New("du", "Summarizes space used by objects under a prefix", newCmdDu(ex))
 	cmds.Group("meta", "Object metadata related commands", func() {
 		cmds.New("get", "Get an object's metadata", newCmdMetaGet(ex))
+		cmds.New("set", "Set entries in an object's metadata", newCmdMetaSet(ex))
+		cmds.New("rm", "Remove entries from an object's metadata", newCmdMetaRm(ex))
+		cmds.New("replace", "Replace all of an object's metadata", newCmdMetaReplace(ex))
+	})
+	cmds.Group("serve", "Serve objects over a local endpoint", func() {
+		cmds.New("http", "Serve a bucket or prefix over http", newCmdServeHTTP(ex))
+		cmds.New("webdav", "Serve a bucket or prefix over webdav", newCmdServeWebDAV(ex))
 	})
 	cmds.New("share", "Shares restricted accesses to objects", newCmdShare(ex))
 	cmds.New("version", "Prints version information", newCmdVersion())
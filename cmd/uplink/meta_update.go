@@ -0,0 +1,110 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/cmd/uplink/ulext"
+	"storj.io/storj/cmd/uplink/ulfs"
+	"storj.io/storj/cmd/uplink/ulloc"
+	"storj.io/uplink"
+)
+
+// metaUpdater contains the flags and logic shared by the commands that
+// modify the custom metadata of objects.
+type metaUpdater struct {
+	ex ulext.External
+
+	access    string
+	encrypted bool
+	recursive bool
+}
+
+func (m *metaUpdater) setup(params clingy.Parameters) {
+	m.access = params.Flag("access", "Access name or value to use", "").(string)
+	m.encrypted = params.Flag("encrypted", "Interprets keys base64 encoded without decrypting", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+	m.recursive = params.Flag("recursive", "Update the metadata of every object under the prefix", false,
+		clingy.Short('r'),
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+}
+
+// update calls edit with the current custom metadata of every object
+// addressed by the location and stores the result as its new metadata.
+func (m *metaUpdater) update(ctx context.Context, location ulloc.Location, edit func(uplink.CustomMetadata) uplink.CustomMetadata) (err error) {
+	if !location.Remote() {
+		return errs.New("location must be remote")
+	}
+
+	fs, err := m.ex.OpenFilesystem(ctx, m.access, ulext.BypassEncryption(m.encrypted))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = fs.Close() }()
+
+	if !m.recursive {
+		info, err := fs.Stat(ctx, location)
+		if err != nil {
+			return err
+		}
+		return m.updateObject(ctx, fs, *info, edit)
+	}
+
+	iter, err := fs.List(ctx, location.AsDirectoryish(), &ulfs.ListOptions{
+		Recursive: true,
+		Expanded:  true,
+	})
+	if err != nil {
+		return err
+	}
+
+	var es errs.Group
+	for iter.Next() {
+		if err := m.updateObject(ctx, fs, iter.Item(), edit); err != nil {
+			fmt.Fprintln(clingy.Stderr(ctx), "update", iter.Item().Loc, "failed:", err.Error())
+			es.Add(err)
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return errs.Wrap(err)
+	}
+	return es.Err()
+}
+
+func (m *metaUpdater) updateObject(ctx context.Context, fs ulfs.Filesystem, info ulfs.ObjectInfo, edit func(uplink.CustomMetadata) uplink.CustomMetadata) error {
+	metadata := edit(info.Metadata.Clone())
+	if err := fs.UpdateMetadata(ctx, info.Loc, metadata); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(clingy.Stdout(ctx), "updated", info.Loc)
+	return nil
+}
+
+// metaEntry is a single KEY=VALUE metadata entry.
+type metaEntry struct {
+	key   string
+	value string
+}
+
+// parseMetaEntry parses a KEY=VALUE metadata entry.
+func parseMetaEntry(entry string) (metaEntry, error) {
+	key, value, ok := strings.Cut(entry, "=")
+	if !ok {
+		return metaEntry{}, errs.New("invalid entry %q: expected KEY=VALUE", entry)
+	}
+	if key == "" {
+		return metaEntry{}, errs.New("invalid entry %q: empty key", entry)
+	}
+	return metaEntry{key: key, value: value}, nil
+}
@@ -0,0 +1,218 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/cmd/uplink/ulfs"
+	"storj.io/storj/cmd/uplink/ulloc"
+)
+
+// resumeState is the progress of a resumable transfer that is stored in a
+// local file so that an interrupted cp can continue where it left off.
+type resumeState struct {
+	Source    string    `json:"source"`
+	Dest      string    `json:"dest"`
+	Size      int64     `json:"size"`
+	Modified  time.Time `json:"modified"`
+	PartSize  int64     `json:"part_size"`
+	UploadID  string    `json:"upload_id,omitempty"`
+	Completed []int     `json:"completed"`
+}
+
+// matches returns true if the state describes the same transfer.
+func (s resumeState) matches(o resumeState) bool {
+	return s.Source == o.Source &&
+		s.Dest == o.Dest &&
+		s.Size == o.Size &&
+		s.Modified.Equal(o.Modified) &&
+		s.PartSize == o.PartSize
+}
+
+// resumeTracker keeps track of the parts completed by a resumable transfer
+// and persists them after every part.
+type resumeTracker struct {
+	fs  ulfs.Filesystem
+	loc ulloc.Location
+
+	mu        sync.Mutex
+	state     resumeState
+	completed map[int]bool
+}
+
+// resumeStateLocation returns the location of the state file for a transfer.
+// It is a hidden file next to the local side of the transfer.
+func resumeStateLocation(source, dest ulloc.Location) (ulloc.Location, bool) {
+	local := dest
+	if !local.Local() {
+		local = source
+	}
+
+	path, ok := local.LocalParts()
+	if !ok {
+		return ulloc.Location{}, false
+	}
+	dir, base := filepath.Split(path)
+	return ulloc.NewLocal(filepath.Join(dir, "."+base+".uplink-resume")), true
+}
+
+// loadResumeTracker returns a tracker for the transfer described by want. If
+// a state file for the same transfer exists, its completed parts are kept.
+func loadResumeTracker(ctx context.Context, fs ulfs.Filesystem, loc ulloc.Location, want resumeState) (*resumeTracker, error) {
+	tracker := &resumeTracker{
+		fs:        fs,
+		loc:       loc,
+		state:     want,
+		completed: make(map[int]bool),
+	}
+
+	existing, err := readResumeState(ctx, fs, loc)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil && existing.matches(want) {
+		tracker.state = *existing
+		for _, part := range existing.Completed {
+			tracker.completed[part] = true
+		}
+	}
+
+	return tracker, nil
+}
+
+func readResumeState(ctx context.Context, fs ulfs.Filesystem, loc ulloc.Location) (_ *resumeState, err error) {
+	if _, err := fs.Stat(ctx, loc); err != nil {
+		// no state file means there is nothing to resume.
+		return nil, nil
+	}
+
+	mrh, err := fs.Open(ctx, loc)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = mrh.Close() }()
+
+	rh, err := mrh.NextPart(ctx, -1)
+	if err != nil {
+		if errs.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, errs.Wrap(err)
+	}
+	defer func() { _ = rh.Close() }()
+
+	data, err := io.ReadAll(rh)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	var state resumeState
+	if err := json.Unmarshal(data, &state); err != nil {
+		// an unreadable state file is treated like a missing one.
+		return nil, nil
+	}
+	return &state, nil
+}
+
+// Resumed returns true if some parts were completed by an earlier attempt.
+func (r *resumeTracker) Resumed() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.completed) > 0 || r.state.UploadID != ""
+}
+
+// UploadID returns the id of the pending upload to continue, if any.
+func (r *resumeTracker) UploadID() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.state.UploadID
+}
+
+// Reset forgets any progress from an earlier attempt.
+func (r *resumeTracker) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.state.UploadID = ""
+	r.state.Completed = nil
+	r.completed = make(map[int]bool)
+}
+
+// SetUploadID records the id of the pending upload and saves the state.
+func (r *resumeTracker) SetUploadID(ctx context.Context, uploadID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.state.UploadID = uploadID
+	return r.saveLocked(ctx)
+}
+
+// Completed returns true if the part was completed by an earlier attempt.
+func (r *resumeTracker) Completed(part int) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.completed[part]
+}
+
+// MarkCompleted records that the part is completed and saves the state.
+func (r *resumeTracker) MarkCompleted(ctx context.Context, part int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.completed[part] = true
+	return r.saveLocked(ctx)
+}
+
+// Finish removes the state file once the transfer is done.
+func (r *resumeTracker) Finish(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.fs.Remove(ctx, r.loc, nil)
+}
+
+func (r *resumeTracker) saveLocked(ctx context.Context) (err error) {
+	r.state.Completed = r.state.Completed[:0]
+	for part := range r.completed {
+		r.state.Completed = append(r.state.Completed, part)
+	}
+	sort.Ints(r.state.Completed)
+
+	data, err := json.Marshal(r.state)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	mwh, err := r.fs.Create(ctx, r.loc, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = mwh.Abort(ctx) }()
+
+	wh, err := mwh.NextPart(ctx, int64(len(data)))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = wh.Abort() }()
+
+	if _, err := wh.Write(data); err != nil {
+		return errs.Wrap(err)
+	}
+	if err := wh.Commit(); err != nil {
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(mwh.Commit(ctx))
+}
@@ -0,0 +1,448 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"golang.org/x/net/webdav"
+
+	"storj.io/storj/cmd/uplink/ulfs"
+	"storj.io/storj/cmd/uplink/ulloc"
+)
+
+// serveFilesystem exposes everything under a root location of a
+// ulfs.Filesystem as a webdav.FileSystem. Object storage has no real
+// directories, so any key prefix is presented as a directory.
+type serveFilesystem struct {
+	fs       ulfs.Filesystem
+	root     ulloc.Location
+	writable bool
+}
+
+var _ webdav.FileSystem = (*serveFilesystem)(nil)
+
+// location returns the location that the slash separated name maps to.
+func (s *serveFilesystem) location(name string) ulloc.Location {
+	name = strings.TrimPrefix(path.Clean("/"+name), "/")
+	return s.root.AsDirectoryish().AppendKey(name)
+}
+
+// Mkdir succeeds without doing anything because directories only exist as
+// key prefixes of the objects within them.
+func (s *serveFilesystem) Mkdir(ctx context.Context, name string, perm os.FileMode) error {
+	if !s.writable {
+		return os.ErrPermission
+	}
+	return nil
+}
+
+// OpenFile opens the object or directory with the name.
+func (s *serveFilesystem) OpenFile(ctx context.Context, name string, flag int, perm os.FileMode) (webdav.File, error) {
+	loc := s.location(name)
+
+	if flag&(os.O_WRONLY|os.O_RDWR|os.O_CREATE|os.O_TRUNC|os.O_APPEND) != 0 {
+		upload, err := s.upload(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		return upload, nil
+	}
+
+	info, err := s.stat(ctx, loc)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return &serveDir{ctx: ctx, fs: s.fs, loc: loc, info: info}, nil
+	}
+
+	mrh, err := s.fs.Open(ctx, loc)
+	if err != nil {
+		return nil, err
+	}
+	return &serveFile{ctx: ctx, mrh: mrh, info: info}, nil
+}
+
+// RemoveAll removes the object with the name or every object under it.
+func (s *serveFilesystem) RemoveAll(ctx context.Context, name string) error {
+	if !s.writable {
+		return os.ErrPermission
+	}
+
+	loc := s.location(name)
+	info, err := s.stat(ctx, loc)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return s.fs.Remove(ctx, loc, nil)
+	}
+
+	iter, err := s.fs.List(ctx, loc.AsDirectoryish(), &ulfs.ListOptions{Recursive: true})
+	if err != nil {
+		return err
+	}
+	var locs []ulloc.Location
+	for iter.Next() {
+		locs = append(locs, iter.Item().Loc)
+	}
+	if err := iter.Err(); err != nil {
+		return errs.Wrap(err)
+	}
+
+	var eg errs.Group
+	for _, loc := range locs {
+		eg.Add(s.fs.Remove(ctx, loc, nil))
+	}
+	return eg.Err()
+}
+
+// Rename moves the object with the old name, or every object under it, to
+// the new name.
+func (s *serveFilesystem) Rename(ctx context.Context, oldName, newName string) error {
+	if !s.writable {
+		return os.ErrPermission
+	}
+
+	source, dest := s.location(oldName), s.location(newName)
+	info, err := s.stat(ctx, source)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return s.fs.Move(ctx, source, dest)
+	}
+
+	source, dest = source.AsDirectoryish(), dest.AsDirectoryish()
+	iter, err := s.fs.List(ctx, source, &ulfs.ListOptions{Recursive: true})
+	if err != nil {
+		return err
+	}
+	var locs []ulloc.Location
+	for iter.Next() {
+		locs = append(locs, iter.Item().Loc)
+	}
+	if err := iter.Err(); err != nil {
+		return errs.Wrap(err)
+	}
+
+	for _, loc := range locs {
+		rel, err := source.RelativeTo(loc)
+		if err != nil {
+			return err
+		}
+		if err := s.fs.Move(ctx, loc, dest.AppendKey(rel)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Stat returns information about the object or directory with the name.
+func (s *serveFilesystem) Stat(ctx context.Context, name string) (os.FileInfo, error) {
+	return s.stat(ctx, s.location(name))
+}
+
+func (s *serveFilesystem) stat(ctx context.Context, loc ulloc.Location) (*serveFileInfo, error) {
+	if loc == s.root.AsDirectoryish() || s.fs.IsLocalDir(ctx, loc) {
+		return &serveFileInfo{name: baseName(loc), dir: true}, nil
+	}
+
+	if !loc.Directoryish() {
+		if info, err := s.fs.Stat(ctx, loc); err == nil {
+			return &serveFileInfo{
+				name:    baseName(loc),
+				size:    info.ContentLength,
+				modTime: info.Created,
+			}, nil
+		}
+	}
+
+	// a prefix that contains at least one object is a directory.
+	iter, err := s.fs.List(ctx, loc.AsDirectoryish(), &ulfs.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if iter.Next() {
+		return &serveFileInfo{name: baseName(loc), dir: true}, nil
+	}
+	if err := iter.Err(); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return nil, os.ErrNotExist
+}
+
+// upload returns a file that uploads everything written to it to the
+// object with the name once it is closed.
+func (s *serveFilesystem) upload(ctx context.Context, name string) (*serveUpload, error) {
+	if !s.writable {
+		return nil, os.ErrPermission
+	}
+
+	loc := s.location(name)
+	if loc.Directoryish() {
+		return nil, os.ErrInvalid
+	}
+
+	mwh, err := s.fs.Create(ctx, loc, &ulfs.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	wh, err := mwh.NextPart(ctx, -1)
+	if err != nil {
+		_ = mwh.Abort(ctx)
+		return nil, err
+	}
+
+	return &serveUpload{
+		ctx: ctx,
+		mwh: mwh,
+		wh:  wh,
+		info: serveFileInfo{
+			name:    baseName(loc),
+			modTime: time.Now(),
+		},
+	}, nil
+}
+
+func baseName(loc ulloc.Location) string {
+	base, _ := loc.Undirectoryish().Base()
+	return base
+}
+
+// serveFileInfo implements os.FileInfo for objects and prefixes.
+type serveFileInfo struct {
+	name    string
+	size    int64
+	modTime time.Time
+	dir     bool
+}
+
+func (fi *serveFileInfo) Name() string       { return fi.name }
+func (fi *serveFileInfo) Size() int64        { return fi.size }
+func (fi *serveFileInfo) ModTime() time.Time { return fi.modTime }
+func (fi *serveFileInfo) IsDir() bool        { return fi.dir }
+func (fi *serveFileInfo) Sys() interface{}   { return nil }
+
+func (fi *serveFileInfo) Mode() os.FileMode {
+	if fi.dir {
+		return fs.ModeDir | 0o555
+	}
+	return 0o444
+}
+
+// ContentType implements webdav.ContentTyper so that listings do not need
+// to download the start of every object to sniff its type.
+func (fi *serveFileInfo) ContentType(ctx context.Context) (string, error) {
+	if contentType := mime.TypeByExtension(path.Ext(fi.name)); contentType != "" {
+		return contentType, nil
+	}
+	return "application/octet-stream", nil
+}
+
+// serveFile is a read only file that supports range reads of an object.
+type serveFile struct {
+	ctx  context.Context
+	mrh  ulfs.MultiReadHandle
+	info *serveFileInfo
+
+	mu  sync.Mutex
+	off int64
+	rh  ulfs.ReadHandle
+}
+
+func (f *serveFile) Read(p []byte) (n int, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.off >= f.info.size {
+		return 0, io.EOF
+	}
+
+	if f.rh == nil {
+		if err := f.mrh.SetOffset(f.off); err != nil {
+			return 0, err
+		}
+		f.rh, err = f.mrh.NextPart(f.ctx, -1)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	n, err = f.rh.Read(p)
+	f.off += int64(n)
+	return n, err
+}
+
+func (f *serveFile) Seek(offset int64, whence int) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var off int64
+	switch whence {
+	case io.SeekStart:
+		off = offset
+	case io.SeekCurrent:
+		off = f.off + offset
+	case io.SeekEnd:
+		off = f.info.size + offset
+	default:
+		return 0, errs.New("invalid whence %d", whence)
+	}
+	if off < 0 {
+		return 0, errs.New("negative offset %d", off)
+	}
+
+	// the next read opens a new ranged download at the new offset.
+	if off != f.off && f.rh != nil {
+		_ = f.rh.Close()
+		f.rh = nil
+	}
+	f.off = off
+	return off, nil
+}
+
+func (f *serveFile) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var eg errs.Group
+	if f.rh != nil {
+		eg.Add(f.rh.Close())
+		f.rh = nil
+	}
+	eg.Add(f.mrh.Close())
+	return eg.Err()
+}
+
+func (f *serveFile) Readdir(count int) ([]fs.FileInfo, error) { return nil, os.ErrInvalid }
+func (f *serveFile) Stat() (fs.FileInfo, error)               { return f.info, nil }
+func (f *serveFile) Write(p []byte) (int, error)              { return 0, os.ErrPermission }
+
+// serveDir is a directory listing of a prefix.
+type serveDir struct {
+	ctx  context.Context
+	fs   ulfs.Filesystem
+	loc  ulloc.Location
+	info *serveFileInfo
+
+	listed  bool
+	entries []fs.FileInfo
+}
+
+func (d *serveDir) Readdir(count int) ([]fs.FileInfo, error) {
+	if !d.listed {
+		if err := d.list(); err != nil {
+			return nil, err
+		}
+		d.listed = true
+	}
+
+	if count <= 0 {
+		entries := d.entries
+		d.entries = nil
+		return entries, nil
+	}
+	if len(d.entries) == 0 {
+		return nil, io.EOF
+	}
+	if count > len(d.entries) {
+		count = len(d.entries)
+	}
+	entries := d.entries[:count]
+	d.entries = d.entries[count:]
+	return entries, nil
+}
+
+func (d *serveDir) list() error {
+	iter, err := d.fs.List(d.ctx, d.loc.AsDirectoryish(), &ulfs.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for iter.Next() {
+		item := iter.Item()
+		name := strings.TrimSuffix(item.Loc.Loc(), "/")
+		if idx := strings.LastIndexByte(name, '/'); idx >= 0 {
+			name = name[idx+1:]
+		}
+		if name == "" {
+			continue
+		}
+		d.entries = append(d.entries, &serveFileInfo{
+			name:    name,
+			size:    item.ContentLength,
+			modTime: item.Created,
+			dir:     item.IsPrefix,
+		})
+	}
+	return errs.Wrap(iter.Err())
+}
+
+func (d *serveDir) Stat() (fs.FileInfo, error)                   { return d.info, nil }
+func (d *serveDir) Close() error                                 { return nil }
+func (d *serveDir) Read(p []byte) (int, error)                   { return 0, os.ErrInvalid }
+func (d *serveDir) Seek(offset int64, whence int) (int64, error) { return 0, os.ErrInvalid }
+func (d *serveDir) Write(p []byte) (int, error)                  { return 0, os.ErrPermission }
+
+// serveUpload is a write only file that uploads an object. The object is
+// committed when the file is closed, unless copying into it has failed.
+type serveUpload struct {
+	ctx  context.Context
+	mwh  ulfs.MultiWriteHandle
+	wh   ulfs.WriteHandle
+	info serveFileInfo
+
+	// err is the first error of writing or copying into the file.
+	err error
+}
+
+func (u *serveUpload) Write(p []byte) (int, error) {
+	n, err := u.wh.Write(p)
+	u.info.size += int64(n)
+	if err != nil && u.err == nil {
+		u.err = err
+	}
+	return n, err
+}
+
+// ReadFrom copies r into the file. It's used by io.Copy, hence the upload
+// isn't committed when reading the request body fails midway.
+func (u *serveUpload) ReadFrom(r io.Reader) (int64, error) {
+	n, err := io.Copy(struct{ io.Writer }{u}, r)
+	if err != nil && u.err == nil {
+		u.err = err
+	}
+	return n, err
+}
+
+func (u *serveUpload) Close() error {
+	if u.err != nil {
+		return errs.Combine(u.err, u.Abort())
+	}
+	if err := u.wh.Commit(); err != nil {
+		_ = u.mwh.Abort(u.ctx)
+		return err
+	}
+	return u.mwh.Commit(u.ctx)
+}
+
+// Abort discards the upload.
+func (u *serveUpload) Abort() error {
+	return errs.Combine(u.wh.Abort(), u.mwh.Abort(u.ctx))
+}
+
+func (u *serveUpload) Stat() (fs.FileInfo, error)                   { info := u.info; return &info, nil }
+func (u *serveUpload) Read(p []byte) (int, error)                   { return 0, os.ErrInvalid }
+func (u *serveUpload) Seek(offset int64, whence int) (int64, error) { return 0, os.ErrInvalid }
+func (u *serveUpload) Readdir(count int) ([]fs.FileInfo, error)     { return nil, os.ErrInvalid }
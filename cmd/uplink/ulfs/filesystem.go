@@ -16,13 +16,28 @@ import (
 type CreateOptions struct {
 	Expires  time.Time
 	Metadata map[string]string
+
+	// Resume keeps the data written so far when the write is aborted so
+	// that a later write can continue it. Local files are opened without
+	// being truncated.
+	Resume bool
+
+	// UploadID continues the pending remote upload with the given id
+	// instead of beginning a new one.
+	UploadID string
 }
 
+func (co *CreateOptions) isResume() bool { return co != nil && co.Resume }
+
 // ListOptions describes options to the List command.
 type ListOptions struct {
 	Recursive bool
 	Pending   bool
 	Expanded  bool
+
+	// PartSizes sets the content length of pending uploads to the size of
+	// their uploaded parts, which requires listing the parts of every upload.
+	PartSizes bool
 }
 
 func (lo *ListOptions) isRecursive() bool { return lo != nil && lo.Recursive }
@@ -46,13 +61,14 @@ type Filesystem interface {
 	List(ctx context.Context, prefix ulloc.Location, opts *ListOptions) (ObjectIterator, error)
 	IsLocalDir(ctx context.Context, loc ulloc.Location) bool
 	Stat(ctx context.Context, loc ulloc.Location) (*ObjectInfo, error)
+	UpdateMetadata(ctx context.Context, loc ulloc.Location, metadata map[string]string) error
 }
 
 // FilesystemLocal is the interface for a local filesystem.
 type FilesystemLocal interface {
 	IsLocalDir(ctx context.Context, path string) bool
 	Open(ctx context.Context, path string) (MultiReadHandle, error)
-	Create(ctx context.Context, path string) (MultiWriteHandle, error)
+	Create(ctx context.Context, path string, opts *CreateOptions) (MultiWriteHandle, error)
 	Move(ctx context.Context, oldpath string, newpath string) error
 	Copy(ctx context.Context, oldpath string, newpath string) error
 	Remove(ctx context.Context, path string, opts *RemoveOptions) error
@@ -70,6 +86,7 @@ type FilesystemRemote interface {
 	Remove(ctx context.Context, bucket, key string, opts *RemoveOptions) error
 	List(ctx context.Context, bucket, key string, opts *ListOptions) ObjectIterator
 	Stat(ctx context.Context, bucket, key string) (*ObjectInfo, error)
+	UpdateMetadata(ctx context.Context, bucket, key string, metadata map[string]string) error
 }
 
 //
@@ -85,6 +102,7 @@ type ObjectInfo struct {
 	ContentLength int64
 	Expires       time.Time
 	Metadata      uplink.CustomMetadata
+	UploadID      string
 }
 
 // uplinkObjectToObjectInfo returns an objectInfo converted from an *uplink.Object.
@@ -108,6 +126,7 @@ func uplinkUploadInfoToObjectInfo(bucket string, upl *uplink.UploadInfo) ObjectI
 		ContentLength: upl.System.ContentLength,
 		Expires:       upl.System.Expires,
 		Metadata:      upl.Custom,
+		UploadID:      upl.UploadID,
 	}
 }
 
@@ -148,12 +167,28 @@ type ReadHandle interface {
 // The returned WriteHandle will error if data is attempted to be written
 // past the provided length. A negative length implies an unknown amount
 // of data, and future calls to NextPart will error.
+//
+// SkipPart moves past a part of the provided length without writing it,
+// leaving any data already stored for that part in place.
 type MultiWriteHandle interface {
 	NextPart(ctx context.Context, length int64) (WriteHandle, error)
+	SkipPart(ctx context.Context, length int64) error
 	Commit(ctx context.Context) error
 	Abort(ctx context.Context) error
 }
 
+// PendingUpload is implemented by MultiWriteHandles that write into a pending
+// remote upload that can be continued later with CreateOptions.UploadID.
+type PendingUpload interface {
+	UploadID() string
+}
+
+// MetadataWriteHandle is implemented by MultiWriteHandles that accept additional
+// custom metadata until they are committed.
+type MetadataWriteHandle interface {
+	SetMetadata(key, value string)
+}
+
 // WriteHandle is anything that can be written to with commit/abort semantics.
 type WriteHandle interface {
 	io.Writer
@@ -207,6 +207,23 @@ func (o *GenericMultiWriteHandle) NextPart(ctx context.Context, length int64) (W
 	return w, nil
 }
 
+// SkipPart moves past length bytes without writing them.
+func (o *GenericMultiWriteHandle) SkipPart(ctx context.Context, length int64) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.done {
+		return errs.New("already closed")
+	} else if o.tail {
+		return errs.New("unable to skip part after tail part")
+	} else if length < 0 {
+		return errs.New("unable to skip part of unknown length")
+	}
+
+	o.off += length
+	return nil
+}
+
 // Commit commits the overall GenericMultiWriteHandle. It errors if
 // any parts were aborted.
 func (o *GenericMultiWriteHandle) Commit(ctx context.Context) error {
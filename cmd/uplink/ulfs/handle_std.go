@@ -184,6 +184,10 @@ func (s *stdMultiWriteHandle) NextPart(ctx context.Context, length int64) (Write
 	return w, nil
 }
 
+func (s *stdMultiWriteHandle) SkipPart(ctx context.Context, length int64) error {
+	return errs.New("unable to skip parts of standard output")
+}
+
 func (s *stdMultiWriteHandle) Commit(ctx context.Context) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -171,6 +171,7 @@ type uplinkMultiWriteHandle struct {
 	bucket   string
 	info     uplink.UploadInfo
 	metadata uplink.CustomMetadata
+	resume   bool
 
 	mu        sync.Mutex
 	tail      bool
@@ -179,12 +180,13 @@ type uplinkMultiWriteHandle struct {
 	abortErr  *error
 }
 
-func newUplinkMultiWriteHandle(project *uplink.Project, bucket string, info uplink.UploadInfo, metadata uplink.CustomMetadata) *uplinkMultiWriteHandle {
+func newUplinkMultiWriteHandle(project *uplink.Project, bucket string, info uplink.UploadInfo, metadata uplink.CustomMetadata, resume bool) *uplinkMultiWriteHandle {
 	return &uplinkMultiWriteHandle{
 		project:  project,
 		bucket:   bucket,
 		info:     info,
 		metadata: metadata,
+		resume:   resume,
 	}
 }
 
@@ -224,6 +226,43 @@ func (u *uplinkMultiWriteHandle) NextPart(ctx context.Context, length int64) (Wr
 	}, nil
 }
 
+// UploadID returns the id of the pending upload being written to.
+func (u *uplinkMultiWriteHandle) UploadID() string {
+	return u.info.UploadID
+}
+
+// SetMetadata adds the custom metadata entry that is stored when the upload is committed.
+func (u *uplinkMultiWriteHandle) SetMetadata(key, value string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	metadata := make(uplink.CustomMetadata, len(u.metadata)+1)
+	for k, v := range u.metadata {
+		metadata[k] = v
+	}
+	metadata[key] = value
+	u.metadata = metadata
+}
+
+func (u *uplinkMultiWriteHandle) SkipPart(ctx context.Context, length int64) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	switch {
+	case u.abortErr != nil:
+		return errs.New("cannot skip part after multipart write has been aborted")
+	case u.commitErr != nil:
+		return errs.New("cannot skip part after multipart write has been committed")
+	case u.tail:
+		return errs.New("unable to skip part after tail part")
+	case length < 0:
+		return errs.New("unable to skip part of unknown length")
+	}
+
+	u.part++
+	return nil
+}
+
 func (u *uplinkMultiWriteHandle) Commit(ctx context.Context) error {
 	u.mu.Lock()
 	defer u.mu.Unlock()
@@ -253,7 +292,11 @@ func (u *uplinkMultiWriteHandle) Abort(ctx context.Context) error {
 		return errs.New("cannot abort a committed multipart write")
 	}
 
-	err := u.project.AbortUpload(ctx, u.bucket, u.info.Key, u.info.UploadID)
+	// a resumable upload is left pending so that it can be continued later.
+	var err error
+	if !u.resume {
+		err = u.project.AbortUpload(ctx, u.bucket, u.info.Key, u.info.UploadID)
+	}
 	u.abortErr = &err
 	return err
 }
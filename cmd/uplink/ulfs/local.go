@@ -31,6 +31,7 @@ type LocalBackend interface {
 	Create(name string) (LocalBackendFile, error)
 	MkdirAll(path string, perm os.FileMode) error
 	Open(name string) (LocalBackendFile, error)
+	OpenWrite(name string) (LocalBackendFile, error)
 	Remove(name string) error
 	Rename(oldname, newname string) error
 	Stat(name string) (os.FileInfo, error)
@@ -58,7 +59,7 @@ func (l *Local) Open(ctx context.Context, path string) (MultiReadHandle, error)
 }
 
 // Create makes any directories necessary to create a file at path and returns a WriteHandle.
-func (l *Local) Create(ctx context.Context, path string) (MultiWriteHandle, error) {
+func (l *Local) Create(ctx context.Context, path string, opts *CreateOptions) (MultiWriteHandle, error) {
 	fi, err := l.fs.Stat(path)
 	if err != nil && !os.IsNotExist(err) {
 		return nil, errs.Wrap(err)
@@ -70,12 +71,20 @@ func (l *Local) Create(ctx context.Context, path string) (MultiWriteHandle, erro
 		return nil, errs.Wrap(err)
 	}
 
+	if opts.isResume() {
+		fh, err := l.fs.OpenWrite(path)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		return newOSMultiWriteHandle(l.fs, fh, true), nil
+	}
+
 	// TODO: atomic rename
 	fh, err := l.fs.Create(path)
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
-	return newOSMultiWriteHandle(l.fs, fh), nil
+	return newOSMultiWriteHandle(l.fs, fh, false), nil
 }
 
 // Move moves file to provided path.
@@ -117,6 +117,27 @@ func (l *LocalBackendMem) Open(name string) (LocalBackendFile, error) {
 	return root, nil
 }
 
+// OpenWrite opens the file with the given name for writing, creating it if
+// it does not exist.
+func (l *LocalBackendMem) OpenWrite(name string) (LocalBackendFile, error) {
+	name = filepath.Clean(name)
+
+	md, base, err := l.openParent(name)
+	if err != nil {
+		return nil, err
+	}
+	if fh, ok := md.children[base]; ok {
+		mf, ok := fh.(*memFile)
+		if !ok {
+			return nil, errs.New("file already exists: %q", name)
+		}
+		return mf, nil
+	}
+	mf := newMemFile(name)
+	md.children[base] = mf
+	return mf, nil
+}
+
 // Remove deletes the file with the given name.
 func (l *LocalBackendMem) Remove(name string) error {
 	name = filepath.Clean(name)
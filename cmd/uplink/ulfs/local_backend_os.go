@@ -28,6 +28,12 @@ func (l *LocalBackendOS) Open(name string) (LocalBackendFile, error) {
 	return os.Open(name)
 }
 
+// OpenWrite calls os.OpenFile to open or create the file for writing
+// without truncating it.
+func (l *LocalBackendOS) OpenWrite(name string) (LocalBackendFile, error) {
+	return os.OpenFile(name, os.O_RDWR|os.O_CREATE, 0666)
+}
+
 // Remove calls os.Remove.
 func (l *LocalBackendOS) Remove(name string) error {
 	return os.Remove(name)
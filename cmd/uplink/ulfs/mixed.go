@@ -46,7 +46,7 @@ func (m *Mixed) Create(ctx context.Context, loc ulloc.Location, opts *CreateOpti
 	if bucket, key, ok := loc.RemoteParts(); ok {
 		return m.remote.Create(ctx, bucket, key, opts)
 	} else if path, ok := loc.LocalParts(); ok {
-		return m.local.Create(ctx, path)
+		return m.local.Create(ctx, path, opts)
 	}
 	return newStdMultiWriteHandle(clingy.Stdout(ctx)), nil
 }
@@ -117,3 +117,11 @@ func (m *Mixed) Stat(ctx context.Context, loc ulloc.Location) (*ObjectInfo, erro
 	}
 	return nil, errs.New("unable to stat loc %q", loc.Loc())
 }
+
+// UpdateMetadata replaces the custom metadata of the object at the specified Location.
+func (m *Mixed) UpdateMetadata(ctx context.Context, loc ulloc.Location, metadata map[string]string) error {
+	if bucket, key, ok := loc.RemoteParts(); ok {
+		return m.remote.UpdateMetadata(ctx, bucket, key, metadata)
+	}
+	return errs.New("unable to update metadata of loc %q", loc.Loc())
+}
@@ -45,6 +45,11 @@ func (r *Remote) Stat(ctx context.Context, bucket, key string) (*ObjectInfo, err
 	return &stat, nil
 }
 
+// UpdateMetadata replaces the custom metadata of the object identified by a given bucket and key.
+func (r *Remote) UpdateMetadata(ctx context.Context, bucket, key string, metadata map[string]string) error {
+	return errs.Wrap(r.project.UpdateObjectMetadata(ctx, bucket, key, uplink.CustomMetadata(metadata), nil))
+}
+
 // Create returns a MultiWriteHandle for the object identified by a given bucket and key.
 func (r *Remote) Create(ctx context.Context, bucket, key string, opts *CreateOptions) (MultiWriteHandle, error) {
 	var customMetadata uplink.CustomMetadata
@@ -56,13 +61,18 @@ func (r *Remote) Create(ctx context.Context, bucket, key string, opts *CreateOpt
 		}
 	}
 
+	if opts.UploadID != "" {
+		info := uplink.UploadInfo{UploadID: opts.UploadID, Key: key}
+		return newUplinkMultiWriteHandle(r.project, bucket, info, customMetadata, opts.Resume), nil
+	}
+
 	info, err := r.project.BeginUpload(ctx, bucket, key, &uplink.UploadOptions{
 		Expires: opts.Expires,
 	})
 	if err != nil {
 		return nil, err
 	}
-	return newUplinkMultiWriteHandle(r.project, bucket, info, customMetadata), nil
+	return newUplinkMultiWriteHandle(r.project, bucket, info, customMetadata, opts.Resume), nil
 }
 
 // Move moves object to provided key and bucket.
@@ -117,6 +127,8 @@ func (r *Remote) List(ctx context.Context, bucket, prefix string, opts *ListOpti
 	var iter ObjectIterator
 	if opts.isPending() {
 		iter = newUplinkUploadIterator(
+			ctx,
+			r.project,
 			bucket,
 			r.project.ListUploads(ctx, bucket, &uplink.ListUploadsOptions{
 				Prefix:    parentPrefix,
@@ -124,6 +136,7 @@ func (r *Remote) List(ctx context.Context, bucket, prefix string, opts *ListOpti
 				System:    true,
 				Custom:    opts.Expanded,
 			}),
+			opts.PartSizes,
 		)
 	} else {
 		iter = newUplinkObjectIterator(
@@ -166,20 +179,59 @@ func (u *uplinkObjectIterator) Item() ObjectInfo {
 
 // uplinkUploadIterator implements objectIterator for *multipart.UploadIterators.
 type uplinkUploadIterator struct {
-	bucket string
-	iter   *uplink.UploadIterator
+	ctx     context.Context
+	project *uplink.Project
+	bucket  string
+	iter    *uplink.UploadIterator
+
+	// partSizes sets the content length of every upload to the size of its
+	// uploaded parts, which requires listing them.
+	partSizes bool
+
+	item ObjectInfo
+	err  error
 }
 
 // newUplinkUploadIterator constructs a *uplinkUploadIterator from a *uplink.UploadIterator.
-func newUplinkUploadIterator(bucket string, iter *uplink.UploadIterator) *uplinkUploadIterator {
+func newUplinkUploadIterator(ctx context.Context, project *uplink.Project, bucket string, iter *uplink.UploadIterator, partSizes bool) *uplinkUploadIterator {
 	return &uplinkUploadIterator{
-		bucket: bucket,
-		iter:   iter,
+		ctx:       ctx,
+		project:   project,
+		bucket:    bucket,
+		iter:      iter,
+		partSizes: partSizes,
 	}
 }
 
-func (u *uplinkUploadIterator) Next() bool { return u.iter.Next() }
-func (u *uplinkUploadIterator) Err() error { return u.iter.Err() }
-func (u *uplinkUploadIterator) Item() ObjectInfo {
-	return uplinkUploadInfoToObjectInfo(u.bucket, u.iter.Item())
+func (u *uplinkUploadIterator) Next() bool {
+	if u.err != nil || !u.iter.Next() {
+		return false
+	}
+
+	u.item = uplinkUploadInfoToObjectInfo(u.bucket, u.iter.Item())
+	if u.partSizes && !u.item.IsPrefix {
+		u.item.ContentLength, u.err = u.uploadSize(u.iter.Item())
+		if u.err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (u *uplinkUploadIterator) Err() error {
+	if u.err != nil {
+		return u.err
+	}
+	return u.iter.Err()
+}
+
+func (u *uplinkUploadIterator) Item() ObjectInfo { return u.item }
+
+// uploadSize returns the sum of the sizes of the uploaded parts.
+func (u *uplinkUploadIterator) uploadSize(upload *uplink.UploadInfo) (size int64, err error) {
+	parts := u.project.ListUploadParts(u.ctx, u.bucket, upload.Key, upload.UploadID, nil)
+	for parts.Next() {
+		size += parts.Item().Size
+	}
+	return size, errs.Wrap(parts.Err())
 }
@@ -6,6 +6,7 @@ package ultest
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"sort"
 	"sync"
@@ -90,11 +91,15 @@ type nopClosingGenericReader struct{ io.ReaderAt }
 
 func (n nopClosingGenericReader) Close() error { return nil }
 
-func newMultiReadHandle(contents string) ulfs.MultiReadHandle {
+func newMultiReadHandle(loc ulloc.Location, mf memFileData) ulfs.MultiReadHandle {
 	return ulfs.NewGenericMultiReadHandle(nopClosingGenericReader{
-		ReaderAt: bytes.NewReader([]byte(contents)),
+		ReaderAt: bytes.NewReader([]byte(mf.contents)),
 	}, ulfs.ObjectInfo{
-		ContentLength: int64(len(contents)),
+		Loc:           loc,
+		Created:       time.Unix(mf.created, 0),
+		ContentLength: int64(len(mf.contents)),
+		Expires:       mf.expires,
+		Metadata:      mf.metadata,
 	})
 }
 
@@ -109,7 +114,7 @@ func (rfs *remoteFilesystem) Open(ctx context.Context, bucket, key string) (ulfs
 		return nil, errs.New("file does not exist %q", loc)
 	}
 
-	return newMultiReadHandle(mf.contents), nil
+	return newMultiReadHandle(loc, mf), nil
 }
 
 func (rfs *remoteFilesystem) Create(ctx context.Context, bucket, key string, opts *ulfs.CreateOptions) (_ ulfs.MultiWriteHandle, err error) {
@@ -123,10 +128,23 @@ func (rfs *remoteFilesystem) Create(ctx context.Context, bucket, key string, opt
 	}
 
 	var metadata map[string]string
+	var resume bool
 	expires := time.Time{}
 	if opts != nil {
 		expires = opts.Expires
 		metadata = opts.Metadata
+		resume = opts.Resume
+	}
+
+	if opts != nil && opts.UploadID != "" {
+		for _, wh := range rfs.pending[loc] {
+			if wh.uploadID() == opts.UploadID {
+				wh.metadata = metadata
+				wh.resume = resume
+				return &memMultiWriteHandle{GenericMultiWriteHandle: ulfs.NewGenericMultiWriteHandle(wh), wh: wh}, nil
+			}
+		}
+		return nil, errs.New("upload %q does not exist", opts.UploadID)
 	}
 
 	rfs.created++
@@ -136,11 +154,32 @@ func (rfs *remoteFilesystem) Create(ctx context.Context, bucket, key string, opt
 		cre:      rfs.created,
 		expires:  expires,
 		metadata: metadata,
+		resume:   resume,
 	}
 
 	rfs.pending[loc] = append(rfs.pending[loc], wh)
 
-	return ulfs.NewGenericMultiWriteHandle(wh), nil
+	return &memMultiWriteHandle{GenericMultiWriteHandle: ulfs.NewGenericMultiWriteHandle(wh), wh: wh}, nil
+}
+
+// memMultiWriteHandle is a MultiWriteHandle for a pending upload.
+type memMultiWriteHandle struct {
+	*ulfs.GenericMultiWriteHandle
+	wh *memWriteHandle
+}
+
+func (m *memMultiWriteHandle) UploadID() string { return m.wh.uploadID() }
+
+func (m *memMultiWriteHandle) SetMetadata(key, value string) {
+	m.wh.rfs.mu.Lock()
+	defer m.wh.rfs.mu.Unlock()
+
+	metadata := make(map[string]string, len(m.wh.metadata)+1)
+	for k, v := range m.wh.metadata {
+		metadata[k] = v
+	}
+	metadata[key] = value
+	m.wh.metadata = metadata
 }
 
 func (rfs *remoteFilesystem) Move(ctx context.Context, oldbucket, oldkey string, newbucket, newkey string) error {
@@ -204,11 +243,16 @@ func (rfs *remoteFilesystem) List(ctx context.Context, bucket, key string, opts
 	var infos []ulfs.ObjectInfo
 	for loc, mf := range rfs.files {
 		if (loc.HasPrefix(prefixDir) || loc == prefix) && !mf.expired() {
-			infos = append(infos, ulfs.ObjectInfo{
+			info := ulfs.ObjectInfo{
 				Loc:     loc,
 				Created: time.Unix(mf.created, 0),
 				Expires: mf.expires,
-			})
+			}
+			if opts != nil && opts.Expanded {
+				info.ContentLength = int64(len(mf.contents))
+				info.Metadata = mf.metadata
+			}
+			infos = append(infos, info)
 		}
 	}
 
@@ -228,10 +272,18 @@ func (rfs *remoteFilesystem) listPending(ctx context.Context, prefix ulloc.Locat
 	for loc, whs := range rfs.pending {
 		if loc.HasPrefix(prefixDir) || loc == prefix {
 			for _, wh := range whs {
-				infos = append(infos, ulfs.ObjectInfo{
-					Loc:     loc,
-					Created: time.Unix(wh.cre, 0),
-				})
+				info := ulfs.ObjectInfo{
+					Loc:      loc,
+					Created:  time.Unix(wh.cre, 0),
+					UploadID: wh.uploadID(),
+				}
+				if opts.Expanded {
+					info.Metadata = wh.metadata
+				}
+				if opts.PartSizes {
+					info.ContentLength = int64(len(wh.buf))
+				}
+				infos = append(infos, info)
 			}
 		}
 	}
@@ -265,9 +317,26 @@ func (rfs *remoteFilesystem) Stat(ctx context.Context, bucket, key string) (*ulf
 		Created:       time.Unix(mf.created, 0),
 		Expires:       mf.expires,
 		ContentLength: int64(len(mf.contents)),
+		Metadata:      mf.metadata,
 	}, nil
 }
 
+func (rfs *remoteFilesystem) UpdateMetadata(ctx context.Context, bucket, key string, metadata map[string]string) error {
+	rfs.mu.Lock()
+	defer rfs.mu.Unlock()
+
+	loc := ulloc.NewRemote(bucket, key)
+
+	mf, ok := rfs.files[loc]
+	if !ok || mf.expired() {
+		return errs.New("file does not exist: %q", loc.Loc())
+	}
+
+	mf.metadata = metadata
+	rfs.files[loc] = mf
+	return nil
+}
+
 //
 // ulfs.WriteHandle
 //
@@ -279,9 +348,14 @@ type memWriteHandle struct {
 	cre      int64
 	expires  time.Time
 	metadata map[string]string
+	resume   bool
 	done     bool
 }
 
+func (b *memWriteHandle) uploadID() string {
+	return fmt.Sprintf("upload-%d", b.cre)
+}
+
 func (b *memWriteHandle) WriteAt(p []byte, off int64) (int, error) {
 	if b.done {
 		return 0, errs.New("write to closed handle")
@@ -315,6 +389,11 @@ func (b *memWriteHandle) Abort() error {
 	b.rfs.mu.Lock()
 	defer b.rfs.mu.Unlock()
 
+	// resumable uploads stay pending so that they can be continued.
+	if b.resume {
+		return nil
+	}
+
 	if err := b.close(); err != nil {
 		return err
 	}
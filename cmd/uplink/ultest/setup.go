@@ -30,6 +30,16 @@ func Setup(cmds Commands, opts ...ExecuteOption) State {
 	}
 }
 
+// NewFilesystem returns an in-memory filesystem with the remote buckets, for
+// testing code that uses a ulfs.Filesystem directly instead of a command.
+func NewFilesystem(buckets ...string) ulfs.Filesystem {
+	rfs := newRemoteFilesystem()
+	for _, bucket := range buckets {
+		rfs.ensureBucket(bucket)
+	}
+	return ulfs.NewMixed(ulfs.NewLocal(ulfs.NewLocalBackendMem()), rfs)
+}
+
 // State represents some state and environment for a command to execute in.
 type State struct {
 	cmds Commands
@@ -190,6 +200,12 @@ func WithStdin(stdin string) ExecuteOption {
 
 // WithFile sets the command to execute with a file created at the given location.
 func WithFile(location string, contents ...string) ExecuteOption {
+	return WithFileMetadata(location, nil, contents...)
+}
+
+// WithFileMetadata sets the command to execute with a file created at the given
+// location with the provided custom metadata.
+func WithFileMetadata(location string, metadata map[string]string, contents ...string) ExecuteOption {
 	contents = append([]string(nil), contents...)
 	return ExecuteOption{func(t *testing.T, ctx context.Context, cs *callbackState) {
 		loc, err := ulloc.Parse(location)
@@ -199,7 +215,7 @@ func WithFile(location string, contents ...string) ExecuteOption {
 			cs.rfs.ensureBucket(bucket)
 		}
 
-		mwh, err := cs.fs.Create(ctx, loc, nil)
+		mwh, err := cs.fs.Create(ctx, loc, &ulfs.CreateOptions{Metadata: metadata})
 		require.NoError(t, err)
 		defer func() { _ = mwh.Abort(ctx) }()
 
@@ -222,8 +238,9 @@ func WithFile(location string, contents ...string) ExecuteOption {
 }
 
 // WithPendingFile sets the command to execute with a pending upload happening to
-// the provided location.
-func WithPendingFile(location string) ExecuteOption {
+// the provided location. The contents, if any, are uploaded as a part.
+func WithPendingFile(location string, contents ...string) ExecuteOption {
+	contents = append([]string(nil), contents...)
 	return ExecuteOption{func(t *testing.T, ctx context.Context, cs *callbackState) {
 		loc, err := ulloc.Parse(location)
 		require.NoError(t, err)
@@ -234,7 +251,19 @@ func WithPendingFile(location string) ExecuteOption {
 			t.Fatalf("Invalid pending local file: %s", loc)
 		}
 
-		_, err = cs.fs.Create(ctx, loc, nil)
+		mwh, err := cs.fs.Create(ctx, loc, nil)
+		require.NoError(t, err)
+
+		if len(contents) == 0 {
+			return
+		}
+
+		wh, err := mwh.NextPart(ctx, -1)
 		require.NoError(t, err)
+		for _, content := range contents {
+			_, err := wh.Write([]byte(content))
+			require.NoError(t, err)
+		}
+		require.NoError(t, wh.Commit())
 	}}
 }
@@ -35,6 +35,7 @@ import (
 	"storj.io/storj/satellite/accounting/rolluparchive"
 	"storj.io/storj/satellite/accounting/tally"
 	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/bucketevents"
 	"storj.io/storj/satellite/compensation"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleweb"
@@ -46,6 +47,7 @@ import (
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/zombiedeletion"
 	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/metainfo/bucketlifecycle"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
 	"storj.io/storj/satellite/nodeevents"
 	"storj.io/storj/satellite/nodestats"
@@ -153,6 +155,15 @@ type Satellite struct {
 		Chore *zombiedeletion.Chore
 	}
 
+	BucketLifecycle struct {
+		Chore *bucketlifecycle.Chore
+	}
+
+	BucketEvents struct {
+		Service *bucketevents.Service
+		Chore   *bucketevents.Chore
+	}
+
 	Accounting struct {
 		Tally            *tally.Service
 		Rollup           *rollup.Service
@@ -636,6 +647,10 @@ func createNewSystem(name string, log *zap.Logger, config satellite.Config, peer
 
 	system.ExpiredDeletion.Chore = peer.ExpiredDeletion.Chore
 	system.ZombieDeletion.Chore = peer.ZombieDeletion.Chore
+	system.BucketLifecycle.Chore = peer.BucketLifecycle.Chore
+
+	system.BucketEvents.Service = api.BucketEvents.Service
+	system.BucketEvents.Chore = peer.BucketEvents.Chore
 
 	system.Accounting.Tally = peer.Accounting.Tally
 	system.Accounting.Rollup = peer.Accounting.Rollup
@@ -0,0 +1,96 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package webhook implements posting to the addresses provided by the users,
+// without letting them reach the internal network of the satellite.
+package webhook
+
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// ErrForbiddenAddress is returned when a webhook refers to an internal address.
+var ErrForbiddenAddress = errs.Class("forbidden webhook address")
+
+// CheckURL verifies that the address is an absolute http or https address,
+// which doesn't refer to a loopback, private, link-local or unspecified IP
+// address. Host names are resolved only when dialing, see NewClient.
+func CheckURL(address string) error {
+	u, err := url.Parse(address)
+	if err != nil {
+		return err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errs.New("url must be an absolute http or https address")
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return ErrForbiddenAddress.New("%s", host)
+	}
+	if ip := net.ParseIP(host); ip != nil && forbiddenIP(ip) {
+		return ErrForbiddenAddress.New("%s", ip)
+	}
+	return nil
+}
+
+// NewClient returns an HTTP client, which refuses to connect to the forbidden
+// addresses and doesn't follow redirects. The addresses are checked after the
+// host name is resolved, so a host name can't be used to bypass CheckURL.
+// allowPrivate disables the check, which is needed to test with local servers.
+func NewClient(timeout time.Duration, allowPrivate bool) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   timeout,
+		KeepAlive: 30 * time.Second,
+	}
+	if !allowPrivate {
+		dialer.Control = func(network, address string, c syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return ErrForbiddenAddress.Wrap(err)
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || forbiddenIP(ip) {
+				return ErrForbiddenAddress.New("%s", host)
+			}
+			return nil
+		}
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			// a proxy would connect to the forbidden addresses on our behalf.
+			Proxy:                 nil,
+			DialContext:           dialer.DialContext,
+			ForceAttemptHTTP2:     true,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: 1 * time.Second,
+		},
+		// a redirect could point to a forbidden address, hence the
+		// redirect response is returned as the response.
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// forbiddenIP returns whether the address belongs to the internal network.
+func forbiddenIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() ||
+		sharedAddressSpace.Contains(ip)
+}
+
+// sharedAddressSpace is the carrier-grade NAT range, RFC 6598.
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
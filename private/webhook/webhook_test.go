@@ -0,0 +1,81 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package webhook_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/private/webhook"
+)
+
+func TestCheckURL(t *testing.T) {
+	for _, address := range []string{
+		"https://example.com/hook",
+		"http://8.8.8.8:8080/hook",
+		"https://[2001:4860:4860::8888]/hook",
+	} {
+		require.NoError(t, webhook.CheckURL(address), address)
+	}
+
+	for _, address := range []string{
+		"ftp://example.com",
+		"/hook",
+		"http://",
+	} {
+		err := webhook.CheckURL(address)
+		require.Error(t, err, address)
+		require.False(t, webhook.ErrForbiddenAddress.Has(err), address)
+	}
+
+	for _, address := range []string{
+		"http://localhost/hook",
+		"http://api.localhost./hook",
+		"http://127.0.0.1:10000",
+		"http://10.0.0.1",
+		"http://192.168.1.1",
+		"http://172.16.0.1",
+		"http://100.64.0.1",
+		"http://169.254.169.254/latest/meta-data",
+		"http://0.0.0.0",
+		"http://[::1]/hook",
+		"http://[fe80::1]/hook",
+		"http://[fd00::1]/hook",
+		"http://[::ffff:127.0.0.1]/hook",
+	} {
+		require.True(t, webhook.ErrForbiddenAddress.Has(webhook.CheckURL(address)), address)
+	}
+}
+
+func TestClient(t *testing.T) {
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer target.Close()
+
+	redirect := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusFound))
+	defer redirect.Close()
+
+	// local addresses are refused when dialing.
+	client := webhook.NewClient(5*time.Second, false)
+	_, err := client.Post(target.URL, "application/json", nil) //nolint:bodyclose // no response
+	require.Error(t, err)
+	require.True(t, webhook.ErrForbiddenAddress.Has(err))
+
+	client = webhook.NewClient(5*time.Second, true)
+	resp, err := client.Post(target.URL, "application/json", nil)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	// redirects aren't followed.
+	resp, err = client.Post(redirect.URL, "application/json", nil)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+}
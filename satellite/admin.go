@@ -22,6 +22,7 @@ import (
 	"storj.io/storj/private/version/checker"
 	"storj.io/storj/satellite/admin"
 	"storj.io/storj/satellite/analytics"
+	"storj.io/storj/satellite/bucketevents"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/restkeys"
@@ -95,7 +96,8 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 	}
 
 	{
-		peer.Buckets.Service = buckets.NewService(db.Buckets(), metabaseDB)
+		bucketEvents := bucketevents.NewService(peer.Log.Named("bucketevents:service"), config.BucketEvents, db.Buckets())
+		peer.Buckets.Service = buckets.NewService(db.Buckets(), metabaseDB, bucketEvents.Resolver())
 	}
 
 	{ // setup rest keys
@@ -0,0 +1,193 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+const (
+	// auditEventsDefaultLimit is the page size used when the limit query param is missing.
+	auditEventsDefaultLimit = 100
+	// auditEventsExportPageSize is the number of audit events queried at once for the CSV export.
+	auditEventsExportPageSize = 1000
+)
+
+func (server *Server) getUserAuditEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	userEmail, ok := vars["useremail"]
+	if !ok {
+		sendJSONError(w, "user-email missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	user, err := server.db.Console().Users().GetByEmail(ctx, userEmail)
+	if errors.Is(err, sql.ErrNoRows) {
+		sendJSONError(w, fmt.Sprintf("user with email %q does not exist", userEmail),
+			"", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		sendJSONError(w, "failed to get user",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	server.sendAuditEvents(w, r, console.AuditEventsCursor{UserID: &user.ID})
+}
+
+func (server *Server) getProjectAuditEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	projectUUIDString, ok := vars["project"]
+	if !ok {
+		sendJSONError(w, "project-uuid missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	projectUUID, err := uuid.FromString(projectUUIDString)
+	if err != nil {
+		sendJSONError(w, "invalid project-uuid",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	project, err := server.db.Console().Projects().Get(ctx, projectUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		sendJSONError(w, "project with specified uuid does not exist",
+			"", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		sendJSONError(w, "unable to fetch project details",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	server.sendAuditEvents(w, r, console.AuditEventsCursor{ProjectID: &project.ID})
+}
+
+// sendAuditEvents responds with the audit events matching the cursor filters and the query params.
+// A page of events is returned as JSON, or all of them as CSV when the format query param is "csv".
+func (server *Server) sendAuditEvents(w http.ResponseWriter, r *http.Request, cursor console.AuditEventsCursor) {
+	ctx := r.Context()
+
+	query := r.URL.Query()
+	for param, value := range map[string]*time.Time{"since": &cursor.Since, "before": &cursor.Before} {
+		str := query.Get(param)
+		if str == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, str)
+		if err != nil {
+			sendJSONError(w, fmt.Sprintf("invalid %s parameter", param),
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+		*value = t
+	}
+
+	if query.Get("format") == "csv" {
+		if cursor.Before.IsZero() {
+			// the events which are added during the export would shift the pages.
+			cursor.Before = server.nowFn()
+		}
+		cursor.Limit = auditEventsExportPageSize
+		cursor.Page = 1
+
+		var buf bytes.Buffer
+		writer, err := console.NewAuditEventsCSVWriter(&buf)
+		if err != nil {
+			sendJSONError(w, "failed to write csv",
+				err.Error(), http.StatusInternalServerError)
+			return
+		}
+		for {
+			page, err := server.db.Console().AuditEvents().GetPaged(ctx, cursor)
+			if err != nil {
+				sendJSONError(w, "failed to get audit events",
+					err.Error(), http.StatusInternalServerError)
+				return
+			}
+			if err := writer.Write(page.Events); err != nil {
+				sendJSONError(w, "failed to write csv",
+					err.Error(), http.StatusInternalServerError)
+				return
+			}
+			if cursor.Page >= page.PageCount {
+				break
+			}
+			cursor.Page++
+		}
+		if err := writer.Flush(); err != nil {
+			sendJSONError(w, "failed to write csv",
+				err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", `attachment; filename="audit-log.csv"`)
+		w.WriteHeader(http.StatusOK)
+		_, _ = buf.WriteTo(w) // any error here entitles a client side disconnect or similar, which we do not care about.
+		return
+	}
+
+	cursor.Limit = auditEventsDefaultLimit
+	if limitStr := query.Get("limit"); limitStr != "" {
+		limit, err := strconv.ParseUint(limitStr, 10, 32)
+		if err != nil || limit == 0 {
+			sendJSONError(w, "invalid limit parameter",
+				limitStr, http.StatusBadRequest)
+			return
+		}
+		cursor.Limit = uint(limit)
+	}
+
+	cursor.Page = 1
+	if pageStr := query.Get("page"); pageStr != "" {
+		page, err := strconv.ParseUint(pageStr, 10, 32)
+		if err != nil || page == 0 {
+			sendJSONError(w, "invalid page parameter",
+				pageStr, http.StatusBadRequest)
+			return
+		}
+		cursor.Page = uint(page)
+	}
+
+	page, err := server.db.Console().AuditEvents().GetPaged(ctx, cursor)
+	if err != nil {
+		sendJSONError(w, "failed to get audit events",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if page.Events == nil {
+		page.Events = []console.AuditEvent{}
+	}
+
+	data, err := json.Marshal(page)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
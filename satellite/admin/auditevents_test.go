@@ -0,0 +1,108 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+)
+
+func TestAuditEvents(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(_ *zap.Logger, _ int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+				// only the events added by the test are expected.
+				config.Console.AuditLogEnabled = false
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		address := sat.Admin.Admin.Listener.Addr()
+		project := planet.Uplinks[0].Projects[0]
+
+		user, err := sat.DB.Console().Users().GetByEmail(ctx, project.Owner.Email)
+		require.NoError(t, err)
+
+		apiKeyID := testrand.UUID()
+		now := time.Now()
+		for i, operation := range []string{"create api key", "delete api key"} {
+			require.NoError(t, sat.DB.Console().AuditEvents().Insert(ctx, &console.AuditEvent{
+				ID:        testrand.UUID(),
+				CreatedAt: now.Add(time.Duration(i) * time.Second),
+				Operation: operation,
+				UserID:    &user.ID,
+				Email:     user.Email,
+				ProjectID: &project.ID,
+				APIKeyID:  &apiKeyID,
+			}))
+		}
+
+		get := func(t *testing.T, path string) (*http.Response, []byte) {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+address.String()+path, nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", sat.Config.Console.AuthToken)
+
+			response, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			body, err := io.ReadAll(response.Body)
+			require.NoError(t, err)
+			require.NoError(t, response.Body.Close())
+			return response, body
+		}
+
+		for _, path := range []string{
+			"/api/users/" + user.Email + "/audit-events",
+			"/api/projects/" + project.ID.String() + "/audit-events",
+		} {
+			response, body := get(t, path+"?limit=1&page=2")
+			require.Equal(t, http.StatusOK, response.StatusCode, string(body))
+
+			var page console.AuditEventsPage
+			require.NoError(t, json.Unmarshal(body, &page))
+			require.EqualValues(t, 2, page.TotalCount)
+			require.EqualValues(t, 2, page.PageCount)
+			require.Len(t, page.Events, 1)
+			require.Equal(t, "create api key", page.Events[0].Operation)
+			require.Equal(t, &apiKeyID, page.Events[0].APIKeyID)
+
+			response, body = get(t, path+"?format=csv")
+			require.Equal(t, http.StatusOK, response.StatusCode, string(body))
+			require.Equal(t, "text/csv", response.Header.Get("Content-Type"))
+
+			records, err := csv.NewReader(bytes.NewReader(body)).ReadAll()
+			require.NoError(t, err)
+			require.Len(t, records, 3)
+			require.Equal(t, "delete api key", records[1][2])
+			require.Equal(t, "create api key", records[2][2])
+		}
+
+		response, body := get(t, "/api/users/"+user.Email+"/audit-events?since="+now.Add(time.Hour).Format(time.RFC3339))
+		require.Equal(t, http.StatusOK, response.StatusCode, string(body))
+		require.JSONEq(t, `{"events":[],"limit":100,"offset":0,"pageCount":0,"currentPage":1,"totalCount":0}`, string(body))
+
+		response, _ = get(t, "/api/users/"+user.Email+"/audit-events?since=yesterday")
+		require.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+		response, _ = get(t, "/api/users/unknown@mail.test/audit-events")
+		require.Equal(t, http.StatusNotFound, response.StatusCode)
+	})
+}
@@ -7,12 +7,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
 )
 
 func validateBucketPathParameters(vars map[string]string) (project uuid.NullUUID, bucket []byte, err error) {
@@ -132,3 +134,105 @@ func (server *Server) getBucketInfo(w http.ResponseWriter, r *http.Request) {
 		sendJSONData(w, http.StatusOK, data)
 	}
 }
+
+func (server *Server) deleteBucket(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	var bypassGovernance bool
+	if value := r.URL.Query().Get("bypass-governance"); value != "" {
+		bypassGovernance, err = strconv.ParseBool(value)
+		if err != nil {
+			sendJSONError(w, "invalid bypass-governance parameter", err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	_, err = server.buckets.GetBucket(ctx, bucket, project.UUID)
+	if err != nil {
+		if buckets.ErrBucketNotFound.Has(err) {
+			sendJSONError(w, "bucket does not exist", "", http.StatusNotFound)
+		} else {
+			sendJSONError(w, "unable to check bucket", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	_, err = server.buckets.DeleteBucketWithObjects(ctx, bucket, project.UUID, bypassGovernance)
+	if err != nil {
+		switch {
+		case metabase.ErrObjectLock.Has(err):
+			sendJSONError(w, "bucket contains locked objects", err.Error(), http.StatusConflict)
+		case buckets.ErrBucketNotEmpty.Has(err):
+			sendJSONError(w, "bucket is not empty", err.Error(), http.StatusConflict)
+		default:
+			sendJSONError(w, "unable to delete bucket", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (server *Server) getBucketRateLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	limits, err := server.buckets.GetBucketRateLimits(ctx, bucket, project.UUID)
+	if err != nil {
+		if buckets.ErrBucketNotFound.Has(err) {
+			sendJSONError(w, "bucket does not exist", "", http.StatusNotFound)
+		} else {
+			sendJSONError(w, "unable to get bucket rate limits", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	data, err := json.Marshal(limits)
+	if err != nil {
+		sendJSONError(w, "failed to marshal bucket rate limits", err.Error(), http.StatusInternalServerError)
+	} else {
+		sendJSONData(w, http.StatusOK, data)
+	}
+}
+
+func (server *Server) setBucketRateLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	var limits buckets.RateLimits
+	if err := json.NewDecoder(r.Body).Decode(&limits); err != nil {
+		sendJSONError(w, "failed to unmarshal request", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = server.buckets.SetBucketRateLimits(ctx, bucket, project.UUID, limits)
+	if err != nil {
+		switch {
+		case buckets.ErrInvalidRateLimits.Has(err):
+			sendJSONError(w, "invalid rate limits", err.Error(), http.StatusBadRequest)
+		case buckets.ErrBucketNotFound.Has(err):
+			sendJSONError(w, "bucket does not exist", "", http.StatusNotFound)
+		default:
+			sendJSONError(w, "unable to set bucket rate limits", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
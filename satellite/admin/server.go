@@ -52,7 +52,7 @@ type Config struct {
 
 // Groups defines permission groups.
 type Groups struct {
-	LimitUpdate string `help:"the group which is only allowed to update user and project limits and freeze and unfreeze accounts."`
+	LimitUpdate string `help:"the group which is only allowed to update user and project limits, freeze and unfreeze accounts and read the console audit log."`
 }
 
 // DB is databases needed for the admin server.
@@ -136,8 +136,11 @@ func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.S
 	fullAccessAPI.HandleFunc("/projects/{project}/apikeys", server.listAPIKeys).Methods("GET")
 	fullAccessAPI.HandleFunc("/projects/{project}/apikeys/{name}", server.deleteAPIKeyByName).Methods("DELETE")
 	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}", server.getBucketInfo).Methods("GET")
+	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}", server.deleteBucket).Methods("DELETE")
 	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/geofence", server.createGeofenceForBucket).Methods("POST")
 	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/geofence", server.deleteGeofenceForBucket).Methods("DELETE")
+	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/ratelimits", server.getBucketRateLimits).Methods("GET")
+	fullAccessAPI.HandleFunc("/projects/{project}/buckets/{bucket}/ratelimits", server.setBucketRateLimits).Methods("PUT")
 	fullAccessAPI.HandleFunc("/projects/{project}/usage", server.checkProjectUsage).Methods("GET")
 	fullAccessAPI.HandleFunc("/projects/{project}/useragent", server.updateProjectsUserAgent).Methods("PATCH")
 	fullAccessAPI.HandleFunc("/projects/{project}/geofence", server.createGeofenceForProject).Methods("POST")
@@ -156,8 +159,10 @@ func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.S
 	limitUpdateAPI.HandleFunc("/users/{useremail}/freeze", server.freezeUser).Methods("PUT")
 	limitUpdateAPI.HandleFunc("/users/{useremail}/freeze", server.unfreezeUser).Methods("DELETE")
 	limitUpdateAPI.HandleFunc("/users/{useremail}/warning", server.unWarnUser).Methods("DELETE")
+	limitUpdateAPI.HandleFunc("/users/{useremail}/audit-events", server.getUserAuditEvents).Methods("GET")
 	limitUpdateAPI.HandleFunc("/projects/{project}/limit", server.getProjectLimit).Methods("GET")
 	limitUpdateAPI.HandleFunc("/projects/{project}/limit", server.putProjectLimit).Methods("PUT", "POST")
+	limitUpdateAPI.HandleFunc("/projects/{project}/audit-events", server.getProjectAuditEvents).Methods("GET")
 
 	// This handler must be the last one because it uses the root as prefix,
 	// otherwise will try to serve all the handlers set after this one.
@@ -33,6 +33,7 @@ import (
 	"storj.io/storj/satellite/abtesting"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/analytics"
+	"storj.io/storj/satellite/bucketevents"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
@@ -41,6 +42,7 @@ import (
 	"storj.io/storj/satellite/console/userinfo"
 	"storj.io/storj/satellite/contact"
 	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metainfo"
@@ -177,6 +179,10 @@ type API struct {
 	Buckets struct {
 		Service *buckets.Service
 	}
+
+	BucketEvents struct {
+		Service *bucketevents.Service
+	}
 }
 
 // NewAPI creates a new satellite API process.
@@ -194,8 +200,12 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		Services: lifecycle.NewGroup(log.Named("services")),
 	}
 
+	{ // setup bucket events service
+		peer.BucketEvents.Service = bucketevents.NewService(peer.Log.Named("bucketevents:service"), config.BucketEvents, db.Buckets())
+	}
+
 	{ // setup buckets service
-		peer.Buckets.Service = buckets.NewService(db.Buckets(), metabaseDB)
+		peer.Buckets.Service = buckets.NewService(db.Buckets(), metabaseDB, peer.BucketEvents.Service.Resolver())
 	}
 
 	{ // setup debug
@@ -447,6 +457,7 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.DB.Console().Projects(),
 			signing.SignerFromFullIdentity(peer.Identity),
 			peer.DB.Revocation(),
+			peer.BucketEvents.Service,
 			config.Metainfo,
 		)
 		if err != nil {
@@ -456,6 +467,9 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		if err := pb.DRPCRegisterMetainfo(peer.Server.DRPC(), peer.Metainfo.Endpoint); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		if err := internalpb.DRPCRegisterMetainfoExt(peer.Server.DRPC(), peer.Metainfo.Endpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 
 		peer.Services.Add(lifecycle.Item{
 			Name:  "metainfo:endpoint",
@@ -602,6 +616,14 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			return nil, errs.Combine(err, peer.Close())
 		}
 
+		peer.Services.Add(lifecycle.Item{
+			Name:  "console:auditevents",
+			Run:   peer.Console.Service.AuditEventsWriter().Run,
+			Close: peer.Console.Service.AuditEventsWriter().Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Console Audit Events Writer", peer.Console.Service.AuditEventsWriter().Loop))
+
 		accountFreezeService := console.NewAccountFreezeService(db.Console().AccountFreezeEvents(), db.Console().Users(), db.Console().Projects(), peer.Analytics.Service)
 
 		peer.Console.Endpoint = consoleweb.NewServer(
@@ -4,7 +4,9 @@
 package audit
 
 import (
+	"bytes"
 	"context"
+	"encoding/gob"
 	"math/rand"
 	"time"
 
@@ -29,7 +31,7 @@ type Observer struct {
 	Reservoirs map[metabase.NodeAlias]*Reservoir
 }
 
-var _ rangedloop.Observer = (*Observer)(nil)
+var _ rangedloop.CheckpointObserver = (*Observer)(nil)
 var _ rangedloop.Partial = (*observerFork)(nil)
 
 // NewObserver instantiates Observer.
@@ -88,6 +90,65 @@ func (obs *Observer) Join(ctx context.Context, partial rangedloop.Partial) (err
 	return nil
 }
 
+// reservoirCheckpoint is the persisted state of a reservoir.
+type reservoirCheckpoint struct {
+	Segments []rangedloop.Segment
+	Keys     []float64
+}
+
+// CheckpointPartial returns the reservoirs of the audit reservoir collector.
+func (obs *Observer) CheckpointPartial(ctx context.Context, partial rangedloop.Partial) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	fork, ok := partial.(*observerFork)
+	if !ok {
+		return nil, errs.New("expected partial type %T but got %T", fork, partial)
+	}
+
+	reservoirs := make(map[metabase.NodeAlias]reservoirCheckpoint, len(fork.reservoirs))
+	for nodeAlias, reservoir := range fork.reservoirs {
+		reservoirs[nodeAlias] = reservoirCheckpoint{
+			Segments: reservoir.Segments(),
+			Keys:     reservoir.Keys(),
+		}
+	}
+
+	// gob is used, because the keys may be infinite, which JSON can't encode.
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(reservoirs); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// RestorePartial returns an audit reservoir collector which continues from
+// the checkpointed reservoirs.
+func (obs *Observer) RestorePartial(ctx context.Context, data []byte) (_ rangedloop.Partial, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var reservoirs map[metabase.NodeAlias]reservoirCheckpoint
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&reservoirs); err != nil {
+		return nil, err
+	}
+
+	partial, err := obs.Fork(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fork := partial.(*observerFork)
+	for nodeAlias, saved := range reservoirs {
+		if len(saved.Segments) != len(saved.Keys) {
+			return nil, errs.New("mismatched reservoir checkpoint: %d segments but %d keys", len(saved.Segments), len(saved.Keys))
+		}
+		reservoir := NewReservoir(fork.slotCount)
+		for i := range saved.Segments {
+			reservoir.sample(saved.Keys[i], saved.Segments[i])
+		}
+		fork.reservoirs[nodeAlias] = reservoir
+	}
+	return fork, nil
+}
+
 // Finish builds and dedups an audit queue from the merged per-node reservoirs.
 func (obs *Observer) Finish(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -6,6 +6,7 @@ package audit_test
 import (
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -13,10 +14,12 @@ import (
 	"go.uber.org/zap/zaptest"
 
 	"storj.io/common/memory"
+	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/rangedloop"
 )
 
@@ -82,6 +85,42 @@ func TestAuditCollector(t *testing.T) {
 	})
 }
 
+func TestObserverCheckpoint(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	observer := audit.NewObserver(zaptest.NewLogger(t), nil, audit.Config{Slots: 2})
+	require.NoError(t, observer.Start(ctx, time.Now()))
+
+	var segments []rangedloop.Segment
+	for i := 0; i < 10; i++ {
+		segments = append(segments, rangedloop.Segment{
+			StreamID:      testrand.UUID(),
+			EncryptedSize: int32(1 + i),
+			Redundancy:    storj.RedundancyScheme{Algorithm: storj.ReedSolomon, RequiredShares: 1, TotalShares: 2},
+			AliasPieces:   metabase.AliasPieces{{Number: 0, Alias: 1}, {Number: 1, Alias: 2}},
+			Pieces:        metabase.Pieces{{Number: 0, StorageNode: testrand.NodeID()}},
+		})
+	}
+
+	fork, err := observer.Fork(ctx)
+	require.NoError(t, err)
+	require.NoError(t, fork.Process(ctx, segments))
+
+	data, err := observer.CheckpointPartial(ctx, fork)
+	require.NoError(t, err)
+	restored, err := observer.RestorePartial(ctx, data)
+	require.NoError(t, err)
+
+	require.NoError(t, observer.Join(ctx, restored))
+	require.Len(t, observer.Reservoirs, 2)
+	for _, reservoir := range observer.Reservoirs {
+		require.Len(t, reservoir.Segments(), 2)
+		for _, segment := range reservoir.Segments() {
+			require.Contains(t, segments, segment)
+		}
+	}
+}
+
 func BenchmarkRemoteSegment(b *testing.B) {
 	testplanet.Bench(b, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
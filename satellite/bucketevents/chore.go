@@ -0,0 +1,203 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketevents
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/private/webhook"
+	"storj.io/storj/satellite/metabase"
+)
+
+// maxResponseSize is the maximum number of bytes read from a webhook response.
+const maxResponseSize = 4 << 10
+
+// Chore delivers the bucket events from the outbox to the webhooks.
+//
+// architecture: Chore
+type Chore struct {
+	log    *zap.Logger
+	config Config
+	db     DB
+	client *http.Client
+
+	nowFn func() time.Time
+	Loop  *sync2.Cycle
+}
+
+// NewChore creates a new bucket events chore.
+func NewChore(log *zap.Logger, config Config, db DB) *Chore {
+	if config.Concurrency < 1 {
+		config.Concurrency = 1
+	}
+	return &Chore{
+		log:    log,
+		config: config,
+		db:     db,
+		client: webhook.NewClient(config.Timeout, config.AllowPrivateNetworks),
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run runs the chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.config.Enabled {
+		return nil
+	}
+
+	return chore.Loop.Run(ctx, chore.processWhileQueueHasItems)
+}
+
+// processWhileQueueHasItems keeps calling process() until there are no more
+// due entries or something else goes wrong in fetching from the outbox.
+func (chore *Chore) processWhileQueueHasItems(ctx context.Context) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		n, err := chore.process(ctx)
+		if err != nil {
+			chore.log.Error("process", zap.Error(Error.Wrap(err)))
+			return nil
+		}
+		if n < chore.config.BatchSize {
+			return nil
+		}
+	}
+}
+
+// process claims a batch of due entries and delivers them.
+func (chore *Chore) process(ctx context.Context) (n int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the lease must outlast the deliveries, otherwise another chore could
+	// claim the same entries while they are still being delivered.
+	lease := chore.config.Timeout * time.Duration(chore.config.BatchSize/chore.config.Concurrency+2)
+
+	entries, err := chore.db.ClaimBucketEvents(ctx, metabase.ClaimBucketEvents{
+		Now:   chore.nowFn(),
+		Lease: lease,
+		Limit: chore.config.BatchSize,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	limiter := sync2.NewLimiter(chore.config.Concurrency)
+	for _, entry := range entries {
+		entry := entry
+		limiter.Go(ctx, func() {
+			chore.deliver(ctx, entry)
+		})
+	}
+	limiter.Wait()
+
+	return len(entries), nil
+}
+
+// deliver posts the entry to the webhook and updates the outbox accordingly.
+func (chore *Chore) deliver(ctx context.Context, entry metabase.BucketEventEntry) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	err = chore.send(ctx, entry)
+	if err == nil {
+		mon.Meter("bucket_events_delivered").Mark(1)
+		if err := chore.db.DeleteBucketEvent(ctx, entry.ID); err != nil {
+			chore.log.Error("unable to delete delivered bucket event", zap.Stringer("ID", entry.ID), zap.Error(err))
+		}
+		return
+	}
+
+	attempts := entry.Attempts + 1
+	if attempts >= chore.config.MaxAttempts {
+		mon.Meter("bucket_events_dropped").Mark(1)
+		chore.log.Error("dropping undeliverable bucket event",
+			zap.Stringer("ID", entry.ID),
+			zap.Stringer("Project ID", entry.Bucket.ProjectID),
+			zap.String("Bucket", entry.Bucket.BucketName),
+			zap.String("Webhook", entry.WebhookURL),
+			zap.Int("Attempts", attempts),
+			zap.Error(err))
+		if err := chore.db.DeleteBucketEvent(ctx, entry.ID); err != nil {
+			chore.log.Error("unable to delete undeliverable bucket event", zap.Stringer("ID", entry.ID), zap.Error(err))
+		}
+		return
+	}
+
+	mon.Meter("bucket_events_failed").Mark(1)
+	chore.log.Debug("unable to deliver bucket event",
+		zap.Stringer("ID", entry.ID),
+		zap.String("Webhook", entry.WebhookURL),
+		zap.Int("Attempts", attempts),
+		zap.Error(err))
+	reschedule := metabase.RescheduleBucketEvent{
+		ID:            entry.ID,
+		NextAttemptAt: chore.nowFn().Add(chore.retryDelay(attempts)),
+		LastError:     err.Error(),
+	}
+	if err := chore.db.RescheduleBucketEvent(ctx, reschedule); err != nil {
+		chore.log.Error("unable to reschedule bucket event", zap.Stringer("ID", entry.ID), zap.Error(err))
+	}
+}
+
+// send posts the payload of the entry to the webhook.
+func (chore *Chore) send(ctx context.Context, entry metabase.BucketEventEntry) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, entry.WebhookURL, bytes.NewReader(entry.Payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := chore.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseSize))
+		err = errs.Combine(err, resp.Body.Close())
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errs.New("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// retryDelay returns how long to wait before the next delivery attempt.
+func (chore *Chore) retryDelay(attempts int) time.Duration {
+	delay := chore.config.RetryInterval
+	for i := 1; i < attempts && delay < chore.config.MaxRetryInterval; i++ {
+		delay *= 2
+	}
+	if delay > chore.config.MaxRetryInterval {
+		delay = chore.config.MaxRetryInterval
+	}
+	return delay
+}
+
+// SetNow sets nowFn on chore for testing.
+func (chore *Chore) SetNow(f func() time.Time) {
+	chore.nowFn = f
+}
+
+// Close closes the chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
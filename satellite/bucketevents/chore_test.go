@@ -0,0 +1,235 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketevents_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/bucketevents"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+)
+
+// webhook records the events it receives and fails the first requests.
+type webhook struct {
+	mu       sync.Mutex
+	failures int
+	events   []bucketevents.Event
+}
+
+func (hook *webhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	hook.mu.Lock()
+	defer hook.mu.Unlock()
+
+	if hook.failures > 0 {
+		hook.failures--
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	var event bucketevents.Event
+	if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	hook.events = append(hook.events, event)
+}
+
+func (hook *webhook) received() []bucketevents.Event {
+	hook.mu.Lock()
+	defer hook.mu.Unlock()
+	return append([]bucketevents.Event(nil), hook.events...)
+}
+
+func TestBucketEvents(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.BucketEvents.Enabled = true
+				config.BucketEvents.MaxAttempts = 3
+				config.BucketEvents.AllowPrivateNetworks = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		projectID := upl.Projects[0].ID
+		chore := sat.Core.BucketEvents.Chore
+
+		chore.Loop.Pause()
+
+		hook := &webhook{failures: 1}
+		server := httptest.NewServer(hook)
+		defer server.Close()
+
+		require.NoError(t, upl.CreateBucket(ctx, sat, "events"))
+
+		configuration := buckets.NotificationConfiguration{
+			Webhooks: []buckets.Webhook{{
+				URL:    server.URL,
+				Events: []buckets.EventType{"ObjectCreated:*"},
+			}},
+		}
+
+		// the service refuses webhooks in the local network.
+		err := sat.API.Buckets.Service.SetBucketNotifications(ctx, []byte("events"), projectID, configuration)
+		require.True(t, buckets.ErrInvalidNotification.Has(err))
+
+		err = sat.DB.Buckets().SetBucketNotifications(ctx, []byte("events"), projectID, configuration)
+		require.NoError(t, err)
+
+		require.NoError(t, upl.Upload(ctx, sat, "events", "object", testrand.Bytes(memory.KiB)))
+		// deletions don't match the configured event types.
+		require.NoError(t, upl.DeleteObject(ctx, sat, "events", "object"))
+
+		// the first delivery fails and is retried later.
+		chore.Loop.TriggerWait()
+		require.Empty(t, hook.received())
+
+		chore.Loop.TriggerWait()
+		require.Empty(t, hook.received())
+
+		chore.SetNow(func() time.Time {
+			return time.Now().Add(time.Hour)
+		})
+		chore.Loop.TriggerWait()
+
+		events := hook.received()
+		require.Len(t, events, 1)
+		require.Equal(t, buckets.ObjectCreatedPut, events[0].Type)
+		require.Equal(t, projectID, events[0].ProjectID)
+		require.Equal(t, "events", events[0].Bucket)
+		require.NotZero(t, events[0].Size)
+
+		// delivered events are removed from the outbox.
+		chore.Loop.TriggerWait()
+		require.Len(t, hook.received(), 1)
+	})
+}
+
+func TestBucketEventsDropped(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.BucketEvents.Enabled = true
+				config.BucketEvents.MaxAttempts = 2
+				config.BucketEvents.AllowPrivateNetworks = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		projectID := upl.Projects[0].ID
+		chore := sat.Core.BucketEvents.Chore
+
+		chore.Loop.Pause()
+
+		hook := &webhook{failures: 2}
+		server := httptest.NewServer(hook)
+		defer server.Close()
+
+		require.NoError(t, upl.CreateBucket(ctx, sat, "events"))
+		err := sat.DB.Buckets().SetBucketNotifications(ctx, []byte("events"), projectID, buckets.NotificationConfiguration{
+			Webhooks: []buckets.Webhook{{
+				URL:    server.URL,
+				Events: []buckets.EventType{buckets.ObjectCreatedPut},
+			}},
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, upl.Upload(ctx, sat, "events", "inline", testrand.Bytes(memory.KiB)))
+
+		now := time.Now()
+		for i := 0; i < 3; i++ {
+			now = now.Add(24 * time.Hour)
+			chore.SetNow(func() time.Time { return now })
+			chore.Loop.TriggerWait()
+		}
+
+		// the event was dropped after the maximum number of attempts.
+		require.Empty(t, hook.received())
+		entries, err := sat.Metabase.DB.ClaimBucketEvents(ctx, metabase.ClaimBucketEvents{
+			Now:   now.Add(time.Hour),
+			Lease: time.Minute,
+			Limit: 10,
+		})
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+}
+
+func TestBucketEventsDeletions(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.BucketEvents.Enabled = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		projectID := upl.Projects[0].ID
+
+		sat.Core.BucketEvents.Chore.Loop.Pause()
+		sat.Core.ExpiredDeletion.Chore.Loop.Pause()
+
+		claim := func() (types []buckets.EventType) {
+			entries, err := sat.Metabase.DB.ClaimBucketEvents(ctx, metabase.ClaimBucketEvents{
+				Now:   time.Now().Add(time.Hour),
+				Lease: time.Hour,
+				Limit: 10,
+			})
+			require.NoError(t, err)
+			for _, entry := range entries {
+				var event bucketevents.Event
+				require.NoError(t, json.Unmarshal(entry.Payload, &event))
+				types = append(types, event.Type)
+			}
+			return types
+		}
+
+		require.NoError(t, upl.CreateBucket(ctx, sat, "events"))
+		err := sat.DB.Buckets().SetBucketNotifications(ctx, []byte("events"), projectID, buckets.NotificationConfiguration{
+			Webhooks: []buckets.Webhook{{
+				URL:    "https://example.test/hook",
+				Events: []buckets.EventType{"ObjectDeleted:*", "LifecycleExpiration:*"},
+			}},
+		})
+		require.NoError(t, err)
+
+		// expired objects are reported by the expired deletion chore.
+		require.NoError(t, upl.UploadWithExpiration(ctx, sat, "events", "expiring", testrand.Bytes(memory.KiB), time.Now().Add(time.Hour)))
+		require.Empty(t, claim())
+
+		sat.Core.ExpiredDeletion.Chore.SetNow(func() time.Time {
+			return time.Now().Add(2 * time.Hour)
+		})
+		sat.Core.ExpiredDeletion.Chore.Loop.TriggerWait()
+		require.Equal(t, []buckets.EventType{buckets.LifecycleExpirationDelete}, claim())
+
+		// deleting a bucket with its objects reports every object.
+		for _, key := range []string{"a", "b"} {
+			require.NoError(t, upl.Upload(ctx, sat, "events", key, testrand.Bytes(memory.KiB)))
+		}
+		_, err = sat.API.Buckets.Service.DeleteBucketWithObjects(ctx, []byte("events"), projectID, false)
+		require.NoError(t, err)
+		require.Equal(t, []buckets.EventType{buckets.ObjectDeletedDelete, buckets.ObjectDeletedDelete}, claim())
+	})
+}
@@ -0,0 +1,28 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketevents
+
+import (
+	"context"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+var _ DB = (*metabase.DB)(nil)
+
+// DB implements the outbox of bucket events waiting to be delivered. The
+// entries are inserted by the metabase together with the changes of the
+// objects, hence it's implemented by *metabase.DB.
+//
+// architecture: Database
+type DB interface {
+	// ClaimBucketEvents returns the due entries and postpones their next
+	// attempt, so other chores won't pick them up.
+	ClaimBucketEvents(ctx context.Context, opts metabase.ClaimBucketEvents) (entries []metabase.BucketEventEntry, err error)
+	// DeleteBucketEvent removes a delivered entry from the outbox.
+	DeleteBucketEvent(ctx context.Context, id uuid.UUID) (err error)
+	// RescheduleBucketEvent records a failed delivery attempt of an entry.
+	RescheduleBucketEvent(ctx context.Context, opts metabase.RescheduleBucketEvent) (err error)
+}
@@ -0,0 +1,18 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+/*
+Package bucketevents implements the bucket event notifications.
+
+The metabase operations which create or delete objects accept the Service
+as their metabase.BucketEvents outbox. The service matches the events against
+the webhooks of the bucket notification configuration and returns an entry for
+every matching webhook, which the metabase inserts into the bucket_events table
+in the same transaction as the change itself.
+
+The Chore claims the due entries from the outbox and posts them to the
+webhooks. An entry is removed only after the webhook accepted it, so every
+event is delivered at least once. Failed deliveries are retried with an
+exponential backoff until the maximum number of attempts is reached.
+*/
+package bucketevents
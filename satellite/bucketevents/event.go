@@ -0,0 +1,55 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketevents
+
+import (
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+)
+
+// eventTypes maps the metabase changes to the bucket event types.
+var eventTypes = map[metabase.BucketEventType]buckets.EventType{
+	metabase.BucketEventPut:          buckets.ObjectCreatedPut,
+	metabase.BucketEventCopy:         buckets.ObjectCreatedCopy,
+	metabase.BucketEventMoveIn:       buckets.ObjectCreatedMove,
+	metabase.BucketEventMoveOut:      buckets.ObjectDeletedMove,
+	metabase.BucketEventDelete:       buckets.ObjectDeletedDelete,
+	metabase.BucketEventDeleteMarker: buckets.ObjectDeletedMarkerCreated,
+	metabase.BucketEventExpire:       buckets.LifecycleExpirationDelete,
+	metabase.BucketEventExpireMarker: buckets.LifecycleExpirationMarkerCreated,
+}
+
+// Event is the payload which is posted to the webhooks.
+type Event struct {
+	ID        uuid.UUID         `json:"id"`
+	Type      buckets.EventType `json:"type"`
+	Time      time.Time         `json:"time"`
+	ProjectID uuid.UUID         `json:"projectId"`
+	Bucket    string            `json:"bucket"`
+	// ObjectKey is the encrypted object key.
+	ObjectKey []byte `json:"objectKey"`
+	Version   int64  `json:"version,omitempty"`
+	Size      int64  `json:"size,omitempty"`
+}
+
+// NewEvent creates an event about the object at the specified location.
+func NewEvent(eventType buckets.EventType, location metabase.ObjectLocation) Event {
+	return Event{
+		Type:      eventType,
+		ProjectID: location.ProjectID,
+		Bucket:    location.BucketName,
+		ObjectKey: []byte(location.ObjectKey),
+	}
+}
+
+// NewObjectEvent creates an event about an object version.
+func NewObjectEvent(eventType buckets.EventType, object metabase.Object) Event {
+	event := NewEvent(eventType, object.Location())
+	event.Version = int64(object.Version)
+	event.Size = object.TotalEncryptedSize
+	return event
+}
@@ -0,0 +1,169 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketevents
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/lrucache"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	// Error is the standard error class for bucket events.
+	Error = errs.Class("bucket events")
+	mon   = monkit.Package()
+)
+
+// Config contains configurable values for bucket event notifications.
+type Config struct {
+	Enabled          bool          `help:"set if bucket event notifications are sent or not" releaseDefault:"false" devDefault:"true"`
+	Interval         time.Duration `help:"how often to check for bucket events waiting to be delivered" releaseDefault:"30s" devDefault:"10s" testDefault:"$TESTINTERVAL"`
+	BatchSize        int           `help:"how many bucket events to claim from the outbox at once" default:"100"`
+	Concurrency      int           `help:"how many bucket events are delivered concurrently" default:"10"`
+	Timeout          time.Duration `help:"timeout of a single webhook request" default:"10s"`
+	MaxAttempts      int           `help:"how many times the delivery of a bucket event is attempted before it's dropped" default:"10"`
+	RetryInterval    time.Duration `help:"how long to wait before retrying a failed delivery, doubled after every attempt" default:"1m"`
+	MaxRetryInterval time.Duration `help:"the maximum time to wait before retrying a failed delivery" default:"6h"`
+	CacheCapacity    int           `help:"how many bucket notification configurations to cache" default:"10000"`
+	CacheExpiration  time.Duration `help:"how long to cache bucket notification configurations, zero disables the cache" default:"1m" testDefault:"0s"`
+
+	AllowPrivateNetworks bool `help:"allow delivering bucket events to loopback and private network addresses" default:"false" hidden:"true"`
+}
+
+// Service looks up the webhooks of the buckets, which convert the events of
+// the buckets into the entries of the bucket events outbox inserted by the
+// metabase.
+//
+// architecture: Service
+type Service struct {
+	log     *zap.Logger
+	config  Config
+	buckets buckets.DB
+
+	configurations *lrucache.ExpiringLRUOf[buckets.NotificationConfiguration]
+}
+
+// NewService creates a new bucket events service.
+func NewService(log *zap.Logger, config Config, bucketsDB buckets.DB) *Service {
+	service := &Service{
+		log:     log,
+		config:  config,
+		buckets: bucketsDB,
+	}
+	if config.CacheExpiration > 0 {
+		service.configurations = lrucache.NewOf[buckets.NotificationConfiguration](lrucache.Options{
+			Capacity:   config.CacheCapacity,
+			Expiration: config.CacheExpiration,
+			Name:       "bucketevents-configurations",
+		})
+	}
+	return service
+}
+
+// Resolver returns the service as the bucket events resolver of the metabase
+// operations, or nil when the bucket events are disabled.
+func (service *Service) Resolver() metabase.BucketEventsResolver {
+	if service == nil || !service.config.Enabled {
+		return nil
+	}
+	return service
+}
+
+// ResolveBucketEvents returns the webhooks of the buckets, or nil when none of
+// the buckets has any webhook. It implements metabase.BucketEventsResolver.
+func (service *Service) ResolveBucketEvents(ctx context.Context, bucketLocations []metabase.BucketLocation) (_ metabase.BucketEvents, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var hooks bucketWebhooks
+	for _, bucket := range bucketLocations {
+		configuration, err := service.getConfiguration(ctx, bucket)
+		if err != nil {
+			if buckets.ErrBucketNotFound.Has(err) {
+				continue
+			}
+			return nil, Error.Wrap(err)
+		}
+		if len(configuration.Webhooks) == 0 {
+			continue
+		}
+		if hooks == nil {
+			hooks = bucketWebhooks{}
+		}
+		hooks[bucket] = configuration.Webhooks
+	}
+	if hooks == nil {
+		return nil, nil
+	}
+	return hooks, nil
+}
+
+// bucketWebhooks are the webhooks of the buckets.
+type bucketWebhooks map[metabase.BucketLocation][]buckets.Webhook
+
+// BucketEventEntries returns an outbox entry for every webhook of the bucket,
+// which is interested in the events. It implements metabase.BucketEvents.
+func (hooks bucketWebhooks) BucketEventEntries(bucket metabase.BucketLocation, events []metabase.BucketEvent) ([]metabase.BucketEventEntry, error) {
+	webhooks := hooks[bucket]
+	if len(webhooks) == 0 {
+		return nil, nil
+	}
+
+	now := time.Now()
+
+	var entries []metabase.BucketEventEntry
+	for _, change := range events {
+		eventType, ok := eventTypes[change.Type]
+		if !ok {
+			return nil, Error.New("unknown event type %d", change.Type)
+		}
+
+		for _, webhook := range webhooks {
+			if !webhook.Matches(eventType, change.Object.ObjectKey) {
+				continue
+			}
+
+			id, err := uuid.New()
+			if err != nil {
+				return nil, Error.Wrap(err)
+			}
+
+			event := NewObjectEvent(eventType, change.Object)
+			event.ID = id
+			event.Time = now
+			payload, err := json.Marshal(event)
+			if err != nil {
+				return nil, Error.Wrap(err)
+			}
+
+			entries = append(entries, metabase.BucketEventEntry{
+				ID:            id,
+				Bucket:        bucket,
+				WebhookURL:    webhook.URL,
+				Payload:       payload,
+				NextAttemptAt: now,
+			})
+		}
+	}
+	return entries, nil
+}
+
+// getConfiguration returns the notification configuration of the bucket.
+func (service *Service) getConfiguration(ctx context.Context, bucket metabase.BucketLocation) (buckets.NotificationConfiguration, error) {
+	get := func() (buckets.NotificationConfiguration, error) {
+		return service.buckets.GetBucketNotifications(ctx, []byte(bucket.BucketName), bucket.ProjectID)
+	}
+	if service.configurations == nil {
+		return get()
+	}
+	return service.configurations.Get(ctx, bucket.ProjectID.String()+"/"+bucket.BucketName, get)
+}
@@ -5,6 +5,7 @@ package buckets
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -39,6 +40,81 @@ type Bucket struct {
 	DefaultRedundancyScheme     storj.RedundancyScheme
 	DefaultEncryptionParameters storj.EncryptionParameters
 	Placement                   storj.PlacementConstraint
+	Versioning                  Versioning
+	DefaultRetention            DefaultRetention
+}
+
+// Versioning represents the versioning state of a bucket.
+type Versioning int
+
+const (
+	// Unversioned is the state of buckets which never had versioning enabled.
+	// Committing an object replaces the previous version.
+	Unversioned Versioning = 0
+	// VersioningEnabled keeps every committed version of an object.
+	VersioningEnabled Versioning = 1
+	// VersioningSuspended keeps the existing versions, but committing an
+	// object replaces the previous unversioned object.
+	VersioningSuspended Versioning = 2
+)
+
+// IsVersioned returns true when new object versions don't replace the previous ones.
+func (versioning Versioning) IsVersioned() bool {
+	return versioning == VersioningEnabled
+}
+
+// String returns a human readable representation of the versioning state.
+func (versioning Versioning) String() string {
+	switch versioning {
+	case Unversioned:
+		return "Unversioned"
+	case VersioningEnabled:
+		return "Enabled"
+	case VersioningSuspended:
+		return "Suspended"
+	default:
+		return fmt.Sprintf("Versioning(%d)", int(versioning))
+	}
+}
+
+// DefaultRetention is the retention applied to objects committed to a bucket
+// without an explicit retention.
+type DefaultRetention struct {
+	Mode metabase.RetentionMode
+	Days int
+}
+
+// Enabled returns true when new objects should be retained.
+func (retention DefaultRetention) Enabled() bool {
+	return retention.Mode != metabase.NoRetention
+}
+
+// Verify verifies default retention fields.
+func (retention DefaultRetention) Verify() error {
+	switch retention.Mode {
+	case metabase.NoRetention:
+		if retention.Days != 0 {
+			return ErrInvalidRetention.New("retention days must not be set without retention mode")
+		}
+	case metabase.GovernanceMode, metabase.ComplianceMode:
+		if retention.Days <= 0 {
+			return ErrInvalidRetention.New("retention days must be positive")
+		}
+	default:
+		return ErrInvalidRetention.New("unknown retention mode %d", int(retention.Mode))
+	}
+	return nil
+}
+
+// Retention returns the retention of an object committed at the specified time.
+func (retention DefaultRetention) Retention(committedAt time.Time) metabase.Retention {
+	if !retention.Enabled() {
+		return metabase.Retention{}
+	}
+	return metabase.Retention{
+		Mode:        retention.Mode,
+		RetainUntil: committedAt.AddDate(0, 0, retention.Days),
+	}
 }
 
 // ListDirection specifies listing direction.
@@ -93,6 +169,8 @@ type DB interface {
 	GetBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (bucket Bucket, err error)
 	// GetBucketPlacement returns with the placement constraint identifier.
 	GetBucketPlacement(ctx context.Context, bucketName []byte, projectID uuid.UUID) (placement storj.PlacementConstraint, err error)
+	// GetBucketVersioning returns the versioning state of the bucket.
+	GetBucketVersioning(ctx context.Context, bucketName []byte, projectID uuid.UUID) (versioning Versioning, err error)
 	// GetMinimalBucket returns existing bucket with minimal number of fields.
 	GetMinimalBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (bucket MinimalBucket, err error)
 	// HasBucket returns if a bucket exists.
@@ -111,4 +189,18 @@ type DB interface {
 	CountBuckets(ctx context.Context, projectID uuid.UUID) (int, error)
 	// IterateBucketLocations iterates through all buckets from some point with limit.
 	IterateBucketLocations(ctx context.Context, projectID uuid.UUID, bucketName string, limit int, fn func([]metabase.BucketLocation) error) (more bool, err error)
+	// GetBucketLifecycle returns the lifecycle configuration of the bucket.
+	GetBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID) (lifecycle Lifecycle, err error)
+	// SetBucketLifecycle replaces the lifecycle configuration of the bucket.
+	SetBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID, lifecycle Lifecycle) (err error)
+	// GetBucketNotifications returns the notification configuration of the bucket.
+	GetBucketNotifications(ctx context.Context, bucketName []byte, projectID uuid.UUID) (configuration NotificationConfiguration, err error)
+	// SetBucketNotifications replaces the notification configuration of the bucket.
+	SetBucketNotifications(ctx context.Context, bucketName []byte, projectID uuid.UUID, configuration NotificationConfiguration) (err error)
+	// GetBucketRateLimits returns the rate limits overrides of the bucket.
+	GetBucketRateLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID) (limits RateLimits, err error)
+	// SetBucketRateLimits replaces the rate limits overrides of the bucket.
+	SetBucketRateLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID, limits RateLimits) (err error)
+	// IterateBucketLifecycles iterates through the buckets which have lifecycle rules, starting after the bucket location.
+	IterateBucketLifecycles(ctx context.Context, after metabase.BucketLocation, limit int, fn func([]BucketLifecycle) error) (more bool, err error)
 }
@@ -0,0 +1,73 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+import (
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/metabase"
+)
+
+// ErrInvalidLifecycle is returned when a caller attempts to set an invalid lifecycle configuration.
+var ErrInvalidLifecycle = errs.Class("invalid lifecycle configuration")
+
+// maxLifecycleRules is the maximum number of rules in a lifecycle configuration.
+const maxLifecycleRules = 1000
+
+// Lifecycle defines when the objects of a bucket are deleted automatically.
+type Lifecycle struct {
+	Rules []LifecycleRule `json:"rules"`
+}
+
+// LifecycleRule defines the actions applied to the objects whose key starts
+// with Prefix. Every action is disabled when its number of days is zero.
+type LifecycleRule struct {
+	ID string `json:"id,omitempty"`
+	// Prefix is compared against the encrypted object keys, hence it should
+	// be encrypted the same way as the keys of the bucket.
+	Prefix []byte `json:"prefix,omitempty"`
+
+	// ExpirationDays deletes the latest version of committed objects the
+	// specified number of days after they were created. In versioned buckets
+	// the objects are hidden behind delete markers instead.
+	ExpirationDays int `json:"expiration_days,omitempty"`
+	// AbortIncompleteUploadDays deletes pending objects the specified number
+	// of days after the upload was started.
+	AbortIncompleteUploadDays int `json:"abort_incomplete_upload_days,omitempty"`
+	// NoncurrentVersionExpirationDays deletes object versions the specified
+	// number of days after a newer version was committed.
+	NoncurrentVersionExpirationDays int `json:"noncurrent_version_expiration_days,omitempty"`
+}
+
+// BucketLifecycle is the lifecycle configuration of a bucket.
+type BucketLifecycle struct {
+	Bucket     metabase.BucketLocation
+	Lifecycle  Lifecycle
+	Versioning Versioning
+}
+
+// Verify verifies lifecycle configuration fields.
+func (lifecycle Lifecycle) Verify() error {
+	if len(lifecycle.Rules) > maxLifecycleRules {
+		return ErrInvalidLifecycle.New("cannot have more than %d rules", maxLifecycleRules)
+	}
+
+	ids := map[string]bool{}
+	for i, rule := range lifecycle.Rules {
+		if rule.ID != "" {
+			if ids[rule.ID] {
+				return ErrInvalidLifecycle.New("duplicate rule id %q", rule.ID)
+			}
+			ids[rule.ID] = true
+		}
+
+		if rule.ExpirationDays < 0 || rule.AbortIncompleteUploadDays < 0 || rule.NoncurrentVersionExpirationDays < 0 {
+			return ErrInvalidLifecycle.New("rule %d: days must not be negative", i)
+		}
+		if rule.ExpirationDays == 0 && rule.AbortIncompleteUploadDays == 0 && rule.NoncurrentVersionExpirationDays == 0 {
+			return ErrInvalidLifecycle.New("rule %d: at least one action is required", i)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/zeebo/errs"
+
+	webhooks "storj.io/storj/private/webhook"
+	"storj.io/storj/satellite/metabase"
+)
+
+// ErrInvalidNotification is returned when a caller attempts to set an invalid notification configuration.
+var ErrInvalidNotification = errs.Class("invalid notification configuration")
+
+// maxWebhooks is the maximum number of webhooks in a notification configuration.
+const maxWebhooks = 100
+
+// EventType is the type of an event that happened to an object of a bucket.
+type EventType string
+
+const (
+	// ObjectCreatedPut is sent when an upload is committed.
+	ObjectCreatedPut EventType = "ObjectCreated:Put"
+	// ObjectCreatedCopy is sent when an object is created by a server side copy.
+	ObjectCreatedCopy EventType = "ObjectCreated:Copy"
+	// ObjectCreatedMove is sent when an object is created by a server side move.
+	ObjectCreatedMove EventType = "ObjectCreated:Move"
+	// ObjectDeletedDelete is sent when an object version is deleted.
+	ObjectDeletedDelete EventType = "ObjectDeleted:Delete"
+	// ObjectDeletedMarkerCreated is sent when a delete marker hides an object.
+	ObjectDeletedMarkerCreated EventType = "ObjectDeleted:DeleteMarkerCreated"
+	// ObjectDeletedMove is sent when an object is removed by a server side move.
+	ObjectDeletedMove EventType = "ObjectDeleted:Move"
+	// LifecycleExpirationDelete is sent when an object version is deleted,
+	// because it expired or by the bucket lifecycle.
+	LifecycleExpirationDelete EventType = "LifecycleExpiration:Delete"
+	// LifecycleExpirationMarkerCreated is sent when the bucket lifecycle hides
+	// an object with a delete marker.
+	LifecycleExpirationMarkerCreated EventType = "LifecycleExpiration:DeleteMarkerCreated"
+)
+
+// eventTypes contains all the known event types.
+var eventTypes = []EventType{
+	ObjectCreatedPut, ObjectCreatedCopy, ObjectCreatedMove,
+	ObjectDeletedDelete, ObjectDeletedMarkerCreated, ObjectDeletedMove,
+	LifecycleExpirationDelete, LifecycleExpirationMarkerCreated,
+}
+
+// NotificationConfiguration defines where the events of a bucket are sent.
+type NotificationConfiguration struct {
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+// Webhook sends the events matching the filters to an HTTP endpoint.
+type Webhook struct {
+	ID  string `json:"id,omitempty"`
+	URL string `json:"url"`
+	// Events lists the event types that are sent. An entry ending with "*"
+	// matches every event type with the same prefix, e.g. "ObjectCreated:*".
+	Events []EventType `json:"events"`
+
+	// Prefix and Suffix are compared against the encrypted object keys,
+	// hence they should be encrypted the same way as the keys of the bucket.
+	Prefix []byte `json:"prefix,omitempty"`
+	Suffix []byte `json:"suffix,omitempty"`
+}
+
+// Verify verifies notification configuration fields.
+func (configuration NotificationConfiguration) Verify() error {
+	if len(configuration.Webhooks) > maxWebhooks {
+		return ErrInvalidNotification.New("cannot have more than %d webhooks", maxWebhooks)
+	}
+
+	ids := map[string]bool{}
+	for i, webhook := range configuration.Webhooks {
+		if webhook.ID != "" {
+			if ids[webhook.ID] {
+				return ErrInvalidNotification.New("duplicate webhook id %q", webhook.ID)
+			}
+			ids[webhook.ID] = true
+		}
+
+		if err := webhook.Verify(); err != nil {
+			return ErrInvalidNotification.New("webhook %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// Verify verifies webhook fields.
+func (webhook Webhook) Verify() error {
+	if err := webhooks.CheckURL(webhook.URL); err != nil {
+		return err
+	}
+
+	if len(webhook.Events) == 0 {
+		return errs.New("at least one event type is required")
+	}
+	for _, pattern := range webhook.Events {
+		if !knownEventPattern(pattern) {
+			return errs.New("unknown event type %q", pattern)
+		}
+	}
+	return nil
+}
+
+// Matches returns whether the event of the object should be sent to the webhook.
+func (webhook Webhook) Matches(eventType EventType, key metabase.ObjectKey) bool {
+	if !bytes.HasPrefix([]byte(key), webhook.Prefix) || !bytes.HasSuffix([]byte(key), webhook.Suffix) {
+		return false
+	}
+	for _, pattern := range webhook.Events {
+		if matchEventType(pattern, eventType) {
+			return true
+		}
+	}
+	return false
+}
+
+// knownEventPattern returns whether the pattern matches any known event type.
+func knownEventPattern(pattern EventType) bool {
+	for _, eventType := range eventTypes {
+		if matchEventType(pattern, eventType) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchEventType returns whether the event type matches the pattern.
+func matchEventType(pattern, eventType EventType) bool {
+	if strings.HasSuffix(string(pattern), "*") {
+		return strings.HasPrefix(string(eventType), strings.TrimSuffix(string(pattern), "*"))
+	}
+	return pattern == eventType
+}
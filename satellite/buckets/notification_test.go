@@ -0,0 +1,65 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+)
+
+func TestWebhookMatches(t *testing.T) {
+	webhook := buckets.Webhook{
+		URL:    "https://example.test/hook",
+		Events: []buckets.EventType{"ObjectCreated:*", buckets.ObjectDeletedMarkerCreated},
+		Prefix: []byte("photos/"),
+		Suffix: []byte(".jpg"),
+	}
+	require.NoError(t, webhook.Verify())
+
+	for _, tt := range []struct {
+		eventType buckets.EventType
+		key       string
+		matches   bool
+	}{
+		{buckets.ObjectCreatedPut, "photos/a.jpg", true},
+		{buckets.ObjectCreatedCopy, "photos/a.jpg", true},
+		{buckets.ObjectDeletedMarkerCreated, "photos/a.jpg", true},
+		{buckets.ObjectDeletedDelete, "photos/a.jpg", false},
+		{buckets.ObjectCreatedPut, "videos/a.jpg", false},
+		{buckets.ObjectCreatedPut, "photos/a.png", false},
+	} {
+		require.Equal(t, tt.matches, webhook.Matches(tt.eventType, metabase.ObjectKey(tt.key)), "%s %s", tt.eventType, tt.key)
+	}
+}
+
+func TestNotificationConfigurationVerify(t *testing.T) {
+	valid := buckets.Webhook{URL: "http://example.test", Events: []buckets.EventType{"ObjectDeleted:*"}}
+
+	require.NoError(t, buckets.NotificationConfiguration{}.Verify())
+	require.NoError(t, buckets.NotificationConfiguration{Webhooks: []buckets.Webhook{valid}}.Verify())
+
+	for _, webhook := range []buckets.Webhook{
+		{URL: "ftp://example.test", Events: valid.Events},
+		{URL: "/relative", Events: valid.Events},
+		{URL: "http://localhost:8080/hook", Events: valid.Events},
+		{URL: "http://127.0.0.1/hook", Events: valid.Events},
+		{URL: "http://169.254.169.254/latest/meta-data", Events: valid.Events},
+		{URL: "http://[::1]/hook", Events: valid.Events},
+		{URL: valid.URL},
+		{URL: valid.URL, Events: []buckets.EventType{"ObjectRestored:*"}},
+		{URL: valid.URL, Events: []buckets.EventType{"ObjectCreated"}},
+	} {
+		err := buckets.NotificationConfiguration{Webhooks: []buckets.Webhook{webhook}}.Verify()
+		require.True(t, buckets.ErrInvalidNotification.Has(err), "%+v", webhook)
+	}
+
+	duplicate := valid
+	duplicate.ID = "same"
+	err := buckets.NotificationConfiguration{Webhooks: []buckets.Webhook{duplicate, duplicate}}.Verify()
+	require.True(t, buckets.ErrInvalidNotification.Has(err))
+}
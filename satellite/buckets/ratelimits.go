@@ -0,0 +1,52 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+import (
+	"github.com/zeebo/errs"
+)
+
+// ErrInvalidRateLimits is returned when a caller attempts to set invalid bucket rate limits.
+var ErrInvalidRateLimits = errs.Class("invalid bucket rate limits")
+
+// RateLimits overrides the rate limits of the satellite for a single bucket.
+// A nil field means that the satellite configuration applies, while zero
+// disables the corresponding limit for the bucket.
+type RateLimits struct {
+	// List, Upload, Download and Delete are the number of requests per second.
+	List     *float64 `json:"list,omitempty"`
+	Upload   *float64 `json:"upload,omitempty"`
+	Download *float64 `json:"download,omitempty"`
+	Delete   *float64 `json:"delete,omitempty"`
+
+	// Egress is the number of bytes per second, which can be downloaded from the bucket.
+	Egress *int64 `json:"egress,omitempty"`
+}
+
+// IsZero returns whether no limit is overridden.
+func (limits RateLimits) IsZero() bool {
+	return limits.List == nil && limits.Upload == nil && limits.Download == nil &&
+		limits.Delete == nil && limits.Egress == nil
+}
+
+// Verify verifies rate limits fields.
+func (limits RateLimits) Verify() error {
+	for _, rate := range []struct {
+		name  string
+		value *float64
+	}{
+		{"list", limits.List},
+		{"upload", limits.Upload},
+		{"download", limits.Download},
+		{"delete", limits.Delete},
+	} {
+		if rate.value != nil && *rate.value < 0 {
+			return ErrInvalidRateLimits.New("%s rate cannot be negative", rate.name)
+		}
+	}
+	if limits.Egress != nil && *limits.Egress < 0 {
+		return ErrInvalidRateLimits.New("egress rate cannot be negative")
+	}
+	return nil
+}
@@ -8,26 +8,36 @@ import (
 
 	"github.com/zeebo/errs"
 
+	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase"
 )
 
 var (
 	// ErrBucketNotEmpty is returned when a caller attempts to change placement constraints.
 	ErrBucketNotEmpty = errs.Class("bucket must be empty")
+
+	// ErrInvalidVersioning is returned when a caller attempts an unsupported versioning state change.
+	ErrInvalidVersioning = errs.Class("invalid versioning state")
+
+	// ErrInvalidRetention is returned when a caller attempts to set an invalid default retention.
+	ErrInvalidRetention = errs.Class("invalid default retention")
 )
 
 // NewService converts the provided db and metabase calls into a single DB interface.
-func NewService(bucketsDB DB, metabase *metabase.DB) *Service {
+// bucketEvents is optional.
+func NewService(bucketsDB DB, metabase *metabase.DB, bucketEvents metabase.BucketEventsResolver) *Service {
 	return &Service{
-		DB:       bucketsDB,
-		metabase: metabase,
+		DB:           bucketsDB,
+		metabase:     metabase,
+		bucketEvents: bucketEvents,
 	}
 }
 
 // Service encapsulates operations around buckets.
 type Service struct {
 	DB
-	metabase *metabase.DB
+	metabase     *metabase.DB
+	bucketEvents metabase.BucketEventsResolver
 }
 
 // UpdateBucket overrides the default UpdateBucket behaviour by adding a check against MetabaseDB to ensure the bucket
@@ -53,5 +63,150 @@ func (buckets *Service) UpdateBucket(ctx context.Context, bucket Bucket) (Bucket
 		}
 	}
 
+	if current.Versioning != bucket.Versioning {
+		if err := validateVersioningChange(current.Versioning, bucket.Versioning); err != nil {
+			return Bucket{}, err
+		}
+	}
+
+	if current.DefaultRetention != bucket.DefaultRetention || current.Versioning != bucket.Versioning {
+		if err := validateDefaultRetention(bucket); err != nil {
+			return Bucket{}, err
+		}
+	}
+
+	return buckets.DB.UpdateBucket(ctx, bucket)
+}
+
+// SetBucketVersioning enables or suspends versioning of an existing bucket.
+func (buckets *Service) SetBucketVersioning(ctx context.Context, bucketName []byte, projectID uuid.UUID, versioning Versioning) (Bucket, error) {
+	bucket, err := buckets.GetBucket(ctx, bucketName, projectID)
+	if err != nil {
+		return Bucket{}, err
+	}
+	if bucket.Versioning == versioning {
+		return bucket, nil
+	}
+	if err := validateVersioningChange(bucket.Versioning, versioning); err != nil {
+		return Bucket{}, err
+	}
+
+	bucket.Versioning = versioning
+	if err := validateDefaultRetention(bucket); err != nil {
+		return Bucket{}, err
+	}
+	return buckets.DB.UpdateBucket(ctx, bucket)
+}
+
+// SetBucketDefaultRetention changes the retention applied to new objects in
+// an existing bucket. The retention of existing objects isn't changed.
+func (buckets *Service) SetBucketDefaultRetention(ctx context.Context, bucketName []byte, projectID uuid.UUID, retention DefaultRetention) (Bucket, error) {
+	bucket, err := buckets.GetBucket(ctx, bucketName, projectID)
+	if err != nil {
+		return Bucket{}, err
+	}
+
+	bucket.DefaultRetention = retention
+	if err := validateDefaultRetention(bucket); err != nil {
+		return Bucket{}, err
+	}
 	return buckets.DB.UpdateBucket(ctx, bucket)
 }
+
+// SetBucketLifecycle validates and replaces the lifecycle configuration of
+// the bucket. An empty configuration disables the lifecycle rules.
+func (buckets *Service) SetBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID, lifecycle Lifecycle) error {
+	if err := lifecycle.Verify(); err != nil {
+		return err
+	}
+	return buckets.DB.SetBucketLifecycle(ctx, bucketName, projectID, lifecycle)
+}
+
+// SetBucketNotifications validates and replaces the notification configuration
+// of the bucket. An empty configuration disables the notifications.
+func (buckets *Service) SetBucketNotifications(ctx context.Context, bucketName []byte, projectID uuid.UUID, configuration NotificationConfiguration) error {
+	if err := configuration.Verify(); err != nil {
+		return err
+	}
+	return buckets.DB.SetBucketNotifications(ctx, bucketName, projectID, configuration)
+}
+
+// SetBucketRateLimits validates and replaces the rate limits overrides of
+// the bucket. Empty limits restore the satellite configuration.
+func (buckets *Service) SetBucketRateLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID, limits RateLimits) error {
+	if err := limits.Verify(); err != nil {
+		return err
+	}
+	return buckets.DB.SetBucketRateLimits(ctx, bucketName, projectID, limits)
+}
+
+// DeleteBucketWithObjects deletes all objects of the bucket and the bucket
+// itself. Objects under governance mode retention are only deleted when
+// bypassGovernance is set; any other locked object prevents the deletion.
+func (buckets *Service) DeleteBucketWithObjects(ctx context.Context, bucketName []byte, projectID uuid.UUID, bypassGovernance bool) (deletedObjects int64, err error) {
+	bucket := metabase.BucketLocation{
+		ProjectID:  projectID,
+		BucketName: string(bucketName),
+	}
+
+	var outbox metabase.BucketEvents
+	if buckets.bucketEvents != nil {
+		outbox, err = buckets.bucketEvents.ResolveBucketEvents(ctx, []metabase.BucketLocation{bucket})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	deletedObjects, err = buckets.metabase.DeleteBucketObjects(ctx, metabase.DeleteBucketObjects{
+		Bucket:           bucket,
+		BypassGovernance: bypassGovernance,
+		BucketEvents:     outbox,
+	})
+	if err != nil {
+		return deletedObjects, err
+	}
+
+	// objects may have been uploaded in the meantime.
+	empty, err := buckets.metabase.BucketEmpty(ctx, metabase.BucketEmpty{
+		ProjectID:  projectID,
+		BucketName: string(bucketName),
+	})
+	if err != nil {
+		return deletedObjects, err
+	}
+	if !empty {
+		return deletedObjects, ErrBucketNotEmpty.New("bucket contains objects uploaded during the deletion")
+	}
+
+	return deletedObjects, buckets.DB.DeleteBucket(ctx, bucketName, projectID)
+}
+
+// validateDefaultRetention checks whether the default retention can be used
+// for the bucket. Retained objects must not be replaced by new commits, which
+// is only guaranteed when versioning is enabled.
+func validateDefaultRetention(bucket Bucket) error {
+	if err := bucket.DefaultRetention.Verify(); err != nil {
+		return err
+	}
+	if bucket.DefaultRetention.Enabled() && bucket.Versioning != VersioningEnabled {
+		return ErrInvalidRetention.New("default retention requires versioning to be enabled")
+	}
+	return nil
+}
+
+// validateVersioningChange checks whether a bucket can go from one versioning
+// state to another. Once a bucket has been versioned it can only be suspended,
+// because the existing versions can't be represented in an unversioned bucket.
+func validateVersioningChange(from, to Versioning) error {
+	switch to {
+	case VersioningEnabled, VersioningSuspended:
+		return nil
+	case Unversioned:
+		if from != Unversioned {
+			return ErrInvalidVersioning.New("versioning can only be suspended once it has been enabled")
+		}
+		return nil
+	default:
+		return ErrInvalidVersioning.New("unknown versioning state %d", int(to))
+	}
+}
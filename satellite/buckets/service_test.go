@@ -9,9 +9,13 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"storj.io/common/memory"
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
 )
 
 const TestBucket = "testbucket"
@@ -247,3 +251,91 @@ func TestBucketPlacement_PendingObject(t *testing.T) {
 		},
 	)
 }
+
+func TestBucketVersioning(t *testing.T) {
+	testplanet.Run(t,
+		testplanet.Config{
+			SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+			satellite := planet.Satellites[0]
+			service := satellite.API.Buckets.Service
+			uplink := planet.Uplinks[0]
+			projectID := uplink.Projects[0].ID
+
+			err := uplink.CreateBucket(ctx, satellite, TestBucket)
+			require.NoError(t, err)
+
+			versioning, err := service.GetBucketVersioning(ctx, []byte(TestBucket), projectID)
+			require.NoError(t, err)
+			require.Equal(t, buckets.Unversioned, versioning)
+
+			bucket, err := service.SetBucketVersioning(ctx, []byte(TestBucket), projectID, buckets.VersioningEnabled)
+			require.NoError(t, err)
+			require.Equal(t, buckets.VersioningEnabled, bucket.Versioning)
+
+			versioning, err = service.GetBucketVersioning(ctx, []byte(TestBucket), projectID)
+			require.NoError(t, err)
+			require.Equal(t, buckets.VersioningEnabled, versioning)
+
+			// versioning can't be disabled once it has been enabled.
+			_, err = service.SetBucketVersioning(ctx, []byte(TestBucket), projectID, buckets.Unversioned)
+			require.True(t, buckets.ErrInvalidVersioning.Has(err))
+
+			bucket, err = service.SetBucketVersioning(ctx, []byte(TestBucket), projectID, buckets.VersioningSuspended)
+			require.NoError(t, err)
+			require.Equal(t, buckets.VersioningSuspended, bucket.Versioning)
+
+			_, err = service.GetBucketVersioning(ctx, []byte("missing"), projectID)
+			require.True(t, buckets.ErrBucketNotFound.Has(err))
+		},
+	)
+}
+
+func TestBucketDefaultRetention(t *testing.T) {
+	testplanet.Run(t,
+		testplanet.Config{
+			SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+			satellite := planet.Satellites[0]
+			service := satellite.API.Buckets.Service
+			uplink := planet.Uplinks[0]
+			projectID := uplink.Projects[0].ID
+
+			err := uplink.CreateBucket(ctx, satellite, TestBucket)
+			require.NoError(t, err)
+
+			retention := buckets.DefaultRetention{Mode: metabase.ComplianceMode, Days: 30}
+
+			// default retention requires versioning.
+			_, err = service.SetBucketDefaultRetention(ctx, []byte(TestBucket), projectID, retention)
+			require.True(t, buckets.ErrInvalidRetention.Has(err))
+
+			_, err = service.SetBucketVersioning(ctx, []byte(TestBucket), projectID, buckets.VersioningEnabled)
+			require.NoError(t, err)
+
+			_, err = service.SetBucketDefaultRetention(ctx, []byte(TestBucket), projectID, buckets.DefaultRetention{Mode: metabase.GovernanceMode})
+			require.True(t, buckets.ErrInvalidRetention.Has(err))
+
+			bucket, err := service.SetBucketDefaultRetention(ctx, []byte(TestBucket), projectID, retention)
+			require.NoError(t, err)
+			require.Equal(t, retention, bucket.DefaultRetention)
+
+			// versioning can't be suspended while new objects are retained.
+			_, err = service.SetBucketVersioning(ctx, []byte(TestBucket), projectID, buckets.VersioningSuspended)
+			require.True(t, buckets.ErrInvalidRetention.Has(err))
+
+			// locked objects prevent the bucket from being deleted.
+			require.NoError(t, uplink.Upload(ctx, satellite, TestBucket, TestObject, testrand.Bytes(memory.KiB)))
+
+			_, err = service.DeleteBucketWithObjects(ctx, []byte(TestBucket), projectID, true)
+			require.True(t, metabase.ErrObjectLock.Has(err))
+
+			objects, err := satellite.Metabase.DB.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			require.Len(t, objects, 1)
+			require.Equal(t, metabase.ComplianceMode, objects[0].Retention.Mode)
+		},
+	)
+}
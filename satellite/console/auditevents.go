@@ -0,0 +1,126 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"time"
+
+	"storj.io/common/uuid"
+)
+
+// AuditEvents exposes methods to manage the console audit log in the database.
+//
+// architecture: Database
+type AuditEvents interface {
+	// Insert is a method for inserting an audit event into the database.
+	Insert(ctx context.Context, event *AuditEvent) error
+	// InsertBatch is a method for inserting multiple audit events into the database at once.
+	InsertBatch(ctx context.Context, events []AuditEvent) error
+	// GetPaged is a method for querying the audit events matching the cursor, newest first.
+	GetPaged(ctx context.Context, cursor AuditEventsCursor) (*AuditEventsPage, error)
+	// DeleteBefore is a method for deleting the audit events created before the given time.
+	DeleteBefore(ctx context.Context, before time.Time, asOfSystemTimeInterval time.Duration, pageSize int) error
+}
+
+// AuditEvent is a record of an operation done through the satellite console.
+type AuditEvent struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+	Operation string    `json:"operation"`
+
+	// UserID is nil when the operation was done by an unknown user, e.g. a login with an invalid email.
+	UserID         *uuid.UUID `json:"userId"`
+	Email          string     `json:"email"`
+	SourceIP       string     `json:"sourceIP"`
+	ForwardedForIP string     `json:"forwardedForIP"`
+	RequestID      string     `json:"requestId"`
+
+	// ProjectID is the public or the private ID of the project targeted by the operation.
+	ProjectID *uuid.UUID `json:"projectId"`
+	// APIKeyID is the ID of the API key targeted by the operation.
+	APIKeyID *uuid.UUID `json:"apiKeyId"`
+}
+
+// AuditEventsCursor holds info for audit events cursor pagination.
+type AuditEventsCursor struct {
+	// ProjectID limits the events to the ones targeting the project. It must be the private ID of
+	// the project; the events which refer to the project by its public ID are included.
+	ProjectID *uuid.UUID
+	// UserID limits the events to the ones done by the user.
+	UserID *uuid.UUID
+	// Since and Before limit the events to the ones created in [Since, Before). Zero values are ignored.
+	Since  time.Time
+	Before time.Time
+
+	Limit uint
+	Page  uint
+}
+
+// AuditEventsPage represents a page of audit events.
+type AuditEventsPage struct {
+	Events []AuditEvent `json:"events"`
+
+	Limit       uint   `json:"limit"`
+	Offset      uint64 `json:"offset"`
+	PageCount   uint   `json:"pageCount"`
+	CurrentPage uint   `json:"currentPage"`
+	TotalCount  uint64 `json:"totalCount"`
+}
+
+// auditEventsCSVHeader is the header row of the CSV written by AuditEventsCSVWriter.
+var auditEventsCSVHeader = []string{
+	"id", "created_at", "operation", "user_id", "email", "source_ip", "forwarded_for_ip", "request_id", "project_id", "api_key_id",
+}
+
+// AuditEventsCSVWriter writes audit events as CSV.
+type AuditEventsCSVWriter struct {
+	w *csv.Writer
+}
+
+// NewAuditEventsCSVWriter writes the header row to w and returns a writer for the events.
+func NewAuditEventsCSVWriter(w io.Writer) (*AuditEventsCSVWriter, error) {
+	writer := &AuditEventsCSVWriter{w: csv.NewWriter(w)}
+	if err := writer.w.Write(auditEventsCSVHeader); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return writer, nil
+}
+
+// Write writes the events.
+func (writer *AuditEventsCSVWriter) Write(events []AuditEvent) error {
+	optionalID := func(id *uuid.UUID) string {
+		if id == nil {
+			return ""
+		}
+		return id.String()
+	}
+
+	for _, event := range events {
+		err := writer.w.Write([]string{
+			event.ID.String(),
+			event.CreatedAt.UTC().Format(time.RFC3339Nano),
+			event.Operation,
+			optionalID(event.UserID),
+			event.Email,
+			event.SourceIP,
+			event.ForwardedForIP,
+			event.RequestID,
+			optionalID(event.ProjectID),
+			optionalID(event.APIKeyID),
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// Flush writes any buffered data to the underlying writer.
+func (writer *AuditEventsCSVWriter) Flush() error {
+	writer.w.Flush()
+	return Error.Wrap(writer.w.Error())
+}
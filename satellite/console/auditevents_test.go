@@ -0,0 +1,211 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestAuditEventsRepository(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		auditEvents := db.Console().AuditEvents()
+
+		project, err := db.Console().Projects().Insert(ctx, &console.Project{
+			Name:    "audited",
+			OwnerID: testrand.UUID(),
+		})
+		require.NoError(t, err)
+
+		userID := testrand.UUID()
+		apiKeyID := testrand.UUID()
+		now := time.Now().Truncate(time.Millisecond)
+
+		insert := func(operation string, createdAt time.Time, userID, projectID, apiKeyID *uuid.UUID) console.AuditEvent {
+			event := console.AuditEvent{
+				ID:             testrand.UUID(),
+				CreatedAt:      createdAt,
+				Operation:      operation,
+				UserID:         userID,
+				Email:          "user@mail.test",
+				SourceIP:       "127.0.0.1:58000",
+				ForwardedForIP: "10.0.0.1",
+				RequestID:      "request-id",
+				ProjectID:      projectID,
+				APIKeyID:       apiKeyID,
+			}
+			require.NoError(t, auditEvents.Insert(ctx, &event))
+			return event
+		}
+
+		login := insert("login", now.Add(-3*time.Hour), &userID, nil, nil)
+		getProject := insert("get project", now.Add(-2*time.Hour), &userID, &project.PublicID, nil)
+		deleteKey := insert("delete api keys", now.Add(-time.Hour), &userID, nil, &apiKeyID)
+		failedLogin := insert("login: failed invalid email", now, nil, nil, nil)
+		createKey := insert("create api key", now.Add(-time.Minute), &userID, &project.ID, nil)
+
+		requireEvents := func(t *testing.T, cursor console.AuditEventsCursor, expected ...console.AuditEvent) {
+			if cursor.Limit == 0 {
+				cursor.Limit = 10
+			}
+			if cursor.Page == 0 {
+				cursor.Page = 1
+			}
+			page, err := auditEvents.GetPaged(ctx, cursor)
+			require.NoError(t, err)
+			require.Len(t, page.Events, len(expected))
+			for i := range expected {
+				require.Equal(t, expected[i].ID, page.Events[i].ID)
+				require.Equal(t, expected[i].Operation, page.Events[i].Operation)
+				require.Equal(t, expected[i].UserID, page.Events[i].UserID)
+				require.Equal(t, expected[i].ProjectID, page.Events[i].ProjectID)
+				require.Equal(t, expected[i].APIKeyID, page.Events[i].APIKeyID)
+				require.Equal(t, expected[i].SourceIP, page.Events[i].SourceIP)
+				require.WithinDuration(t, expected[i].CreatedAt, page.Events[i].CreatedAt, time.Millisecond)
+			}
+		}
+
+		t.Run("project", func(t *testing.T) {
+			// the events which refer to the project by the public ID are included.
+			requireEvents(t, console.AuditEventsCursor{ProjectID: &project.ID}, createKey, getProject)
+		})
+
+		t.Run("user", func(t *testing.T) {
+			requireEvents(t, console.AuditEventsCursor{UserID: &userID}, createKey, deleteKey, getProject, login)
+		})
+
+		t.Run("time range", func(t *testing.T) {
+			requireEvents(t, console.AuditEventsCursor{
+				Since:  now.Add(-2 * time.Hour),
+				Before: now,
+			}, createKey, deleteKey, getProject)
+		})
+
+		t.Run("pages", func(t *testing.T) {
+			requireEvents(t, console.AuditEventsCursor{Limit: 2, Page: 3}, login)
+
+			page, err := auditEvents.GetPaged(ctx, console.AuditEventsCursor{Limit: 2, Page: 1})
+			require.NoError(t, err)
+			require.EqualValues(t, 5, page.TotalCount)
+			require.EqualValues(t, 3, page.PageCount)
+
+			page, err = auditEvents.GetPaged(ctx, console.AuditEventsCursor{Limit: 2, Page: 4})
+			require.NoError(t, err)
+			require.Empty(t, page.Events)
+		})
+
+		t.Run("delete before", func(t *testing.T) {
+			require.NoError(t, auditEvents.DeleteBefore(ctx, now.Add(-90*time.Minute), 0, 1))
+			requireEvents(t, console.AuditEventsCursor{Since: now.Add(-time.Hour)}, failedLogin, createKey, deleteKey)
+			requireEvents(t, console.AuditEventsCursor{}, failedLogin, createKey, deleteKey)
+		})
+
+		t.Run("insert batch", func(t *testing.T) {
+			batch := []console.AuditEvent{
+				{ID: testrand.UUID(), CreatedAt: now.Add(time.Minute), Operation: "delete project", UserID: &userID, ProjectID: &project.ID},
+				{ID: testrand.UUID(), CreatedAt: now.Add(2 * time.Minute), Operation: "login: failed invalid email", SourceIP: "127.0.0.1:58000"},
+			}
+			require.NoError(t, auditEvents.InsertBatch(ctx, batch))
+			require.NoError(t, auditEvents.InsertBatch(ctx, nil))
+
+			requireEvents(t, console.AuditEventsCursor{Since: now.Add(time.Minute)}, batch[1], batch[0])
+		})
+	})
+}
+
+func TestAuditEventsWriter(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	db := &batchRecorder{}
+	writer := console.NewAuditEventsWriter(zaptest.NewLogger(t), db, console.AuditEventsWriterConfig{
+		BatchSize:     2,
+		FlushInterval: time.Hour,
+	})
+
+	writer.Add(ctx, console.AuditEvent{Operation: "first"})
+	require.Empty(t, db.stored())
+
+	// a full batch is stored in the background.
+	writer.Add(ctx, console.AuditEvent{Operation: "second"})
+	require.Eventually(t, func() bool { return len(db.stored()) == 1 }, 5*time.Second, time.Millisecond)
+	require.Len(t, db.stored()[0], 2)
+
+	// the remaining events are stored on close.
+	writer.Add(ctx, console.AuditEvent{Operation: "third"})
+	require.NoError(t, writer.Close())
+	require.Len(t, db.stored(), 2)
+	require.Equal(t, "third", db.stored()[1][0].Operation)
+}
+
+// batchRecorder records the batches of audit events, which are inserted.
+type batchRecorder struct {
+	console.AuditEvents
+
+	mu      sync.Mutex
+	batches [][]console.AuditEvent
+}
+
+func (recorder *batchRecorder) InsertBatch(ctx context.Context, events []console.AuditEvent) error {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	recorder.batches = append(recorder.batches, events)
+	return nil
+}
+
+func (recorder *batchRecorder) stored() [][]console.AuditEvent {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	return append([][]console.AuditEvent(nil), recorder.batches...)
+}
+
+func TestAuditEventsCSVWriter(t *testing.T) {
+	userID, projectID := testrand.UUID(), testrand.UUID()
+	events := []console.AuditEvent{
+		{
+			ID:        testrand.UUID(),
+			CreatedAt: time.Date(2023, 6, 1, 8, 28, 24, 0, time.UTC),
+			Operation: "delete project",
+			UserID:    &userID,
+			Email:     "user@mail.test",
+			SourceIP:  "127.0.0.1:58000",
+			RequestID: "request-id",
+			ProjectID: &projectID,
+		},
+		{
+			ID:        testrand.UUID(),
+			CreatedAt: time.Date(2023, 6, 2, 8, 28, 24, 0, time.UTC),
+			Operation: "login: failed invalid email",
+			Email:     "unknown, \"quoted\"",
+		},
+	}
+
+	var buf bytes.Buffer
+	writer, err := console.NewAuditEventsCSVWriter(&buf)
+	require.NoError(t, err)
+	require.NoError(t, writer.Write(events[:1]))
+	require.NoError(t, writer.Write(events[1:]))
+	require.NoError(t, writer.Flush())
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, [][]string{
+		{"id", "created_at", "operation", "user_id", "email", "source_ip", "forwarded_for_ip", "request_id", "project_id", "api_key_id"},
+		{events[0].ID.String(), "2023-06-01T08:28:24Z", "delete project", userID.String(), "user@mail.test", "127.0.0.1:58000", "", "request-id", projectID.String(), ""},
+		{events[1].ID.String(), "2023-06-02T08:28:24Z", "login: failed invalid email", "", "unknown, \"quoted\"", "", "", "", "", ""},
+	}, records)
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/context2"
+	"storj.io/common/sync2"
+)
+
+// AuditEventsWriterConfig contains the configuration of the audit events writer.
+type AuditEventsWriterConfig struct {
+	BatchSize     int           `help:"number of console audit events that are stored in the database at once" default:"100"`
+	FlushInterval time.Duration `help:"how often pending console audit events are stored in the database" default:"10s"`
+}
+
+// AuditEventsWriter stores audit events in the database in batches, so that
+// recording the console activity doesn't add a database write to every request.
+type AuditEventsWriter struct {
+	log       *zap.Logger
+	db        AuditEvents
+	batchSize int
+
+	Loop *sync2.Cycle
+
+	wg       sync.WaitGroup
+	mu       sync.Mutex
+	pending  []AuditEvent
+	flushing sync.Mutex
+}
+
+// NewAuditEventsWriter creates a new audit events writer.
+func NewAuditEventsWriter(log *zap.Logger, db AuditEvents, config AuditEventsWriterConfig) *AuditEventsWriter {
+	batchSize := config.BatchSize
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+	return &AuditEventsWriter{
+		log:       log,
+		db:        db,
+		batchSize: batchSize,
+		Loop:      sync2.NewCycle(config.FlushInterval),
+	}
+}
+
+// Run periodically stores the pending audit events.
+func (writer *AuditEventsWriter) Run(ctx context.Context) error {
+	return writer.Loop.Run(ctx, func(ctx context.Context) error {
+		writer.Flush(ctx)
+		return nil
+	})
+}
+
+// Add queues the audit event. A full batch is stored in the background.
+func (writer *AuditEventsWriter) Add(ctx context.Context, event AuditEvent) {
+	writer.mu.Lock()
+	writer.pending = append(writer.pending, event)
+	full := len(writer.pending) >= writer.batchSize
+	writer.mu.Unlock()
+
+	if full {
+		writer.wg.Add(1)
+		go func() {
+			defer writer.wg.Done()
+			writer.Flush(context2.WithoutCancellation(ctx))
+		}()
+	}
+}
+
+// Flush stores the pending audit events.
+func (writer *AuditEventsWriter) Flush(ctx context.Context) {
+	defer mon.Task()(&ctx)(nil)
+
+	writer.flushing.Lock()
+	defer writer.flushing.Unlock()
+
+	writer.mu.Lock()
+	events := writer.pending
+	writer.pending = nil
+	writer.mu.Unlock()
+
+	if len(events) == 0 {
+		return
+	}
+
+	if err := writer.db.InsertBatch(ctx, events); err != nil {
+		writer.log.Warn("failed to store console activity", zap.Int("count", len(events)), zap.Error(err))
+	}
+}
+
+// Close stops the writer and stores the pending audit events.
+func (writer *AuditEventsWriter) Close() error {
+	writer.Loop.Close()
+	writer.wg.Wait()
+	writer.Flush(context.Background())
+	return nil
+}
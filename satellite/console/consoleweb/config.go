@@ -49,6 +49,7 @@ type FrontendConfig struct {
 	NewUploadModalEnabled           bool                  `json:"newUploadModalEnabled"`
 	GalleryViewEnabled              bool                  `json:"galleryViewEnabled"`
 	NeededTransactionConfirmations  int                   `json:"neededTransactionConfirmations"`
+	SSOEnabled                      bool                  `json:"ssoEnabled"`
 }
 
 // Satellites is a configuration value that contains a list of satellite names and addresses.
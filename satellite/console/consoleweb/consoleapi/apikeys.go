@@ -71,6 +71,10 @@ func (keys *APIKeys) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
 			keys.serveJSONError(ctx, w, http.StatusUnauthorized, err)
 			return
 		}
+		if console.ErrForbidden.Has(err) {
+			keys.serveJSONError(ctx, w, http.StatusForbidden, err)
+			return
+		}
 
 		keys.serveJSONError(ctx, w, http.StatusInternalServerError, err)
 		return
@@ -171,6 +175,10 @@ func (keys *APIKeys) GetProjectAPIKeys(w http.ResponseWriter, r *http.Request) {
 			keys.serveJSONError(ctx, w, http.StatusUnauthorized, err)
 			return
 		}
+		if console.ErrForbidden.Has(err) {
+			keys.serveJSONError(ctx, w, http.StatusForbidden, err)
+			return
+		}
 
 		keys.serveJSONError(ctx, w, http.StatusInternalServerError, err)
 		return
@@ -206,6 +214,10 @@ func (keys *APIKeys) GetAllAPIKeyNames(w http.ResponseWriter, r *http.Request) {
 			keys.serveJSONError(ctx, w, http.StatusUnauthorized, err)
 			return
 		}
+		if console.ErrForbidden.Has(err) {
+			keys.serveJSONError(ctx, w, http.StatusForbidden, err)
+			return
+		}
 
 		keys.serveJSONError(ctx, w, http.StatusInternalServerError, err)
 		return
@@ -250,6 +262,10 @@ func (keys *APIKeys) DeleteByIDs(w http.ResponseWriter, r *http.Request) {
 			keys.serveJSONError(ctx, w, http.StatusUnauthorized, err)
 			return
 		}
+		if console.ErrForbidden.Has(err) {
+			keys.serveJSONError(ctx, w, http.StatusForbidden, err)
+			return
+		}
 
 		keys.serveJSONError(ctx, w, http.StatusInternalServerError, err)
 		return
@@ -296,6 +312,10 @@ func (keys *APIKeys) DeleteByNameAndProjectID(w http.ResponseWriter, r *http.Req
 			keys.serveJSONError(ctx, w, http.StatusUnauthorized, err)
 			return
 		}
+		if console.ErrForbidden.Has(err) {
+			keys.serveJSONError(ctx, w, http.StatusForbidden, err)
+			return
+		}
 
 		if console.ErrNoAPIKey.Has(err) {
 			keys.serveJSONError(ctx, w, http.StatusNoContent, err)
@@ -22,6 +22,7 @@ import (
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleweb/consoleapi/utils"
 	"storj.io/storj/satellite/console/consoleweb/consolewebauth"
+	"storj.io/storj/satellite/console/webauthn"
 	"storj.io/storj/satellite/mailservice"
 )
 
@@ -834,6 +835,194 @@ func (a *Auth) GenerateMFARecoveryCodes(w http.ResponseWriter, r *http.Request)
 	}
 }
 
+// BeginWebAuthnRegistration returns the options for registering a new WebAuthn credential of the user.
+func (a *Auth) BeginWebAuthnRegistration(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	options, err := a.service.BeginWebAuthnRegistration(ctx)
+	if err != nil {
+		a.serveJSONError(ctx, w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(options)
+	if err != nil {
+		a.log.Error("could not encode WebAuthn registration options", zap.Error(ErrAuthAPI.Wrap(err)))
+		return
+	}
+}
+
+// FinishWebAuthnRegistration stores the WebAuthn credential created by the user's authenticator.
+func (a *Auth) FinishWebAuthnRegistration(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var data struct {
+		Name       string                       `json:"name"`
+		Credential webauthn.AttestationResponse `json:"credential"`
+	}
+	err = json.NewDecoder(r.Body).Decode(&data)
+	if err != nil {
+		a.serveJSONError(ctx, w, err)
+		return
+	}
+
+	credential, err := a.service.FinishWebAuthnRegistration(ctx, data.Name, data.Credential)
+	if err != nil {
+		a.serveJSONError(ctx, w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(credential)
+	if err != nil {
+		a.log.Error("could not encode WebAuthn credential", zap.Error(ErrAuthAPI.Wrap(err)))
+		return
+	}
+}
+
+// GetWebAuthnCredentials returns the WebAuthn credentials of the user.
+func (a *Auth) GetWebAuthnCredentials(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	credentials, err := a.service.GetWebAuthnCredentials(ctx)
+	if err != nil {
+		a.serveJSONError(ctx, w, err)
+		return
+	}
+	if credentials == nil {
+		credentials = []console.WebAuthnCredential{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(credentials)
+	if err != nil {
+		a.log.Error("could not encode WebAuthn credentials", zap.Error(ErrAuthAPI.Wrap(err)))
+		return
+	}
+}
+
+// DeleteWebAuthnCredential deletes a WebAuthn credential of the user.
+func (a *Auth) DeleteWebAuthnCredential(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	idParam, ok := mux.Vars(r)["id"]
+	if !ok {
+		a.serveJSONError(ctx, w, console.ErrValidation.New("missing id route param"))
+		return
+	}
+
+	id, err := uuid.FromString(idParam)
+	if err != nil {
+		a.serveJSONError(ctx, w, console.ErrValidation.Wrap(err))
+		return
+	}
+
+	var data struct {
+		Passcode          string                      `json:"passcode"`
+		RecoveryCode      string                      `json:"recoveryCode"`
+		WebAuthnAssertion *webauthn.AssertionResponse `json:"webAuthnAssertion"`
+	}
+	err = json.NewDecoder(r.Body).Decode(&data)
+	if err != nil {
+		a.serveJSONError(ctx, w, err)
+		return
+	}
+
+	err = a.service.DeleteWebAuthnCredential(ctx, id, data.Passcode, data.RecoveryCode, data.WebAuthnAssertion, time.Now())
+	if err != nil {
+		a.serveJSONError(ctx, w, err)
+		return
+	}
+}
+
+// BeginWebAuthnCredentialDeletion returns the options for getting the WebAuthn assertion required to
+// delete a WebAuthn credential.
+func (a *Auth) BeginWebAuthnCredentialDeletion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	options, err := a.service.BeginWebAuthnCredentialDeletion(ctx)
+	if err != nil {
+		a.serveJSONError(ctx, w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(options)
+	if err != nil {
+		a.log.Error("could not encode WebAuthn credential deletion options", zap.Error(ErrAuthAPI.Wrap(err)))
+		return
+	}
+}
+
+// BeginWebAuthnLogin returns the options for getting the WebAuthn assertion required to log in.
+func (a *Auth) BeginWebAuthnLogin(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var data struct {
+		Email string `json:"email"`
+	}
+	err = json.NewDecoder(r.Body).Decode(&data)
+	if err != nil {
+		a.serveJSONError(ctx, w, err)
+		return
+	}
+
+	options, err := a.service.BeginWebAuthnLogin(ctx, data.Email)
+	if err != nil {
+		a.serveJSONError(ctx, w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(options)
+	if err != nil {
+		a.log.Error("could not encode WebAuthn login options", zap.Error(ErrAuthAPI.Wrap(err)))
+		return
+	}
+}
+
+// BeginWebAuthnPasswordReset returns the options for getting the WebAuthn assertion required to reset the password.
+func (a *Auth) BeginWebAuthnPasswordReset(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var data struct {
+		RecoveryToken string `json:"token"`
+	}
+	err = json.NewDecoder(r.Body).Decode(&data)
+	if err != nil {
+		a.serveJSONError(ctx, w, err)
+		return
+	}
+
+	options, err := a.service.BeginWebAuthnPasswordReset(ctx, data.RecoveryToken)
+	if err != nil {
+		a.serveJSONError(ctx, w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(options)
+	if err != nil {
+		a.log.Error("could not encode WebAuthn password reset options", zap.Error(ErrAuthAPI.Wrap(err)))
+		return
+	}
+}
+
 // ResetPassword resets user's password using recovery token.
 func (a *Auth) ResetPassword(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -845,6 +1034,8 @@ func (a *Auth) ResetPassword(w http.ResponseWriter, r *http.Request) {
 		NewPassword     string `json:"password"`
 		MFAPasscode     string `json:"mfaPasscode"`
 		MFARecoveryCode string `json:"mfaRecoveryCode"`
+
+		WebAuthnAssertion *webauthn.AssertionResponse `json:"webAuthnAssertion"`
 	}
 
 	err = json.NewDecoder(r.Body).Decode(&resetPassword)
@@ -852,9 +1043,9 @@ func (a *Auth) ResetPassword(w http.ResponseWriter, r *http.Request) {
 		a.serveJSONError(ctx, w, err)
 	}
 
-	err = a.service.ResetPassword(ctx, resetPassword.RecoveryToken, resetPassword.NewPassword, resetPassword.MFAPasscode, resetPassword.MFARecoveryCode, time.Now())
+	err = a.service.ResetPassword(ctx, resetPassword.RecoveryToken, resetPassword.NewPassword, resetPassword.MFAPasscode, resetPassword.MFARecoveryCode, resetPassword.WebAuthnAssertion, time.Now())
 
-	if console.ErrMFAMissing.Has(err) || console.ErrMFAPasscode.Has(err) || console.ErrMFARecoveryCode.Has(err) {
+	if console.ErrMFAMissing.Has(err) || console.ErrMFAPasscode.Has(err) || console.ErrMFARecoveryCode.Has(err) || console.ErrMFAWebAuthn.Has(err) {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(a.getStatusCode(err))
 
@@ -1033,12 +1224,14 @@ func (a *Auth) getStatusCode(err error) int {
 	var maxBytesError *http.MaxBytesError
 
 	switch {
-	case console.ErrValidation.Has(err), console.ErrCaptcha.Has(err), console.ErrMFAMissing.Has(err), console.ErrMFAPasscode.Has(err), console.ErrMFARecoveryCode.Has(err), console.ErrChangePassword.Has(err):
+	case console.ErrValidation.Has(err), console.ErrCaptcha.Has(err), console.ErrMFAMissing.Has(err), console.ErrMFAPasscode.Has(err), console.ErrMFARecoveryCode.Has(err), console.ErrMFAWebAuthn.Has(err), console.ErrChangePassword.Has(err):
 		return http.StatusBadRequest
 	case console.ErrUnauthorized.Has(err), console.ErrTokenExpiration.Has(err), console.ErrRecoveryToken.Has(err), console.ErrLoginCredentials.Has(err):
 		return http.StatusUnauthorized
 	case console.ErrEmailUsed.Has(err), console.ErrMFAConflict.Has(err):
 		return http.StatusConflict
+	case console.ErrWebAuthnCredentialNotFound.Has(err):
+		return http.StatusNotFound
 	case errors.Is(err, errNotImplemented):
 		return http.StatusNotImplemented
 	case errors.As(err, &maxBytesError):
@@ -1072,6 +1265,10 @@ func (a *Auth) getUserErrorMessage(err error) string {
 		return "The MFA passcode is not valid or has expired"
 	case console.ErrMFARecoveryCode.Has(err):
 		return "The MFA recovery code is not valid or has been previously used"
+	case console.ErrMFAWebAuthn.Has(err):
+		return "The security key is not valid for this account"
+	case console.ErrWebAuthnCredentialNotFound.Has(err):
+		return "The security key does not exist"
 	case console.ErrLoginCredentials.Has(err):
 		return "Your login credentials are incorrect, please try again"
 	case console.ErrValidation.Has(err), console.ErrChangePassword.Has(err):
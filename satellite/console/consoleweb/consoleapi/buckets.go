@@ -78,6 +78,10 @@ func (b *Buckets) AllBucketNames(w http.ResponseWriter, r *http.Request) {
 			b.serveJSONError(ctx, w, http.StatusUnauthorized, err)
 			return
 		}
+		if console.ErrForbidden.Has(err) {
+			b.serveJSONError(ctx, w, http.StatusForbidden, err)
+			return
+		}
 
 		b.serveJSONError(ctx, w, http.StatusInternalServerError, err)
 		return
@@ -149,7 +153,17 @@ func (b *Buckets) GetBucketTotals(w http.ResponseWriter, r *http.Request) {
 		Page:   page,
 	}, before)
 	if err != nil {
+		if console.ErrUnauthorized.Has(err) {
+			b.serveJSONError(ctx, w, http.StatusUnauthorized, err)
+			return
+		}
+		if console.ErrForbidden.Has(err) {
+			b.serveJSONError(ctx, w, http.StatusForbidden, err)
+			return
+		}
+
 		b.serveJSONError(ctx, w, http.StatusInternalServerError, err)
+		return
 	}
 
 	err = json.NewEncoder(w).Encode(totals)
@@ -60,6 +60,19 @@ func main() {
 			},
 		})
 
+		g.Patch("/member-role/{id}/{memberID}", &apigen.Endpoint{
+			Name:        "Update Project Member Role",
+			Description: "Changes the role of a project member",
+			MethodName:  "GenUpdateProjectMemberRole",
+			RequestName: "updateProjectMemberRole",
+			Response:    console.ProjectMember{},
+			Request:     console.UpdateProjectMemberRoleRequest{},
+			PathParams: []apigen.Param{
+				apigen.NewParam("id", uuid.UUID{}),
+				apigen.NewParam("memberID", uuid.UUID{}),
+			},
+		})
+
 		g.Get("/", &apigen.Endpoint{
 			Name:        "Get Projects",
 			Description: "Gets all projects user has",
@@ -4,6 +4,7 @@
 package consoleapi
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"encoding/json"
@@ -43,15 +44,17 @@ type ProjectMembersPage struct {
 
 // Member is a project member in a ProjectMembersPage.
 type Member struct {
-	User     *console.User `json:"user"`
-	JoinedAt time.Time     `json:"joinedAt"`
+	User     *console.User             `json:"user"`
+	Role     console.ProjectMemberRole `json:"role"`
+	JoinedAt time.Time                 `json:"joinedAt"`
 }
 
 // Invitation is a project invitation in a ProjectMembersPage.
 type Invitation struct {
-	Email     string    `json:"email"`
-	CreatedAt time.Time `json:"createdAt"`
-	Expired   bool      `json:"expired"`
+	Email     string                    `json:"email"`
+	Role      console.ProjectMemberRole `json:"role"`
+	CreatedAt time.Time                 `json:"createdAt"`
+	Expired   bool                      `json:"expired"`
 }
 
 // NewProjects is a constructor for api analytics controller.
@@ -225,7 +228,7 @@ func (p *Projects) GetMembersAndInvitations(w http.ResponseWriter, r *http.Reque
 
 	project, err := p.service.GetProject(ctx, publicID)
 	if err != nil {
-		p.serveJSONError(ctx, w, http.StatusInternalServerError, err)
+		p.serveJSONError(ctx, w, projectErrorStatus(err), err)
 		return
 	}
 
@@ -282,7 +285,7 @@ func (p *Projects) GetMembersAndInvitations(w http.ResponseWriter, r *http.Reque
 		OrderDirection: console.OrderDirection(orderDir),
 	})
 	if err != nil {
-		p.serveJSONError(ctx, w, http.StatusUnauthorized, err)
+		p.serveJSONError(ctx, w, projectErrorStatus(err), err)
 		return
 	}
 	memberPage.Search = membersAndInvitations.Search
@@ -304,6 +307,7 @@ func (p *Projects) GetMembersAndInvitations(w http.ResponseWriter, r *http.Reque
 		}
 		member := Member{
 			User:     user,
+			Role:     m.Role,
 			JoinedAt: m.CreatedAt,
 		}
 		memberPage.Members = append(memberPage.Members, member)
@@ -311,6 +315,7 @@ func (p *Projects) GetMembersAndInvitations(w http.ResponseWriter, r *http.Reque
 	for _, inv := range membersAndInvitations.ProjectInvitations {
 		invitee := Invitation{
 			Email:     inv.Email,
+			Role:      inv.Role,
 			CreatedAt: inv.CreatedAt,
 			Expired:   p.service.IsProjectInvitationExpired(&inv),
 		}
@@ -343,7 +348,7 @@ func (p *Projects) GetSalt(w http.ResponseWriter, r *http.Request) {
 
 	salt, err := p.service.GetSalt(ctx, id)
 	if err != nil {
-		p.serveJSONError(ctx, w, http.StatusUnauthorized, err)
+		p.serveJSONError(ctx, w, projectErrorStatus(err), err)
 		return
 	}
 
@@ -372,7 +377,8 @@ func (p *Projects) InviteUsers(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var data struct {
-		Emails []string `json:"emails"`
+		Emails []string                  `json:"emails"`
+		Role   console.ProjectMemberRole `json:"role"`
 	}
 
 	err = json.NewDecoder(r.Body).Decode(&data)
@@ -385,7 +391,56 @@ func (p *Projects) InviteUsers(w http.ResponseWriter, r *http.Request) {
 		data.Emails[i] = strings.TrimSpace(email)
 	}
 
-	_, err = p.service.InviteProjectMembers(ctx, id, data.Emails)
+	_, err = p.service.InviteProjectMembers(ctx, id, data.Emails, data.Role)
+	if err != nil {
+		p.serveJSONError(ctx, w, projectErrorStatus(err), err)
+	}
+}
+
+// UpdateMemberRole handles changing the role of a project member.
+func (p *Projects) UpdateMemberRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	idParam, ok := mux.Vars(r)["id"]
+	if !ok {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, errs.New("missing project id route param"))
+		return
+	}
+	id, err := uuid.FromString(idParam)
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	memberIDParam, ok := mux.Vars(r)["memberID"]
+	if !ok {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, errs.New("missing member id route param"))
+		return
+	}
+	memberID, err := uuid.FromString(memberIDParam)
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	var payload console.UpdateProjectMemberRoleRequest
+	err = json.NewDecoder(r.Body).Decode(&payload)
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	member, err := p.service.UpdateProjectMemberRole(ctx, id, memberID, payload.Role)
+	if err != nil {
+		p.serveJSONError(ctx, w, projectErrorStatus(err), err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(member)
 	if err != nil {
 		p.serveJSONError(ctx, w, http.StatusInternalServerError, err)
 	}
@@ -414,7 +469,8 @@ func (p *Projects) GetInviteLink(w http.ResponseWriter, r *http.Request) {
 
 	link, err := p.service.GetInviteLink(ctx, id, email)
 	if err != nil {
-		p.serveJSONError(ctx, w, http.StatusInternalServerError, err)
+		p.serveJSONError(ctx, w, projectErrorStatus(err), err)
+		return
 	}
 
 	err = json.NewEncoder(w).Encode(link)
@@ -524,6 +580,143 @@ func (p *Projects) RespondToInvitation(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// GetAuditEvents returns a page of the console activity in a given project(id).
+func (p *Projects) GetAuditEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	idParam, ok := mux.Vars(r)["id"]
+	if !ok {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, errs.New("missing id route param"))
+		return
+	}
+
+	id, err := uuid.FromString(idParam)
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	limitStr := r.URL.Query().Get("limit")
+	if limitStr == "" {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, errs.New("missing limit query param"))
+		return
+	}
+	limit, err := strconv.ParseUint(limitStr, 10, 32)
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, errs.New("invalid limit parameter: %s", limitStr))
+		return
+	}
+
+	pageStr := r.URL.Query().Get("page")
+	if pageStr == "" {
+		pageStr = "1"
+	}
+	page, err := strconv.ParseUint(pageStr, 10, 32)
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, errs.New("invalid page parameter: %s", pageStr))
+		return
+	}
+
+	since, before, err := parseTimeRange(r)
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	events, err := p.service.GetProjectAuditEvents(ctx, id, console.AuditEventsCursor{
+		Since:  since,
+		Before: before,
+		Limit:  uint(limit),
+		Page:   uint(page),
+	})
+	if err != nil {
+		p.serveJSONError(ctx, w, projectErrorStatus(err), err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(events)
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusInternalServerError, err)
+	}
+}
+
+// ExportAuditEvents returns the console activity in a given project(id) as CSV.
+func (p *Projects) ExportAuditEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	idParam, ok := mux.Vars(r)["id"]
+	if !ok {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, errs.New("missing id route param"))
+		return
+	}
+
+	id, err := uuid.FromString(idParam)
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	since, before, err := parseTimeRange(r)
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	// the CSV is buffered, so a failure in the middle of the export is reported as an error.
+	var buf bytes.Buffer
+	err = p.service.ExportProjectAuditEvents(ctx, id, since, before, &buf)
+	if err != nil {
+		p.serveJSONError(ctx, w, projectErrorStatus(err), err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", "attachment; filename=\"audit-log-"+idParam+".csv\"")
+	_, err = buf.WriteTo(w)
+	if err != nil {
+		p.log.Debug("failed to write csv audit events response", zap.Error(err))
+	}
+}
+
+// parseTimeRange parses the optional since and before query params, which are in RFC 3339 format.
+func parseTimeRange(r *http.Request) (since, before time.Time, err error) {
+	if sinceStr := r.URL.Query().Get("since"); sinceStr != "" {
+		since, err = time.Parse(time.RFC3339, sinceStr)
+		if err != nil {
+			return time.Time{}, time.Time{}, errs.New("invalid since parameter: %s", sinceStr)
+		}
+	}
+	if beforeStr := r.URL.Query().Get("before"); beforeStr != "" {
+		before, err = time.Parse(time.RFC3339, beforeStr)
+		if err != nil {
+			return time.Time{}, time.Time{}, errs.New("invalid before parameter: %s", beforeStr)
+		}
+	}
+	return since, before, nil
+}
+
+// projectErrorStatus returns the HTTP status of an error returned by a project member gated service method.
+func projectErrorStatus(err error) int {
+	switch {
+	case console.ErrUnauthorized.Has(err), console.ErrNoMembership.Has(err):
+		return http.StatusUnauthorized
+	case console.ErrForbidden.Has(err):
+		return http.StatusForbidden
+	case console.ErrValidation.Has(err):
+		return http.StatusBadRequest
+	case console.ErrAlreadyMember.Has(err), console.ErrAlreadyInvited.Has(err):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (p *Projects) serveJSONError(ctx context.Context, w http.ResponseWriter, status int, err error) {
 	web.ServeJSONError(ctx, p.log, w, status, err)
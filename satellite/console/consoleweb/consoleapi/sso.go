@@ -0,0 +1,190 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/private/web"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleweb/consolewebauth"
+	"storj.io/storj/satellite/console/sso"
+)
+
+// ErrSSOAPI - console sso api error type.
+var ErrSSOAPI = errs.Class("consoleapi sso")
+
+const (
+	// ssoStateCookieName is the name of the cookie which binds the identity provider callback to the browser which started the sign in.
+	ssoStateCookieName = "_ssoState"
+	// ssoStateCookieMaxAge limits the time the user has to sign in at the identity provider.
+	ssoStateCookieMaxAge = 10 * time.Minute
+)
+
+// SSO is an api controller that signs in users through external identity providers.
+type SSO struct {
+	log             *zap.Logger
+	service         *console.Service
+	ssoService      *sso.Service
+	cookieAuth      *consolewebauth.CookieAuth
+	externalAddress string
+}
+
+// NewSSO is a constructor for api sso controller.
+func NewSSO(log *zap.Logger, service *console.Service, ssoService *sso.Service, cookieAuth *consolewebauth.CookieAuth, externalAddress string) *SSO {
+	return &SSO{
+		log:             log,
+		service:         service,
+		ssoService:      ssoService,
+		cookieAuth:      cookieAuth,
+		externalAddress: externalAddress,
+	}
+}
+
+// Login redirects the user to the identity provider assigned to the domain of the email query param.
+func (a *SSO) Login(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	provider, ok := a.ssoService.ProviderByEmail(r.URL.Query().Get("email"))
+	if !ok {
+		a.redirectError(w, r, "unknown_domain")
+		return
+	}
+
+	state, err := randomSSOValue()
+	if err != nil {
+		a.log.Error("failed to generate sso state", zap.Error(ErrSSOAPI.Wrap(err)))
+		a.redirectError(w, r, "internal")
+		return
+	}
+	nonce, err := randomSSOValue()
+	if err != nil {
+		a.log.Error("failed to generate sso nonce", zap.Error(ErrSSOAPI.Wrap(err)))
+		a.redirectError(w, r, "internal")
+		return
+	}
+
+	authURL, err := provider.AuthCodeURL(ctx, state, nonce)
+	if err != nil {
+		a.log.Error("failed to get identity provider url", zap.String("provider", provider.Name()), zap.Error(ErrSSOAPI.Wrap(err)))
+		a.redirectError(w, r, "provider_unavailable")
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     ssoStateCookieName,
+		Value:    strings.Join([]string{provider.Name(), state, nonce}, "."),
+		Path:     "/api/v0/auth/sso/" + provider.Name(),
+		MaxAge:   int(ssoStateCookieMaxAge.Seconds()),
+		HttpOnly: true,
+		// the callback is a cross-site navigation from the identity provider.
+		SameSite: http.SameSiteLaxMode,
+		Secure:   r.TLS != nil || strings.HasPrefix(a.externalAddress, "https://"),
+	})
+
+	http.Redirect(w, r, authURL, http.StatusFound)
+}
+
+// Callback signs in the user who is redirected back from the identity provider.
+func (a *SSO) Callback(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	providerName := mux.Vars(r)["provider"]
+	provider, ok := a.ssoService.ProviderByName(providerName)
+	if !ok {
+		a.redirectError(w, r, "unknown_provider")
+		return
+	}
+
+	// the state cookie is single use.
+	http.SetCookie(w, &http.Cookie{
+		Name:   ssoStateCookieName,
+		Path:   "/api/v0/auth/sso/" + providerName,
+		MaxAge: -1,
+	})
+
+	query := r.URL.Query()
+	if query.Get("error") != "" {
+		a.log.Info("identity provider returned an error", zap.String("provider", providerName), zap.String("error", query.Get("error")))
+		a.redirectError(w, r, "provider_denied")
+		return
+	}
+
+	cookie, err := r.Cookie(ssoStateCookieName)
+	if err != nil {
+		a.redirectError(w, r, "invalid_state")
+		return
+	}
+	parts := strings.Split(cookie.Value, ".")
+	if len(parts) != 3 || parts[0] != providerName ||
+		subtle.ConstantTimeCompare([]byte(parts[1]), []byte(query.Get("state"))) != 1 {
+		a.redirectError(w, r, "invalid_state")
+		return
+	}
+	nonce := parts[2]
+
+	claims, err := provider.Exchange(ctx, query.Get("code"), nonce)
+	if err != nil {
+		a.log.Info("failed to verify identity provider sign in", zap.String("provider", providerName), zap.Error(ErrSSOAPI.Wrap(err)))
+		a.redirectError(w, r, "invalid_token")
+		return
+	}
+
+	ip, err := web.GetRequestIP(r)
+	if err != nil {
+		a.redirectError(w, r, "internal")
+		return
+	}
+
+	tokenInfo, err := a.service.TokenBySSO(ctx, console.SSOClaims{
+		Provider: claims.Provider,
+		Subject:  claims.Subject,
+		Email:    claims.Email,
+		FullName: claims.Name,
+	}, ip, r.UserAgent())
+	if err != nil {
+		a.log.Info("failed to sign in through identity provider", zap.String("provider", providerName), zap.String("email", claims.Email), zap.Error(ErrSSOAPI.Wrap(err)))
+		switch {
+		case console.ErrLoginCredentials.Has(err):
+			a.redirectError(w, r, "account_inactive")
+		case console.ErrSSOLinkMFA.Has(err):
+			a.redirectError(w, r, "mfa_enabled")
+		default:
+			a.redirectError(w, r, "internal")
+		}
+		return
+	}
+
+	a.cookieAuth.SetTokenCookie(w, *tokenInfo)
+
+	http.Redirect(w, r, a.externalAddress, http.StatusFound)
+}
+
+// redirectError redirects the user to the login page, which shows the error.
+func (a *SSO) redirectError(w http.ResponseWriter, r *http.Request, code string) {
+	http.Redirect(w, r, a.externalAddress+"login?sso_error="+url.QueryEscape(code), http.StatusFound)
+}
+
+// randomSSOValue returns an unguessable value for the state and nonce parameters.
+func randomSSOValue() (string, error) {
+	data := make([]byte, 32)
+	if _, err := rand.Read(data); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
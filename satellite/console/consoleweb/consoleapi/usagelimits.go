@@ -67,6 +67,9 @@ func (ul *UsageLimits) ProjectUsageLimits(w http.ResponseWriter, r *http.Request
 		case console.ErrUnauthorized.Has(err):
 			ul.serveJSONError(ctx, w, http.StatusUnauthorized, err)
 			return
+		case console.ErrForbidden.Has(err):
+			ul.serveJSONError(ctx, w, http.StatusForbidden, err)
+			return
 		case accounting.ErrInvalidArgument.Has(err):
 			ul.serveJSONError(ctx, w, http.StatusBadRequest, err)
 			return
@@ -144,6 +147,10 @@ func (ul *UsageLimits) DailyUsage(w http.ResponseWriter, r *http.Request) {
 			ul.serveJSONError(ctx, w, http.StatusUnauthorized, err)
 			return
 		}
+		if console.ErrForbidden.Has(err) {
+			ul.serveJSONError(ctx, w, http.StatusForbidden, err)
+			return
+		}
 
 		ul.serveJSONError(ctx, w, http.StatusInternalServerError, err)
 		return
@@ -155,6 +162,126 @@ func (ul *UsageLimits) DailyUsage(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// UsageAlerts returns the usage alerts of the project.
+func (ul *UsageLimits) UsageAlerts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, err := ul.projectIDParam(r)
+	if err != nil {
+		ul.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	alerts, err := ul.service.GetProjectUsageAlerts(ctx, projectID)
+	if err != nil {
+		ul.serveJSONError(ctx, w, usageAlertsStatusCode(err), err)
+		return
+	}
+	if alerts == nil {
+		alerts = []console.ProjectUsageAlert{}
+	}
+
+	err = json.NewEncoder(w).Encode(alerts)
+	if err != nil {
+		ul.log.Error("error encoding project usage alerts", zap.Error(ErrUsageLimitsAPI.Wrap(err)))
+	}
+}
+
+// CreateUsageAlert creates a usage alert of the project.
+func (ul *UsageLimits) CreateUsageAlert(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, err := ul.projectIDParam(r)
+	if err != nil {
+		ul.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	var request console.CreateProjectUsageAlertRequest
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		ul.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	alert, err := ul.service.CreateProjectUsageAlert(ctx, projectID, request)
+	if err != nil {
+		ul.serveJSONError(ctx, w, usageAlertsStatusCode(err), err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(alert)
+	if err != nil {
+		ul.log.Error("error encoding project usage alert", zap.Error(ErrUsageLimitsAPI.Wrap(err)))
+	}
+}
+
+// DeleteUsageAlert deletes a usage alert of the project.
+func (ul *UsageLimits) DeleteUsageAlert(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, err := ul.projectIDParam(r)
+	if err != nil {
+		ul.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	alertIDParam, ok := mux.Vars(r)["alertID"]
+	if !ok {
+		ul.serveJSONError(ctx, w, http.StatusBadRequest, errs.New("missing alert id route param"))
+		return
+	}
+	alertID, err := uuid.FromString(alertIDParam)
+	if err != nil {
+		ul.serveJSONError(ctx, w, http.StatusBadRequest, errs.New("invalid alert id: %v", err))
+		return
+	}
+
+	err = ul.service.DeleteProjectUsageAlert(ctx, projectID, alertID)
+	if err != nil {
+		ul.serveJSONError(ctx, w, usageAlertsStatusCode(err), err)
+		return
+	}
+}
+
+// projectIDParam returns the project ID from the route params.
+func (ul *UsageLimits) projectIDParam(r *http.Request) (uuid.UUID, error) {
+	idParam, ok := mux.Vars(r)["id"]
+	if !ok {
+		return uuid.UUID{}, errs.New("missing project id route param")
+	}
+	projectID, err := uuid.FromString(idParam)
+	if err != nil {
+		return uuid.UUID{}, errs.New("invalid project id: %v", err)
+	}
+	return projectID, nil
+}
+
+// usageAlertsStatusCode returns the status code of the usage alerts error.
+func usageAlertsStatusCode(err error) int {
+	switch {
+	case console.ErrUnauthorized.Has(err), console.ErrNoMembership.Has(err):
+		return http.StatusUnauthorized
+	case console.ErrForbidden.Has(err):
+		return http.StatusForbidden
+	case console.ErrValidation.Has(err):
+		return http.StatusBadRequest
+	case console.ErrProjectUsageAlertNotFound.Has(err):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (ul *UsageLimits) serveJSONError(ctx context.Context, w http.ResponseWriter, status int, err error) {
 	web.ServeJSONError(ctx, ul.log, w, status, err)
@@ -152,6 +152,9 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 					FieldEmail: &graphql.ArgumentConfig{
 						Type: graphql.NewNonNull(graphql.NewList(graphql.String)),
 					},
+					FieldRole: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.Int),
+					},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
 					inviter, err := console.GetUser(p.Context)
@@ -160,6 +163,7 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 					}
 
 					emails, _ := p.Args[FieldEmail].([]interface{})
+					role, _ := p.Args[FieldRole].(int)
 
 					projectID, err := getProjectID(p)
 					if err != nil {
@@ -176,7 +180,7 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 						return nil, err
 					}
 
-					users, err := service.AddProjectMembers(p.Context, project.ID, userEmails)
+					users, err := service.AddProjectMembers(p.Context, project.ID, userEmails, console.ProjectMemberRole(role))
 					if err != nil {
 						return nil, err
 					}
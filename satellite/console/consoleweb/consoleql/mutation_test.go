@@ -330,10 +330,11 @@ func TestGraphqlMutation(t *testing.T) {
 
 		t.Run("Add project members mutation", func(t *testing.T) {
 			query := fmt.Sprintf(
-				"mutation {addProjectMembers(projectID:\"%s\",email:[\"%s\",\"%s\"]){id,publicId,name,membersAndInvitations(cursor: { limit: 50, search: \"\", page: 1, order: 1, orderDirection: 2 }){projectMembers{joinedAt}}}}",
+				"mutation {addProjectMembers(projectID:\"%s\",email:[\"%s\",\"%s\"],role:%d){id,publicId,name,membersAndInvitations(cursor: { limit: 50, search: \"\", page: 1, order: 1, orderDirection: 2 }){projectMembers{joinedAt}}}}",
 				project.ID.String(),
 				user1.Email,
 				user2.Email,
+				console.RoleDeveloper,
 			)
 
 			testAdd(query, 3)
@@ -341,9 +342,10 @@ func TestGraphqlMutation(t *testing.T) {
 
 		t.Run("Add project members mutation with publicId", func(t *testing.T) {
 			query := fmt.Sprintf(
-				"mutation {addProjectMembers(publicId:\"%s\",email:[\"%s\"]){id,publicId,name,membersAndInvitations(cursor: { limit: 50, search: \"\", page: 1, order: 1, orderDirection: 2 }){projectMembers{joinedAt}}}}",
+				"mutation {addProjectMembers(publicId:\"%s\",email:[\"%s\"],role:%d){id,publicId,name,membersAndInvitations(cursor: { limit: 50, search: \"\", page: 1, order: 1, orderDirection: 2 }){projectMembers{joinedAt}}}}",
 				project.PublicID.String(),
 				user3.Email,
+				console.RoleReadOnly,
 			)
 
 			testAdd(query, 4)
@@ -351,9 +353,10 @@ func TestGraphqlMutation(t *testing.T) {
 
 		t.Run("Fail add project members mutation without ID", func(t *testing.T) {
 			query := fmt.Sprintf(
-				"mutation {addProjectMembers(email:[\"%s\",\"%s\"]){id,publicId,name,membersAndInvitations(cursor: { limit: 50, search: \"\", page: 1, order: 1, orderDirection: 2 }){projectMembers{joinedAt}}}}",
+				"mutation {addProjectMembers(email:[\"%s\",\"%s\"],role:%d){id,publicId,name,membersAndInvitations(cursor: { limit: 50, search: \"\", page: 1, order: 1, orderDirection: 2 }){projectMembers{joinedAt}}}}",
 				user1.Email,
 				user2.Email,
+				console.RoleDeveloper,
 			)
 
 			_, err = testQuery(t, query)
@@ -20,6 +20,8 @@ const (
 	FieldJoinedAt = "joinedAt"
 	// FieldExpired is a field name for expiration status.
 	FieldExpired = "expired"
+	// FieldRole is a field name for the role of a project member.
+	FieldRole = "role"
 )
 
 // graphqlProjectMember creates projectMember type.
@@ -280,7 +280,7 @@ func TestGraphqlQuery(t *testing.T) {
 		users, err := service.AddProjectMembers(userCtx, createdProject.ID, []string{
 			user1.Email,
 			user2.Email,
-		})
+		}, console.RoleAdmin)
 		require.NoError(t, err)
 		assert.Equal(t, 2, len(users))
 
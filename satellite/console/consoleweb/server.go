@@ -43,6 +43,7 @@ import (
 	"storj.io/storj/satellite/console/consoleweb/consoleapi"
 	"storj.io/storj/satellite/console/consoleweb/consoleql"
 	"storj.io/storj/satellite/console/consoleweb/consolewebauth"
+	"storj.io/storj/satellite/console/sso"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/oidc"
 	"storj.io/storj/satellite/payments/paymentsconfig"
@@ -121,6 +122,8 @@ type Config struct {
 
 	ABTesting abtesting.Config
 
+	SSO sso.Config
+
 	console.Config
 }
 
@@ -282,6 +285,9 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 	projectsRouter.Handle("/{id}/members", http.HandlerFunc(projectsController.GetMembersAndInvitations)).Methods(http.MethodGet, http.MethodOptions)
 	projectsRouter.Handle("/{id}/invite", http.HandlerFunc(projectsController.InviteUsers)).Methods(http.MethodPost, http.MethodOptions)
 	projectsRouter.Handle("/{id}/invite-link", http.HandlerFunc(projectsController.GetInviteLink)).Methods(http.MethodGet, http.MethodOptions)
+	projectsRouter.Handle("/{id}/members/{memberID}/role", http.HandlerFunc(projectsController.UpdateMemberRole)).Methods(http.MethodPatch, http.MethodOptions)
+	projectsRouter.Handle("/{id}/audit-events", http.HandlerFunc(projectsController.GetAuditEvents)).Methods(http.MethodGet, http.MethodOptions)
+	projectsRouter.Handle("/{id}/audit-events/csv", http.HandlerFunc(projectsController.ExportAuditEvents)).Methods(http.MethodGet, http.MethodOptions)
 	projectsRouter.Handle("/invitations", http.HandlerFunc(projectsController.GetUserInvitations)).Methods(http.MethodGet, http.MethodOptions)
 	projectsRouter.Handle("/invitations/{id}/respond", http.HandlerFunc(projectsController.RespondToInvitation)).Methods(http.MethodPost, http.MethodOptions)
 
@@ -289,6 +295,9 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 	projectsRouter.Handle("/{id}/usage-limits", http.HandlerFunc(usageLimitsController.ProjectUsageLimits)).Methods(http.MethodGet, http.MethodOptions)
 	projectsRouter.Handle("/usage-limits", http.HandlerFunc(usageLimitsController.TotalUsageLimits)).Methods(http.MethodGet, http.MethodOptions)
 	projectsRouter.Handle("/{id}/daily-usage", http.HandlerFunc(usageLimitsController.DailyUsage)).Methods(http.MethodGet, http.MethodOptions)
+	projectsRouter.Handle("/{id}/usage-alerts", http.HandlerFunc(usageLimitsController.UsageAlerts)).Methods(http.MethodGet, http.MethodOptions)
+	projectsRouter.Handle("/{id}/usage-alerts", http.HandlerFunc(usageLimitsController.CreateUsageAlert)).Methods(http.MethodPost, http.MethodOptions)
+	projectsRouter.Handle("/{id}/usage-alerts/{alertID}", http.HandlerFunc(usageLimitsController.DeleteUsageAlert)).Methods(http.MethodDelete, http.MethodOptions)
 
 	authController := consoleapi.NewAuth(logger, service, accountFreezeService, mailService, server.cookieAuth, server.analytics, config.SatelliteName, server.config.ExternalAddress, config.LetUsKnowURL, config.TermsAndConditionsURL, config.ContactInfoURL, config.GeneralRequestURL)
 	authRouter := router.PathPrefix("/api/v0/auth").Subrouter()
@@ -306,6 +315,13 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 	authRouter.Handle("/mfa/disable", server.withAuth(http.HandlerFunc(authController.DisableUserMFA))).Methods(http.MethodPost, http.MethodOptions)
 	authRouter.Handle("/mfa/generate-secret-key", server.withAuth(http.HandlerFunc(authController.GenerateMFASecretKey))).Methods(http.MethodPost, http.MethodOptions)
 	authRouter.Handle("/mfa/generate-recovery-codes", server.withAuth(http.HandlerFunc(authController.GenerateMFARecoveryCodes))).Methods(http.MethodPost, http.MethodOptions)
+	authRouter.Handle("/webauthn/register/begin", server.withAuth(http.HandlerFunc(authController.BeginWebAuthnRegistration))).Methods(http.MethodPost, http.MethodOptions)
+	authRouter.Handle("/webauthn/register/finish", server.withAuth(http.HandlerFunc(authController.FinishWebAuthnRegistration))).Methods(http.MethodPost, http.MethodOptions)
+	authRouter.Handle("/webauthn/credentials", server.withAuth(http.HandlerFunc(authController.GetWebAuthnCredentials))).Methods(http.MethodGet, http.MethodOptions)
+	authRouter.Handle("/webauthn/credentials/{id}", server.withAuth(http.HandlerFunc(authController.DeleteWebAuthnCredential))).Methods(http.MethodDelete, http.MethodOptions)
+	authRouter.Handle("/webauthn/delete/begin", server.withAuth(http.HandlerFunc(authController.BeginWebAuthnCredentialDeletion))).Methods(http.MethodPost, http.MethodOptions)
+	authRouter.Handle("/webauthn/login/begin", server.ipRateLimiter.Limit(http.HandlerFunc(authController.BeginWebAuthnLogin))).Methods(http.MethodPost, http.MethodOptions)
+	authRouter.Handle("/webauthn/reset-password/begin", server.ipRateLimiter.Limit(http.HandlerFunc(authController.BeginWebAuthnPasswordReset))).Methods(http.MethodPost, http.MethodOptions)
 	authRouter.Handle("/logout", server.withAuth(http.HandlerFunc(authController.Logout))).Methods(http.MethodPost, http.MethodOptions)
 	authRouter.Handle("/token", server.ipRateLimiter.Limit(http.HandlerFunc(authController.Token))).Methods(http.MethodPost, http.MethodOptions)
 	authRouter.Handle("/token-by-api-key", server.ipRateLimiter.Limit(http.HandlerFunc(authController.TokenByAPIKey))).Methods(http.MethodPost, http.MethodOptions)
@@ -315,6 +331,12 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 	authRouter.Handle("/reset-password", server.ipRateLimiter.Limit(http.HandlerFunc(authController.ResetPassword))).Methods(http.MethodPost, http.MethodOptions)
 	authRouter.Handle("/refresh-session", server.withAuth(http.HandlerFunc(authController.RefreshSession))).Methods(http.MethodPost, http.MethodOptions)
 
+	if config.SSO.Enabled {
+		ssoController := consoleapi.NewSSO(logger, service, sso.NewService(config.SSO, server.config.ExternalAddress, nil), server.cookieAuth, server.config.ExternalAddress)
+		authRouter.Handle("/sso/login", server.ipRateLimiter.Limit(http.HandlerFunc(ssoController.Login))).Methods(http.MethodGet)
+		authRouter.Handle("/sso/{provider}/callback", server.ipRateLimiter.Limit(http.HandlerFunc(ssoController.Callback))).Methods(http.MethodGet)
+	}
+
 	if config.ABTesting.Enabled {
 		abController := consoleapi.NewABTesting(logger, abTesting)
 		abRouter := router.PathPrefix("/api/v0/ab").Subrouter()
@@ -730,6 +752,7 @@ func (server *Server) frontendConfigHandler(w http.ResponseWriter, r *http.Reque
 		NewUploadModalEnabled:           server.config.NewUploadModalEnabled,
 		GalleryViewEnabled:              server.config.GalleryViewEnabled,
 		NeededTransactionConfirmations:  server.neededTokenPaymentConfirmations,
+		SSOEnabled:                      server.config.SSO.Enabled,
 	}
 
 	err := json.NewEncoder(w).Encode(&cfg)
@@ -133,7 +133,7 @@ func TestInvitedRouting(t *testing.T) {
 
 		ownerCtx, err := sat.UserContext(ctx, owner.ID)
 		require.NoError(t, err)
-		_, err = service.InviteProjectMembers(ownerCtx, project.ID, []string{invitedEmail})
+		_, err = service.InviteProjectMembers(ownerCtx, project.ID, []string{invitedEmail}, console.RoleAdmin)
 		require.NoError(t, err)
 
 		// Valid invite for nonexistent user should redirect to registration page with
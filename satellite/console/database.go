@@ -31,6 +31,16 @@ type DB interface {
 	WebappSessions() consoleauth.WebappSessions
 	// AccountFreezeEvents is a getter for AccountFreezeEvents repository.
 	AccountFreezeEvents() AccountFreezeEvents
+	// AuditEvents is a getter for AuditEvents repository.
+	AuditEvents() AuditEvents
+	// SSOIdentities is a getter for SSOIdentities repository.
+	SSOIdentities() SSOIdentities
+	// WebAuthnCredentials is a getter for WebAuthnCredentials repository.
+	WebAuthnCredentials() WebAuthnCredentials
+	// WebAuthnChallenges is a getter for WebAuthnChallenges repository.
+	WebAuthnChallenges() WebAuthnChallenges
+	// ProjectUsageAlerts is a getter for ProjectUsageAlerts repository.
+	ProjectUsageAlerts() ProjectUsageAlerts
 
 	// WithTx is a method for executing transactions with retrying as necessary.
 	WithTx(ctx context.Context, fn func(ctx context.Context, tx DBTx) error) error
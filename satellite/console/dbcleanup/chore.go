@@ -25,6 +25,7 @@ type Config struct {
 	PageSize               int           `help:"maximum number of database records to scan at once" default:"1000"`
 
 	MaxUnverifiedUserAge time.Duration `help:"maximum lifetime of unverified user account records" default:"168h"`
+	MaxAuditEventAge     time.Duration `help:"maximum lifetime of console audit log records" default:"2160h"`
 }
 
 // Chore periodically removes unwanted records from the satellite console database.
@@ -60,6 +61,17 @@ func (chore *Chore) Run(ctx context.Context) (err error) {
 			chore.log.Error("Error deleting expired webapp sessions", zap.Error(err))
 		}
 
+		err = chore.db.WebAuthnChallenges().DeleteExpired(ctx, time.Now())
+		if err != nil {
+			chore.log.Error("Error deleting expired WebAuthn challenges", zap.Error(err))
+		}
+
+		before = time.Now().Add(-chore.config.MaxAuditEventAge)
+		err = chore.db.AuditEvents().DeleteBefore(ctx, before, chore.config.AsOfSystemTimeInterval, chore.config.PageSize)
+		if err != nil {
+			chore.log.Error("Error deleting old audit events", zap.Error(err))
+		}
+
 		return nil
 	})
 }
@@ -132,3 +132,21 @@ func (*LockAccountEmail) Template() string { return "LockAccount" }
 
 // Subject gets email subject.
 func (*LockAccountEmail) Subject() string { return "Account Lock" }
+
+// UsageAlertEmail is mailservice template for email sent when the project usage crosses the threshold of a usage alert.
+type UsageAlertEmail struct {
+	UserName    string
+	ProjectName string
+	Kind        string
+	Threshold   string
+	Usage       string
+	ProjectLink string
+}
+
+// Template returns email template name.
+func (*UsageAlertEmail) Template() string { return "UsageAlert" }
+
+// Subject gets email subject.
+func (email *UsageAlertEmail) Subject() string {
+	return "Your project " + email.ProjectName + " has reached its usage alert"
+}
@@ -31,5 +31,7 @@ type ProjectInvitation struct {
 	ProjectID uuid.UUID
 	Email     string
 	InviterID *uuid.UUID
+	// Role is the role the user is given upon accepting the invitation.
+	Role      ProjectMemberRole
 	CreatedAt time.Time
 }
@@ -18,8 +18,12 @@ type ProjectMembers interface {
 	GetByMemberID(ctx context.Context, memberID uuid.UUID) ([]ProjectMember, error)
 	// GetPagedWithInvitationsByProjectID is a method for querying project members and invitations from the database by projectID and cursor.
 	GetPagedWithInvitationsByProjectID(ctx context.Context, projectID uuid.UUID, cursor ProjectMembersCursor) (*ProjectMembersPage, error)
-	// Insert is a method for inserting project member into the database.
+	// Insert is a method for inserting project member with the admin role into the database.
 	Insert(ctx context.Context, memberID, projectID uuid.UUID) (*ProjectMember, error)
+	// InsertWithRole is a method for inserting project member with the given role into the database.
+	InsertWithRole(ctx context.Context, memberID, projectID uuid.UUID, role ProjectMemberRole) (*ProjectMember, error)
+	// UpdateRole is a method for updating the role of a project member.
+	UpdateRole(ctx context.Context, memberID, projectID uuid.UUID, role ProjectMemberRole) (*ProjectMember, error)
 	// Delete is a method for deleting project member by memberID and projectID from the database.
 	Delete(ctx context.Context, memberID, projectID uuid.UUID) error
 }
@@ -27,11 +31,18 @@ type ProjectMembers interface {
 // ProjectMember is a database object that describes ProjectMember entity.
 type ProjectMember struct {
 	// FK on Users table.
-	MemberID uuid.UUID
+	MemberID uuid.UUID `json:"memberId"`
 	// FK on Projects table.
-	ProjectID uuid.UUID
+	ProjectID uuid.UUID `json:"projectId"`
+	// Role determines what the member is allowed to do in the project.
+	Role ProjectMemberRole `json:"role"`
 
-	CreatedAt time.Time
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// UpdateProjectMemberRoleRequest holds the new role of a project member.
+type UpdateProjectMemberRoleRequest struct {
+	Role ProjectMemberRole `json:"role"`
 }
 
 // ProjectMembersCursor holds info for project members cursor pagination.
@@ -5,6 +5,7 @@ package console_test
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -45,6 +46,7 @@ func TestProjectMembersRepository(t *testing.T) {
 			projMember1, err := projectMembers.Insert(ctx, createdUsers[0].ID, createdProjects[0].ID)
 			assert.NotNil(t, projMember1)
 			assert.NoError(t, err)
+			assert.Equal(t, console.RoleAdmin, projMember1.Role)
 
 			projMember2, err := projectMembers.Insert(ctx, createdUsers[1].ID, createdProjects[0].ID)
 			assert.NotNil(t, projMember2)
@@ -71,6 +73,29 @@ func TestProjectMembersRepository(t *testing.T) {
 			assert.NoError(t, err)
 		})
 
+		t.Run("Update role", func(t *testing.T) {
+			member, err := projectMembers.UpdateRole(ctx, createdUsers[1].ID, createdProjects[0].ID, console.RoleReadOnly)
+			require.NoError(t, err)
+			require.Equal(t, console.RoleReadOnly, member.Role)
+
+			memberships, err := projectMembers.GetByMemberID(ctx, createdUsers[1].ID)
+			require.NoError(t, err)
+			require.Len(t, memberships, 1)
+			require.Equal(t, console.RoleReadOnly, memberships[0].Role)
+
+			page, err := projectMembers.GetPagedWithInvitationsByProjectID(ctx, createdProjects[0].ID, console.ProjectMembersCursor{
+				Search: createdUsers[1].Email,
+				Limit:  10,
+				Page:   1,
+			})
+			require.NoError(t, err)
+			require.Len(t, page.ProjectMembers, 1)
+			require.Equal(t, console.RoleReadOnly, page.ProjectMembers[0].Role)
+
+			_, err = projectMembers.UpdateRole(ctx, createdUsers[1].ID, createdProjects[1].ID, console.RoleReadOnly)
+			require.ErrorIs(t, err, sql.ErrNoRows)
+		})
+
 		t.Run("Get projects by userID", func(t *testing.T) {
 			projects, err := projects.GetByUserID(ctx, createdUsers[0].ID)
 			assert.NoError(t, err)
@@ -0,0 +1,104 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+// ProjectMemberRole is the role of a project member, which determines what the member
+// is allowed to do in the project.
+type ProjectMemberRole int
+
+const (
+	// RoleAdmin is allowed to do everything in the project, including managing its members.
+	// The project owner and the members added before the roles were introduced are admins.
+	RoleAdmin ProjectMemberRole = 0
+	// RoleDeveloper is allowed to manage the API keys and to view the usage of the project.
+	RoleDeveloper ProjectMemberRole = 1
+	// RoleReadOnly is allowed to view the project, its API keys and usage.
+	RoleReadOnly ProjectMemberRole = 2
+	// RoleBilling is allowed to view the project, its usage and limits, and to manage its usage alerts.
+	RoleBilling ProjectMemberRole = 3
+)
+
+// String returns the name of the role.
+func (role ProjectMemberRole) String() string {
+	switch role {
+	case RoleAdmin:
+		return "admin"
+	case RoleDeveloper:
+		return "developer"
+	case RoleReadOnly:
+		return "read-only"
+	case RoleBilling:
+		return "billing"
+	default:
+		return "unknown"
+	}
+}
+
+// Valid returns whether the role is one of the known roles.
+func (role ProjectMemberRole) Valid() bool {
+	return role >= RoleAdmin && role <= RoleBilling
+}
+
+// ProjectPermission is an action in a project, which a project member may be allowed to take.
+type ProjectPermission int
+
+const (
+	// PermissionViewProject allows viewing the project and its members.
+	PermissionViewProject ProjectPermission = iota
+	// PermissionViewUsage allows viewing the usage, the limits and the bucket names of the project.
+	PermissionViewUsage
+	// PermissionViewAPIKeys allows listing the API keys of the project.
+	PermissionViewAPIKeys
+	// PermissionManageAPIKeys allows creating and deleting the API keys of the project. Every other
+	// access to the data, e.g. deleting buckets, requires an API key.
+	PermissionManageAPIKeys
+	// PermissionManageProject allows updating the info and the limits of the project.
+	PermissionManageProject
+	// PermissionManageMembers allows inviting and removing members, and changing their roles.
+	PermissionManageMembers
+	// PermissionManageUsageAlerts allows creating and deleting the usage alerts of the project.
+	PermissionManageUsageAlerts
+)
+
+// String returns the name of the permission.
+func (permission ProjectPermission) String() string {
+	switch permission {
+	case PermissionViewProject:
+		return "view project"
+	case PermissionViewUsage:
+		return "view usage"
+	case PermissionViewAPIKeys:
+		return "view API keys"
+	case PermissionManageAPIKeys:
+		return "manage API keys"
+	case PermissionManageProject:
+		return "manage project"
+	case PermissionManageMembers:
+		return "manage members"
+	case PermissionManageUsageAlerts:
+		return "manage usage alerts"
+	default:
+		return "unknown"
+	}
+}
+
+// rolePermissions contains the permissions of every role, except the admin which has all of them.
+var rolePermissions = map[ProjectMemberRole][]ProjectPermission{
+	RoleDeveloper: {PermissionViewProject, PermissionViewUsage, PermissionViewAPIKeys, PermissionManageAPIKeys},
+	RoleReadOnly:  {PermissionViewProject, PermissionViewUsage, PermissionViewAPIKeys},
+	RoleBilling:   {PermissionViewProject, PermissionViewUsage, PermissionManageUsageAlerts},
+}
+
+// Allows returns whether the role has the permission.
+func (role ProjectMemberRole) Allows(permission ProjectPermission) bool {
+	if role == RoleAdmin {
+		return true
+	}
+	for _, allowed := range rolePermissions[role] {
+		if allowed == permission {
+			return true
+		}
+	}
+	return false
+}
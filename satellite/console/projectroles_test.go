@@ -0,0 +1,44 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/console"
+)
+
+func TestProjectMemberRolePermissions(t *testing.T) {
+	permissions := []console.ProjectPermission{
+		console.PermissionViewProject,
+		console.PermissionViewUsage,
+		console.PermissionViewAPIKeys,
+		console.PermissionManageAPIKeys,
+		console.PermissionManageProject,
+		console.PermissionManageMembers,
+		console.PermissionManageUsageAlerts,
+	}
+
+	allowed := map[console.ProjectMemberRole][]console.ProjectPermission{
+		console.RoleAdmin:     permissions,
+		console.RoleDeveloper: permissions[:4],
+		console.RoleReadOnly:  permissions[:3],
+		console.RoleBilling:   {console.PermissionViewProject, console.PermissionViewUsage, console.PermissionManageUsageAlerts},
+	}
+
+	for role, rolePermissions := range allowed {
+		for _, permission := range permissions {
+			expected := false
+			for _, allowed := range rolePermissions {
+				expected = expected || allowed == permission
+			}
+			require.Equal(t, expected, role.Allows(permission), "%s: %s", role, permission)
+		}
+	}
+
+	require.False(t, console.ProjectMemberRole(100).Valid())
+	require.False(t, console.ProjectMemberRole(100).Allows(console.PermissionViewProject))
+}
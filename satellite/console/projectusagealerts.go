@@ -0,0 +1,237 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/webhook"
+)
+
+// MaxProjectUsageAlerts is the maximum number of usage alerts of a project.
+const MaxProjectUsageAlerts = 10
+
+// maxWebhookURLLength is the maximum length of the webhook URL of a usage alert.
+const maxWebhookURLLength = 500
+
+// Error messages.
+const (
+	usageAlertKindErrMsg      = "The usage alert kind must be one of charges, storage or egress"
+	usageAlertThresholdErrMsg = "The usage alert threshold must be positive"
+	usageAlertWebhookErrMsg   = "The webhook URL must be an absolute public http or https address of at most 500 characters"
+	usageAlertLimitErrMsg     = "The maximum number of usage alerts has been reached"
+)
+
+// ErrProjectUsageAlertNotFound is error type that occurs when a usage alert of the project doesn't exist.
+var ErrProjectUsageAlertNotFound = errs.Class("project usage alert not found")
+
+// UsageAlertKind determines the usage, which is measured by a project usage alert.
+type UsageAlertKind string
+
+const (
+	// UsageAlertCharges measures the estimated charges of the project in cents.
+	UsageAlertCharges UsageAlertKind = "charges"
+	// UsageAlertStorage measures the stored bytes of the project.
+	UsageAlertStorage UsageAlertKind = "storage"
+	// UsageAlertEgress measures the egress bytes of the project.
+	UsageAlertEgress UsageAlertKind = "egress"
+)
+
+// Valid returns whether the kind is one of the known kinds.
+func (kind UsageAlertKind) Valid() bool {
+	switch kind {
+	case UsageAlertCharges, UsageAlertStorage, UsageAlertEgress:
+		return true
+	default:
+		return false
+	}
+}
+
+// Format returns the human readable representation of a usage of the kind.
+func (kind UsageAlertKind) Format(usage int64) string {
+	if kind == UsageAlertCharges {
+		return "$" + decimal.New(usage, -2).StringFixed(2)
+	}
+	return memory.Size(usage).String()
+}
+
+// ProjectUsageAlerts exposes methods to manage the usage alerts of the projects.
+//
+// architecture: Database
+type ProjectUsageAlerts interface {
+	// Insert is a method for inserting a usage alert into the database.
+	Insert(ctx context.Context, alert *ProjectUsageAlert) error
+	// GetByProjectID is a method for querying the usage alerts of the project in the order of their creation.
+	GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]ProjectUsageAlert, error)
+	// List is a method for querying at most limit usage alerts, whose ID is greater than the cursor, ordered by ID.
+	List(ctx context.Context, cursor uuid.UUID, limit int) ([]ProjectUsageAlert, error)
+	// UpdateNotifiedAt is a method for updating when the users were notified about the usage crossing the threshold.
+	// It resets the failed webhook deliveries.
+	UpdateNotifiedAt(ctx context.Context, id uuid.UUID, notifiedAt time.Time) error
+	// UpdateWebhookDeliveredAt is a method for updating when the webhook was notified about the usage crossing the threshold.
+	UpdateWebhookDeliveredAt(ctx context.Context, id uuid.UUID, deliveredAt time.Time) error
+	// IncrementWebhookAttempts is a method for counting a failed webhook delivery.
+	IncrementWebhookAttempts(ctx context.Context, id uuid.UUID) error
+	// Delete is a method for deleting a usage alert of the project. It returns sql.ErrNoRows if the alert doesn't exist.
+	Delete(ctx context.Context, projectID, id uuid.UUID) error
+}
+
+// ProjectUsageAlert is a soft threshold of the project usage in the current billing period.
+type ProjectUsageAlert struct {
+	ID        uuid.UUID      `json:"id"`
+	ProjectID uuid.UUID      `json:"-"`
+	Kind      UsageAlertKind `json:"kind"`
+	// Threshold is the usage, which triggers the alert. It's in cents for the charges and in bytes otherwise.
+	Threshold int64 `json:"threshold"`
+	// WebhookURL is an optional URL, which is notified in addition to the email.
+	WebhookURL string `json:"webhookURL"`
+
+	// NotifiedAt is when the users were last notified about the usage crossing the threshold.
+	NotifiedAt *time.Time `json:"notifiedAt"`
+	CreatedAt  time.Time  `json:"createdAt"`
+
+	// WebhookDeliveredAt is when the webhook was last notified about the usage crossing the threshold.
+	WebhookDeliveredAt *time.Time `json:"-"`
+	// WebhookAttempts is the number of failed webhook deliveries since the users were last notified.
+	WebhookAttempts int `json:"-"`
+}
+
+// Notified returns whether the users were already notified in the billing period, which started at periodStart.
+func (alert *ProjectUsageAlert) Notified(periodStart time.Time) bool {
+	return alert.NotifiedAt != nil && !alert.NotifiedAt.Before(periodStart)
+}
+
+// WebhookPending returns whether the users were notified, but the webhook
+// wasn't delivered yet.
+func (alert *ProjectUsageAlert) WebhookPending() bool {
+	return alert.WebhookURL != "" && alert.NotifiedAt != nil &&
+		(alert.WebhookDeliveredAt == nil || alert.WebhookDeliveredAt.Before(*alert.NotifiedAt))
+}
+
+// CreateProjectUsageAlertRequest holds the parameters of a new project usage alert.
+type CreateProjectUsageAlertRequest struct {
+	Kind       UsageAlertKind `json:"kind"`
+	Threshold  int64          `json:"threshold"`
+	WebhookURL string         `json:"webhookURL"`
+}
+
+// GetProjectUsageAlerts returns the usage alerts of the project.
+func (s *Service) GetProjectUsageAlerts(ctx context.Context, projectID uuid.UUID) (_ []ProjectUsageAlert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get project usage alerts", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	isMember, err := s.hasProjectPermission(ctx, user.ID, projectID, PermissionViewUsage)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	alerts, err := s.store.ProjectUsageAlerts().GetByProjectID(ctx, isMember.project.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return alerts, nil
+}
+
+// CreateProjectUsageAlert creates a usage alert of the project.
+func (s *Service) CreateProjectUsageAlert(ctx context.Context, projectID uuid.UUID, request CreateProjectUsageAlertRequest) (_ *ProjectUsageAlert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "create project usage alert",
+		zap.String("projectID", projectID.String()),
+		zap.String("kind", string(request.Kind)),
+		zap.Int64("threshold", request.Threshold),
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if !request.Kind.Valid() {
+		return nil, ErrValidation.New(usageAlertKindErrMsg)
+	}
+	if request.Threshold <= 0 {
+		return nil, ErrValidation.New(usageAlertThresholdErrMsg)
+	}
+	if request.WebhookURL != "" {
+		if err := validateWebhookURL(request.WebhookURL); err != nil {
+			return nil, ErrValidation.New(usageAlertWebhookErrMsg)
+		}
+	}
+
+	isMember, err := s.hasProjectPermission(ctx, user.ID, projectID, PermissionManageUsageAlerts)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	alerts, err := s.store.ProjectUsageAlerts().GetByProjectID(ctx, isMember.project.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if len(alerts) >= MaxProjectUsageAlerts {
+		return nil, ErrValidation.New(usageAlertLimitErrMsg)
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	alert := &ProjectUsageAlert{
+		ID:         id,
+		ProjectID:  isMember.project.ID,
+		Kind:       request.Kind,
+		Threshold:  request.Threshold,
+		WebhookURL: request.WebhookURL,
+	}
+	if err := s.store.ProjectUsageAlerts().Insert(ctx, alert); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return alert, nil
+}
+
+// DeleteProjectUsageAlert deletes a usage alert of the project.
+func (s *Service) DeleteProjectUsageAlert(ctx context.Context, projectID, alertID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "delete project usage alert",
+		zap.String("projectID", projectID.String()),
+		zap.String("alertID", alertID.String()),
+	)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	isMember, err := s.hasProjectPermission(ctx, user.ID, projectID, PermissionManageUsageAlerts)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = s.store.ProjectUsageAlerts().Delete(ctx, isMember.project.ID, alertID)
+	if errs.Is(err, sql.ErrNoRows) {
+		return ErrProjectUsageAlertNotFound.New("%s", alertID)
+	}
+	return Error.Wrap(err)
+}
+
+// validateWebhookURL checks that the webhook URL is an absolute http or https
+// address, which doesn't refer to the internal network.
+func validateWebhookURL(webhookURL string) error {
+	if len(webhookURL) > maxWebhookURLLength {
+		return errs.New("webhook URL is too long")
+	}
+	return webhook.CheckURL(webhookURL)
+}
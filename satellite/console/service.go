@@ -6,9 +6,12 @@ package console
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
 	"database/sql"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math"
 	"net/http"
 	"net/mail"
@@ -21,6 +24,7 @@ import (
 	"github.com/stripe/stripe-go/v72"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 	"golang.org/x/crypto/bcrypt"
 
 	"storj.io/common/currency"
@@ -36,6 +40,7 @@ import (
 	"storj.io/storj/satellite/analytics"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console/consoleauth"
+	"storj.io/storj/satellite/console/webauthn"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/billing"
@@ -48,6 +53,9 @@ const (
 	// maxLimit specifies the limit for all paged queries.
 	maxLimit = 50
 
+	// auditEventsExportPageSize is the number of audit events queried at once when they are exported.
+	auditEventsExportPageSize = 1000
+
 	// TestPasswordCost is the hashing complexity to use for testing.
 	TestPasswordCost = bcrypt.MinCost
 )
@@ -59,6 +67,7 @@ const (
 	emailNotFoundErrMsg                  = "There are no users with the specified email"
 	passwordRecoveryTokenIsExpiredErrMsg = "Your password recovery link has expired, please request another one"
 	credentialsErrMsg                    = "Your login credentials are incorrect, please try again"
+	ssoLinkMFAErrMsg                     = "Sign in with your password and second factor, because the account has MFA enabled"
 	generateSessionTokenErrMsg           = "Failed to generate session token"
 	failedToRetrieveUserErrMsg           = "Failed to retrieve user from database"
 	apiKeyCredentialsErrMsg              = "Your API Key is incorrect"
@@ -77,6 +86,10 @@ const (
 	projInviteAlreadyMemberErrMsg        = "You are already a member of the project"
 	projInviteResponseInvalidErrMsg      = "Invalid project member invitation response"
 	projInviteExistsErrMsg               = "An active invitation for '%s' already exists"
+	projMemberRoleForbiddenErrMsg        = "Your role in the project does not allow you to %s"
+	projMemberRoleInvalidErrMsg          = "Invalid project member role"
+	projOwnerRoleChangeErrMsg            = "The role of the project owner can not be changed"
+	projMemberNotFoundErrMsg             = "The user is not a member of the project"
 )
 
 var (
@@ -146,6 +159,12 @@ var (
 
 	// ErrAlreadyInvited occurs when trying to invite a user who already has an unexpired invitation.
 	ErrAlreadyInvited = errs.Class("user is already invited")
+
+	// ErrForbidden occurs when the role of a project member doesn't allow the requested action.
+	ErrForbidden = errs.Class("forbidden")
+
+	// ErrSSOLinkMFA occurs when the identity provider account would be linked to a user with MFA configured.
+	ErrSSOLinkMFA = errs.Class("sso link mfa")
 )
 
 // Service is handling accounts related logic.
@@ -166,6 +185,7 @@ type Service struct {
 	analytics                  *analytics.Service
 	tokens                     *consoleauth.Service
 	mailService                *mailservice.Service
+	auditEvents                *AuditEventsWriter
 
 	satelliteAddress string
 	satelliteName    string
@@ -197,6 +217,8 @@ type Config struct {
 	FailedLoginPenalty          float64       `help:"incremental duration of penalty for failed login attempts in minutes" default:"2.0"`
 	ProjectInvitationExpiration time.Duration `help:"duration that project member invitations are valid for" default:"168h"`
 	UserBalanceForUpgrade       int64         `help:"amount of base units of US micro dollars needed to upgrade user's tier status" default:"10000000"`
+	AuditLogEnabled             bool          `help:"whether console activity is stored in the database, in addition to the audit log" default:"true"`
+	AuditEvents                 AuditEventsWriterConfig
 	UsageLimits                 UsageLimitsConfig
 	Captcha                     CaptchaConfig
 	Session                     SessionConfig
@@ -279,6 +301,7 @@ func NewService(log *zap.Logger, store DB, restKeys RESTKeys, projectAccounting
 		analytics:                  analytics,
 		tokens:                     tokens,
 		mailService:                mailService,
+		auditEvents:                NewAuditEventsWriter(log.Named("auditevents"), store.AuditEvents(), config.AuditEvents),
 		satelliteAddress:           satelliteAddress,
 		satelliteName:              satelliteName,
 		config:                     config,
@@ -307,12 +330,68 @@ func (s *Service) auditLog(ctx context.Context, operation string, userID *uuid.U
 	if email != "" {
 		fields = append(fields, zap.String("email", email))
 	}
-	if requestID := requestid.FromContext(ctx); requestID != "" {
+	requestID := requestid.FromContext(ctx)
+	if requestID != "" {
 		fields = append(fields, zap.String("requestID", requestID))
 	}
 
-	fields = append(fields, fields...)
+	fields = append(fields, extra...)
 	s.auditLogger.Info("console activity", fields...)
+
+	if s.config.AuditLogEnabled {
+		s.storeAuditEvent(ctx, AuditEvent{
+			Operation:      operation,
+			UserID:         userID,
+			Email:          email,
+			SourceIP:       sourceIP,
+			ForwardedForIP: forwardedForIP,
+			RequestID:      requestID,
+		}, extra)
+	}
+}
+
+// AuditEventsWriter returns the writer which stores the console activity in the database.
+func (s *Service) AuditEventsWriter() *AuditEventsWriter {
+	return s.auditEvents
+}
+
+// storeAuditEvent queues the console activity to be stored in the database. The targeted project
+// and API key are taken from the "projectID" and "apiKeyID" fields. Read-only requests are only
+// written to the audit log.
+func (s *Service) storeAuditEvent(ctx context.Context, event AuditEvent, extra []zap.Field) {
+	if req := GetRequest(ctx); req != nil && req.Method == http.MethodGet {
+		return
+	}
+
+	enc := zapcore.NewMapObjectEncoder()
+	for _, field := range extra {
+		field.AddTo(enc)
+	}
+
+	parseID := func(key string) *uuid.UUID {
+		str, ok := enc.Fields[key].(string)
+		if !ok {
+			return nil
+		}
+		id, err := uuid.FromString(str)
+		if err != nil {
+			return nil
+		}
+		return &id
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		s.log.Warn("failed to store console activity", zap.String("operation", event.Operation), zap.Error(err))
+		return
+	}
+
+	event.ID = id
+	event.CreatedAt = s.nowFn()
+	event.ProjectID = parseID("projectID")
+	event.APIKeyID = parseID("apiKeyID")
+
+	s.auditEvents.Add(ctx, event)
 }
 
 func (s *Service) getUserAndAuditLog(ctx context.Context, operation string, extra ...zap.Field) (*User, error) {
@@ -981,7 +1060,7 @@ func (s *Service) ActivateAccount(ctx context.Context, activationToken string) (
 }
 
 // ResetPassword - is a method for resetting user password.
-func (s *Service) ResetPassword(ctx context.Context, resetPasswordToken, password string, passcode string, recoveryCode string, t time.Time) (err error) {
+func (s *Service) ResetPassword(ctx context.Context, resetPasswordToken, password string, passcode string, recoveryCode string, webAuthnAssertion *webauthn.AssertionResponse, t time.Time) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	secret, err := ResetPasswordSecretFromBase64(resetPasswordToken)
@@ -998,8 +1077,24 @@ func (s *Service) ResetPassword(ctx context.Context, resetPasswordToken, passwor
 		return Error.Wrap(err)
 	}
 
-	if user.MFAEnabled {
-		if recoveryCode != "" {
+	webAuthnCredentials, err := s.store.WebAuthnCredentials().GetByUserID(ctx, user.ID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if user.MFAEnabled || len(webAuthnCredentials) > 0 {
+		if webAuthnAssertion != nil {
+			err = s.verifyWebAuthnAssertion(ctx, user.ID, webAuthnCredentials, webAuthnPurposePasswordReset, *webAuthnAssertion)
+			if err != nil {
+				if ErrMFAWebAuthn.Has(err) {
+					return ErrValidation.Wrap(ErrMFAWebAuthn.New(mfaWebAuthnInvalidErrMsg))
+				}
+				return err
+			}
+		} else if !user.MFAEnabled {
+			// only security keys are registered, so the passcodes and the recovery codes aren't second factors.
+			return ErrMFAMissing.New(mfaWebAuthnRequiredErrMsg)
+		} else if recoveryCode != "" {
 			found := false
 			for _, code := range user.MFARecoveryCodes {
 				if code == recoveryCode {
@@ -1149,14 +1244,44 @@ func (s *Service) Token(ctx context.Context, request AuthUser) (response *TokenI
 		return nil, ErrLoginCredentials.New(credentialsErrMsg)
 	}
 
-	if user.MFAEnabled {
-		if request.MFARecoveryCode != "" && request.MFAPasscode != "" {
+	webAuthnCredentials, err := s.store.WebAuthnCredentials().GetByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if user.MFAEnabled || len(webAuthnCredentials) > 0 {
+		factors := 0
+		for _, provided := range []bool{request.MFARecoveryCode != "", request.MFAPasscode != "", request.WebAuthnAssertion != nil} {
+			if provided {
+				factors++
+			}
+		}
+		if factors > 1 {
 			mon.Counter("login_mfa_conflict").Inc(1) //mon:locked
 			s.auditLog(ctx, "login: failed mfa conflict", &user.ID, user.Email)
 			return nil, ErrMFAConflict.New(mfaConflictErrMsg)
 		}
 
-		if request.MFARecoveryCode != "" {
+		if request.WebAuthnAssertion != nil {
+			err = s.verifyWebAuthnAssertion(ctx, user.ID, webAuthnCredentials, webAuthnPurposeLogin, *request.WebAuthnAssertion)
+			if err != nil {
+				if !ErrMFAWebAuthn.Has(err) {
+					return nil, err
+				}
+				if err := handleLockAccount(); err != nil {
+					return nil, err
+				}
+				mon.Counter("login_mfa_webauthn_failure").Inc(1) //mon:locked
+				s.auditLog(ctx, "login: failed mfa webauthn invalid", &user.ID, user.Email)
+				return nil, ErrMFAWebAuthn.New(mfaWebAuthnInvalidErrMsg)
+			}
+			mon.Counter("login_mfa_webauthn_success").Inc(1) //mon:locked
+		} else if !user.MFAEnabled {
+			// only security keys are registered, so the passcodes and the recovery codes aren't second factors.
+			mon.Counter("login_mfa_missing").Inc(1) //mon:locked
+			s.auditLog(ctx, "login: failed mfa webauthn missing", &user.ID, user.Email)
+			return nil, ErrMFAMissing.New(mfaWebAuthnRequiredErrMsg)
+		} else if request.MFARecoveryCode != "" {
 			found := false
 			codeIndex := -1
 			for i, code := range user.MFARecoveryCodes {
@@ -1257,6 +1382,207 @@ func (s *Service) TokenByAPIKey(ctx context.Context, userAgent string, ip string
 	return response, nil
 }
 
+// SSOClaims are the claims of a user who signed in through an identity provider.
+type SSOClaims struct {
+	// Provider is the name of the identity provider in the satellite configuration.
+	Provider string
+	// Subject is the identifier of the user's account at the identity provider.
+	Subject  string
+	Email    string
+	FullName string
+}
+
+// TokenBySSO authenticates the user who signed in through an identity provider and returns a session token.
+// The account at the identity provider is linked to the user with the same email on the first sign in, and
+// a user is created when there isn't any. The identity provider is trusted to verify the email and to require
+// MFA, so users with the console MFA configured aren't linked.
+func (s *Service) TokenBySSO(ctx context.Context, claims SSOClaims, ip, userAgent string) (response *TokenInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	mon.Counter("login_sso_attempt").Inc(1) //mon:locked
+
+	var user *User
+	userID, err := s.store.SSOIdentities().GetUserID(ctx, claims.Provider, claims.Subject)
+	switch {
+	case err == nil:
+		user, err = s.store.Users().Get(ctx, userID)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+	case errs.Is(err, sql.ErrNoRows):
+		user, err = s.linkSSOIdentity(ctx, claims)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, Error.Wrap(err)
+	}
+
+	if user.Status != Active {
+		mon.Counter("login_sso_user_inactive").Inc(1) //mon:locked
+		s.auditLog(ctx, "sso: failed user not active", &user.ID, user.Email)
+		return nil, ErrLoginCredentials.New(credentialsErrMsg)
+	}
+
+	response, err = s.GenerateSessionToken(ctx, user.ID, user.Email, ip, userAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	mon.Counter("login_sso_success").Inc(1) //mon:locked
+
+	return response, nil
+}
+
+// linkSSOIdentity links the identity provider account to the user with the same email,
+// activating or creating the user as necessary.
+func (s *Service) linkSSOIdentity(ctx context.Context, claims SSOClaims) (user *User, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	verified, unverified, err := s.store.Users().GetByEmailWithUnverified(ctx, claims.Email)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if verified != nil {
+		user = verified
+	} else {
+		for i := range unverified {
+			if unverified[i].Status == Inactive {
+				user = &unverified[i]
+				break
+			}
+		}
+	}
+
+	switch {
+	case user != nil && user.Status == Inactive:
+		// the identity provider has verified the email, but the password and the MFA were chosen by
+		// whoever registered the email, so they are reset.
+		hash, err := s.randomPasswordHash()
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		status := Active
+		mfaEnabled := false
+		var mfaSecretKey *string
+		mfaRecoveryCodes := []string{}
+		err = s.store.Users().Update(ctx, user.ID, UpdateUserRequest{
+			Status:           &status,
+			PasswordHash:     hash,
+			MFAEnabled:       &mfaEnabled,
+			MFASecretKey:     &mfaSecretKey,
+			MFARecoveryCodes: &mfaRecoveryCodes,
+		})
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		credentials, err := s.store.WebAuthnCredentials().GetByUserID(ctx, user.ID)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		for _, credential := range credentials {
+			if err := s.store.WebAuthnCredentials().Delete(ctx, user.ID, credential.ID); err != nil {
+				return nil, Error.Wrap(err)
+			}
+		}
+		user.Status = Active
+		user.PasswordHash = hash
+		user.MFAEnabled = false
+		user.MFASecretKey = ""
+		user.MFARecoveryCodes = nil
+		s.auditLog(ctx, "activate account", &user.ID, user.Email)
+	case user != nil:
+		// the console MFA would be bypassed by the identity provider.
+		credentials, err := s.store.WebAuthnCredentials().GetByUserID(ctx, user.ID)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		if user.MFAEnabled || len(credentials) > 0 {
+			mon.Counter("login_sso_link_mfa").Inc(1) //mon:locked
+			s.auditLog(ctx, "sso: failed link mfa enabled", &user.ID, user.Email, zap.String("provider", claims.Provider))
+			return nil, ErrSSOLinkMFA.New(ssoLinkMFAErrMsg)
+		}
+	case user == nil:
+		user, err = s.createSSOUser(ctx, claims)
+		if err != nil {
+			return nil, err
+		}
+		s.auditLog(ctx, "sso: create user", &user.ID, user.Email, zap.String("provider", claims.Provider))
+	}
+
+	err = s.store.SSOIdentities().Insert(ctx, SSOIdentity{
+		Provider: claims.Provider,
+		Subject:  claims.Subject,
+		UserID:   user.ID,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	s.auditLog(ctx, "sso: link account", &user.ID, user.Email, zap.String("provider", claims.Provider))
+
+	return user, nil
+}
+
+// createSSOUser creates an active user for the identity provider account. The password
+// of the user is random, so the user can only sign in through the identity provider
+// until the password is reset.
+func (s *Service) createSSOUser(ctx context.Context, claims SSOClaims) (_ *User, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	userID, err := uuid.New()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	hash, err := s.randomPasswordHash()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	fullName := claims.FullName
+	if fullName == "" {
+		fullName = claims.Email
+	}
+
+	user, err := s.store.Users().Insert(ctx, &User{
+		ID:                    userID,
+		Email:                 claims.Email,
+		FullName:              fullName,
+		PasswordHash:          hash,
+		ProjectLimit:          s.config.UsageLimits.Project.Free,
+		ProjectStorageLimit:   s.config.UsageLimits.Storage.Free.Int64(),
+		ProjectBandwidthLimit: s.config.UsageLimits.Bandwidth.Free.Int64(),
+		ProjectSegmentLimit:   s.config.UsageLimits.Segment.Free,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	// users are inserted as inactive.
+	status := Active
+	err = s.store.Users().Update(ctx, user.ID, UpdateUserRequest{
+		Status: &status,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	user.Status = Active
+
+	mon.Counter("create_user_sso_success").Inc(1) //mon:locked
+
+	return user, nil
+}
+
+// randomPasswordHash returns the hash of a random password, which nobody knows.
+func (s *Service) randomPasswordHash() ([]byte, error) {
+	password := make([]byte, 32)
+	if _, err := rand.Read(password); err != nil {
+		return nil, err
+	}
+	return bcrypt.GenerateFromPassword([]byte(base64.StdEncoding.EncodeToString(password)), s.config.PasswordCost)
+}
+
 // UpdateUsersFailedLoginState updates User's failed login state.
 func (s *Service) UpdateUsersFailedLoginState(ctx context.Context, user *User) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -1496,7 +1822,7 @@ func (s *Service) GetProject(ctx context.Context, projectID uuid.UUID) (p *Proje
 		return nil, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.hasProjectPermission(ctx, user.ID, projectID, PermissionViewProject)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -1536,7 +1862,7 @@ func (s *Service) GetSalt(ctx context.Context, projectID uuid.UUID) (salt []byte
 		return nil, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.hasProjectPermission(ctx, user.ID, projectID, PermissionManageAPIKeys)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -1918,10 +2244,14 @@ func (s *Service) GenUpdateProject(ctx context.Context, projectID uuid.UUID, pro
 		}
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.hasProjectPermission(ctx, user.ID, projectID, PermissionManageProject)
 	if err != nil {
+		status := http.StatusUnauthorized
+		if ErrForbidden.Has(err) {
+			status = http.StatusForbidden
+		}
 		return nil, api.HTTPError{
-			Status: http.StatusUnauthorized,
+			Status: status,
 			Err:    Error.Wrap(err),
 		}
 	}
@@ -2008,17 +2338,21 @@ func (s *Service) GenUpdateProject(ctx context.Context, projectID uuid.UUID, pro
 	return project, httpError
 }
 
-// AddProjectMembers adds users by email to given project.
+// AddProjectMembers adds users by email to given project with the given role.
 // Email addresses not belonging to a user are ignored.
 // projectID here may be project.PublicID or project.ID.
-func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, emails []string) (users []*User, err error) {
+func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, emails []string, role ProjectMemberRole) (users []*User, err error) {
 	defer mon.Task()(&ctx)(&err)
-	user, err := s.getUserAndAuditLog(ctx, "add project members", zap.String("projectID", projectID.String()), zap.Strings("emails", emails))
+	user, err := s.getUserAndAuditLog(ctx, "add project members", zap.String("projectID", projectID.String()), zap.Strings("emails", emails), zap.Stringer("role", role))
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if !role.Valid() {
+		return nil, ErrValidation.New(projMemberRoleInvalidErrMsg)
+	}
+
+	isMember, err := s.hasProjectPermission(ctx, user.ID, projectID, PermissionManageMembers)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -2037,7 +2371,7 @@ func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, em
 	// add project members in transaction scope
 	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
 		for _, user := range users {
-			if _, err := tx.ProjectMembers().Insert(ctx, user.ID, isMember.project.ID); err != nil {
+			if _, err := tx.ProjectMembers().InsertWithRole(ctx, user.ID, isMember.project.ID, role); err != nil {
 				if dbx.IsConstraintError(err) {
 					return errs.New("%s is already on the project", user.Email)
 				}
@@ -2065,7 +2399,7 @@ func (s *Service) DeleteProjectMembersAndInvitations(ctx context.Context, projec
 	}
 
 	var isMember isProjectMember
-	if isMember, err = s.isProjectMember(ctx, user.ID, projectID); err != nil {
+	if isMember, err = s.hasProjectPermission(ctx, user.ID, projectID, PermissionManageMembers); err != nil {
 		return Error.Wrap(err)
 	}
 
@@ -2135,7 +2469,7 @@ func (s *Service) GetProjectMembersAndInvitations(ctx context.Context, projectID
 		return nil, Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, user.ID, projectID)
+	_, err = s.hasProjectPermission(ctx, user.ID, projectID, PermissionViewProject)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -2152,6 +2486,155 @@ func (s *Service) GetProjectMembersAndInvitations(ctx context.Context, projectID
 	return
 }
 
+// UpdateProjectMemberRole changes the role of a project member.
+// projectID here may be project.PublicID or project.ID.
+func (s *Service) UpdateProjectMemberRole(ctx context.Context, projectID, memberID uuid.UUID, role ProjectMemberRole) (_ *ProjectMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "update project member role",
+		zap.String("projectID", projectID.String()),
+		zap.String("memberID", memberID.String()),
+		zap.Stringer("role", role),
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if !role.Valid() {
+		return nil, ErrValidation.New(projMemberRoleInvalidErrMsg)
+	}
+
+	isMember, err := s.hasProjectPermission(ctx, user.ID, projectID, PermissionManageMembers)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	// the owner always stays an admin, so every project has at least one.
+	if isMember.project.OwnerID == memberID {
+		return nil, ErrValidation.New(projOwnerRoleChangeErrMsg)
+	}
+
+	member, err := s.store.ProjectMembers().UpdateRole(ctx, memberID, isMember.project.ID, role)
+	if err != nil {
+		if errs.Is(err, sql.ErrNoRows) {
+			return nil, ErrValidation.New(projMemberNotFoundErrMsg)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return member, nil
+}
+
+// GenUpdateProjectMemberRole changes the role of a project member for generated API.
+func (s *Service) GenUpdateProjectMemberRole(ctx context.Context, projectID, memberID uuid.UUID, request UpdateProjectMemberRoleRequest) (_ *ProjectMember, httpError api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	member, err := s.UpdateProjectMemberRole(ctx, projectID, memberID, request.Role)
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case ErrUnauthorized.Has(err), ErrNoMembership.Has(err):
+			status = http.StatusUnauthorized
+		case ErrForbidden.Has(err):
+			status = http.StatusForbidden
+		case ErrValidation.Has(err):
+			status = http.StatusBadRequest
+		}
+		return nil, api.HTTPError{
+			Status: status,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	return member, httpError
+}
+
+// GetProjectAuditEvents returns a page of the console activity in the project, newest first.
+// Only the project owner is allowed to see it. projectID here may be project.PublicID or project.ID.
+func (s *Service) GetProjectAuditEvents(ctx context.Context, projectID uuid.UUID, cursor AuditEventsCursor) (_ *AuditEventsPage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get project audit events", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	_, project, err := s.isProjectOwner(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if cursor.Limit > maxLimit {
+		cursor.Limit = maxLimit
+	}
+	cursor.ProjectID = &project.ID
+	cursor.UserID = nil
+
+	// include the activity, which is still waiting to be stored.
+	s.auditEvents.Flush(ctx)
+
+	page, err := s.store.AuditEvents().GetPaged(ctx, cursor)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return page, nil
+}
+
+// ExportProjectAuditEvents writes the console activity in the project, which happened in [since, before),
+// to w as CSV. Zero since and before are ignored. Only the project owner is allowed to export it.
+// projectID here may be project.PublicID or project.ID.
+func (s *Service) ExportProjectAuditEvents(ctx context.Context, projectID uuid.UUID, since, before time.Time, w io.Writer) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "export project audit events", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, project, err := s.isProjectOwner(ctx, user.ID, projectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if before.IsZero() {
+		// the events which are added during the export would shift the pages.
+		before = s.nowFn()
+	}
+
+	// include the activity, which is still waiting to be stored.
+	s.auditEvents.Flush(ctx)
+
+	writer, err := NewAuditEventsCSVWriter(w)
+	if err != nil {
+		return err
+	}
+
+	cursor := AuditEventsCursor{
+		ProjectID: &project.ID,
+		Since:     since,
+		Before:    before,
+		Limit:     auditEventsExportPageSize,
+		Page:      1,
+	}
+	for {
+		page, err := s.store.AuditEvents().GetPaged(ctx, cursor)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if err := writer.Write(page.Events); err != nil {
+			return err
+		}
+		if cursor.Page >= page.PageCount {
+			break
+		}
+		cursor.Page++
+	}
+
+	return writer.Flush()
+}
+
 // CreateAPIKey creates new api key.
 // projectID here may be project.PublicID or project.ID.
 func (s *Service) CreateAPIKey(ctx context.Context, projectID uuid.UUID, name string) (_ *APIKeyInfo, _ *macaroon.APIKey, err error) {
@@ -2162,7 +2645,7 @@ func (s *Service) CreateAPIKey(ctx context.Context, projectID uuid.UUID, name st
 		return nil, nil, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.hasProjectPermission(ctx, user.ID, projectID, PermissionManageAPIKeys)
 	if err != nil {
 		return nil, nil, Error.Wrap(err)
 	}
@@ -2218,10 +2701,14 @@ func (s *Service) GenCreateAPIKey(ctx context.Context, requestInfo CreateAPIKeyR
 		}
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, reqProjectID)
+	isMember, err := s.hasProjectPermission(ctx, user.ID, reqProjectID, PermissionManageAPIKeys)
 	if err != nil {
+		status := http.StatusUnauthorized
+		if ErrForbidden.Has(err) {
+			status = http.StatusForbidden
+		}
 		return nil, api.HTTPError{
-			Status: http.StatusUnauthorized,
+			Status: status,
 			Err:    Error.Wrap(err),
 		}
 	}
@@ -2285,8 +2772,10 @@ func (s *Service) GenDeleteAPIKey(ctx context.Context, keyID uuid.UUID) (httpErr
 		}
 
 		status := http.StatusInternalServerError
-		if ErrUnauthorized.Has(err) {
+		if ErrUnauthorized.Has(err) || ErrNoMembership.Has(err) {
 			status = http.StatusUnauthorized
+		} else if ErrForbidden.Has(err) {
+			status = http.StatusForbidden
 		} else if ErrAPIKeyRequest.Has(err) {
 			status = http.StatusBadRequest
 		}
@@ -2311,8 +2800,10 @@ func (s *Service) GenGetAPIKeys(ctx context.Context, projectID uuid.UUID, search
 	})
 	if err != nil {
 		status := http.StatusInternalServerError
-		if ErrUnauthorized.Has(err) {
+		if ErrUnauthorized.Has(err) || ErrNoMembership.Has(err) {
 			status = http.StatusUnauthorized
+		} else if ErrForbidden.Has(err) {
+			status = http.StatusForbidden
 		} else if ErrAPIKeyRequest.Has(err) {
 			status = http.StatusBadRequest
 		}
@@ -2342,7 +2833,7 @@ func (s *Service) GetAPIKeyInfoByName(ctx context.Context, projectID uuid.UUID,
 		return nil, Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, user.ID, key.ProjectID)
+	_, err = s.hasProjectPermission(ctx, user.ID, key.ProjectID, PermissionViewAPIKeys)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -2364,7 +2855,7 @@ func (s *Service) GetAPIKeyInfo(ctx context.Context, id uuid.UUID) (_ *APIKeyInf
 		return nil, Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, user.ID, key.ProjectID)
+	_, err = s.hasProjectPermission(ctx, user.ID, key.ProjectID, PermissionViewAPIKeys)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -2387,6 +2878,7 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 	}
 
 	var keysErr errs.Group
+	keys := make([]*APIKeyInfo, 0, len(ids))
 
 	for _, keyID := range ids {
 		key, err := s.store.APIKeys().Get(ctx, keyID)
@@ -2395,11 +2887,13 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 			continue
 		}
 
-		_, err = s.isProjectMember(ctx, user.ID, key.ProjectID)
+		_, err = s.hasProjectPermission(ctx, user.ID, key.ProjectID, PermissionManageAPIKeys)
 		if err != nil {
 			keysErr.Add(ErrUnauthorized.Wrap(err))
 			continue
 		}
+
+		keys = append(keys, key)
 	}
 
 	if err = keysErr.Err(); err != nil {
@@ -2416,7 +2910,17 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 
 		return nil
 	})
-	return Error.Wrap(err)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	// the projects of the keys are known only now, so every deleted key gets its own audit log entry.
+	for _, key := range keys {
+		s.auditLog(ctx, "delete api key", &user.ID, user.Email,
+			zap.String("projectID", key.ProjectID.String()), zap.String("apiKeyID", key.ID.String()))
+	}
+
+	return nil
 }
 
 // GetAllAPIKeyNamesByProjectID returns all api key names by project ID.
@@ -2428,7 +2932,7 @@ func (s *Service) GetAllAPIKeyNamesByProjectID(ctx context.Context, projectID uu
 		return nil, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.hasProjectPermission(ctx, user.ID, projectID, PermissionViewAPIKeys)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -2451,7 +2955,7 @@ func (s *Service) DeleteAPIKeyByNameAndProjectID(ctx context.Context, name strin
 		return Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.hasProjectPermission(ctx, user.ID, projectID, PermissionManageAPIKeys)
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -2466,6 +2970,9 @@ func (s *Service) DeleteAPIKeyByNameAndProjectID(ctx context.Context, name strin
 		return Error.Wrap(err)
 	}
 
+	s.auditLog(ctx, "delete api key", &user.ID, user.Email,
+		zap.String("projectID", key.ProjectID.String()), zap.String("apiKeyID", key.ID.String()))
+
 	return nil
 }
 
@@ -2478,7 +2985,7 @@ func (s *Service) GetAPIKeys(ctx context.Context, reqProjectID uuid.UUID, cursor
 		return nil, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, reqProjectID)
+	isMember, err := s.hasProjectPermission(ctx, user.ID, reqProjectID, PermissionViewAPIKeys)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
@@ -2545,7 +3052,7 @@ func (s *Service) GetProjectUsage(ctx context.Context, projectID uuid.UUID, sinc
 		return nil, Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, user.ID, projectID)
+	_, err = s.hasProjectPermission(ctx, user.ID, projectID, PermissionViewUsage)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -2567,7 +3074,7 @@ func (s *Service) GetBucketTotals(ctx context.Context, projectID uuid.UUID, curs
 		return nil, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.hasProjectPermission(ctx, user.ID, projectID, PermissionViewUsage)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -2590,7 +3097,7 @@ func (s *Service) GetAllBucketNames(ctx context.Context, projectID uuid.UUID) (_
 		return nil, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.hasProjectPermission(ctx, user.ID, projectID, PermissionViewUsage)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -2629,10 +3136,14 @@ func (s *Service) GenGetBucketUsageRollups(ctx context.Context, reqProjectID uui
 		}
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, reqProjectID)
+	isMember, err := s.hasProjectPermission(ctx, user.ID, reqProjectID, PermissionViewUsage)
 	if err != nil {
+		status := http.StatusUnauthorized
+		if ErrForbidden.Has(err) {
+			status = http.StatusForbidden
+		}
 		return nil, api.HTTPError{
-			Status: http.StatusUnauthorized,
+			Status: status,
 			Err:    Error.Wrap(err),
 		}
 	}
@@ -2670,10 +3181,14 @@ func (s *Service) GenGetSingleBucketUsageRollup(ctx context.Context, reqProjectI
 		}
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, reqProjectID)
+	isMember, err := s.hasProjectPermission(ctx, user.ID, reqProjectID, PermissionViewUsage)
 	if err != nil {
+		status := http.StatusUnauthorized
+		if ErrForbidden.Has(err) {
+			status = http.StatusForbidden
+		}
 		return nil, api.HTTPError{
-			Status: http.StatusUnauthorized,
+			Status: status,
 			Err:    Error.Wrap(err),
 		}
 	}
@@ -2704,7 +3219,7 @@ func (s *Service) GetDailyProjectUsage(ctx context.Context, projectID uuid.UUID,
 		return nil, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.hasProjectPermission(ctx, user.ID, projectID, PermissionViewUsage)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -2729,7 +3244,7 @@ func (s *Service) GetProjectUsageLimits(ctx context.Context, projectID uuid.UUID
 		return nil, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.hasProjectPermission(ctx, user.ID, projectID, PermissionViewUsage)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -3064,6 +3579,23 @@ func (s *Service) isProjectMember(ctx context.Context, userID uuid.UUID, project
 	return isProjectMember{}, ErrNoMembership.New(unauthorizedErrMsg)
 }
 
+// hasProjectPermission checks if the user is a member of given project, whose role has the permission.
+// The project owner has every permission. projectID can be either private ID or public ID.
+func (s *Service) hasProjectPermission(ctx context.Context, userID uuid.UUID, projectID uuid.UUID, permission ProjectPermission) (_ isProjectMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	isMember, err := s.isProjectMember(ctx, userID, projectID)
+	if err != nil {
+		return isProjectMember{}, err
+	}
+
+	if isMember.project.OwnerID != userID && !isMember.membership.Role.Allows(permission) {
+		return isProjectMember{}, ErrForbidden.New(projMemberRoleForbiddenErrMsg, permission)
+	}
+
+	return isMember, nil
+}
+
 // WalletInfo contains all the information about a destination wallet assigned to a user.
 type WalletInfo struct {
 	Address blockchain.Address `json:"address"`
@@ -3595,7 +4127,7 @@ func (s *Service) RespondToProjectInvitation(ctx context.Context, projectID uuid
 		return Error.Wrap(s.store.ProjectInvitations().Delete(ctx, projectID, user.Email))
 	}
 
-	_, err = s.store.ProjectMembers().Insert(ctx, user.ID, projectID)
+	_, err = s.store.ProjectMembers().InsertWithRole(ctx, user.ID, projectID, invite.Role)
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -3608,14 +4140,22 @@ func (s *Service) RespondToProjectInvitation(ctx context.Context, projectID uuid
 // InviteProjectMembers invites users by email to given project.
 // If an invitation already exists and has expired, it will be replaced and the user will be sent a new email.
 // projectID here may be project.PublicID or project.ID.
-func (s *Service) InviteProjectMembers(ctx context.Context, projectID uuid.UUID, emails []string) (invites []ProjectInvitation, err error) {
+func (s *Service) InviteProjectMembers(ctx context.Context, projectID uuid.UUID, emails []string, role ProjectMemberRole) (invites []ProjectInvitation, err error) {
 	defer mon.Task()(&ctx)(&err)
-	user, err := s.getUserAndAuditLog(ctx, "invite project members", zap.String("projectID", projectID.String()), zap.Strings("emails", emails))
+	user, err := s.getUserAndAuditLog(ctx, "invite project members",
+		zap.String("projectID", projectID.String()),
+		zap.Strings("emails", emails),
+		zap.Stringer("role", role),
+	)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if !role.Valid() {
+		return nil, ErrValidation.New(projMemberRoleInvalidErrMsg)
+	}
+
+	isMember, err := s.hasProjectPermission(ctx, user.ID, projectID, PermissionManageMembers)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -3656,6 +4196,7 @@ func (s *Service) InviteProjectMembers(ctx context.Context, projectID uuid.UUID,
 				ProjectID: projectID,
 				Email:     email,
 				InviterID: &user.ID,
+				Role:      role,
 			})
 			if err != nil {
 				return err
@@ -3752,7 +4293,7 @@ func (s *Service) GetInviteLink(ctx context.Context, publicProjectID uuid.UUID,
 		return "", Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, user.ID, publicProjectID)
+	isMember, err := s.hasProjectPermission(ctx, user.ID, publicProjectID, PermissionManageMembers)
 	if err != nil {
 		return "", Error.Wrap(err)
 	}
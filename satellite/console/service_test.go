@@ -33,6 +33,8 @@ import (
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/webauthn"
+	"storj.io/storj/satellite/console/webauthn/webauthntest"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/billing"
 	"storj.io/storj/satellite/payments/coinpayments"
@@ -273,7 +275,7 @@ func TestService(t *testing.T) {
 				require.Nil(t, updatedProject)
 
 				user2, userCtx2 := getOwnerAndCtx(ctx, up2Proj)
-				_, err = service.AddProjectMembers(userCtx1, up1Proj.ID, []string{user2.Email})
+				_, err = service.AddProjectMembers(userCtx1, up1Proj.ID, []string{user2.Email}, console.RoleAdmin)
 				require.NoError(t, err)
 				// Members should not be able to update project.
 				_, err = service.UpdateProject(userCtx2, up1Proj.ID, console.UpsertProjectInfo{
@@ -289,14 +291,27 @@ func TestService(t *testing.T) {
 			t.Run("AddProjectMembers", func(t *testing.T) {
 				up2User, _ := getOwnerAndCtx(ctx, up2Proj)
 
+				// The role must be given explicitly.
+				addedUsers, err := service.AddProjectMembers(userCtx1, up1Proj.ID, []string{up2User.Email}, console.ProjectMemberRole(-1))
+				require.True(t, console.ErrValidation.Has(err))
+				require.Nil(t, addedUsers)
+
 				// Adding members to own project should work
-				addedUsers, err := service.AddProjectMembers(userCtx1, up1Proj.ID, []string{up2User.Email})
+				addedUsers, err = service.AddProjectMembers(userCtx1, up1Proj.ID, []string{up2User.Email}, console.RoleDeveloper)
 				require.NoError(t, err)
 				require.Len(t, addedUsers, 1)
 				require.Contains(t, addedUsers, up2User)
 
+				memberships, err := sat.DB.Console().ProjectMembers().GetByMemberID(ctx, up2User.ID)
+				require.NoError(t, err)
+				for _, membership := range memberships {
+					if membership.ProjectID == up1Proj.ID {
+						require.Equal(t, console.RoleDeveloper, membership.Role)
+					}
+				}
+
 				// Adding members to someone else project should not work
-				addedUsers, err = service.AddProjectMembers(userCtx1, up2Proj.ID, []string{up2User.Email})
+				addedUsers, err = service.AddProjectMembers(userCtx1, up2Proj.ID, []string{up2User.Email}, console.RoleDeveloper)
 				require.Error(t, err)
 				require.Nil(t, addedUsers)
 			})
@@ -1067,19 +1082,19 @@ func TestResetPassword(t *testing.T) {
 		token := getNewResetToken()
 
 		// Expect error when providing bad token.
-		err = service.ResetPassword(ctx, "badToken", newPass, "", "", token.CreatedAt)
+		err = service.ResetPassword(ctx, "badToken", newPass, "", "", nil, token.CreatedAt)
 		require.True(t, console.ErrRecoveryToken.Has(err))
 
 		// Expect error when providing good but expired token.
-		err = service.ResetPassword(ctx, token.Secret.String(), newPass, "", "", token.CreatedAt.Add(sat.Config.ConsoleAuth.TokenExpirationTime).Add(time.Second))
+		err = service.ResetPassword(ctx, token.Secret.String(), newPass, "", "", nil, token.CreatedAt.Add(sat.Config.ConsoleAuth.TokenExpirationTime).Add(time.Second))
 		require.True(t, console.ErrTokenExpiration.Has(err))
 
 		// Expect error when providing good token with bad (too short) password.
-		err = service.ResetPassword(ctx, token.Secret.String(), "bad", "", "", token.CreatedAt)
+		err = service.ResetPassword(ctx, token.Secret.String(), "bad", "", "", nil, token.CreatedAt)
 		require.True(t, console.ErrValidation.Has(err))
 
 		// Expect success when providing good token and good password.
-		err = service.ResetPassword(ctx, token.Secret.String(), newPass, "", "", token.CreatedAt)
+		err = service.ResetPassword(ctx, token.Secret.String(), newPass, "", "", nil, token.CreatedAt)
 		require.NoError(t, err)
 
 		token = getNewResetToken()
@@ -1102,22 +1117,22 @@ func TestResetPassword(t *testing.T) {
 		// Expect error when providing bad passcode.
 		badPasscode, err := console.NewMFAPasscode(key, token.CreatedAt.Add(time.Hour))
 		require.NoError(t, err)
-		err = service.ResetPassword(ctx, token.Secret.String(), newPass, badPasscode, "", token.CreatedAt)
+		err = service.ResetPassword(ctx, token.Secret.String(), newPass, badPasscode, "", nil, token.CreatedAt)
 		require.True(t, console.ErrMFAPasscode.Has(err))
 
 		for _, recoveryCode := range user.MFARecoveryCodes {
 			// Expect success when providing bad passcode and good recovery code.
-			err = service.ResetPassword(ctx, token.Secret.String(), newPass, badPasscode, recoveryCode, token.CreatedAt)
+			err = service.ResetPassword(ctx, token.Secret.String(), newPass, badPasscode, recoveryCode, nil, token.CreatedAt)
 			require.NoError(t, err)
 			token = getNewResetToken()
 
 			// Expect error when providing bad passcode and already-used recovery code.
-			err = service.ResetPassword(ctx, token.Secret.String(), newPass, badPasscode, recoveryCode, token.CreatedAt)
+			err = service.ResetPassword(ctx, token.Secret.String(), newPass, badPasscode, recoveryCode, nil, token.CreatedAt)
 			require.True(t, console.ErrMFARecoveryCode.Has(err))
 		}
 
 		// Expect success when providing good passcode.
-		err = service.ResetPassword(ctx, token.Secret.String(), newPass, passcode, "", token.CreatedAt)
+		err = service.ResetPassword(ctx, token.Secret.String(), newPass, passcode, "", nil, token.CreatedAt)
 		require.NoError(t, err)
 	})
 }
@@ -1144,7 +1159,7 @@ func TestChangePassword(t *testing.T) {
 		require.NoError(t, err)
 		require.NoError(t, bcrypt.CompareHashAndPassword(user.PasswordHash, []byte(newPass)))
 
-		err = sat.API.Console.Service.ResetPassword(userCtx, passwordRecoveryToken, "aDifferentPassword123!", "", "", time.Now())
+		err = sat.API.Console.Service.ResetPassword(userCtx, passwordRecoveryToken, "aDifferentPassword123!", "", "", nil, time.Now())
 		require.Error(t, err)
 		require.True(t, console.ErrRecoveryToken.Has(err))
 	})
@@ -2099,6 +2114,178 @@ func TestServiceGenMethods(t *testing.T) {
 	})
 }
 
+func TestProjectMemberRoles(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+
+		addUser := func(t *testing.T) (*console.User, context.Context) {
+			user, err := sat.AddUser(ctx, console.CreateUser{
+				FullName: "Test User",
+				Email:    fmt.Sprintf("%s@mail.test", testrand.RandAlphaNumeric(16)),
+			}, 1)
+			require.NoError(t, err)
+			userCtx, err := sat.UserContext(ctx, user.ID)
+			require.NoError(t, err)
+			return user, userCtx
+		}
+
+		owner, ownerCtx := addUser(t)
+		project, err := sat.AddProject(ownerCtx, owner.ID, "Test Project")
+		require.NoError(t, err)
+
+		addMember := func(t *testing.T, role console.ProjectMemberRole) (*console.User, context.Context) {
+			user, userCtx := addUser(t)
+			_, err := sat.DB.Console().ProjectMembers().InsertWithRole(ctx, user.ID, project.ID, role)
+			require.NoError(t, err)
+			return user, userCtx
+		}
+
+		admin, adminCtx := addMember(t, console.RoleAdmin)
+		developer, developerCtx := addMember(t, console.RoleDeveloper)
+		readOnly, readOnlyCtx := addMember(t, console.RoleReadOnly)
+		_, billingCtx := addMember(t, console.RoleBilling)
+
+		t.Run("api keys", func(t *testing.T) {
+			_, _, err := service.CreateAPIKey(developerCtx, project.ID, "developer key")
+			require.NoError(t, err)
+
+			for _, userCtx := range []context.Context{readOnlyCtx, billingCtx} {
+				_, _, err = service.CreateAPIKey(userCtx, project.ID, "forbidden key")
+				require.True(t, console.ErrForbidden.Has(err))
+			}
+
+			names, err := service.GetAllAPIKeyNamesByProjectID(readOnlyCtx, project.ID)
+			require.NoError(t, err)
+			require.Equal(t, []string{"developer key"}, names)
+
+			_, err = service.GetAllAPIKeyNamesByProjectID(billingCtx, project.ID)
+			require.True(t, console.ErrForbidden.Has(err))
+
+			err = service.DeleteAPIKeyByNameAndProjectID(readOnlyCtx, "developer key", project.ID)
+			require.True(t, console.ErrForbidden.Has(err))
+		})
+
+		t.Run("usage", func(t *testing.T) {
+			for _, userCtx := range []context.Context{developerCtx, readOnlyCtx, billingCtx} {
+				_, err := service.GetProjectUsageLimits(userCtx, project.ID)
+				require.NoError(t, err)
+			}
+		})
+
+		t.Run("members", func(t *testing.T) {
+			_, err := service.InviteProjectMembers(developerCtx, project.ID, []string{"new@mail.test"}, console.RoleDeveloper)
+			require.True(t, console.ErrForbidden.Has(err))
+
+			_, err = service.UpdateProjectMemberRole(developerCtx, project.ID, developer.ID, console.RoleAdmin)
+			require.True(t, console.ErrForbidden.Has(err))
+
+			member, err := service.UpdateProjectMemberRole(adminCtx, project.PublicID, readOnly.ID, console.RoleDeveloper)
+			require.NoError(t, err)
+			require.Equal(t, console.RoleDeveloper, member.Role)
+
+			_, _, err = service.CreateAPIKey(readOnlyCtx, project.ID, "promoted key")
+			require.NoError(t, err)
+
+			_, err = service.UpdateProjectMemberRole(adminCtx, project.ID, owner.ID, console.RoleReadOnly)
+			require.True(t, console.ErrValidation.Has(err))
+
+			_, err = service.UpdateProjectMemberRole(adminCtx, project.ID, admin.ID, console.ProjectMemberRole(100))
+			require.True(t, console.ErrValidation.Has(err))
+
+			nonMember, _ := addUser(t)
+			_, err = service.UpdateProjectMemberRole(adminCtx, project.ID, nonMember.ID, console.RoleDeveloper)
+			require.True(t, console.ErrValidation.Has(err))
+		})
+
+		t.Run("invitations carry the role", func(t *testing.T) {
+			invitee, inviteeCtx := addUser(t)
+
+			invites, err := service.InviteProjectMembers(adminCtx, project.ID, []string{invitee.Email}, console.RoleBilling)
+			require.NoError(t, err)
+			require.Len(t, invites, 1)
+			require.Equal(t, console.RoleBilling, invites[0].Role)
+
+			require.NoError(t, service.RespondToProjectInvitation(inviteeCtx, project.ID, console.ProjectInvitationAccept))
+
+			memberships, err := sat.DB.Console().ProjectMembers().GetByMemberID(ctx, invitee.ID)
+			require.NoError(t, err)
+			require.Len(t, memberships, 1)
+			require.Equal(t, console.RoleBilling, memberships[0].Role)
+
+			_, _, err = service.CreateAPIKey(inviteeCtx, project.ID, "billing key")
+			require.True(t, console.ErrForbidden.Has(err))
+		})
+	})
+}
+
+func TestProjectAuditEvents(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+
+		addUser := func(t *testing.T) (*console.User, context.Context) {
+			user, err := sat.AddUser(ctx, console.CreateUser{
+				FullName: "Test User",
+				Email:    fmt.Sprintf("%s@mail.test", testrand.RandAlphaNumeric(16)),
+			}, 1)
+			require.NoError(t, err)
+			userCtx, err := sat.UserContext(ctx, user.ID)
+			require.NoError(t, err)
+			return user, userCtx
+		}
+
+		owner, ownerCtx := addUser(t)
+		project, err := sat.AddProject(ownerCtx, owner.ID, "Test Project")
+		require.NoError(t, err)
+
+		member, memberCtx := addUser(t)
+		_, err = sat.DB.Console().ProjectMembers().Insert(ctx, member.ID, project.ID)
+		require.NoError(t, err)
+
+		key1, _, err := service.CreateAPIKey(memberCtx, project.PublicID, "key 1")
+		require.NoError(t, err)
+		key2, _, err := service.CreateAPIKey(memberCtx, project.ID, "key 2")
+		require.NoError(t, err)
+		require.NoError(t, service.DeleteAPIKeys(memberCtx, []uuid.UUID{key1.ID, key2.ID}))
+
+		_, err = service.GetProjectAuditEvents(memberCtx, project.ID, console.AuditEventsCursor{Limit: 10, Page: 1})
+		require.True(t, console.ErrUnauthorized.Has(err))
+		require.True(t, console.ErrUnauthorized.Has(service.ExportProjectAuditEvents(memberCtx, project.ID, time.Time{}, time.Time{}, &bytes.Buffer{})))
+
+		page, err := service.GetProjectAuditEvents(ownerCtx, project.PublicID, console.AuditEventsCursor{Limit: 10, Page: 1})
+		require.NoError(t, err)
+
+		var deletedKeys []uuid.UUID
+		var created int
+		for _, event := range page.Events {
+			switch event.Operation {
+			case "create api key":
+				require.Equal(t, &member.ID, event.UserID)
+				require.Equal(t, member.Email, event.Email)
+				created++
+			case "delete api key":
+				require.Equal(t, &member.ID, event.UserID)
+				require.Equal(t, &project.ID, event.ProjectID)
+				deletedKeys = append(deletedKeys, *event.APIKeyID)
+			}
+		}
+		require.Equal(t, 2, created)
+		require.ElementsMatch(t, []uuid.UUID{key1.ID, key2.ID}, deletedKeys)
+
+		var buf bytes.Buffer
+		require.NoError(t, service.ExportProjectAuditEvents(ownerCtx, project.ID, time.Time{}, time.Time{}, &buf))
+		csv := buf.String()
+		require.Contains(t, csv, "id,created_at,operation,")
+		require.Contains(t, csv, key1.ID.String())
+		require.Contains(t, csv, key2.ID.String())
+	})
+}
+
 func TestProjectInvitations(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1,
@@ -2165,7 +2352,7 @@ func TestProjectInvitations(t *testing.T) {
 			project, err := sat.AddProject(ctx, user.ID, "Test Project")
 			require.NoError(t, err)
 
-			invites, err := service.InviteProjectMembers(ctx, project.ID, []string{user2.Email})
+			invites, err := service.InviteProjectMembers(ctx, project.ID, []string{user2.Email}, console.RoleAdmin)
 			require.NoError(t, err)
 			require.Len(t, invites, 1)
 
@@ -2174,7 +2361,7 @@ func TestProjectInvitations(t *testing.T) {
 			require.Len(t, invites, 1)
 
 			// adding in a non-existent user should work.
-			invites, err = service.InviteProjectMembers(ctx, project.ID, []string{user3.Email, "notauser@mail.com"})
+			invites, err = service.InviteProjectMembers(ctx, project.ID, []string{user3.Email, "notauser@mail.com"}, console.RoleAdmin)
 			require.NoError(t, err)
 			require.Len(t, invites, 2)
 
@@ -2183,14 +2370,14 @@ func TestProjectInvitations(t *testing.T) {
 			require.Len(t, invites, 1)
 
 			// prevent unauthorized users from inviting others (user2 is not a member of the project yet).
-			_, err = service.InviteProjectMembers(ctx2, project.ID, []string{"other@mail.com"})
+			_, err = service.InviteProjectMembers(ctx2, project.ID, []string{"other@mail.com"}, console.RoleAdmin)
 			require.Error(t, err)
 			require.True(t, console.ErrNoMembership.Has(err))
 
 			require.NoError(t, service.RespondToProjectInvitation(ctx2, project.ID, console.ProjectInvitationAccept))
 
 			// resending an active invitation should fail.
-			invites, err = service.InviteProjectMembers(ctx2, project.ID, []string{user3.Email})
+			invites, err = service.InviteProjectMembers(ctx2, project.ID, []string{user3.Email}, console.RoleAdmin)
 			require.True(t, console.ErrAlreadyInvited.Has(err))
 			require.Empty(t, invites)
 
@@ -2203,14 +2390,14 @@ func TestProjectInvitations(t *testing.T) {
 			require.True(t, service.IsProjectInvitationExpired(user3Invite))
 
 			// resending an expired invitation should succeed.
-			invites, err = service.InviteProjectMembers(ctx2, project.ID, []string{user3.Email})
+			invites, err = service.InviteProjectMembers(ctx2, project.ID, []string{user3.Email}, console.RoleAdmin)
 			require.NoError(t, err)
 			require.Len(t, invites, 1)
 			require.Equal(t, user2.ID, *invites[0].InviterID)
 			require.True(t, invites[0].CreatedAt.After(oldCreatedAt))
 
 			// inviting a project member should fail.
-			_, err = service.InviteProjectMembers(ctx, project.ID, []string{user2.Email})
+			_, err = service.InviteProjectMembers(ctx, project.ID, []string{user2.Email}, console.RoleAdmin)
 			require.Error(t, err)
 		})
 
@@ -2411,3 +2598,397 @@ func TestProjectInvitations(t *testing.T) {
 		})
 	})
 }
+
+func TestTokenBySSO(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+		usersDB := sat.DB.Console().Users()
+
+		newClaims := func() console.SSOClaims {
+			return console.SSOClaims{
+				Provider: "corporate",
+				Subject:  string(testrand.RandAlphaNumeric(16)),
+				Email:    fmt.Sprintf("%s@mail.test", testrand.RandAlphaNumeric(16)),
+				FullName: "SSO User",
+			}
+		}
+
+		requireLinked := func(t *testing.T, claims console.SSOClaims, userID uuid.UUID) {
+			linkedID, err := sat.DB.Console().SSOIdentities().GetUserID(ctx, claims.Provider, claims.Subject)
+			require.NoError(t, err)
+			require.Equal(t, userID, linkedID)
+		}
+
+		t.Run("create user", func(t *testing.T) {
+			claims := newClaims()
+			tokenInfo, err := service.TokenBySSO(ctx, claims, "127.0.0.1", "")
+			require.NoError(t, err)
+			require.NotEmpty(t, tokenInfo.Token.String())
+
+			user, err := usersDB.GetByEmail(ctx, claims.Email)
+			require.NoError(t, err)
+			require.Equal(t, console.Active, user.Status)
+			require.Equal(t, claims.FullName, user.FullName)
+			require.Equal(t, sat.Config.Console.UsageLimits.Project.Free, user.ProjectLimit)
+			require.Equal(t, sat.Config.Console.UsageLimits.Storage.Free.Int64(), user.ProjectStorageLimit)
+			requireLinked(t, claims, user.ID)
+
+			// the next sign in uses the linked user.
+			_, err = service.TokenBySSO(ctx, claims, "127.0.0.1", "")
+			require.NoError(t, err)
+			_, unverified, err := usersDB.GetByEmailWithUnverified(ctx, claims.Email)
+			require.NoError(t, err)
+			require.Empty(t, unverified)
+		})
+
+		t.Run("link verified user", func(t *testing.T) {
+			claims := newClaims()
+			user, err := sat.AddUser(ctx, console.CreateUser{
+				FullName: "Test User",
+				Email:    claims.Email,
+			}, 1)
+			require.NoError(t, err)
+
+			_, err = service.TokenBySSO(ctx, claims, "127.0.0.1", "")
+			require.NoError(t, err)
+			requireLinked(t, claims, user.ID)
+		})
+
+		t.Run("activate unverified user", func(t *testing.T) {
+			claims := newClaims()
+			user, err := usersDB.Insert(ctx, &console.User{
+				ID:           testrand.UUID(),
+				FullName:     "Test User",
+				Email:        claims.Email,
+				PasswordHash: []byte("password"),
+			})
+			require.NoError(t, err)
+			require.Equal(t, console.Inactive, user.Status)
+
+			mfaEnabled := true
+			secret := "secret"
+			mfaSecretKey := &secret
+			require.NoError(t, usersDB.Update(ctx, user.ID, console.UpdateUserRequest{
+				MFAEnabled:       &mfaEnabled,
+				MFASecretKey:     &mfaSecretKey,
+				MFARecoveryCodes: &[]string{"code"},
+			}))
+
+			_, err = service.TokenBySSO(ctx, claims, "127.0.0.1", "")
+			require.NoError(t, err)
+			requireLinked(t, claims, user.ID)
+
+			// the password and the MFA of whoever registered the email are reset.
+			user, err = usersDB.Get(ctx, user.ID)
+			require.NoError(t, err)
+			require.Equal(t, console.Active, user.Status)
+			require.NotEqual(t, []byte("password"), user.PasswordHash)
+			require.False(t, user.MFAEnabled)
+			require.Empty(t, user.MFASecretKey)
+			require.Empty(t, user.MFARecoveryCodes)
+		})
+
+		t.Run("don't link user with mfa", func(t *testing.T) {
+			claims := newClaims()
+			user, err := sat.AddUser(ctx, console.CreateUser{
+				FullName: "Test User",
+				Email:    claims.Email,
+			}, 1)
+			require.NoError(t, err)
+
+			mfaEnabled := true
+			require.NoError(t, usersDB.Update(ctx, user.ID, console.UpdateUserRequest{MFAEnabled: &mfaEnabled}))
+
+			_, err = service.TokenBySSO(ctx, claims, "127.0.0.1", "")
+			require.True(t, console.ErrSSOLinkMFA.Has(err), err)
+
+			_, err = sat.DB.Console().SSOIdentities().GetUserID(ctx, claims.Provider, claims.Subject)
+			require.ErrorIs(t, err, sql.ErrNoRows)
+		})
+
+		t.Run("deleted user", func(t *testing.T) {
+			claims := newClaims()
+			_, err := service.TokenBySSO(ctx, claims, "127.0.0.1", "")
+			require.NoError(t, err)
+
+			user, err := usersDB.GetByEmail(ctx, claims.Email)
+			require.NoError(t, err)
+			status := console.Deleted
+			require.NoError(t, usersDB.Update(ctx, user.ID, console.UpdateUserRequest{Status: &status}))
+
+			_, err = service.TokenBySSO(ctx, claims, "127.0.0.1", "")
+			require.True(t, console.ErrLoginCredentials.Has(err))
+		})
+	})
+}
+
+func TestWebAuthn(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+
+		user, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "WebAuthn User",
+			Email:    "webauthnuser@mail.test",
+		}, 1)
+		require.NoError(t, err)
+
+		userCtx, err := sat.UserContext(ctx, user.ID)
+		require.NoError(t, err)
+
+		authenticator, err := webauthntest.NewAuthenticator(sat.ConsoleURL())
+		require.NoError(t, err)
+
+		register := func(t *testing.T, authenticator *webauthntest.Authenticator, name string) (*console.WebAuthnCredential, error) {
+			options, err := service.BeginWebAuthnRegistration(userCtx)
+			require.NoError(t, err)
+			response, err := authenticator.Create(options)
+			require.NoError(t, err)
+			return service.FinishWebAuthnRegistration(userCtx, name, response)
+		}
+
+		t.Run("register", func(t *testing.T) {
+			credential, err := register(t, authenticator, "laptop")
+			require.NoError(t, err)
+			require.Equal(t, "laptop", credential.Name)
+
+			_, err = register(t, authenticator, "again")
+			require.True(t, console.ErrValidation.Has(err), err)
+
+			other, err := webauthntest.NewAuthenticator(sat.ConsoleURL())
+			require.NoError(t, err)
+			_, err = register(t, other, "laptop")
+			require.True(t, console.ErrValidation.Has(err), err)
+			_, err = register(t, other, "")
+			require.True(t, console.ErrValidation.Has(err), err)
+
+			phishing, err := webauthntest.NewAuthenticator("https://phishing.test")
+			require.NoError(t, err)
+			_, err = register(t, phishing, "phone")
+			require.True(t, console.ErrMFAWebAuthn.Has(err), err)
+
+			second, err := register(t, other, "phone")
+			require.NoError(t, err)
+
+			credentials, err := service.GetWebAuthnCredentials(userCtx)
+			require.NoError(t, err)
+			require.Len(t, credentials, 2)
+			require.Equal(t, "laptop", credentials[0].Name)
+			require.Equal(t, "phone", credentials[1].Name)
+
+			deletionAssertion := func(t *testing.T, authenticator *webauthntest.Authenticator) *webauthn.AssertionResponse {
+				options, err := service.BeginWebAuthnCredentialDeletion(userCtx)
+				require.NoError(t, err)
+				assertion, err := authenticator.Get(options)
+				require.NoError(t, err)
+				return &assertion
+			}
+
+			err = service.DeleteWebAuthnCredential(userCtx, second.ID, "", "", nil, time.Now())
+			require.True(t, console.ErrMFAMissing.Has(err), err)
+
+			// assertions for logging in can't be used to delete credentials.
+			loginOptions, err := service.BeginWebAuthnLogin(ctx, user.Email)
+			require.NoError(t, err)
+			loginAssertion, err := other.Get(loginOptions)
+			require.NoError(t, err)
+			err = service.DeleteWebAuthnCredential(userCtx, second.ID, "", "", &loginAssertion, time.Now())
+			require.True(t, console.ErrMFAWebAuthn.Has(err), err)
+
+			require.NoError(t, service.DeleteWebAuthnCredential(userCtx, second.ID, "", "", deletionAssertion(t, other), time.Now()))
+			err = service.DeleteWebAuthnCredential(userCtx, second.ID, "", "", deletionAssertion(t, authenticator), time.Now())
+			require.True(t, console.ErrWebAuthnCredentialNotFound.Has(err), err)
+		})
+
+		t.Run("login", func(t *testing.T) {
+			request := console.AuthUser{Email: user.Email, Password: user.FullName}
+
+			_, err := service.Token(ctx, request)
+			require.True(t, console.ErrMFAMissing.Has(err), err)
+
+			// the passcode of the empty TOTP secret and recovery codes aren't accepted without TOTP.
+			emptySecretPasscode, err := console.NewMFAPasscode("", time.Now())
+			require.NoError(t, err)
+			for _, passcodeRequest := range []console.AuthUser{
+				{Email: user.Email, Password: user.FullName, MFAPasscode: emptySecretPasscode},
+				{Email: user.Email, Password: user.FullName, MFARecoveryCode: "code"},
+			} {
+				_, err = service.Token(ctx, passcodeRequest)
+				require.True(t, console.ErrMFAMissing.Has(err), err)
+			}
+
+			options, err := service.BeginWebAuthnLogin(ctx, user.Email)
+			require.NoError(t, err)
+			require.Len(t, options.AllowCredentials, 1)
+
+			assertion, err := authenticator.Get(options)
+			require.NoError(t, err)
+
+			request.WebAuthnAssertion = &assertion
+			request.MFARecoveryCode = "code"
+			_, err = service.Token(ctx, request)
+			require.True(t, console.ErrMFAConflict.Has(err), err)
+			request.MFARecoveryCode = ""
+
+			token, err := service.Token(ctx, request)
+			require.NoError(t, err)
+			require.NotEmpty(t, token)
+
+			// the signature counter of the replayed assertion is outdated.
+			_, err = service.Token(ctx, request)
+			require.True(t, console.ErrMFAWebAuthn.Has(err), err)
+
+			credentials, err := service.GetWebAuthnCredentials(userCtx)
+			require.NoError(t, err)
+			require.Equal(t, authenticator.SignCount, credentials[0].SignCount)
+			require.NotNil(t, credentials[0].LastUsedAt)
+
+			// a new assertion with an up-to-date signature counter can't reuse the challenge.
+			assertion, err = authenticator.Get(options)
+			require.NoError(t, err)
+			_, err = service.Token(ctx, request)
+			require.True(t, console.ErrMFAWebAuthn.Has(err), err)
+
+			// unknown users get options without credentials.
+			options, err = service.BeginWebAuthnLogin(ctx, "unknown@mail.test")
+			require.NoError(t, err)
+			require.Empty(t, options.AllowCredentials)
+		})
+
+		t.Run("reset password", func(t *testing.T) {
+			resetToken, err := sat.DB.Console().ResetPasswordTokens().Create(ctx, user.ID)
+			require.NoError(t, err)
+
+			err = service.ResetPassword(ctx, resetToken.Secret.String(), user.FullName, "", "", nil, resetToken.CreatedAt)
+			require.True(t, console.ErrMFAMissing.Has(err), err)
+
+			emptySecretPasscode, err := console.NewMFAPasscode("", resetToken.CreatedAt)
+			require.NoError(t, err)
+			err = service.ResetPassword(ctx, resetToken.Secret.String(), user.FullName, emptySecretPasscode, "", nil, resetToken.CreatedAt)
+			require.True(t, console.ErrMFAMissing.Has(err), err)
+
+			// assertions for logging in can't be used to reset the password.
+			loginOptions, err := service.BeginWebAuthnLogin(ctx, user.Email)
+			require.NoError(t, err)
+			assertion, err := authenticator.Get(loginOptions)
+			require.NoError(t, err)
+			err = service.ResetPassword(ctx, resetToken.Secret.String(), user.FullName, "", "", &assertion, resetToken.CreatedAt)
+			require.True(t, console.ErrMFAWebAuthn.Has(err), err)
+
+			options, err := service.BeginWebAuthnPasswordReset(ctx, resetToken.Secret.String())
+			require.NoError(t, err)
+			assertion, err = authenticator.Get(options)
+			require.NoError(t, err)
+			err = service.ResetPassword(ctx, resetToken.Secret.String(), user.FullName, "", "", &assertion, resetToken.CreatedAt)
+			require.NoError(t, err)
+		})
+	})
+}
+
+func TestProjectUsageAlerts(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+
+		addUser := func(t *testing.T) (*console.User, context.Context) {
+			user, err := sat.AddUser(ctx, console.CreateUser{
+				FullName: "Test User",
+				Email:    fmt.Sprintf("%s@mail.test", testrand.RandAlphaNumeric(16)),
+			}, 1)
+			require.NoError(t, err)
+			userCtx, err := sat.UserContext(ctx, user.ID)
+			require.NoError(t, err)
+			return user, userCtx
+		}
+
+		owner, ownerCtx := addUser(t)
+		project, err := sat.AddProject(ownerCtx, owner.ID, "Test Project")
+		require.NoError(t, err)
+
+		addMember := func(t *testing.T, role console.ProjectMemberRole) context.Context {
+			user, userCtx := addUser(t)
+			_, err := sat.DB.Console().ProjectMembers().InsertWithRole(ctx, user.ID, project.ID, role)
+			require.NoError(t, err)
+			return userCtx
+		}
+		developerCtx := addMember(t, console.RoleDeveloper)
+		billingCtx := addMember(t, console.RoleBilling)
+		_, nonMemberCtx := addUser(t)
+
+		t.Run("validation", func(t *testing.T) {
+			for _, request := range []console.CreateProjectUsageAlertRequest{
+				{Kind: "unknown", Threshold: 100},
+				{Kind: console.UsageAlertCharges, Threshold: 0},
+				{Kind: console.UsageAlertStorage, Threshold: -1},
+				{Kind: console.UsageAlertEgress, Threshold: 100, WebhookURL: "ftp://example.test"},
+				{Kind: console.UsageAlertEgress, Threshold: 100, WebhookURL: "/relative"},
+				{Kind: console.UsageAlertEgress, Threshold: 100, WebhookURL: "http://localhost:8080/hook"},
+				{Kind: console.UsageAlertEgress, Threshold: 100, WebhookURL: "http://169.254.169.254/latest/meta-data"},
+			} {
+				_, err := service.CreateProjectUsageAlert(ownerCtx, project.ID, request)
+				require.True(t, console.ErrValidation.Has(err), err)
+			}
+		})
+
+		t.Run("create, list and delete", func(t *testing.T) {
+			alert, err := service.CreateProjectUsageAlert(billingCtx, project.PublicID, console.CreateProjectUsageAlertRequest{
+				Kind:       console.UsageAlertCharges,
+				Threshold:  1000,
+				WebhookURL: "https://example.test/hook",
+			})
+			require.NoError(t, err)
+			require.Equal(t, project.ID, alert.ProjectID)
+
+			alerts, err := service.GetProjectUsageAlerts(developerCtx, project.ID)
+			require.NoError(t, err)
+			require.Len(t, alerts, 1)
+			require.Equal(t, alert.ID, alerts[0].ID)
+			require.Equal(t, console.UsageAlertCharges, alerts[0].Kind)
+			require.EqualValues(t, 1000, alerts[0].Threshold)
+			require.Equal(t, "https://example.test/hook", alerts[0].WebhookURL)
+			require.Nil(t, alerts[0].NotifiedAt)
+
+			err = service.DeleteProjectUsageAlert(developerCtx, project.ID, alert.ID)
+			require.True(t, console.ErrForbidden.Has(err), err)
+
+			require.NoError(t, service.DeleteProjectUsageAlert(ownerCtx, project.ID, alert.ID))
+			err = service.DeleteProjectUsageAlert(ownerCtx, project.ID, alert.ID)
+			require.True(t, console.ErrProjectUsageAlertNotFound.Has(err), err)
+
+			alerts, err = service.GetProjectUsageAlerts(ownerCtx, project.ID)
+			require.NoError(t, err)
+			require.Empty(t, alerts)
+		})
+
+		t.Run("permissions", func(t *testing.T) {
+			request := console.CreateProjectUsageAlertRequest{Kind: console.UsageAlertStorage, Threshold: memory.GB.Int64()}
+
+			_, err := service.CreateProjectUsageAlert(developerCtx, project.ID, request)
+			require.True(t, console.ErrForbidden.Has(err), err)
+
+			_, err = service.CreateProjectUsageAlert(nonMemberCtx, project.ID, request)
+			require.True(t, console.ErrNoMembership.Has(err), err)
+
+			_, err = service.GetProjectUsageAlerts(nonMemberCtx, project.ID)
+			require.True(t, console.ErrNoMembership.Has(err), err)
+		})
+
+		t.Run("limit", func(t *testing.T) {
+			request := console.CreateProjectUsageAlertRequest{Kind: console.UsageAlertEgress, Threshold: memory.GB.Int64()}
+			for i := 0; i < console.MaxProjectUsageAlerts; i++ {
+				_, err := service.CreateProjectUsageAlert(ownerCtx, project.ID, request)
+				require.NoError(t, err)
+			}
+
+			_, err := service.CreateProjectUsageAlert(ownerCtx, project.ID, request)
+			require.True(t, console.ErrValidation.Has(err), err)
+		})
+	})
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package sso
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+// Config contains configurations for signing in to the console through external identity providers.
+type Config struct {
+	Enabled   bool      `help:"whether users can sign in through the configured OpenID Connect identity providers" default:"false"`
+	Providers Providers `help:"JSON list of OpenID Connect identity providers, e.g. [{\"name\":\"corporate\",\"issuer\":\"https://idp.example.test\",\"clientID\":\"console\",\"clientSecret\":\"secret\",\"domains\":[\"example.test\"]}]" default:"[]"`
+}
+
+// ProviderConfig contains the configuration of an OpenID Connect identity provider.
+type ProviderConfig struct {
+	// Name identifies the provider in the callback URL and in the linked identities.
+	Name string `json:"name"`
+	// Issuer is the issuer URL of the provider, which serves /.well-known/openid-configuration.
+	Issuer       string `json:"issuer"`
+	ClientID     string `json:"clientID"`
+	ClientSecret string `json:"clientSecret"`
+	// Domains are the email domains whose users sign in through the provider.
+	Domains []string `json:"domains"`
+}
+
+// Providers is a configuration value that contains a list of identity providers.
+//
+// Can be used as a flag.
+type Providers []ProviderConfig
+
+var providerNameRegexp = regexp.MustCompile(`^[a-z0-9-]+$`)
+
+// Type implements pflag.Value.
+func (Providers) Type() string { return "sso.Providers" }
+
+// String is required for pflag.Value.
+func (providers *Providers) String() string {
+	data, err := json.Marshal(*providers)
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
+
+// Set does validation on the configured JSON.
+func (providers *Providers) Set(s string) (err error) {
+	var configs []ProviderConfig
+
+	err = json.Unmarshal([]byte(s), &configs)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	names := make(map[string]struct{})
+	domains := make(map[string]struct{})
+	for i, config := range configs {
+		if !providerNameRegexp.MatchString(config.Name) {
+			return Error.New("invalid provider name %q: only lowercase letters, digits and dashes are allowed", config.Name)
+		}
+		if _, ok := names[config.Name]; ok {
+			return Error.New("duplicate provider name %q", config.Name)
+		}
+		names[config.Name] = struct{}{}
+
+		if config.Issuer == "" || config.ClientID == "" {
+			return Error.New("provider %q: issuer and client ID are required", config.Name)
+		}
+		if len(config.Domains) == 0 {
+			return Error.New("provider %q: at least one domain is required", config.Name)
+		}
+		for j, domain := range config.Domains {
+			domain = strings.ToLower(domain)
+			if _, ok := domains[domain]; ok {
+				return Error.New("provider %q: domain %q is already assigned", config.Name, domain)
+			}
+			domains[domain] = struct{}{}
+			configs[i].Domains[j] = domain
+		}
+	}
+
+	*providers = configs
+	return nil
+}
@@ -0,0 +1,419 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package sso
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"golang.org/x/oauth2"
+)
+
+var (
+	// Error is the default error class for the sso package.
+	Error = errs.Class("sso")
+	// ErrInvalidToken is the error class for ID tokens which fail the verification.
+	ErrInvalidToken = errs.Class("sso invalid token")
+)
+
+const (
+	// maxResponseSize limits the size of the responses read from an identity provider.
+	maxResponseSize = 1 << 20
+	// clockSkew is the tolerated difference between the clocks of the satellite and the identity provider.
+	clockSkew = time.Minute
+)
+
+// Claims are the verified claims of an ID token.
+type Claims struct {
+	Provider string
+	Subject  string
+	Email    string
+	Name     string
+}
+
+// Provider is an OpenID Connect identity provider.
+type Provider struct {
+	config      ProviderConfig
+	redirectURL string
+	client      *http.Client
+
+	mu        sync.Mutex
+	discovery *discovery
+	keys      map[string]crypto.PublicKey
+}
+
+// discovery is the part of the provider metadata used by the console.
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// NewProvider creates a provider which redirects the users back to redirectURL.
+// The provider metadata is fetched on first use.
+func NewProvider(config ProviderConfig, redirectURL string, client *http.Client) *Provider {
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &Provider{
+		config:      config,
+		redirectURL: redirectURL,
+		client:      client,
+	}
+}
+
+// Name returns the name of the provider.
+func (provider *Provider) Name() string { return provider.config.Name }
+
+// AuthCodeURL returns the URL of the provider's consent page, which redirects back with an authorization code.
+func (provider *Provider) AuthCodeURL(ctx context.Context, state, nonce string) (_ string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	oauthConfig, err := provider.oauthConfig(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return oauthConfig.AuthCodeURL(state, oauth2.SetAuthURLParam("nonce", nonce)), nil
+}
+
+// Exchange exchanges the authorization code for an ID token and returns its verified claims.
+func (provider *Provider) Exchange(ctx context.Context, code, nonce string) (_ *Claims, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	oauthConfig, err := provider.oauthConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := oauthConfig.Exchange(context.WithValue(ctx, oauth2.HTTPClient, provider.client), code)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	idToken, ok := token.Extra("id_token").(string)
+	if !ok || idToken == "" {
+		return nil, ErrInvalidToken.New("token response has no id_token")
+	}
+
+	return provider.verify(ctx, idToken, nonce, time.Now())
+}
+
+func (provider *Provider) oauthConfig(ctx context.Context) (*oauth2.Config, error) {
+	discovery, err := provider.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &oauth2.Config{
+		ClientID:     provider.config.ClientID,
+		ClientSecret: provider.config.ClientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  discovery.AuthorizationEndpoint,
+			TokenURL: discovery.TokenEndpoint,
+		},
+		RedirectURL: provider.redirectURL,
+		Scopes:      []string{"openid", "email", "profile"},
+	}, nil
+}
+
+// getDiscovery returns the provider metadata, fetching it when it isn't cached yet.
+func (provider *Provider) getDiscovery(ctx context.Context) (*discovery, error) {
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+
+	if provider.discovery != nil {
+		return provider.discovery, nil
+	}
+
+	var metadata discovery
+	err := provider.getJSON(ctx, strings.TrimSuffix(provider.config.Issuer, "/")+"/.well-known/openid-configuration", &metadata)
+	if err != nil {
+		return nil, err
+	}
+	if metadata.Issuer != provider.config.Issuer {
+		return nil, Error.New("issuer mismatch: expected %q, got %q", provider.config.Issuer, metadata.Issuer)
+	}
+	if metadata.AuthorizationEndpoint == "" || metadata.TokenEndpoint == "" || metadata.JWKSURI == "" {
+		return nil, Error.New("incomplete provider metadata")
+	}
+
+	provider.discovery = &metadata
+	return provider.discovery, nil
+}
+
+// getKey returns the signing key with the given ID. The key set is fetched
+// again when the key isn't known, because the provider may have rotated its keys.
+func (provider *Provider) getKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	discovery, err := provider.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+
+	if key, ok := provider.keys[kid]; ok {
+		return key, nil
+	}
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := provider.getJSON(ctx, discovery.JWKSURI, &set); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			// keys of unsupported types are skipped.
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	provider.keys = keys
+
+	if key, ok := provider.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, ErrInvalidToken.New("unknown signing key %q", kid)
+}
+
+func (provider *Provider) getJSON(ctx context.Context, url string, v interface{}) (err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	resp, err := provider.client.Do(req)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(resp.Body.Close())) }()
+
+	if resp.StatusCode != http.StatusOK {
+		return Error.New("unexpected status code %d from %s", resp.StatusCode, url)
+	}
+
+	return Error.Wrap(json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(v))
+}
+
+// idTokenClaims are the claims of an ID token checked by the console.
+type idTokenClaims struct {
+	Issuer        string          `json:"iss"`
+	Subject       string          `json:"sub"`
+	Audience      json.RawMessage `json:"aud"`
+	Expiry        int64           `json:"exp"`
+	Nonce         string          `json:"nonce"`
+	Email         string          `json:"email"`
+	EmailVerified interface{}     `json:"email_verified"`
+	Name          string          `json:"name"`
+}
+
+// verify checks the signature and the claims of the ID token.
+func (provider *Provider) verify(ctx context.Context, idToken, nonce string, now time.Time) (_ *Claims, err error) {
+	parts := strings.Split(idToken, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidToken.New("malformed token")
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, err
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrInvalidToken.Wrap(err)
+	}
+
+	key, err := provider.getKey(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	switch header.Alg {
+	case "RS256":
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return nil, ErrInvalidToken.New("key %q is not an RSA key", header.Kid)
+		}
+		if err := rsa.VerifyPKCS1v15(rsaKey, crypto.SHA256, digest[:], signature); err != nil {
+			return nil, ErrInvalidToken.New("invalid signature")
+		}
+	case "ES256":
+		ecKey, ok := key.(*ecdsa.PublicKey)
+		if !ok || len(signature) != 64 {
+			return nil, ErrInvalidToken.New("key %q is not a P-256 key", header.Kid)
+		}
+		r := new(big.Int).SetBytes(signature[:32])
+		s := new(big.Int).SetBytes(signature[32:])
+		if !ecdsa.Verify(ecKey, digest[:], r, s) {
+			return nil, ErrInvalidToken.New("invalid signature")
+		}
+	default:
+		return nil, ErrInvalidToken.New("unsupported signing algorithm %q", header.Alg)
+	}
+
+	var claims idTokenClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, err
+	}
+
+	if claims.Issuer != provider.config.Issuer {
+		return nil, ErrInvalidToken.New("unexpected issuer %q", claims.Issuer)
+	}
+	if !audienceContains(claims.Audience, provider.config.ClientID) {
+		return nil, ErrInvalidToken.New("token is not issued for the console")
+	}
+	if now.After(time.Unix(claims.Expiry, 0).Add(clockSkew)) {
+		return nil, ErrInvalidToken.New("token has expired")
+	}
+	if claims.Nonce != nonce {
+		return nil, ErrInvalidToken.New("nonce mismatch")
+	}
+	if claims.Subject == "" || claims.Email == "" {
+		return nil, ErrInvalidToken.New("token has no subject or email")
+	}
+	// the users are linked by the email, so it must be verified explicitly.
+	if verified, ok := claims.EmailVerified.(bool); !(ok && verified) && claims.EmailVerified != "true" {
+		return nil, ErrInvalidToken.New("email is not verified by the provider")
+	}
+	if !provider.hasDomain(claims.Email) {
+		return nil, ErrInvalidToken.New("email domain is not assigned to the provider")
+	}
+
+	return &Claims{
+		Provider: provider.config.Name,
+		Subject:  claims.Subject,
+		Email:    claims.Email,
+		Name:     claims.Name,
+	}, nil
+}
+
+// hasDomain returns whether the domain of the email is assigned to the provider.
+func (provider *Provider) hasDomain(email string) bool {
+	domain := emailDomain(email)
+	for _, d := range provider.config.Domains {
+		if d == domain {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return ErrInvalidToken.Wrap(err)
+	}
+	return ErrInvalidToken.Wrap(json.Unmarshal(data, v))
+}
+
+// audienceContains checks the aud claim, which is either a string or a list of strings.
+func audienceContains(aud json.RawMessage, clientID string) bool {
+	var single string
+	if err := json.Unmarshal(aud, &single); err == nil {
+		return single == clientID
+	}
+
+	var list []string
+	if err := json.Unmarshal(aud, &list); err != nil {
+		return false
+	}
+	for _, a := range list {
+		if a == clientID {
+			return true
+		}
+	}
+	return false
+}
+
+// emailDomain returns the lowercase domain of the email.
+func emailDomain(email string) string {
+	i := strings.LastIndexByte(email, '@')
+	if i < 0 {
+		return ""
+	}
+	return strings.ToLower(email[i+1:])
+}
+
+// jsonWebKey is an RSA or EC public key of a JSON Web Key Set.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (jwk jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	decode := func(s string) (*big.Int, error) {
+		data, err := base64.RawURLEncoding.DecodeString(s)
+		if err != nil {
+			return nil, err
+		}
+		return new(big.Int).SetBytes(data), nil
+	}
+
+	switch jwk.Kty {
+	case "RSA":
+		n, err := decode(jwk.N)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		e, err := decode(jwk.E)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, Error.New("invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		if jwk.Crv != "P-256" {
+			return nil, Error.New("unsupported curve %q", jwk.Crv)
+		}
+		x, err := decode(jwk.X)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		y, err := decode(jwk.Y)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		if !elliptic.P256().IsOnCurve(x, y) {
+			return nil, Error.New("point is not on curve")
+		}
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+	default:
+		return nil, Error.New("unsupported key type %q", jwk.Kty)
+	}
+}
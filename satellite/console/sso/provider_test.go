@@ -0,0 +1,196 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package sso_test
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/console/sso"
+)
+
+// fakeIdentityProvider is an OpenID Connect provider which issues the ID token built by claims for any code.
+type fakeIdentityProvider struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	claims func(issuer string) map[string]interface{}
+}
+
+func newFakeIdentityProvider(t *testing.T) *fakeIdentityProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	idp := &fakeIdentityProvider{key: key}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 idp.server.URL,
+			"authorization_endpoint": idp.server.URL + "/authorize",
+			"token_endpoint":         idp.server.URL + "/token",
+			"jwks_uri":               idp.server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "key-1",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "access-token",
+			"token_type":   "Bearer",
+			"id_token":     idp.sign(t, idp.claims(idp.server.URL)),
+		})
+	})
+	idp.server = httptest.NewServer(mux)
+
+	return idp
+}
+
+func (idp *fakeIdentityProvider) sign(t *testing.T, claims map[string]interface{}) string {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "kid": "key-1", "typ": "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, idp.key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestProvider(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	idp := newFakeIdentityProvider(t)
+	defer idp.server.Close()
+
+	service := sso.NewService(sso.Config{
+		Enabled: true,
+		Providers: sso.Providers{{
+			Name:         "corporate",
+			Issuer:       idp.server.URL,
+			ClientID:     "console",
+			ClientSecret: "secret",
+			Domains:      []string{"example.test"},
+		}},
+	}, "https://satellite.test/", idp.server.Client())
+
+	_, ok := service.ProviderByEmail("user@other.test")
+	require.False(t, ok)
+
+	provider, ok := service.ProviderByEmail("User@Example.TEST")
+	require.True(t, ok)
+	require.Equal(t, "corporate", provider.Name())
+
+	authURL, err := provider.AuthCodeURL(ctx, "state", "nonce")
+	require.NoError(t, err)
+	parsed, err := url.Parse(authURL)
+	require.NoError(t, err)
+	require.Equal(t, idp.server.URL+"/authorize", parsed.Scheme+"://"+parsed.Host+parsed.Path)
+	require.Equal(t, "state", parsed.Query().Get("state"))
+	require.Equal(t, "nonce", parsed.Query().Get("nonce"))
+	require.Equal(t, "https://satellite.test/api/v0/auth/sso/corporate/callback", parsed.Query().Get("redirect_uri"))
+
+	validClaims := func(issuer string) map[string]interface{} {
+		return map[string]interface{}{
+			"iss":            issuer,
+			"sub":            "subject-1",
+			"aud":            []string{"console", "other"},
+			"exp":            time.Now().Add(time.Hour).Unix(),
+			"nonce":          "nonce",
+			"email":          "user@example.test",
+			"email_verified": true,
+			"name":           "Test User",
+		}
+	}
+
+	idp.claims = validClaims
+	claims, err := provider.Exchange(ctx, "code", "nonce")
+	require.NoError(t, err)
+	require.Equal(t, &sso.Claims{
+		Provider: "corporate",
+		Subject:  "subject-1",
+		Email:    "user@example.test",
+		Name:     "Test User",
+	}, claims)
+
+	for _, tt := range []struct {
+		name  string
+		nonce string
+		edit  func(claims map[string]interface{})
+	}{
+		{name: "wrong nonce", nonce: "other-nonce"},
+		{name: "wrong audience", edit: func(claims map[string]interface{}) { claims["aud"] = "other" }},
+		{name: "wrong issuer", edit: func(claims map[string]interface{}) { claims["iss"] = "https://attacker.test" }},
+		{name: "expired", edit: func(claims map[string]interface{}) { claims["exp"] = time.Now().Add(-time.Hour).Unix() }},
+		{name: "foreign domain", edit: func(claims map[string]interface{}) { claims["email"] = "user@other.test" }},
+		{name: "unverified email", edit: func(claims map[string]interface{}) { claims["email_verified"] = false }},
+		{name: "unknown email verification", edit: func(claims map[string]interface{}) { delete(claims, "email_verified") }},
+		{name: "malformed email verification", edit: func(claims map[string]interface{}) { claims["email_verified"] = "yes" }},
+		{name: "no subject", edit: func(claims map[string]interface{}) { delete(claims, "sub") }},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			idp.claims = func(issuer string) map[string]interface{} {
+				claims := validClaims(issuer)
+				if tt.edit != nil {
+					tt.edit(claims)
+				}
+				return claims
+			}
+			nonce := tt.nonce
+			if nonce == "" {
+				nonce = "nonce"
+			}
+
+			_, err := provider.Exchange(ctx, "code", nonce)
+			require.Error(t, err)
+			require.True(t, sso.ErrInvalidToken.Has(err), err)
+		})
+	}
+}
+
+func TestProvidersSet(t *testing.T) {
+	var providers sso.Providers
+	require.NoError(t, providers.Set(`[]`))
+	require.Empty(t, providers)
+
+	require.NoError(t, providers.Set(`[{"name":"corporate","issuer":"https://idp.test","clientID":"console","domains":["Example.test"]}]`))
+	require.Len(t, providers, 1)
+	require.Equal(t, []string{"example.test"}, providers[0].Domains)
+
+	for _, invalid := range []string{
+		`not json`,
+		`[{"name":"Corporate","issuer":"https://idp.test","clientID":"console","domains":["example.test"]}]`,
+		`[{"name":"corporate","clientID":"console","domains":["example.test"]}]`,
+		`[{"name":"corporate","issuer":"https://idp.test","clientID":"console"}]`,
+		`[{"name":"a","issuer":"https://idp.test","clientID":"console","domains":["example.test"]},{"name":"a","issuer":"https://idp2.test","clientID":"console","domains":["other.test"]}]`,
+		`[{"name":"a","issuer":"https://idp.test","clientID":"console","domains":["example.test"]},{"name":"b","issuer":"https://idp2.test","clientID":"console","domains":["EXAMPLE.test"]}]`,
+	} {
+		require.Error(t, providers.Set(invalid), invalid)
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package sso
+
+import (
+	"net/http"
+
+	"github.com/spacemonkeygo/monkit/v3"
+)
+
+var mon = monkit.Package()
+
+// Service selects the identity provider through which a user signs in to the console.
+type Service struct {
+	providers map[string]*Provider
+	byDomain  map[string]*Provider
+}
+
+// NewService creates a service for the configured providers. The providers redirect
+// the users back to the callback endpoint of the console at externalAddress, which
+// must end with a slash.
+func NewService(config Config, externalAddress string, client *http.Client) *Service {
+	service := &Service{
+		providers: make(map[string]*Provider, len(config.Providers)),
+		byDomain:  make(map[string]*Provider),
+	}
+
+	for _, providerConfig := range config.Providers {
+		provider := NewProvider(providerConfig, CallbackURL(externalAddress, providerConfig.Name), client)
+		service.providers[providerConfig.Name] = provider
+		for _, domain := range providerConfig.Domains {
+			service.byDomain[domain] = provider
+		}
+	}
+
+	return service
+}
+
+// CallbackURL returns the URL of the console endpoint where the provider redirects the users back.
+func CallbackURL(externalAddress, providerName string) string {
+	return externalAddress + "api/v0/auth/sso/" + providerName + "/callback"
+}
+
+// ProviderByName returns the provider with the given name.
+func (service *Service) ProviderByName(name string) (*Provider, bool) {
+	provider, ok := service.providers[name]
+	return provider, ok
+}
+
+// ProviderByEmail returns the provider assigned to the domain of the email.
+func (service *Service) ProviderByEmail(email string) (*Provider, bool) {
+	provider, ok := service.byDomain[emailDomain(email)]
+	return provider, ok
+}
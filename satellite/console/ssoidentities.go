@@ -0,0 +1,32 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/uuid"
+)
+
+// SSOIdentities exposes methods to manage the links between identity provider accounts and users.
+//
+// architecture: Database
+type SSOIdentities interface {
+	// Insert is a method for linking an identity provider account to a user.
+	Insert(ctx context.Context, identity SSOIdentity) error
+	// GetUserID is a method for querying the user linked to an identity provider account.
+	// It returns sql.ErrNoRows if the account isn't linked.
+	GetUserID(ctx context.Context, provider, subject string) (uuid.UUID, error)
+}
+
+// SSOIdentity links an account of an identity provider to a user.
+type SSOIdentity struct {
+	// Provider is the name of the identity provider in the satellite configuration.
+	Provider string
+	// Subject is the identifier of the account at the identity provider.
+	Subject   string
+	UserID    uuid.UUID
+	CreatedAt time.Time
+}
@@ -0,0 +1,344 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package usagealerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/post"
+	"storj.io/storj/private/webhook"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/satellite/payments"
+)
+
+var (
+	// Error is the standard error class for usage alerts chore errors.
+	Error = errs.Class("usage-alerts-chore")
+	mon   = monkit.Package()
+)
+
+// maxResponseSize is the maximum number of bytes read from a webhook response.
+const maxResponseSize = 4 << 10
+
+// Config contains configurable values for the usage alerts chore.
+type Config struct {
+	Enabled            bool          `help:"whether to notify the users about the usage crossing the thresholds of the project usage alerts" default:"false"`
+	Interval           time.Duration `help:"how often to check the usage of the projects with usage alerts" default:"1h"`
+	ListLimit          int           `help:"how many usage alerts to query in a batch" default:"100"`
+	WebhookTimeout     time.Duration `help:"timeout of a single webhook request" default:"10s"`
+	WebhookMaxAttempts int           `help:"how many times the delivery of a webhook is attempted, once per interval, before it's dropped" default:"5"`
+
+	AllowPrivateNetworks bool `help:"allow delivering webhooks to loopback and private network addresses" default:"false" hidden:"true"`
+}
+
+// WebhookPayload is the body posted to the webhook of a usage alert.
+type WebhookPayload struct {
+	AlertID     uuid.UUID              `json:"alertId"`
+	ProjectID   uuid.UUID              `json:"projectId"`
+	ProjectName string                 `json:"projectName"`
+	Kind        console.UsageAlertKind `json:"kind"`
+	Threshold   int64                  `json:"threshold"`
+	Usage       int64                  `json:"usage"`
+	PeriodStart time.Time              `json:"periodStart"`
+}
+
+// Chore notifies the users when the usage of a project crosses the threshold of a usage alert.
+// The users are notified at most once per alert and billing period. A failed
+// webhook delivery is retried in the next cycles, without sending the email again.
+//
+// architecture: Chore
+type Chore struct {
+	log               *zap.Logger
+	alerts            console.ProjectUsageAlerts
+	projects          console.Projects
+	users             console.Users
+	payments          payments.Accounts
+	liveAccounting    accounting.Cache
+	projectAccounting accounting.ProjectAccounting
+	mailService       *mailservice.Service
+	client            *http.Client
+	config            Config
+	address           string
+
+	nowFn func() time.Time
+	Loop  *sync2.Cycle
+}
+
+// NewChore is a constructor for Chore.
+func NewChore(log *zap.Logger, alerts console.ProjectUsageAlerts, projects console.Projects, users console.Users, payments payments.Accounts, liveAccounting accounting.Cache, projectAccounting accounting.ProjectAccounting, mailService *mailservice.Service, config Config, address string) *Chore {
+	if config.ListLimit < 1 {
+		config.ListLimit = 1
+	}
+	if !strings.HasSuffix(address, "/") {
+		address += "/"
+	}
+	return &Chore{
+		log:               log,
+		alerts:            alerts,
+		projects:          projects,
+		users:             users,
+		payments:          payments,
+		liveAccounting:    liveAccounting,
+		projectAccounting: projectAccounting,
+		mailService:       mailService,
+		client:            webhook.NewClient(config.WebhookTimeout, config.AllowPrivateNetworks),
+		config:            config,
+		address:           address,
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run runs the chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := chore.checkAlerts(ctx); err != nil {
+			chore.log.Error("failed to check project usage alerts", zap.Error(Error.Wrap(err)))
+		}
+		return nil
+	})
+}
+
+// cycleState holds the usage, which is shared by the alerts checked in a cycle.
+type cycleState struct {
+	now         time.Time
+	periodStart time.Time
+	// charges are the project charges of the project owners.
+	charges map[uuid.UUID]payments.ProjectChargesResponse
+}
+
+// checkAlerts checks all the usage alerts, which weren't triggered in the current billing period.
+func (chore *Chore) checkAlerts(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := chore.nowFn().UTC()
+	state := &cycleState{
+		now:         now,
+		periodStart: time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC),
+		charges:     make(map[uuid.UUID]payments.ProjectChargesResponse),
+	}
+
+	var cursor uuid.UUID
+	for {
+		alerts, err := chore.alerts.List(ctx, cursor, chore.config.ListLimit)
+		if err != nil {
+			return err
+		}
+
+		for _, alert := range alerts {
+			var err error
+			switch {
+			case !alert.Notified(state.periodStart):
+				err = chore.checkAlert(ctx, state, alert)
+			case alert.WebhookPending() && alert.WebhookAttempts < chore.config.WebhookMaxAttempts:
+				err = chore.retryWebhook(ctx, state, alert)
+			default:
+				continue
+			}
+			if err != nil {
+				chore.log.Error("failed to check project usage alert",
+					zap.Stringer("Alert ID", alert.ID),
+					zap.Stringer("Project ID", alert.ProjectID),
+					zap.Error(err))
+			}
+		}
+
+		if len(alerts) < chore.config.ListLimit {
+			return nil
+		}
+		cursor = alerts[len(alerts)-1].ID
+	}
+}
+
+// checkAlert notifies the project owner if the usage crossed the threshold of the alert.
+func (chore *Chore) checkAlert(ctx context.Context, state *cycleState, alert console.ProjectUsageAlert) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	project, err := chore.projects.Get(ctx, alert.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	usage, err := chore.projectUsage(ctx, state, project, alert.Kind)
+	if err != nil {
+		return err
+	}
+	if usage < alert.Threshold {
+		return nil
+	}
+
+	owner, err := chore.users.Get(ctx, project.OwnerID)
+	if err != nil {
+		return err
+	}
+
+	mon.Meter("usage_alerts_triggered").Mark(1)
+
+	chore.mailService.SendRenderedAsync(
+		ctx,
+		[]post.Address{{Address: owner.Email, Name: owner.FullName}},
+		&console.UsageAlertEmail{
+			UserName:    owner.ShortName,
+			ProjectName: project.Name,
+			Kind:        string(alert.Kind),
+			Threshold:   alert.Kind.Format(alert.Threshold),
+			Usage:       alert.Kind.Format(usage),
+			ProjectLink: chore.address + "project-dashboard",
+		},
+	)
+
+	// the email was sent already, so only the webhook is retried when its
+	// delivery fails.
+	if err := chore.alerts.UpdateNotifiedAt(ctx, alert.ID, state.now); err != nil {
+		return err
+	}
+	if alert.WebhookURL == "" {
+		return nil
+	}
+
+	alert.WebhookAttempts = 0
+	return chore.deliverWebhook(ctx, state, alert, project, usage)
+}
+
+// retryWebhook delivers the webhook of an alert, whose previous delivery failed.
+func (chore *Chore) retryWebhook(ctx context.Context, state *cycleState, alert console.ProjectUsageAlert) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	project, err := chore.projects.Get(ctx, alert.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	usage, err := chore.projectUsage(ctx, state, project, alert.Kind)
+	if err != nil {
+		return err
+	}
+
+	return chore.deliverWebhook(ctx, state, alert, project, usage)
+}
+
+// deliverWebhook posts the alert to its webhook and records the outcome of the delivery.
+func (chore *Chore) deliverWebhook(ctx context.Context, state *cycleState, alert console.ProjectUsageAlert, project *console.Project, usage int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = chore.send(ctx, alert.WebhookURL, WebhookPayload{
+		AlertID:     alert.ID,
+		ProjectID:   project.PublicID,
+		ProjectName: project.Name,
+		Kind:        alert.Kind,
+		Threshold:   alert.Threshold,
+		Usage:       usage,
+		PeriodStart: state.periodStart,
+	})
+	if err == nil {
+		mon.Meter("usage_alerts_webhook_delivered").Mark(1)
+		return chore.alerts.UpdateWebhookDeliveredAt(ctx, alert.ID, state.now)
+	}
+
+	attempts := alert.WebhookAttempts + 1
+	mon.Meter("usage_alerts_webhook_failed").Mark(1)
+	message := "unable to deliver project usage alert, retrying in the next cycle"
+	if attempts >= chore.config.WebhookMaxAttempts {
+		mon.Meter("usage_alerts_webhook_dropped").Mark(1)
+		message = "unable to deliver project usage alert, giving up"
+	}
+	chore.log.Warn(message,
+		zap.Stringer("Alert ID", alert.ID),
+		zap.Stringer("Project ID", alert.ProjectID),
+		zap.String("Webhook", alert.WebhookURL),
+		zap.Int("Attempts", attempts),
+		zap.Error(err))
+
+	return chore.alerts.IncrementWebhookAttempts(ctx, alert.ID)
+}
+
+// projectUsage returns the usage of the project in the current billing period.
+func (chore *Chore) projectUsage(ctx context.Context, state *cycleState, project *console.Project, kind console.UsageAlertKind) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch kind {
+	case console.UsageAlertCharges:
+		charges, ok := state.charges[project.OwnerID]
+		if !ok {
+			charges, err = chore.payments.ProjectCharges(ctx, project.OwnerID, state.periodStart, state.now)
+			if err != nil {
+				return 0, err
+			}
+			state.charges[project.OwnerID] = charges
+		}
+
+		var total int64
+		for _, charge := range charges[project.PublicID] {
+			total += charge.StorageMBMonthCents + charge.EgressMBCents + charge.SegmentMonthCents
+		}
+		return total, nil
+	case console.UsageAlertStorage:
+		total, err := chore.liveAccounting.GetProjectStorageUsage(ctx, project.ID)
+		if accounting.ErrKeyNotFound.Has(err) {
+			return 0, nil
+		}
+		return total, err
+	case console.UsageAlertEgress:
+		return chore.projectAccounting.GetProjectBandwidth(ctx, project.ID, state.periodStart.Year(), state.periodStart.Month(), 1, 0)
+	default:
+		return 0, errs.New("unknown usage alert kind %q", kind)
+	}
+}
+
+// send posts the payload to the webhook.
+func (chore *Chore) send(ctx context.Context, webhookURL string, payload WebhookPayload) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := chore.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseSize))
+		err = errs.Combine(err, resp.Body.Close())
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errs.New("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// TestSetNow sets nowFn on chore for testing.
+func (chore *Chore) TestSetNow(f func() time.Time) {
+	chore.nowFn = f
+}
+
+// Close closes the chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
@@ -0,0 +1,173 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package usagealerts_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/usagealerts"
+)
+
+// webhook records the payloads it receives and fails the first requests.
+type webhook struct {
+	mu       sync.Mutex
+	failures int
+	payloads []usagealerts.WebhookPayload
+}
+
+func (hook *webhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	hook.mu.Lock()
+	defer hook.mu.Unlock()
+
+	if hook.failures > 0 {
+		hook.failures--
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	var payload usagealerts.WebhookPayload
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	hook.payloads = append(hook.payloads, payload)
+}
+
+func (hook *webhook) fail(failures int) {
+	hook.mu.Lock()
+	defer hook.mu.Unlock()
+	hook.failures = failures
+}
+
+func (hook *webhook) received() []usagealerts.WebhookPayload {
+	hook.mu.Lock()
+	defer hook.mu.Unlock()
+	return append([]usagealerts.WebhookPayload(nil), hook.payloads...)
+}
+
+func TestUsageAlertsChore(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.UsageAlerts.Enabled = true
+				config.UsageAlerts.ListLimit = 1
+				config.UsageAlerts.WebhookMaxAttempts = 2
+				config.UsageAlerts.AllowPrivateNetworks = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		alertsDB := sat.DB.Console().ProjectUsageAlerts()
+		chore := sat.Core.UsageAlerts.Chore
+
+		chore.Loop.Pause()
+
+		hook := &webhook{}
+		server := httptest.NewServer(hook)
+		defer server.Close()
+
+		user, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Test User",
+			Email:    "user@mail.test",
+		}, 1)
+		require.NoError(t, err)
+
+		project, err := sat.AddProject(ctx, user.ID, "test")
+		require.NoError(t, err)
+
+		newAlert := func(kind console.UsageAlertKind, threshold int64, webhookURL string) *console.ProjectUsageAlert {
+			alert := &console.ProjectUsageAlert{
+				ID:         testrand.UUID(),
+				ProjectID:  project.ID,
+				Kind:       kind,
+				Threshold:  threshold,
+				WebhookURL: webhookURL,
+			}
+			require.NoError(t, alertsDB.Insert(ctx, alert))
+			return alert
+		}
+		storageAlert := newAlert(console.UsageAlertStorage, memory.KiB.Int64(), server.URL)
+		newAlert(console.UsageAlertEgress, memory.TB.Int64(), server.URL)
+		newAlert(console.UsageAlertCharges, 100, server.URL)
+
+		requireNotified := func(t *testing.T, expected int) {
+			alerts, err := alertsDB.GetByProjectID(ctx, project.ID)
+			require.NoError(t, err)
+
+			notified := 0
+			for _, alert := range alerts {
+				if alert.NotifiedAt != nil {
+					require.Equal(t, storageAlert.ID, alert.ID)
+					notified++
+				}
+			}
+			require.Equal(t, expected, notified)
+		}
+
+		// the usage is below the thresholds.
+		chore.Loop.TriggerWait()
+		require.Empty(t, hook.received())
+		requireNotified(t, 0)
+
+		require.NoError(t, sat.Core.LiveAccounting.Cache.AddProjectStorageUsage(ctx, project.ID, 2*memory.KiB.Int64()))
+
+		// a failed webhook delivery is retried in the next cycle.
+		hook.fail(1)
+		chore.Loop.TriggerWait()
+		require.Empty(t, hook.received())
+		requireNotified(t, 1)
+
+		chore.Loop.TriggerWait()
+		payloads := hook.received()
+		require.Len(t, payloads, 1)
+		require.Equal(t, storageAlert.ID, payloads[0].AlertID)
+		require.Equal(t, project.PublicID, payloads[0].ProjectID)
+		require.Equal(t, console.UsageAlertStorage, payloads[0].Kind)
+		require.Equal(t, 2*memory.KiB.Int64(), payloads[0].Usage)
+		requireNotified(t, 1)
+
+		// the users are notified once per billing period.
+		chore.Loop.TriggerWait()
+		require.Len(t, hook.received(), 1)
+
+		chore.TestSetNow(func() time.Time {
+			return time.Now().AddDate(0, 1, 0)
+		})
+		chore.Loop.TriggerWait()
+		payloads = hook.received()
+		require.Len(t, payloads, 2)
+		require.True(t, payloads[1].PeriodStart.After(payloads[0].PeriodStart))
+
+		// the webhook is dropped after the maximum number of attempts.
+		hook.fail(3)
+		chore.TestSetNow(func() time.Time {
+			return time.Now().AddDate(0, 2, 0)
+		})
+		for i := 0; i < 3; i++ {
+			chore.Loop.TriggerWait()
+		}
+		require.Len(t, hook.received(), 2)
+
+		alerts, err := alertsDB.GetByProjectID(ctx, project.ID)
+		require.NoError(t, err)
+		require.Equal(t, storageAlert.ID, alerts[0].ID)
+		require.Equal(t, 2, alerts[0].WebhookAttempts)
+		require.True(t, alerts[0].WebhookPending())
+	})
+}
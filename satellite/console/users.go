@@ -14,6 +14,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/console/consoleauth"
+	"storj.io/storj/satellite/console/webauthn"
 )
 
 // Users exposes methods to manage User table in database.
@@ -123,9 +124,11 @@ type AuthUser struct {
 	Password        string `json:"password"`
 	MFAPasscode     string `json:"mfaPasscode"`
 	MFARecoveryCode string `json:"mfaRecoveryCode"`
-	CaptchaResponse string `json:"captchaResponse"`
-	IP              string `json:"-"`
-	UserAgent       string `json:"-"`
+	// WebAuthnAssertion is the assertion for the options returned by Service.BeginWebAuthnLogin.
+	WebAuthnAssertion *webauthn.AssertionResponse `json:"webAuthnAssertion"`
+	CaptchaResponse   string                      `json:"captchaResponse"`
+	IP                string                      `json:"-"`
+	UserAgent         string                      `json:"-"`
 }
 
 // TokenInfo holds info for user authentication token responses.
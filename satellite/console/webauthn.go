@@ -0,0 +1,489 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console/consoleauth"
+	"storj.io/storj/satellite/console/webauthn"
+)
+
+const (
+	// MaxWebAuthnCredentials is the maximum number of WebAuthn credentials a user can register.
+	MaxWebAuthnCredentials = 10
+	// webAuthnTimeout is how long the user has to complete a WebAuthn ceremony.
+	webAuthnTimeout = 5 * time.Minute
+	// maxWebAuthnCredentialNameLength is the maximum length of a WebAuthn credential name.
+	maxWebAuthnCredentialNameLength = 100
+)
+
+// The purposes of the WebAuthn challenges.
+const (
+	webAuthnPurposeRegistration  = "registration"
+	webAuthnPurposeLogin         = "login"
+	webAuthnPurposePasswordReset = "password reset"
+	webAuthnPurposeDeletion      = "credential deletion"
+)
+
+// Error messages.
+const (
+	mfaWebAuthnInvalidErrMsg     = "The security key is not valid for this account"
+	mfaWebAuthnRequiredErrMsg    = "A security key is required for this account"
+	webAuthnNameInvalidErrMsg    = "The security key name must be between 1 and 100 characters"
+	webAuthnNameUsedErrMsg       = "A security key with this name already exists"
+	webAuthnLimitErrMsg          = "The maximum number of security keys has been reached"
+	webAuthnCredentialUsedErrMsg = "The security key is already registered"
+)
+
+var (
+	// ErrMFAWebAuthn is error type that represents usage of an invalid WebAuthn assertion.
+	ErrMFAWebAuthn = errs.Class("MFA WebAuthn")
+
+	// ErrWebAuthnCredentialNotFound is error type that occurs when a WebAuthn credential of the user doesn't exist.
+	ErrWebAuthnCredentialNotFound = errs.Class("WebAuthn credential not found")
+)
+
+// WebAuthnCredentials exposes methods to manage the WebAuthn credentials of the users.
+//
+// architecture: Database
+type WebAuthnCredentials interface {
+	// Insert is a method for inserting a credential into the database.
+	Insert(ctx context.Context, credential *WebAuthnCredential) error
+	// GetByUserID is a method for querying the credentials of the user in the order of their registration.
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]WebAuthnCredential, error)
+	// UpdateUsage is a method for updating the signature counter of a credential, which was used to sign in.
+	UpdateUsage(ctx context.Context, id uuid.UUID, signCount uint32, usedAt time.Time) error
+	// Delete is a method for deleting a credential of the user. It returns sql.ErrNoRows if the credential doesn't exist.
+	Delete(ctx context.Context, userID, id uuid.UUID) error
+}
+
+// WebAuthnChallenges exposes methods to keep track of the WebAuthn challenges, which haven't been used yet.
+//
+// architecture: Database
+type WebAuthnChallenges interface {
+	// Insert is a method for storing the nonce of a new challenge.
+	Insert(ctx context.Context, nonce []byte, expiresAt time.Time) error
+	// Consume is a method for deleting the nonce of a challenge. It returns sql.ErrNoRows if the
+	// nonce doesn't exist or has expired.
+	Consume(ctx context.Context, nonce []byte, now time.Time) error
+	// DeleteExpired is a method for deleting the nonces of the challenges, which expired before now.
+	DeleteExpired(ctx context.Context, now time.Time) error
+}
+
+// WebAuthnCredential is a WebAuthn credential, which a user registered as a second factor.
+type WebAuthnCredential struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"-"`
+	Name   string    `json:"name"`
+
+	// CredentialID is the identifier of the credential generated by the authenticator.
+	CredentialID []byte `json:"-"`
+	// PublicKey is the COSE encoded public key of the credential.
+	PublicKey []byte `json:"-"`
+	// SignCount is the last signature counter reported by the authenticator.
+	SignCount uint32 `json:"-"`
+
+	CreatedAt  time.Time  `json:"createdAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+}
+
+// webAuthnChallenge is the payload of the signed challenges issued for the WebAuthn ceremonies.
+// The challenges are bound to the user and the purpose. Their nonces are stored until the first
+// use, so a challenge can't be replayed.
+type webAuthnChallenge struct {
+	UserID     uuid.UUID `json:"userId"`
+	Purpose    string    `json:"purpose"`
+	Nonce      []byte    `json:"nonce"`
+	Expiration time.Time `json:"expires"`
+}
+
+// BeginWebAuthnRegistration returns the options for registering a new WebAuthn credential of the user.
+func (s *Service) BeginWebAuthnRegistration(ctx context.Context) (_ *webauthn.CredentialCreationOptions, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "begin WebAuthn registration")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	credentials, err := s.store.WebAuthnCredentials().GetByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if len(credentials) >= MaxWebAuthnCredentials {
+		return nil, ErrValidation.New(webAuthnLimitErrMsg)
+	}
+
+	rp, err := s.webAuthnRelyingParty()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	challenge, err := s.createWebAuthnChallenge(ctx, user.ID, webAuthnPurposeRegistration)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return rp.CreationOptions(challenge, user.ID.Bytes(), user.Email, user.FullName, credentialIDs(credentials), webAuthnTimeout), nil
+}
+
+// FinishWebAuthnRegistration verifies and stores the WebAuthn credential created with the options
+// returned by BeginWebAuthnRegistration.
+func (s *Service) FinishWebAuthnRegistration(ctx context.Context, name string, response webauthn.AttestationResponse) (_ *WebAuthnCredential, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "register WebAuthn credential")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if name == "" || len(name) > maxWebAuthnCredentialNameLength {
+		return nil, ErrValidation.New(webAuthnNameInvalidErrMsg)
+	}
+
+	credentials, err := s.store.WebAuthnCredentials().GetByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if len(credentials) >= MaxWebAuthnCredentials {
+		return nil, ErrValidation.New(webAuthnLimitErrMsg)
+	}
+	for _, credential := range credentials {
+		if credential.Name == name {
+			return nil, ErrValidation.New(webAuthnNameUsedErrMsg)
+		}
+	}
+
+	rp, err := s.webAuthnRelyingParty()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	verified, err := rp.VerifyRegistration(response, s.verifyWebAuthnChallenge(ctx, user.ID, webAuthnPurposeRegistration))
+	if err != nil {
+		if webauthn.ErrVerification.Has(err) {
+			return nil, ErrValidation.Wrap(ErrMFAWebAuthn.Wrap(err))
+		}
+		return nil, Error.Wrap(err)
+	}
+	for _, credential := range credentials {
+		if bytes.Equal(credential.CredentialID, verified.ID) {
+			return nil, ErrValidation.New(webAuthnCredentialUsedErrMsg)
+		}
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	credential := &WebAuthnCredential{
+		ID:           id,
+		UserID:       user.ID,
+		Name:         name,
+		CredentialID: verified.ID,
+		PublicKey:    verified.PublicKey,
+		SignCount:    verified.SignCount,
+	}
+	if err := s.store.WebAuthnCredentials().Insert(ctx, credential); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return credential, nil
+}
+
+// GetWebAuthnCredentials returns the WebAuthn credentials of the user.
+func (s *Service) GetWebAuthnCredentials(ctx context.Context) (_ []WebAuthnCredential, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get WebAuthn credentials")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	credentials, err := s.store.WebAuthnCredentials().GetByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return credentials, nil
+}
+
+// BeginWebAuthnCredentialDeletion returns the options for getting the WebAuthn assertion, which is
+// passed to DeleteWebAuthnCredential to confirm the deletion.
+func (s *Service) BeginWebAuthnCredentialDeletion(ctx context.Context) (_ *webauthn.CredentialRequestOptions, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "begin WebAuthn credential deletion")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	credentials, err := s.store.WebAuthnCredentials().GetByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return s.webAuthnRequestOptions(ctx, user.ID, webAuthnPurposeDeletion, credentials)
+}
+
+// DeleteWebAuthnCredential deletes a WebAuthn credential of the user if the given second factor is valid.
+// The second factor is an assertion for the options returned by BeginWebAuthnCredentialDeletion or,
+// if TOTP is enabled, a passcode or a recovery code.
+func (s *Service) DeleteWebAuthnCredential(ctx context.Context, id uuid.UUID, passcode string, recoveryCode string, assertion *webauthn.AssertionResponse, t time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "delete WebAuthn credential")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	credentials, err := s.store.WebAuthnCredentials().GetByUserID(ctx, user.ID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	factors := 0
+	for _, provided := range []bool{recoveryCode != "", passcode != "", assertion != nil} {
+		if provided {
+			factors++
+		}
+	}
+	if factors > 1 {
+		return ErrMFAConflict.New(mfaConflictErrMsg)
+	}
+
+	if assertion != nil {
+		err = s.verifyWebAuthnAssertion(ctx, user.ID, credentials, webAuthnPurposeDeletion, *assertion)
+		if err != nil {
+			if ErrMFAWebAuthn.Has(err) {
+				return ErrValidation.Wrap(ErrMFAWebAuthn.New(mfaWebAuthnInvalidErrMsg))
+			}
+			return err
+		}
+	} else if !user.MFAEnabled {
+		// only security keys are registered, so the passcodes and the recovery codes aren't second factors.
+		return ErrMFAMissing.New(mfaWebAuthnRequiredErrMsg)
+	} else if recoveryCode != "" {
+		found := false
+		for _, code := range user.MFARecoveryCodes {
+			if code == recoveryCode {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return ErrMFARecoveryCode.New(mfaRecoveryInvalidErrMsg)
+		}
+	} else if passcode != "" {
+		valid, err := ValidateMFAPasscode(passcode, user.MFASecretKey, t)
+		if err != nil {
+			return ErrValidation.Wrap(ErrMFAPasscode.Wrap(err))
+		}
+		if !valid {
+			return ErrValidation.Wrap(ErrMFAPasscode.New(mfaPasscodeInvalidErrMsg))
+		}
+	} else {
+		return ErrMFAMissing.New(mfaRequiredErrMsg)
+	}
+
+	err = s.store.WebAuthnCredentials().Delete(ctx, user.ID, id)
+	if errs.Is(err, sql.ErrNoRows) {
+		return ErrWebAuthnCredentialNotFound.New("%s", id)
+	}
+	return Error.Wrap(err)
+}
+
+// BeginWebAuthnLogin returns the options for getting the WebAuthn assertion, which is
+// passed to Token as the second factor.
+//
+// The options for unknown users are the same as for users without credentials. The options
+// list the credentials of the user, so they reveal whether the account of the email has
+// any security keys registered.
+func (s *Service) BeginWebAuthnLogin(ctx context.Context, email string) (_ *webauthn.CredentialRequestOptions, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var userID uuid.UUID
+	var credentials []WebAuthnCredential
+
+	user, err := s.store.Users().GetByEmail(ctx, email)
+	switch {
+	case err == nil:
+		userID = user.ID
+		credentials, err = s.store.WebAuthnCredentials().GetByUserID(ctx, user.ID)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+	case !errs.Is(err, sql.ErrNoRows):
+		return nil, Error.Wrap(err)
+	}
+
+	return s.webAuthnRequestOptions(ctx, userID, webAuthnPurposeLogin, credentials)
+}
+
+// BeginWebAuthnPasswordReset returns the options for getting the WebAuthn assertion, which is
+// passed to ResetPassword as the second factor.
+func (s *Service) BeginWebAuthnPasswordReset(ctx context.Context, resetPasswordToken string) (_ *webauthn.CredentialRequestOptions, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	secret, err := ResetPasswordSecretFromBase64(resetPasswordToken)
+	if err != nil {
+		return nil, ErrRecoveryToken.Wrap(err)
+	}
+	token, err := s.store.ResetPasswordTokens().GetBySecret(ctx, secret)
+	if err != nil {
+		return nil, ErrRecoveryToken.Wrap(err)
+	}
+
+	credentials, err := s.store.WebAuthnCredentials().GetByUserID(ctx, *token.OwnerID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return s.webAuthnRequestOptions(ctx, *token.OwnerID, webAuthnPurposePasswordReset, credentials)
+}
+
+func (s *Service) webAuthnRequestOptions(ctx context.Context, userID uuid.UUID, purpose string, credentials []WebAuthnCredential) (*webauthn.CredentialRequestOptions, error) {
+	rp, err := s.webAuthnRelyingParty()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	challenge, err := s.createWebAuthnChallenge(ctx, userID, purpose)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return rp.RequestOptions(challenge, credentialIDs(credentials), webAuthnTimeout), nil
+}
+
+// verifyWebAuthnAssertion verifies the assertion made with one of the credentials of the user
+// and updates the signature counter of the credential.
+func (s *Service) verifyWebAuthnAssertion(ctx context.Context, userID uuid.UUID, credentials []WebAuthnCredential, purpose string, assertion webauthn.AssertionResponse) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var credential *WebAuthnCredential
+	for i := range credentials {
+		if bytes.Equal(credentials[i].CredentialID, assertion.RawID) {
+			credential = &credentials[i]
+			break
+		}
+	}
+	if credential == nil {
+		return ErrMFAWebAuthn.New(mfaWebAuthnInvalidErrMsg)
+	}
+
+	rp, err := s.webAuthnRelyingParty()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	signCount, err := rp.VerifyAssertion(assertion, credential.PublicKey, credential.SignCount, s.verifyWebAuthnChallenge(ctx, userID, purpose))
+	if err != nil {
+		if webauthn.ErrVerification.Has(err) {
+			return ErrMFAWebAuthn.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(s.store.WebAuthnCredentials().UpdateUsage(ctx, credential.ID, signCount, time.Now()))
+}
+
+// webAuthnRelyingParty returns the relying party of the console.
+func (s *Service) webAuthnRelyingParty() (webauthn.RelyingParty, error) {
+	rp, err := webauthn.NewRelyingParty(s.satelliteName, s.satelliteAddress)
+	if err != nil {
+		return rp, err
+	}
+	if rp.Name == "" {
+		rp.Name = rp.ID
+	}
+	return rp, nil
+}
+
+// createWebAuthnChallenge returns a signed challenge for the WebAuthn ceremony of the user.
+func (s *Service) createWebAuthnChallenge(ctx context.Context, userID uuid.UUID, purpose string) ([]byte, error) {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	expiration := time.Now().Add(webAuthnTimeout)
+
+	payload, err := json.Marshal(webAuthnChallenge{
+		UserID:     userID,
+		Purpose:    purpose,
+		Nonce:      nonce,
+		Expiration: expiration,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.store.WebAuthnChallenges().Insert(ctx, nonce, expiration); err != nil {
+		return nil, err
+	}
+
+	token := consoleauth.Token{Payload: payload}
+	token.Signature, err = s.tokens.SignToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(token.String()), nil
+}
+
+// verifyWebAuthnChallenge returns a function, which checks that a challenge was issued
+// for the WebAuthn ceremony of the user and that it hasn't expired or been used before.
+// The challenge is consumed, so it can't be used again.
+func (s *Service) verifyWebAuthnChallenge(ctx context.Context, userID uuid.UUID, purpose string) func(challenge []byte) error {
+	return func(challenge []byte) error {
+		token, err := consoleauth.FromBase64URLString(string(challenge))
+		if err != nil {
+			return err
+		}
+
+		valid, err := s.tokens.ValidateToken(token)
+		if err != nil {
+			return err
+		}
+		if !valid {
+			return errs.New("invalid challenge signature")
+		}
+
+		var payload webAuthnChallenge
+		if err := json.Unmarshal(token.Payload, &payload); err != nil {
+			return err
+		}
+		if payload.UserID != userID || payload.Purpose != purpose {
+			return errs.New("challenge was issued for another ceremony")
+		}
+		now := time.Now()
+		if now.After(payload.Expiration) {
+			return errs.New("challenge has expired")
+		}
+
+		err = s.store.WebAuthnChallenges().Consume(ctx, payload.Nonce, now)
+		if errs.Is(err, sql.ErrNoRows) {
+			return errs.New("challenge has already been used")
+		}
+		return err
+	}
+}
+
+func credentialIDs(credentials []WebAuthnCredential) [][]byte {
+	ids := make([][]byte, 0, len(credentials))
+	for _, credential := range credentials {
+		ids = append(ids, credential.CredentialID)
+	}
+	return ids
+}
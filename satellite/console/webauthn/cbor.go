@@ -0,0 +1,160 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package webauthn
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// maxCBORDepth limits the nesting of the decoded CBOR items.
+const maxCBORDepth = 16
+
+// decodeCBOR decodes the first CBOR item of data and returns the remaining bytes.
+//
+// Only the subset of CBOR used by authenticators is supported: integers, byte and
+// text strings, arrays, maps, tags, booleans, null and floats with definite lengths.
+// Integers are decoded to int64, maps to map[interface{}]interface{}.
+func decodeCBOR(data []byte) (value interface{}, rest []byte, err error) {
+	return decodeCBORItem(data, 0)
+}
+
+func decodeCBORItem(data []byte, depth int) (value interface{}, rest []byte, err error) {
+	if depth > maxCBORDepth {
+		return nil, nil, Error.New("cbor: nesting too deep")
+	}
+	if len(data) == 0 {
+		return nil, nil, Error.New("cbor: unexpected end of data")
+	}
+
+	major, info := data[0]>>5, data[0]&0x1f
+	data = data[1:]
+
+	if major == 7 {
+		switch info {
+		case 20:
+			return false, data, nil
+		case 21:
+			return true, data, nil
+		case 22, 23:
+			return nil, data, nil
+		}
+	}
+
+	var arg uint64
+	switch {
+	case info < 24:
+		arg = uint64(info)
+	case info <= 27:
+		size := 1 << (info - 24)
+		if len(data) < size {
+			return nil, nil, Error.New("cbor: unexpected end of data")
+		}
+		switch size {
+		case 1:
+			arg = uint64(data[0])
+		case 2:
+			arg = uint64(binary.BigEndian.Uint16(data))
+		case 4:
+			arg = uint64(binary.BigEndian.Uint32(data))
+		case 8:
+			arg = binary.BigEndian.Uint64(data)
+		}
+		data = data[size:]
+	default:
+		return nil, nil, Error.New("cbor: unsupported additional info %d", info)
+	}
+
+	switch major {
+	case 0:
+		if arg > math.MaxInt64 {
+			return nil, nil, Error.New("cbor: integer overflow")
+		}
+		return int64(arg), data, nil
+	case 1:
+		if arg > math.MaxInt64 {
+			return nil, nil, Error.New("cbor: integer overflow")
+		}
+		return -1 - int64(arg), data, nil
+	case 2, 3:
+		if arg > uint64(len(data)) {
+			return nil, nil, Error.New("cbor: unexpected end of data")
+		}
+		if major == 2 {
+			return append([]byte(nil), data[:arg]...), data[arg:], nil
+		}
+		return string(data[:arg]), data[arg:], nil
+	case 4:
+		// every item takes at least one byte.
+		if arg > uint64(len(data)) {
+			return nil, nil, Error.New("cbor: unexpected end of data")
+		}
+		items := make([]interface{}, 0, arg)
+		for i := uint64(0); i < arg; i++ {
+			var item interface{}
+			item, data, err = decodeCBORItem(data, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+			items = append(items, item)
+		}
+		return items, data, nil
+	case 5:
+		if arg > uint64(len(data))/2 {
+			return nil, nil, Error.New("cbor: unexpected end of data")
+		}
+		items := make(map[interface{}]interface{}, arg)
+		for i := uint64(0); i < arg; i++ {
+			var key, item interface{}
+			key, data, err = decodeCBORItem(data, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+			switch key.(type) {
+			case int64, string:
+			default:
+				return nil, nil, Error.New("cbor: unsupported map key type %T", key)
+			}
+			item, data, err = decodeCBORItem(data, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+			items[key] = item
+		}
+		return items, data, nil
+	case 6:
+		// the semantics of tags aren't needed, only the tagged item.
+		return decodeCBORItem(data, depth+1)
+	default: // 7
+		switch info {
+		case 25:
+			return float64(halfToFloat32(uint16(arg))), data, nil
+		case 26:
+			return float64(math.Float32frombits(uint32(arg))), data, nil
+		case 27:
+			return math.Float64frombits(arg), data, nil
+		}
+		return nil, nil, Error.New("cbor: unsupported simple value %d", arg)
+	}
+}
+
+// halfToFloat32 converts an IEEE 754 half precision float.
+func halfToFloat32(h uint16) float32 {
+	sign := uint32(h>>15) << 31
+	exp := uint32(h>>10) & 0x1f
+	frac := uint32(h) & 0x3ff
+
+	switch {
+	case exp == 0x1f:
+		return math.Float32frombits(sign | 0xff<<23 | frac<<13)
+	case exp == 0:
+		value := float32(frac) / (1 << 24)
+		if sign != 0 {
+			return -value
+		}
+		return value
+	default:
+		return math.Float32frombits(sign | (exp+127-15)<<23 | frac<<13)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package webauthn
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeCBOR(t *testing.T) {
+	for _, tt := range []struct {
+		hex      string
+		expected interface{}
+	}{
+		// examples from RFC 8949, Appendix A.
+		{"00", int64(0)},
+		{"17", int64(23)},
+		{"1818", int64(24)},
+		{"1903e8", int64(1000)},
+		{"1b000000e8d4a51000", int64(1000000000000)},
+		{"20", int64(-1)},
+		{"3903e7", int64(-1000)},
+		{"f4", false},
+		{"f5", true},
+		{"f6", nil},
+		{"f93c00", float64(1)},
+		{"fa47c35000", float64(100000)},
+		{"fb3ff199999999999a", 1.1},
+		{"4401020304", []byte{1, 2, 3, 4}},
+		{"6449455446", "IETF"},
+		{"83010203", []interface{}{int64(1), int64(2), int64(3)}},
+		{"a201020304", map[interface{}]interface{}{int64(1): int64(2), int64(3): int64(4)}},
+		{"a26161016162820203", map[interface{}]interface{}{"a": int64(1), "b": []interface{}{int64(2), int64(3)}}},
+		{"c074323031332d30332d32315432303a30343a30305a", "2013-03-21T20:04:00Z"},
+	} {
+		data, err := hex.DecodeString(tt.hex)
+		require.NoError(t, err)
+
+		value, rest, err := decodeCBOR(data)
+		require.NoError(t, err, tt.hex)
+		require.Empty(t, rest, tt.hex)
+		require.Equal(t, tt.expected, value, tt.hex)
+	}
+
+	value, rest, err := decodeCBOR([]byte{0x01, 0x02})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), value)
+	require.Equal(t, []byte{0x02}, rest)
+
+	for _, invalid := range []string{
+		"",
+		"18",                                   // missing argument
+		"1bffffffffffffffff",                   // integer overflow
+		"45010203",                             // byte string longer than the data
+		"9f01ff",                               // indefinite length array
+		"a1830102030405",                       // array as map key
+		"9affffffff",                           // array longer than the data
+		"818181818181818181818181818181818101", // too deep
+	} {
+		data, err := hex.DecodeString(invalid)
+		require.NoError(t, err)
+
+		_, _, err = decodeCBOR(data)
+		require.Error(t, err, invalid)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"math/big"
+)
+
+// COSE algorithm identifiers.
+const (
+	// AlgES256 is ECDSA with the P-256 curve and SHA-256.
+	AlgES256 int64 = -7
+	// AlgEdDSA is EdDSA with the Ed25519 curve.
+	AlgEdDSA int64 = -8
+	// AlgRS256 is RSASSA-PKCS1-v1_5 with SHA-256.
+	AlgRS256 int64 = -257
+)
+
+// supportedAlgorithms are the signature algorithms in the order of preference.
+var supportedAlgorithms = []int64{AlgES256, AlgEdDSA, AlgRS256}
+
+// COSE key parameters.
+const (
+	coseKeyType  int64 = 1
+	coseKeyAlg   int64 = 3
+	coseKeyCurve int64 = -1
+	coseKeyX     int64 = -2
+	coseKeyY     int64 = -3
+	coseKeyN     int64 = -1
+	coseKeyE     int64 = -2
+
+	coseKeyTypeOKP int64 = 1
+	coseKeyTypeEC2 int64 = 2
+	coseKeyTypeRSA int64 = 3
+
+	coseCurveP256    int64 = 1
+	coseCurveEd25519 int64 = 6
+)
+
+// parseCOSEKey parses a COSE encoded public key and returns the key and its algorithm.
+func parseCOSEKey(data []byte) (_ crypto.PublicKey, alg int64, err error) {
+	decoded, rest, err := decodeCBOR(data)
+	if err != nil {
+		return nil, 0, ErrVerification.Wrap(err)
+	}
+	if len(rest) != 0 {
+		return nil, 0, ErrVerification.New("trailing data after the public key")
+	}
+	params, ok := decoded.(map[interface{}]interface{})
+	if !ok {
+		return nil, 0, ErrVerification.New("public key is not a map")
+	}
+
+	integer := func(label int64) int64 {
+		value, _ := params[label].(int64)
+		return value
+	}
+	byteString := func(label int64) []byte {
+		value, _ := params[label].([]byte)
+		return value
+	}
+
+	alg = integer(coseKeyAlg)
+	switch kty := integer(coseKeyType); {
+	case kty == coseKeyTypeEC2 && alg == AlgES256:
+		if integer(coseKeyCurve) != coseCurveP256 {
+			return nil, 0, ErrVerification.New("unsupported curve %d", integer(coseKeyCurve))
+		}
+		x := new(big.Int).SetBytes(byteString(coseKeyX))
+		y := new(big.Int).SetBytes(byteString(coseKeyY))
+		if !elliptic.P256().IsOnCurve(x, y) {
+			return nil, 0, ErrVerification.New("point is not on curve")
+		}
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, alg, nil
+	case kty == coseKeyTypeOKP && alg == AlgEdDSA:
+		if integer(coseKeyCurve) != coseCurveEd25519 {
+			return nil, 0, ErrVerification.New("unsupported curve %d", integer(coseKeyCurve))
+		}
+		x := byteString(coseKeyX)
+		if len(x) != ed25519.PublicKeySize {
+			return nil, 0, ErrVerification.New("invalid Ed25519 public key")
+		}
+		return ed25519.PublicKey(x), alg, nil
+	case kty == coseKeyTypeRSA && alg == AlgRS256:
+		n := new(big.Int).SetBytes(byteString(coseKeyN))
+		e := new(big.Int).SetBytes(byteString(coseKeyE))
+		if n.BitLen() < 2048 || !e.IsInt64() || e.Int64() < 3 || e.Int64() > 1<<31-1 {
+			return nil, 0, ErrVerification.New("invalid RSA public key")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, alg, nil
+	default:
+		return nil, 0, ErrVerification.New("unsupported key type %d with algorithm %d", kty, alg)
+	}
+}
+
+// verifySignature verifies the signature of the data made with the key of the given algorithm.
+func verifySignature(key crypto.PublicKey, alg int64, data, signature []byte) error {
+	var valid bool
+	switch alg {
+	case AlgES256:
+		digest := sha256.Sum256(data)
+		valid = ecdsa.VerifyASN1(key.(*ecdsa.PublicKey), digest[:], signature)
+	case AlgEdDSA:
+		valid = ed25519.Verify(key.(ed25519.PublicKey), data, signature)
+	case AlgRS256:
+		digest := sha256.Sum256(data)
+		valid = rsa.VerifyPKCS1v15(key.(*rsa.PublicKey), crypto.SHA256, digest[:], signature) == nil
+	}
+	if !valid {
+		return ErrVerification.New("invalid signature")
+	}
+	return nil
+}
@@ -0,0 +1,387 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package webauthn
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+var (
+	// Error is the default error class for the webauthn package.
+	Error = errs.Class("webauthn")
+	// ErrVerification is the error class for credentials and assertions which fail the verification.
+	ErrVerification = errs.Class("webauthn verification")
+)
+
+// Authenticator data flags.
+const (
+	flagUserPresent            = 0x01
+	flagAttestedCredentialData = 0x40
+)
+
+// publicKeyCredentialType is the only credential type defined by WebAuthn.
+const publicKeyCredentialType = "public-key"
+
+// URLEncodedBase64 is binary data, which is encoded as unpadded base64url in JSON.
+type URLEncodedBase64 []byte
+
+// MarshalJSON implements json.Marshaler.
+func (data URLEncodedBase64) MarshalJSON() ([]byte, error) {
+	if data == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(base64.RawURLEncoding.EncodeToString(data))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Padded values are accepted too.
+func (data *URLEncodedBase64) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		*data = nil
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return err
+	}
+	*data = decoded
+	return nil
+}
+
+// RelyingParty identifies the console to the authenticators.
+type RelyingParty struct {
+	// ID is the domain of the console, which scopes the credentials.
+	ID   string
+	Name string
+	// Origin is the origin of the console pages, which call the WebAuthn API.
+	Origin string
+}
+
+// NewRelyingParty returns the relying party of the console served at externalAddress.
+func NewRelyingParty(name, externalAddress string) (RelyingParty, error) {
+	parsed, err := url.Parse(externalAddress)
+	if err != nil {
+		return RelyingParty{}, Error.Wrap(err)
+	}
+	if parsed.Scheme == "" || parsed.Hostname() == "" {
+		return RelyingParty{}, Error.New("invalid external address %q", externalAddress)
+	}
+
+	return RelyingParty{
+		ID:     parsed.Hostname(),
+		Name:   name,
+		Origin: parsed.Scheme + "://" + parsed.Host,
+	}, nil
+}
+
+// CredentialCreationOptions are the options for creating a credential,
+// which are passed to navigator.credentials.create as the publicKey member.
+type CredentialCreationOptions struct {
+	RP                     RelyingPartyEntity     `json:"rp"`
+	User                   UserEntity             `json:"user"`
+	Challenge              URLEncodedBase64       `json:"challenge"`
+	PubKeyCredParams       []CredentialParameter  `json:"pubKeyCredParams"`
+	Timeout                int64                  `json:"timeout"`
+	ExcludeCredentials     []CredentialDescriptor `json:"excludeCredentials"`
+	AuthenticatorSelection AuthenticatorSelection `json:"authenticatorSelection"`
+	Attestation            string                 `json:"attestation"`
+}
+
+// CredentialRequestOptions are the options for getting an assertion,
+// which are passed to navigator.credentials.get as the publicKey member.
+type CredentialRequestOptions struct {
+	Challenge        URLEncodedBase64       `json:"challenge"`
+	Timeout          int64                  `json:"timeout"`
+	RPID             string                 `json:"rpId"`
+	AllowCredentials []CredentialDescriptor `json:"allowCredentials"`
+	UserVerification string                 `json:"userVerification"`
+}
+
+// RelyingPartyEntity describes the relying party in the credential creation options.
+type RelyingPartyEntity struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// UserEntity describes the user in the credential creation options.
+type UserEntity struct {
+	ID          URLEncodedBase64 `json:"id"`
+	Name        string           `json:"name"`
+	DisplayName string           `json:"displayName"`
+}
+
+// CredentialParameter is a supported credential type and signature algorithm.
+type CredentialParameter struct {
+	Type string `json:"type"`
+	Alg  int64  `json:"alg"`
+}
+
+// CredentialDescriptor identifies a credential.
+type CredentialDescriptor struct {
+	Type string           `json:"type"`
+	ID   URLEncodedBase64 `json:"id"`
+}
+
+// AuthenticatorSelection are the requirements for the authenticators.
+type AuthenticatorSelection struct {
+	ResidentKey      string `json:"residentKey"`
+	UserVerification string `json:"userVerification"`
+}
+
+// CreationOptions returns the options for creating a credential for the user. The credentials,
+// which are already registered, are excluded, so an authenticator is registered only once.
+func (rp RelyingParty) CreationOptions(challenge, userHandle []byte, name, displayName string, exclude [][]byte, timeout time.Duration) *CredentialCreationOptions {
+	params := make([]CredentialParameter, 0, len(supportedAlgorithms))
+	for _, alg := range supportedAlgorithms {
+		params = append(params, CredentialParameter{Type: publicKeyCredentialType, Alg: alg})
+	}
+
+	return &CredentialCreationOptions{
+		RP:                 RelyingPartyEntity{ID: rp.ID, Name: rp.Name},
+		User:               UserEntity{ID: userHandle, Name: name, DisplayName: displayName},
+		Challenge:          challenge,
+		PubKeyCredParams:   params,
+		Timeout:            timeout.Milliseconds(),
+		ExcludeCredentials: descriptors(exclude),
+		AuthenticatorSelection: AuthenticatorSelection{
+			// the credentials are a second factor, the password identifies the user.
+			ResidentKey:      "discouraged",
+			UserVerification: "discouraged",
+		},
+		Attestation: "none",
+	}
+}
+
+// RequestOptions returns the options for getting an assertion with one of the allowed credentials.
+func (rp RelyingParty) RequestOptions(challenge []byte, allow [][]byte, timeout time.Duration) *CredentialRequestOptions {
+	return &CredentialRequestOptions{
+		Challenge:        challenge,
+		Timeout:          timeout.Milliseconds(),
+		RPID:             rp.ID,
+		AllowCredentials: descriptors(allow),
+		UserVerification: "discouraged",
+	}
+}
+
+func descriptors(ids [][]byte) []CredentialDescriptor {
+	list := make([]CredentialDescriptor, 0, len(ids))
+	for _, id := range ids {
+		list = append(list, CredentialDescriptor{Type: publicKeyCredentialType, ID: id})
+	}
+	return list
+}
+
+// AttestationResponse is the credential returned by navigator.credentials.create.
+type AttestationResponse struct {
+	ID       string                           `json:"id"`
+	RawID    URLEncodedBase64                 `json:"rawId"`
+	Type     string                           `json:"type"`
+	Response AuthenticatorAttestationResponse `json:"response"`
+}
+
+// AuthenticatorAttestationResponse is the response of the authenticator to a credential creation.
+type AuthenticatorAttestationResponse struct {
+	ClientDataJSON    URLEncodedBase64 `json:"clientDataJSON"`
+	AttestationObject URLEncodedBase64 `json:"attestationObject"`
+}
+
+// AssertionResponse is the credential returned by navigator.credentials.get.
+type AssertionResponse struct {
+	ID       string                         `json:"id"`
+	RawID    URLEncodedBase64               `json:"rawId"`
+	Type     string                         `json:"type"`
+	Response AuthenticatorAssertionResponse `json:"response"`
+}
+
+// AuthenticatorAssertionResponse is the response of the authenticator to an assertion request.
+type AuthenticatorAssertionResponse struct {
+	ClientDataJSON    URLEncodedBase64 `json:"clientDataJSON"`
+	AuthenticatorData URLEncodedBase64 `json:"authenticatorData"`
+	Signature         URLEncodedBase64 `json:"signature"`
+	UserHandle        URLEncodedBase64 `json:"userHandle"`
+}
+
+// Credential is a verified new credential.
+type Credential struct {
+	ID []byte
+	// PublicKey is the COSE encoded public key of the credential.
+	PublicKey []byte
+	SignCount uint32
+}
+
+// clientData is the part of the client data checked by the relying party.
+type clientData struct {
+	Type      string           `json:"type"`
+	Challenge URLEncodedBase64 `json:"challenge"`
+	Origin    string           `json:"origin"`
+}
+
+// authenticatorData is the parsed authenticator data.
+type authenticatorData struct {
+	rpIDHash  []byte
+	flags     byte
+	signCount uint32
+
+	credentialID        []byte
+	credentialPublicKey []byte
+}
+
+// VerifyRegistration verifies the credential created by the authenticator. verifyChallenge
+// must check that the challenge was issued by the console for the registration.
+//
+// The attestation statement isn't verified, because any authenticator model is accepted.
+func (rp RelyingParty) VerifyRegistration(response AttestationResponse, verifyChallenge func(challenge []byte) error) (_ *Credential, err error) {
+	if response.Type != publicKeyCredentialType {
+		return nil, ErrVerification.New("unexpected credential type %q", response.Type)
+	}
+
+	if err := rp.verifyClientData(response.Response.ClientDataJSON, "webauthn.create", verifyChallenge); err != nil {
+		return nil, err
+	}
+
+	decoded, _, err := decodeCBOR(response.Response.AttestationObject)
+	if err != nil {
+		return nil, ErrVerification.Wrap(err)
+	}
+	attestation, ok := decoded.(map[interface{}]interface{})
+	if !ok {
+		return nil, ErrVerification.New("attestation object is not a map")
+	}
+	rawAuthData, ok := attestation["authData"].([]byte)
+	if !ok {
+		return nil, ErrVerification.New("attestation object has no authenticator data")
+	}
+
+	authData, err := rp.parseAuthenticatorData(rawAuthData)
+	if err != nil {
+		return nil, err
+	}
+	if authData.flags&flagAttestedCredentialData == 0 {
+		return nil, ErrVerification.New("authenticator data has no credential")
+	}
+	if !bytes.Equal(authData.credentialID, response.RawID) {
+		return nil, ErrVerification.New("credential ID mismatch")
+	}
+	if _, _, err := parseCOSEKey(authData.credentialPublicKey); err != nil {
+		return nil, err
+	}
+
+	return &Credential{
+		ID:        authData.credentialID,
+		PublicKey: authData.credentialPublicKey,
+		SignCount: authData.signCount,
+	}, nil
+}
+
+// VerifyAssertion verifies the assertion signed with the credential, whose COSE encoded public key and
+// last signature counter are given. verifyChallenge must check that the challenge was issued by the
+// console for the assertion. The new signature counter of the credential is returned.
+func (rp RelyingParty) VerifyAssertion(response AssertionResponse, publicKey []byte, signCount uint32, verifyChallenge func(challenge []byte) error) (_ uint32, err error) {
+	if response.Type != publicKeyCredentialType {
+		return 0, ErrVerification.New("unexpected credential type %q", response.Type)
+	}
+
+	if err := rp.verifyClientData(response.Response.ClientDataJSON, "webauthn.get", verifyChallenge); err != nil {
+		return 0, err
+	}
+
+	authData, err := rp.parseAuthenticatorData(response.Response.AuthenticatorData)
+	if err != nil {
+		return 0, err
+	}
+
+	key, alg, err := parseCOSEKey(publicKey)
+	if err != nil {
+		return 0, err
+	}
+
+	clientDataHash := sha256.Sum256(response.Response.ClientDataJSON)
+	signed := append(append([]byte(nil), response.Response.AuthenticatorData...), clientDataHash[:]...)
+	if err := verifySignature(key, alg, signed, response.Response.Signature); err != nil {
+		return 0, err
+	}
+
+	// authenticators which don't implement the counter always return 0.
+	if (authData.signCount != 0 || signCount != 0) && authData.signCount <= signCount {
+		return 0, ErrVerification.New("signature counter did not increase; the authenticator may be cloned")
+	}
+
+	return authData.signCount, nil
+}
+
+func (rp RelyingParty) verifyClientData(data []byte, expectedType string, verifyChallenge func(challenge []byte) error) error {
+	var client clientData
+	if err := json.Unmarshal(data, &client); err != nil {
+		return ErrVerification.Wrap(err)
+	}
+
+	if client.Type != expectedType {
+		return ErrVerification.New("unexpected client data type %q", client.Type)
+	}
+	if client.Origin != rp.Origin {
+		return ErrVerification.New("unexpected origin %q", client.Origin)
+	}
+	if err := verifyChallenge(client.Challenge); err != nil {
+		return ErrVerification.Wrap(err)
+	}
+	return nil
+}
+
+func (rp RelyingParty) parseAuthenticatorData(data []byte) (*authenticatorData, error) {
+	if len(data) < 37 {
+		return nil, ErrVerification.New("authenticator data is too short")
+	}
+
+	authData := &authenticatorData{
+		rpIDHash:  data[:32],
+		flags:     data[32],
+		signCount: binary.BigEndian.Uint32(data[33:37]),
+	}
+
+	rpIDHash := sha256.Sum256([]byte(rp.ID))
+	if subtle.ConstantTimeCompare(authData.rpIDHash, rpIDHash[:]) != 1 {
+		return nil, ErrVerification.New("credential is scoped to another relying party")
+	}
+	if authData.flags&flagUserPresent == 0 {
+		return nil, ErrVerification.New("user is not present")
+	}
+
+	if authData.flags&flagAttestedCredentialData != 0 {
+		// aaguid (16 bytes) and credential ID length (2 bytes).
+		rest := data[37:]
+		if len(rest) < 18 {
+			return nil, ErrVerification.New("attested credential data is too short")
+		}
+		length := int(binary.BigEndian.Uint16(rest[16:18]))
+		rest = rest[18:]
+		if len(rest) < length {
+			return nil, ErrVerification.New("attested credential data is too short")
+		}
+		authData.credentialID = rest[:length]
+		rest = rest[length:]
+
+		_, extensions, err := decodeCBOR(rest)
+		if err != nil {
+			return nil, ErrVerification.Wrap(err)
+		}
+		authData.credentialPublicKey = rest[:len(rest)-len(extensions)]
+	}
+
+	return authData, nil
+}
@@ -0,0 +1,136 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package webauthn_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/console/webauthn"
+	"storj.io/storj/satellite/console/webauthn/webauthntest"
+)
+
+func TestRegistrationAndAssertion(t *testing.T) {
+	rp, err := webauthn.NewRelyingParty("Satellite", "https://console.example.test:10100/")
+	require.NoError(t, err)
+	require.Equal(t, webauthn.RelyingParty{
+		ID:     "console.example.test",
+		Name:   "Satellite",
+		Origin: "https://console.example.test:10100",
+	}, rp)
+
+	authenticator, err := webauthntest.NewAuthenticator(rp.Origin)
+	require.NoError(t, err)
+
+	challenge := []byte("challenge")
+	expectChallenge := func(expected []byte) func([]byte) error {
+		return func(challenge []byte) error {
+			if !bytes.Equal(expected, challenge) {
+				return errors.New("unexpected challenge")
+			}
+			return nil
+		}
+	}
+
+	creationOptions := rp.CreationOptions(challenge, []byte("user"), "user@mail.test", "User", nil, time.Minute)
+	attestation, err := authenticator.Create(creationOptions)
+	require.NoError(t, err)
+
+	// the responses are sent by the browser as JSON.
+	data, err := json.Marshal(attestation)
+	require.NoError(t, err)
+	var received webauthn.AttestationResponse
+	require.NoError(t, json.Unmarshal(data, &received))
+
+	credential, err := rp.VerifyRegistration(received, expectChallenge(challenge))
+	require.NoError(t, err)
+	require.Equal(t, authenticator.CredentialID(), credential.ID)
+	require.Equal(t, authenticator.PublicKey(), credential.PublicKey)
+	require.EqualValues(t, 1, credential.SignCount)
+
+	_, err = rp.VerifyRegistration(received, expectChallenge([]byte("other")))
+	require.True(t, webauthn.ErrVerification.Has(err), err)
+
+	otherRP := rp
+	otherRP.ID = "example.test"
+	_, err = otherRP.VerifyRegistration(received, expectChallenge(challenge))
+	require.True(t, webauthn.ErrVerification.Has(err), err)
+
+	requestOptions := rp.RequestOptions(challenge, [][]byte{credential.ID}, time.Minute)
+	signCount := credential.SignCount
+
+	t.Run("assertion", func(t *testing.T) {
+		assertion, err := authenticator.Get(requestOptions)
+		require.NoError(t, err)
+
+		newSignCount, err := rp.VerifyAssertion(assertion, credential.PublicKey, signCount, expectChallenge(challenge))
+		require.NoError(t, err)
+		require.Equal(t, signCount+1, newSignCount)
+
+		// a replayed assertion doesn't increase the counter.
+		_, err = rp.VerifyAssertion(assertion, credential.PublicKey, newSignCount, expectChallenge(challenge))
+		require.True(t, webauthn.ErrVerification.Has(err), err)
+		signCount = newSignCount
+	})
+
+	t.Run("invalid assertions", func(t *testing.T) {
+		assertion, err := authenticator.Get(requestOptions)
+		require.NoError(t, err)
+
+		_, err = rp.VerifyAssertion(assertion, credential.PublicKey, signCount, expectChallenge([]byte("other")))
+		require.True(t, webauthn.ErrVerification.Has(err), err)
+
+		tampered := assertion
+		tampered.Response.Signature = append([]byte(nil), assertion.Response.Signature...)
+		tampered.Response.Signature[len(tampered.Response.Signature)-1] ^= 1
+		_, err = rp.VerifyAssertion(tampered, credential.PublicKey, signCount, expectChallenge(challenge))
+		require.True(t, webauthn.ErrVerification.Has(err), err)
+
+		other, err := webauthntest.NewAuthenticator(rp.Origin)
+		require.NoError(t, err)
+		_, err = rp.VerifyAssertion(assertion, other.PublicKey(), signCount, expectChallenge(challenge))
+		require.True(t, webauthn.ErrVerification.Has(err), err)
+
+		authenticator.Origin = "https://phishing.test"
+		assertion, err = authenticator.Get(requestOptions)
+		require.NoError(t, err)
+		_, err = rp.VerifyAssertion(assertion, credential.PublicKey, signCount, expectChallenge(challenge))
+		require.True(t, webauthn.ErrVerification.Has(err), err)
+		authenticator.Origin = rp.Origin
+	})
+
+	t.Run("no counter", func(t *testing.T) {
+		authenticator.SignCount = 0
+		for i := 0; i < 2; i++ {
+			assertion, err := authenticator.Get(requestOptions)
+			require.NoError(t, err)
+			newSignCount, err := rp.VerifyAssertion(assertion, credential.PublicKey, 0, expectChallenge(challenge))
+			require.NoError(t, err)
+			require.Zero(t, newSignCount)
+		}
+	})
+}
+
+func TestURLEncodedBase64(t *testing.T) {
+	var value struct {
+		Data webauthn.URLEncodedBase64 `json:"data"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(`{"data":"_-8"}`), &value))
+	require.Equal(t, []byte{0xff, 0xef}, []byte(value.Data))
+
+	require.NoError(t, json.Unmarshal([]byte(`{"data":"_-8="}`), &value))
+	require.Equal(t, []byte{0xff, 0xef}, []byte(value.Data))
+
+	data, err := json.Marshal(value)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data":"_-8"}`, string(data))
+
+	require.Error(t, json.Unmarshal([]byte(`{"data":"+/8"}`), &value))
+}
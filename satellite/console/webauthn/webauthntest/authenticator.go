@@ -0,0 +1,190 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package webauthntest implements a software authenticator for testing the WebAuthn verification.
+package webauthntest
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"sort"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/console/webauthn"
+)
+
+// Authenticator is a software authenticator with a single ES256 credential.
+type Authenticator struct {
+	// Origin is the origin the client reports in the client data.
+	Origin string
+	// SignCount is the signature counter of the credential. It is incremented on
+	// every assertion unless it's zero, as in authenticators without a counter.
+	SignCount uint32
+
+	credentialID []byte
+	key          *ecdsa.PrivateKey
+}
+
+// NewAuthenticator creates an authenticator with a new credential.
+func NewAuthenticator(origin string) (*Authenticator, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	credentialID := make([]byte, 32)
+	if _, err := rand.Read(credentialID); err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	return &Authenticator{
+		Origin:       origin,
+		SignCount:    1,
+		credentialID: credentialID,
+		key:          key,
+	}, nil
+}
+
+// CredentialID returns the ID of the credential.
+func (a *Authenticator) CredentialID() []byte { return a.credentialID }
+
+// Create returns the response to the credential creation options.
+func (a *Authenticator) Create(options *webauthn.CredentialCreationOptions) (webauthn.AttestationResponse, error) {
+	clientDataJSON, err := a.clientData("webauthn.create", options.Challenge)
+	if err != nil {
+		return webauthn.AttestationResponse{}, err
+	}
+
+	authData := a.authenticatorData(options.RP.ID, 0x41)
+	authData = append(authData, make([]byte, 16)...) // aaguid
+	authData = append(authData, byte(len(a.credentialID)>>8), byte(len(a.credentialID)))
+	authData = append(authData, a.credentialID...)
+	authData = append(authData, a.PublicKey()...)
+
+	attestationObject := encodeMap([][2][]byte{
+		{encodeText("fmt"), encodeText("none")},
+		{encodeText("attStmt"), encodeMap(nil)},
+		{encodeText("authData"), encodeBytes(authData)},
+	})
+
+	return webauthn.AttestationResponse{
+		RawID: a.credentialID,
+		Type:  "public-key",
+		Response: webauthn.AuthenticatorAttestationResponse{
+			ClientDataJSON:    clientDataJSON,
+			AttestationObject: attestationObject,
+		},
+	}, nil
+}
+
+// Get returns the assertion for the credential request options.
+func (a *Authenticator) Get(options *webauthn.CredentialRequestOptions) (webauthn.AssertionResponse, error) {
+	clientDataJSON, err := a.clientData("webauthn.get", options.Challenge)
+	if err != nil {
+		return webauthn.AssertionResponse{}, err
+	}
+
+	if a.SignCount != 0 {
+		a.SignCount++
+	}
+	authData := a.authenticatorData(options.RPID, 0x01)
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(append([]byte(nil), authData...), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		return webauthn.AssertionResponse{}, errs.Wrap(err)
+	}
+
+	return webauthn.AssertionResponse{
+		RawID: a.credentialID,
+		Type:  "public-key",
+		Response: webauthn.AuthenticatorAssertionResponse{
+			ClientDataJSON:    clientDataJSON,
+			AuthenticatorData: authData,
+			Signature:         signature,
+		},
+	}, nil
+}
+
+// PublicKey returns the COSE encoded public key of the credential.
+func (a *Authenticator) PublicKey() []byte {
+	coordinate := func(v []byte) []byte {
+		return append(make([]byte, 32-len(v)), v...)
+	}
+	return encodeMap([][2][]byte{
+		{encodeInt(1), encodeInt(2)},  // kty: EC2
+		{encodeInt(3), encodeInt(-7)}, // alg: ES256
+		{encodeInt(-1), encodeInt(1)}, // crv: P-256
+		{encodeInt(-2), encodeBytes(coordinate(a.key.X.Bytes()))},
+		{encodeInt(-3), encodeBytes(coordinate(a.key.Y.Bytes()))},
+	})
+}
+
+func (a *Authenticator) clientData(typ string, challenge []byte) ([]byte, error) {
+	data, err := json.Marshal(struct {
+		Type      string                    `json:"type"`
+		Challenge webauthn.URLEncodedBase64 `json:"challenge"`
+		Origin    string                    `json:"origin"`
+	}{typ, challenge, a.Origin})
+	return data, errs.Wrap(err)
+}
+
+func (a *Authenticator) authenticatorData(rpID string, flags byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	data := append(rpIDHash[:], flags)
+	var signCount [4]byte
+	binary.BigEndian.PutUint32(signCount[:], a.SignCount)
+	return append(data, signCount[:]...)
+}
+
+// encodeHead encodes the initial byte and the argument of a CBOR item.
+func encodeHead(major byte, arg uint64) []byte {
+	switch {
+	case arg < 24:
+		return []byte{major<<5 | byte(arg)}
+	case arg <= 0xff:
+		return []byte{major<<5 | 24, byte(arg)}
+	case arg <= 0xffff:
+		return []byte{major<<5 | 25, byte(arg >> 8), byte(arg)}
+	default:
+		var buf [9]byte
+		buf[0] = major<<5 | 27
+		binary.BigEndian.PutUint64(buf[1:], arg)
+		return buf[:]
+	}
+}
+
+func encodeInt(v int64) []byte {
+	if v < 0 {
+		return encodeHead(1, uint64(-1-v))
+	}
+	return encodeHead(0, uint64(v))
+}
+
+func encodeBytes(v []byte) []byte { return append(encodeHead(2, uint64(len(v))), v...) }
+
+func encodeText(v string) []byte { return append(encodeHead(3, uint64(len(v))), v...) }
+
+// encodeMap encodes the encoded key and value pairs in the canonical order.
+func encodeMap(pairs [][2][]byte) []byte {
+	sort.Slice(pairs, func(i, j int) bool {
+		if len(pairs[i][0]) != len(pairs[j][0]) {
+			return len(pairs[i][0]) < len(pairs[j][0])
+		}
+		return bytes.Compare(pairs[i][0], pairs[j][0]) < 0
+	})
+
+	data := encodeHead(5, uint64(len(pairs)))
+	for _, pair := range pairs {
+		data = append(data, pair[0]...)
+		data = append(data, pair[1]...)
+	}
+	return data
+}
@@ -30,14 +30,17 @@ import (
 	"storj.io/storj/satellite/accounting/tally"
 	"storj.io/storj/satellite/analytics"
 	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/bucketevents"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/dbcleanup"
 	"storj.io/storj/satellite/console/emailreminders"
+	"storj.io/storj/satellite/console/usagealerts"
 	"storj.io/storj/satellite/gc/sender"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/zombiedeletion"
+	"storj.io/storj/satellite/metainfo/bucketlifecycle"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
 	"storj.io/storj/satellite/nodeevents"
 	"storj.io/storj/satellite/overlay"
@@ -120,6 +123,15 @@ type Core struct {
 		Chore *zombiedeletion.Chore
 	}
 
+	BucketLifecycle struct {
+		Chore *bucketlifecycle.Chore
+	}
+
+	BucketEvents struct {
+		Service *bucketevents.Service
+		Chore   *bucketevents.Chore
+	}
+
 	Accounting struct {
 		Tally                 *tally.Service
 		Rollup                *rollup.Service
@@ -144,6 +156,10 @@ type Core struct {
 		Chore *dbcleanup.Chore
 	}
 
+	UsageAlerts struct {
+		Chore *usagealerts.Chore
+	}
+
 	GarbageCollection struct {
 		Sender *sender.Service
 	}
@@ -353,11 +369,33 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			debug.Cycle("Audit Containment Sync Chore", peer.Audit.ContainmentSyncChore.Loop))
 	}
 
+	{ // setup bucket event notifications
+		peer.BucketEvents.Service = bucketevents.NewService(
+			peer.Log.Named("bucketevents:service"),
+			config.BucketEvents,
+			peer.DB.Buckets(),
+		)
+
+		peer.BucketEvents.Chore = bucketevents.NewChore(
+			peer.Log.Named("bucketevents:chore"),
+			config.BucketEvents,
+			peer.Metainfo.Metabase,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "bucketevents:chore",
+			Run:   peer.BucketEvents.Chore.Run,
+			Close: peer.BucketEvents.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Bucket Events Chore", peer.BucketEvents.Chore.Loop))
+	}
+
 	{ // setup expired segment cleanup
 		peer.ExpiredDeletion.Chore = expireddeletion.NewChore(
 			peer.Log.Named("core-expired-deletion"),
 			config.ExpiredDeletion,
 			peer.Metainfo.Metabase,
+			peer.BucketEvents.Service.Resolver(),
 		)
 		peer.Services.Add(lifecycle.Item{
 			Name:  "expireddeletion:chore",
@@ -368,6 +406,23 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			debug.Cycle("Expired Segments Chore", peer.ExpiredDeletion.Chore.Loop))
 	}
 
+	{ // setup bucket lifecycle rules
+		peer.BucketLifecycle.Chore = bucketlifecycle.NewChore(
+			peer.Log.Named("core-bucket-lifecycle"),
+			config.BucketLifecycle,
+			peer.DB.Buckets(),
+			peer.Metainfo.Metabase,
+			peer.BucketEvents.Service.Resolver(),
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "bucketlifecycle:chore",
+			Run:   peer.BucketLifecycle.Chore.Run,
+			Close: peer.BucketLifecycle.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Bucket Lifecycle Chore", peer.BucketLifecycle.Chore.Loop))
+	}
+
 	{ // setup zombie objects cleanup
 		peer.ZombieDeletion.Chore = zombiedeletion.NewChore(
 			peer.Log.Named("core-zombie-deletion"),
@@ -561,6 +616,29 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		}
 	}
 
+	{ // setup project usage alerts
+		if config.UsageAlerts.Enabled {
+			peer.UsageAlerts.Chore = usagealerts.NewChore(
+				peer.Log.Named("console.usagealerts:chore"),
+				peer.DB.Console().ProjectUsageAlerts(),
+				peer.DB.Console().Projects(),
+				peer.DB.Console().Users(),
+				peer.Payments.Accounts,
+				peer.LiveAccounting.Cache,
+				peer.DB.ProjectAccounting(),
+				peer.Mail.Service,
+				config.UsageAlerts,
+				config.Console.ExternalAddress,
+			)
+
+			peer.Services.Add(lifecycle.Item{
+				Name:  "usagealerts:chore",
+				Run:   peer.UsageAlerts.Chore.Run,
+				Close: peer.UsageAlerts.Chore.Close,
+			})
+		}
+	}
+
 	// setup console DB cleanup service
 	if config.ConsoleDBCleanup.Enabled {
 		peer.ConsoleDBCleanup.Chore = dbcleanup.NewChore(
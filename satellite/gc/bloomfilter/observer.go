@@ -5,6 +5,7 @@ package bloomfilter
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -43,7 +44,7 @@ type Observer struct {
 	seed               byte
 }
 
-var _ (rangedloop.Observer) = (*Observer)(nil)
+var _ (rangedloop.CheckpointObserver) = (*Observer)(nil)
 var _ (rangedloop.Partial) = (*observerFork)(nil)
 
 // NewObserver creates a new instance of the gc rangedloop observer.
@@ -119,6 +120,73 @@ func (obs *Observer) Join(ctx context.Context, partial rangedloop.Partial) (err
 	return nil
 }
 
+// forkCheckpoint is the persisted state of an observerFork.
+//
+// The seed and the piece counts are shared by all the partials of the loop
+// iteration. They are persisted, because the bloom filters can only be merged
+// when they were created with the same parameters.
+type forkCheckpoint struct {
+	Seed               byte                    `json:"seed"`
+	PieceCounts        map[storj.NodeID]int64  `json:"piece_counts"`
+	Filters            map[storj.NodeID][]byte `json:"filters"`
+	Counts             map[storj.NodeID]int    `json:"counts"`
+	LatestCreationTime time.Time               `json:"latest_creation_time"`
+}
+
+// CheckpointPartial returns the bloom filters of the partial.
+func (obs *Observer) CheckpointPartial(ctx context.Context, partial rangedloop.Partial) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	fork, ok := partial.(*observerFork)
+	if !ok {
+		return nil, errs.New("expected %T but got %T", fork, partial)
+	}
+
+	saved := forkCheckpoint{
+		Seed:               fork.seed,
+		PieceCounts:        fork.pieceCounts,
+		Filters:            make(map[storj.NodeID][]byte, len(fork.retainInfos)),
+		Counts:             make(map[storj.NodeID]int, len(fork.retainInfos)),
+		LatestCreationTime: fork.latestCreationTime,
+	}
+	for nodeID, info := range fork.retainInfos {
+		saved.Filters[nodeID] = info.Filter.Bytes()
+		saved.Counts[nodeID] = info.Count
+	}
+	return json.Marshal(saved)
+}
+
+// RestorePartial returns a partial which continues from the checkpointed
+// bloom filters. It also restores the seed and the piece counts of the loop
+// iteration, so that the partials forked afterwards create compatible filters.
+func (obs *Observer) RestorePartial(ctx context.Context, data []byte) (_ rangedloop.Partial, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var saved forkCheckpoint
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return nil, err
+	}
+	if saved.PieceCounts == nil {
+		saved.PieceCounts = make(map[storj.NodeID]int64)
+	}
+
+	obs.seed = saved.Seed
+	obs.lastPieceCounts = saved.PieceCounts
+
+	fork := newObserverFork(obs.log.Named("gc observer"), obs.config, obs.lastPieceCounts, obs.seed, obs.startTime)
+	fork.latestCreationTime = saved.LatestCreationTime
+	for nodeID, filterData := range saved.Filters {
+		filter, err := bloomfilter.NewFromBytes(filterData)
+		if err != nil {
+			return nil, err
+		}
+		fork.retainInfos[nodeID] = &RetainInfo{
+			Filter: filter,
+			Count:  saved.Counts[nodeID],
+		}
+	}
+	return fork, nil
+}
+
 // Finish uploads the bloom filters.
 func (obs *Observer) Finish(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
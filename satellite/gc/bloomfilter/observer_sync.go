@@ -19,6 +19,9 @@ import (
 )
 
 // SyncObserver implements a rangedloop observer to collect bloom filters for the garbage collection.
+//
+// The ranges share the bloom filters, so they can't be checkpointed
+// separately and the loop iterations which use SyncObserver aren't resumable.
 type SyncObserver struct {
 	log     *zap.Logger
 	config  Config
@@ -0,0 +1,1147 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: metainfo_ext.proto
+
+package internalpb
+
+import (
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	proto "github.com/gogo/protobuf/proto"
+
+	pb "storj.io/common/pb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BucketVersioning is the versioning state of a bucket.
+type BucketVersioning int32
+
+const (
+	BucketVersioning_UNVERSIONED BucketVersioning = 0
+	BucketVersioning_ENABLED     BucketVersioning = 1
+	BucketVersioning_SUSPENDED   BucketVersioning = 2
+)
+
+var BucketVersioning_name = map[int32]string{
+	0: "UNVERSIONED",
+	1: "ENABLED",
+	2: "SUSPENDED",
+}
+
+var BucketVersioning_value = map[string]int32{
+	"UNVERSIONED": 0,
+	"ENABLED":     1,
+	"SUSPENDED":   2,
+}
+
+func (x BucketVersioning) String() string {
+	return proto.EnumName(BucketVersioning_name, int32(x))
+}
+
+func (BucketVersioning) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{0}
+}
+
+// RetentionMode defines whether the retention of an object can be shortened.
+type RetentionMode int32
+
+const (
+	RetentionMode_NO_RETENTION RetentionMode = 0
+	RetentionMode_GOVERNANCE   RetentionMode = 1
+	RetentionMode_COMPLIANCE   RetentionMode = 2
+)
+
+var RetentionMode_name = map[int32]string{
+	0: "NO_RETENTION",
+	1: "GOVERNANCE",
+	2: "COMPLIANCE",
+}
+
+var RetentionMode_value = map[string]int32{
+	"NO_RETENTION": 0,
+	"GOVERNANCE":   1,
+	"COMPLIANCE":   2,
+}
+
+func (x RetentionMode) String() string {
+	return proto.EnumName(RetentionMode_name, int32(x))
+}
+
+func (RetentionMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{1}
+}
+
+type GetBucketVersioningRequest struct {
+	Header               *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Name                 []byte            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetBucketVersioningRequest) Reset()         { *m = GetBucketVersioningRequest{} }
+func (m *GetBucketVersioningRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBucketVersioningRequest) ProtoMessage()    {}
+func (*GetBucketVersioningRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{0}
+}
+func (m *GetBucketVersioningRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketVersioningRequest.Unmarshal(m, b)
+}
+func (m *GetBucketVersioningRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketVersioningRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBucketVersioningRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketVersioningRequest.Merge(m, src)
+}
+func (m *GetBucketVersioningRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBucketVersioningRequest.Size(m)
+}
+func (m *GetBucketVersioningRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketVersioningRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketVersioningRequest proto.InternalMessageInfo
+
+func (m *GetBucketVersioningRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *GetBucketVersioningRequest) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+type GetBucketVersioningResponse struct {
+	Versioning           BucketVersioning `protobuf:"varint,1,opt,name=versioning,proto3,enum=satellite.metainfo_ext.BucketVersioning" json:"versioning,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetBucketVersioningResponse) Reset()         { *m = GetBucketVersioningResponse{} }
+func (m *GetBucketVersioningResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBucketVersioningResponse) ProtoMessage()    {}
+func (*GetBucketVersioningResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{1}
+}
+func (m *GetBucketVersioningResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketVersioningResponse.Unmarshal(m, b)
+}
+func (m *GetBucketVersioningResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketVersioningResponse.Marshal(b, m, deterministic)
+}
+func (m *GetBucketVersioningResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketVersioningResponse.Merge(m, src)
+}
+func (m *GetBucketVersioningResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBucketVersioningResponse.Size(m)
+}
+func (m *GetBucketVersioningResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketVersioningResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketVersioningResponse proto.InternalMessageInfo
+
+func (m *GetBucketVersioningResponse) GetVersioning() BucketVersioning {
+	if m != nil {
+		return m.Versioning
+	}
+	return BucketVersioning_UNVERSIONED
+}
+
+type SetBucketVersioningRequest struct {
+	Header *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Name   []byte            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// versioning can only be changed to ENABLED or SUSPENDED.
+	Versioning           BucketVersioning `protobuf:"varint,2,opt,name=versioning,proto3,enum=satellite.metainfo_ext.BucketVersioning" json:"versioning,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SetBucketVersioningRequest) Reset()         { *m = SetBucketVersioningRequest{} }
+func (m *SetBucketVersioningRequest) String() string { return proto.CompactTextString(m) }
+func (*SetBucketVersioningRequest) ProtoMessage()    {}
+func (*SetBucketVersioningRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{2}
+}
+func (m *SetBucketVersioningRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketVersioningRequest.Unmarshal(m, b)
+}
+func (m *SetBucketVersioningRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketVersioningRequest.Marshal(b, m, deterministic)
+}
+func (m *SetBucketVersioningRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketVersioningRequest.Merge(m, src)
+}
+func (m *SetBucketVersioningRequest) XXX_Size() int {
+	return xxx_messageInfo_SetBucketVersioningRequest.Size(m)
+}
+func (m *SetBucketVersioningRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketVersioningRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketVersioningRequest proto.InternalMessageInfo
+
+func (m *SetBucketVersioningRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SetBucketVersioningRequest) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *SetBucketVersioningRequest) GetVersioning() BucketVersioning {
+	if m != nil {
+		return m.Versioning
+	}
+	return BucketVersioning_UNVERSIONED
+}
+
+type SetBucketVersioningResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetBucketVersioningResponse) Reset()         { *m = SetBucketVersioningResponse{} }
+func (m *SetBucketVersioningResponse) String() string { return proto.CompactTextString(m) }
+func (*SetBucketVersioningResponse) ProtoMessage()    {}
+func (*SetBucketVersioningResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{3}
+}
+func (m *SetBucketVersioningResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketVersioningResponse.Unmarshal(m, b)
+}
+func (m *SetBucketVersioningResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketVersioningResponse.Marshal(b, m, deterministic)
+}
+func (m *SetBucketVersioningResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketVersioningResponse.Merge(m, src)
+}
+func (m *SetBucketVersioningResponse) XXX_Size() int {
+	return xxx_messageInfo_SetBucketVersioningResponse.Size(m)
+}
+func (m *SetBucketVersioningResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketVersioningResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketVersioningResponse proto.InternalMessageInfo
+
+type SetBucketDefaultRetentionRequest struct {
+	Header *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Name   []byte            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Mode   RetentionMode     `protobuf:"varint,2,opt,name=mode,proto3,enum=satellite.metainfo_ext.RetentionMode" json:"mode,omitempty"`
+	// days must be set together with mode and is zero without retention.
+	Days                 int32    `protobuf:"varint,3,opt,name=days,proto3" json:"days,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetBucketDefaultRetentionRequest) Reset()         { *m = SetBucketDefaultRetentionRequest{} }
+func (m *SetBucketDefaultRetentionRequest) String() string { return proto.CompactTextString(m) }
+func (*SetBucketDefaultRetentionRequest) ProtoMessage()    {}
+func (*SetBucketDefaultRetentionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{4}
+}
+func (m *SetBucketDefaultRetentionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketDefaultRetentionRequest.Unmarshal(m, b)
+}
+func (m *SetBucketDefaultRetentionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketDefaultRetentionRequest.Marshal(b, m, deterministic)
+}
+func (m *SetBucketDefaultRetentionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketDefaultRetentionRequest.Merge(m, src)
+}
+func (m *SetBucketDefaultRetentionRequest) XXX_Size() int {
+	return xxx_messageInfo_SetBucketDefaultRetentionRequest.Size(m)
+}
+func (m *SetBucketDefaultRetentionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketDefaultRetentionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketDefaultRetentionRequest proto.InternalMessageInfo
+
+func (m *SetBucketDefaultRetentionRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SetBucketDefaultRetentionRequest) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *SetBucketDefaultRetentionRequest) GetMode() RetentionMode {
+	if m != nil {
+		return m.Mode
+	}
+	return RetentionMode_NO_RETENTION
+}
+
+func (m *SetBucketDefaultRetentionRequest) GetDays() int32 {
+	if m != nil {
+		return m.Days
+	}
+	return 0
+}
+
+type SetBucketDefaultRetentionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetBucketDefaultRetentionResponse) Reset()         { *m = SetBucketDefaultRetentionResponse{} }
+func (m *SetBucketDefaultRetentionResponse) String() string { return proto.CompactTextString(m) }
+func (*SetBucketDefaultRetentionResponse) ProtoMessage()    {}
+func (*SetBucketDefaultRetentionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{5}
+}
+func (m *SetBucketDefaultRetentionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketDefaultRetentionResponse.Unmarshal(m, b)
+}
+func (m *SetBucketDefaultRetentionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketDefaultRetentionResponse.Marshal(b, m, deterministic)
+}
+func (m *SetBucketDefaultRetentionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketDefaultRetentionResponse.Merge(m, src)
+}
+func (m *SetBucketDefaultRetentionResponse) XXX_Size() int {
+	return xxx_messageInfo_SetBucketDefaultRetentionResponse.Size(m)
+}
+func (m *SetBucketDefaultRetentionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketDefaultRetentionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketDefaultRetentionResponse proto.InternalMessageInfo
+
+type SetObjectRetentionRequest struct {
+	Header             *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Bucket             []byte            `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedObjectKey []byte            `protobuf:"bytes,2,opt,name=encrypted_object_key,json=encryptedObjectKey,proto3" json:"encrypted_object_key,omitempty"`
+	// version selects the object version, zero selects the latest version.
+	Version     int64         `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Mode        RetentionMode `protobuf:"varint,4,opt,name=mode,proto3,enum=satellite.metainfo_ext.RetentionMode" json:"mode,omitempty"`
+	RetainUntil time.Time     `protobuf:"bytes,5,opt,name=retain_until,json=retainUntil,proto3,stdtime" json:"retain_until"`
+	// bypass_governance allows shortening or removing a governance mode retention.
+	BypassGovernance     bool     `protobuf:"varint,6,opt,name=bypass_governance,json=bypassGovernance,proto3" json:"bypass_governance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetObjectRetentionRequest) Reset()         { *m = SetObjectRetentionRequest{} }
+func (m *SetObjectRetentionRequest) String() string { return proto.CompactTextString(m) }
+func (*SetObjectRetentionRequest) ProtoMessage()    {}
+func (*SetObjectRetentionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{6}
+}
+func (m *SetObjectRetentionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetObjectRetentionRequest.Unmarshal(m, b)
+}
+func (m *SetObjectRetentionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetObjectRetentionRequest.Marshal(b, m, deterministic)
+}
+func (m *SetObjectRetentionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetObjectRetentionRequest.Merge(m, src)
+}
+func (m *SetObjectRetentionRequest) XXX_Size() int {
+	return xxx_messageInfo_SetObjectRetentionRequest.Size(m)
+}
+func (m *SetObjectRetentionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetObjectRetentionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetObjectRetentionRequest proto.InternalMessageInfo
+
+func (m *SetObjectRetentionRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SetObjectRetentionRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *SetObjectRetentionRequest) GetEncryptedObjectKey() []byte {
+	if m != nil {
+		return m.EncryptedObjectKey
+	}
+	return nil
+}
+
+func (m *SetObjectRetentionRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SetObjectRetentionRequest) GetMode() RetentionMode {
+	if m != nil {
+		return m.Mode
+	}
+	return RetentionMode_NO_RETENTION
+}
+
+func (m *SetObjectRetentionRequest) GetRetainUntil() time.Time {
+	if m != nil {
+		return m.RetainUntil
+	}
+	return time.Time{}
+}
+
+func (m *SetObjectRetentionRequest) GetBypassGovernance() bool {
+	if m != nil {
+		return m.BypassGovernance
+	}
+	return false
+}
+
+type SetObjectRetentionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetObjectRetentionResponse) Reset()         { *m = SetObjectRetentionResponse{} }
+func (m *SetObjectRetentionResponse) String() string { return proto.CompactTextString(m) }
+func (*SetObjectRetentionResponse) ProtoMessage()    {}
+func (*SetObjectRetentionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{7}
+}
+func (m *SetObjectRetentionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetObjectRetentionResponse.Unmarshal(m, b)
+}
+func (m *SetObjectRetentionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetObjectRetentionResponse.Marshal(b, m, deterministic)
+}
+func (m *SetObjectRetentionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetObjectRetentionResponse.Merge(m, src)
+}
+func (m *SetObjectRetentionResponse) XXX_Size() int {
+	return xxx_messageInfo_SetObjectRetentionResponse.Size(m)
+}
+func (m *SetObjectRetentionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetObjectRetentionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetObjectRetentionResponse proto.InternalMessageInfo
+
+type SetObjectLegalHoldRequest struct {
+	Header             *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Bucket             []byte            `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedObjectKey []byte            `protobuf:"bytes,2,opt,name=encrypted_object_key,json=encryptedObjectKey,proto3" json:"encrypted_object_key,omitempty"`
+	// version selects the object version, zero selects the latest version.
+	Version              int64    `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	LegalHold            bool     `protobuf:"varint,4,opt,name=legal_hold,json=legalHold,proto3" json:"legal_hold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetObjectLegalHoldRequest) Reset()         { *m = SetObjectLegalHoldRequest{} }
+func (m *SetObjectLegalHoldRequest) String() string { return proto.CompactTextString(m) }
+func (*SetObjectLegalHoldRequest) ProtoMessage()    {}
+func (*SetObjectLegalHoldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{8}
+}
+func (m *SetObjectLegalHoldRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetObjectLegalHoldRequest.Unmarshal(m, b)
+}
+func (m *SetObjectLegalHoldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetObjectLegalHoldRequest.Marshal(b, m, deterministic)
+}
+func (m *SetObjectLegalHoldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetObjectLegalHoldRequest.Merge(m, src)
+}
+func (m *SetObjectLegalHoldRequest) XXX_Size() int {
+	return xxx_messageInfo_SetObjectLegalHoldRequest.Size(m)
+}
+func (m *SetObjectLegalHoldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetObjectLegalHoldRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetObjectLegalHoldRequest proto.InternalMessageInfo
+
+func (m *SetObjectLegalHoldRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SetObjectLegalHoldRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *SetObjectLegalHoldRequest) GetEncryptedObjectKey() []byte {
+	if m != nil {
+		return m.EncryptedObjectKey
+	}
+	return nil
+}
+
+func (m *SetObjectLegalHoldRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SetObjectLegalHoldRequest) GetLegalHold() bool {
+	if m != nil {
+		return m.LegalHold
+	}
+	return false
+}
+
+type SetObjectLegalHoldResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetObjectLegalHoldResponse) Reset()         { *m = SetObjectLegalHoldResponse{} }
+func (m *SetObjectLegalHoldResponse) String() string { return proto.CompactTextString(m) }
+func (*SetObjectLegalHoldResponse) ProtoMessage()    {}
+func (*SetObjectLegalHoldResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{9}
+}
+func (m *SetObjectLegalHoldResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetObjectLegalHoldResponse.Unmarshal(m, b)
+}
+func (m *SetObjectLegalHoldResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetObjectLegalHoldResponse.Marshal(b, m, deterministic)
+}
+func (m *SetObjectLegalHoldResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetObjectLegalHoldResponse.Merge(m, src)
+}
+func (m *SetObjectLegalHoldResponse) XXX_Size() int {
+	return xxx_messageInfo_SetObjectLegalHoldResponse.Size(m)
+}
+func (m *SetObjectLegalHoldResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetObjectLegalHoldResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetObjectLegalHoldResponse proto.InternalMessageInfo
+
+// LifecycleRule defines the actions applied to the objects whose encrypted key
+// starts with the prefix. Every action is disabled when its number of days is zero.
+type LifecycleRule struct {
+	Id                              string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Prefix                          []byte   `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	ExpirationDays                  int32    `protobuf:"varint,3,opt,name=expiration_days,json=expirationDays,proto3" json:"expiration_days,omitempty"`
+	AbortIncompleteUploadDays       int32    `protobuf:"varint,4,opt,name=abort_incomplete_upload_days,json=abortIncompleteUploadDays,proto3" json:"abort_incomplete_upload_days,omitempty"`
+	NoncurrentVersionExpirationDays int32    `protobuf:"varint,5,opt,name=noncurrent_version_expiration_days,json=noncurrentVersionExpirationDays,proto3" json:"noncurrent_version_expiration_days,omitempty"`
+	XXX_NoUnkeyedLiteral            struct{} `json:"-"`
+	XXX_unrecognized                []byte   `json:"-"`
+	XXX_sizecache                   int32    `json:"-"`
+}
+
+func (m *LifecycleRule) Reset()         { *m = LifecycleRule{} }
+func (m *LifecycleRule) String() string { return proto.CompactTextString(m) }
+func (*LifecycleRule) ProtoMessage()    {}
+func (*LifecycleRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{10}
+}
+func (m *LifecycleRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LifecycleRule.Unmarshal(m, b)
+}
+func (m *LifecycleRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LifecycleRule.Marshal(b, m, deterministic)
+}
+func (m *LifecycleRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LifecycleRule.Merge(m, src)
+}
+func (m *LifecycleRule) XXX_Size() int {
+	return xxx_messageInfo_LifecycleRule.Size(m)
+}
+func (m *LifecycleRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_LifecycleRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LifecycleRule proto.InternalMessageInfo
+
+func (m *LifecycleRule) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *LifecycleRule) GetPrefix() []byte {
+	if m != nil {
+		return m.Prefix
+	}
+	return nil
+}
+
+func (m *LifecycleRule) GetExpirationDays() int32 {
+	if m != nil {
+		return m.ExpirationDays
+	}
+	return 0
+}
+
+func (m *LifecycleRule) GetAbortIncompleteUploadDays() int32 {
+	if m != nil {
+		return m.AbortIncompleteUploadDays
+	}
+	return 0
+}
+
+func (m *LifecycleRule) GetNoncurrentVersionExpirationDays() int32 {
+	if m != nil {
+		return m.NoncurrentVersionExpirationDays
+	}
+	return 0
+}
+
+type GetBucketLifecycleRequest struct {
+	Header               *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Name                 []byte            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetBucketLifecycleRequest) Reset()         { *m = GetBucketLifecycleRequest{} }
+func (m *GetBucketLifecycleRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBucketLifecycleRequest) ProtoMessage()    {}
+func (*GetBucketLifecycleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{11}
+}
+func (m *GetBucketLifecycleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketLifecycleRequest.Unmarshal(m, b)
+}
+func (m *GetBucketLifecycleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketLifecycleRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBucketLifecycleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketLifecycleRequest.Merge(m, src)
+}
+func (m *GetBucketLifecycleRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBucketLifecycleRequest.Size(m)
+}
+func (m *GetBucketLifecycleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketLifecycleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketLifecycleRequest proto.InternalMessageInfo
+
+func (m *GetBucketLifecycleRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *GetBucketLifecycleRequest) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+type GetBucketLifecycleResponse struct {
+	Rules                []*LifecycleRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetBucketLifecycleResponse) Reset()         { *m = GetBucketLifecycleResponse{} }
+func (m *GetBucketLifecycleResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBucketLifecycleResponse) ProtoMessage()    {}
+func (*GetBucketLifecycleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{12}
+}
+func (m *GetBucketLifecycleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketLifecycleResponse.Unmarshal(m, b)
+}
+func (m *GetBucketLifecycleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketLifecycleResponse.Marshal(b, m, deterministic)
+}
+func (m *GetBucketLifecycleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketLifecycleResponse.Merge(m, src)
+}
+func (m *GetBucketLifecycleResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBucketLifecycleResponse.Size(m)
+}
+func (m *GetBucketLifecycleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketLifecycleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketLifecycleResponse proto.InternalMessageInfo
+
+func (m *GetBucketLifecycleResponse) GetRules() []*LifecycleRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+type SetBucketLifecycleRequest struct {
+	Header *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Name   []byte            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// rules replace the existing rules, no rules disable the lifecycle.
+	Rules                []*LifecycleRule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SetBucketLifecycleRequest) Reset()         { *m = SetBucketLifecycleRequest{} }
+func (m *SetBucketLifecycleRequest) String() string { return proto.CompactTextString(m) }
+func (*SetBucketLifecycleRequest) ProtoMessage()    {}
+func (*SetBucketLifecycleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{13}
+}
+func (m *SetBucketLifecycleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketLifecycleRequest.Unmarshal(m, b)
+}
+func (m *SetBucketLifecycleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketLifecycleRequest.Marshal(b, m, deterministic)
+}
+func (m *SetBucketLifecycleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketLifecycleRequest.Merge(m, src)
+}
+func (m *SetBucketLifecycleRequest) XXX_Size() int {
+	return xxx_messageInfo_SetBucketLifecycleRequest.Size(m)
+}
+func (m *SetBucketLifecycleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketLifecycleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketLifecycleRequest proto.InternalMessageInfo
+
+func (m *SetBucketLifecycleRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SetBucketLifecycleRequest) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *SetBucketLifecycleRequest) GetRules() []*LifecycleRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+type SetBucketLifecycleResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetBucketLifecycleResponse) Reset()         { *m = SetBucketLifecycleResponse{} }
+func (m *SetBucketLifecycleResponse) String() string { return proto.CompactTextString(m) }
+func (*SetBucketLifecycleResponse) ProtoMessage()    {}
+func (*SetBucketLifecycleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{14}
+}
+func (m *SetBucketLifecycleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketLifecycleResponse.Unmarshal(m, b)
+}
+func (m *SetBucketLifecycleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketLifecycleResponse.Marshal(b, m, deterministic)
+}
+func (m *SetBucketLifecycleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketLifecycleResponse.Merge(m, src)
+}
+func (m *SetBucketLifecycleResponse) XXX_Size() int {
+	return xxx_messageInfo_SetBucketLifecycleResponse.Size(m)
+}
+func (m *SetBucketLifecycleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketLifecycleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketLifecycleResponse proto.InternalMessageInfo
+
+// Webhook sends the bucket events matching the filters to an HTTP endpoint.
+// prefix and suffix are compared against the encrypted object keys.
+type Webhook struct {
+	Id  string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// events lists the event types, e.g. "ObjectCreated:Put". An entry
+	// ending with "*" matches every event type with the same prefix.
+	Events               []string `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	Prefix               []byte   `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Suffix               []byte   `protobuf:"bytes,5,opt,name=suffix,proto3" json:"suffix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Webhook) Reset()         { *m = Webhook{} }
+func (m *Webhook) String() string { return proto.CompactTextString(m) }
+func (*Webhook) ProtoMessage()    {}
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{15}
+}
+func (m *Webhook) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Webhook.Unmarshal(m, b)
+}
+func (m *Webhook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Webhook.Marshal(b, m, deterministic)
+}
+func (m *Webhook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Webhook.Merge(m, src)
+}
+func (m *Webhook) XXX_Size() int {
+	return xxx_messageInfo_Webhook.Size(m)
+}
+func (m *Webhook) XXX_DiscardUnknown() {
+	xxx_messageInfo_Webhook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Webhook proto.InternalMessageInfo
+
+func (m *Webhook) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Webhook) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Webhook) GetEvents() []string {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *Webhook) GetPrefix() []byte {
+	if m != nil {
+		return m.Prefix
+	}
+	return nil
+}
+
+func (m *Webhook) GetSuffix() []byte {
+	if m != nil {
+		return m.Suffix
+	}
+	return nil
+}
+
+type GetBucketNotificationsRequest struct {
+	Header               *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Name                 []byte            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetBucketNotificationsRequest) Reset()         { *m = GetBucketNotificationsRequest{} }
+func (m *GetBucketNotificationsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBucketNotificationsRequest) ProtoMessage()    {}
+func (*GetBucketNotificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{16}
+}
+func (m *GetBucketNotificationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketNotificationsRequest.Unmarshal(m, b)
+}
+func (m *GetBucketNotificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketNotificationsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBucketNotificationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketNotificationsRequest.Merge(m, src)
+}
+func (m *GetBucketNotificationsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBucketNotificationsRequest.Size(m)
+}
+func (m *GetBucketNotificationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketNotificationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketNotificationsRequest proto.InternalMessageInfo
+
+func (m *GetBucketNotificationsRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *GetBucketNotificationsRequest) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+type GetBucketNotificationsResponse struct {
+	Webhooks             []*Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GetBucketNotificationsResponse) Reset()         { *m = GetBucketNotificationsResponse{} }
+func (m *GetBucketNotificationsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBucketNotificationsResponse) ProtoMessage()    {}
+func (*GetBucketNotificationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{17}
+}
+func (m *GetBucketNotificationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketNotificationsResponse.Unmarshal(m, b)
+}
+func (m *GetBucketNotificationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketNotificationsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetBucketNotificationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketNotificationsResponse.Merge(m, src)
+}
+func (m *GetBucketNotificationsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBucketNotificationsResponse.Size(m)
+}
+func (m *GetBucketNotificationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketNotificationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketNotificationsResponse proto.InternalMessageInfo
+
+func (m *GetBucketNotificationsResponse) GetWebhooks() []*Webhook {
+	if m != nil {
+		return m.Webhooks
+	}
+	return nil
+}
+
+type SetBucketNotificationsRequest struct {
+	Header *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Name   []byte            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// webhooks replace the existing webhooks, no webhooks disable the notifications.
+	Webhooks             []*Webhook `protobuf:"bytes,2,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *SetBucketNotificationsRequest) Reset()         { *m = SetBucketNotificationsRequest{} }
+func (m *SetBucketNotificationsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetBucketNotificationsRequest) ProtoMessage()    {}
+func (*SetBucketNotificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{18}
+}
+func (m *SetBucketNotificationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketNotificationsRequest.Unmarshal(m, b)
+}
+func (m *SetBucketNotificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketNotificationsRequest.Marshal(b, m, deterministic)
+}
+func (m *SetBucketNotificationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketNotificationsRequest.Merge(m, src)
+}
+func (m *SetBucketNotificationsRequest) XXX_Size() int {
+	return xxx_messageInfo_SetBucketNotificationsRequest.Size(m)
+}
+func (m *SetBucketNotificationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketNotificationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketNotificationsRequest proto.InternalMessageInfo
+
+func (m *SetBucketNotificationsRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SetBucketNotificationsRequest) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *SetBucketNotificationsRequest) GetWebhooks() []*Webhook {
+	if m != nil {
+		return m.Webhooks
+	}
+	return nil
+}
+
+type SetBucketNotificationsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetBucketNotificationsResponse) Reset()         { *m = SetBucketNotificationsResponse{} }
+func (m *SetBucketNotificationsResponse) String() string { return proto.CompactTextString(m) }
+func (*SetBucketNotificationsResponse) ProtoMessage()    {}
+func (*SetBucketNotificationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{19}
+}
+func (m *SetBucketNotificationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketNotificationsResponse.Unmarshal(m, b)
+}
+func (m *SetBucketNotificationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketNotificationsResponse.Marshal(b, m, deterministic)
+}
+func (m *SetBucketNotificationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketNotificationsResponse.Merge(m, src)
+}
+func (m *SetBucketNotificationsResponse) XXX_Size() int {
+	return xxx_messageInfo_SetBucketNotificationsResponse.Size(m)
+}
+func (m *SetBucketNotificationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketNotificationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketNotificationsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("satellite.metainfo_ext.BucketVersioning", BucketVersioning_name, BucketVersioning_value)
+	proto.RegisterEnum("satellite.metainfo_ext.RetentionMode", RetentionMode_name, RetentionMode_value)
+	proto.RegisterType((*GetBucketVersioningRequest)(nil), "satellite.metainfo_ext.GetBucketVersioningRequest")
+	proto.RegisterType((*GetBucketVersioningResponse)(nil), "satellite.metainfo_ext.GetBucketVersioningResponse")
+	proto.RegisterType((*SetBucketVersioningRequest)(nil), "satellite.metainfo_ext.SetBucketVersioningRequest")
+	proto.RegisterType((*SetBucketVersioningResponse)(nil), "satellite.metainfo_ext.SetBucketVersioningResponse")
+	proto.RegisterType((*SetBucketDefaultRetentionRequest)(nil), "satellite.metainfo_ext.SetBucketDefaultRetentionRequest")
+	proto.RegisterType((*SetBucketDefaultRetentionResponse)(nil), "satellite.metainfo_ext.SetBucketDefaultRetentionResponse")
+	proto.RegisterType((*SetObjectRetentionRequest)(nil), "satellite.metainfo_ext.SetObjectRetentionRequest")
+	proto.RegisterType((*SetObjectRetentionResponse)(nil), "satellite.metainfo_ext.SetObjectRetentionResponse")
+	proto.RegisterType((*SetObjectLegalHoldRequest)(nil), "satellite.metainfo_ext.SetObjectLegalHoldRequest")
+	proto.RegisterType((*SetObjectLegalHoldResponse)(nil), "satellite.metainfo_ext.SetObjectLegalHoldResponse")
+	proto.RegisterType((*LifecycleRule)(nil), "satellite.metainfo_ext.LifecycleRule")
+	proto.RegisterType((*GetBucketLifecycleRequest)(nil), "satellite.metainfo_ext.GetBucketLifecycleRequest")
+	proto.RegisterType((*GetBucketLifecycleResponse)(nil), "satellite.metainfo_ext.GetBucketLifecycleResponse")
+	proto.RegisterType((*SetBucketLifecycleRequest)(nil), "satellite.metainfo_ext.SetBucketLifecycleRequest")
+	proto.RegisterType((*SetBucketLifecycleResponse)(nil), "satellite.metainfo_ext.SetBucketLifecycleResponse")
+	proto.RegisterType((*Webhook)(nil), "satellite.metainfo_ext.Webhook")
+	proto.RegisterType((*GetBucketNotificationsRequest)(nil), "satellite.metainfo_ext.GetBucketNotificationsRequest")
+	proto.RegisterType((*GetBucketNotificationsResponse)(nil), "satellite.metainfo_ext.GetBucketNotificationsResponse")
+	proto.RegisterType((*SetBucketNotificationsRequest)(nil), "satellite.metainfo_ext.SetBucketNotificationsRequest")
+	proto.RegisterType((*SetBucketNotificationsResponse)(nil), "satellite.metainfo_ext.SetBucketNotificationsResponse")
+}
+
+func init() { proto.RegisterFile("metainfo_ext.proto", fileDescriptor_d8cdca9bebb3074f) }
+
+var fileDescriptor_d8cdca9bebb3074f = []byte{
+	// 1068 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x5f, 0x6f, 0xe3, 0x44,
+	0x10, 0xaf, 0xd3, 0xbf, 0x99, 0xf4, 0x4f, 0x58, 0x50, 0x49, 0x7d, 0xed, 0x35, 0xf8, 0x74, 0x22,
+	0x3a, 0xa4, 0x04, 0x72, 0x02, 0x71, 0xe2, 0xe1, 0xd4, 0x5e, 0xac, 0xb6, 0xba, 0x36, 0x39, 0xec,
+	0xb6, 0x08, 0x24, 0x64, 0x9c, 0x78, 0x92, 0xfa, 0xea, 0x78, 0x83, 0xbd, 0x2e, 0x8d, 0x90, 0x78,
+	0xe5, 0xf5, 0xbe, 0x00, 0x0f, 0x3c, 0xf3, 0xce, 0x67, 0xe0, 0x8d, 0x6f, 0x00, 0xe2, 0x0b, 0xf0,
+	0x05, 0x78, 0x41, 0x5e, 0x6f, 0x9c, 0xa4, 0x89, 0x7b, 0xe7, 0xd2, 0x4a, 0xbc, 0xed, 0xec, 0xce,
+	0xec, 0xfc, 0x7e, 0x33, 0xb3, 0xb3, 0x03, 0xa4, 0x8b, 0xcc, 0xb4, 0xdd, 0x36, 0x35, 0xf0, 0x92,
+	0x95, 0x7b, 0x1e, 0x65, 0x94, 0xac, 0xfb, 0x26, 0x43, 0xc7, 0xb1, 0x19, 0x96, 0x47, 0x4f, 0x65,
+	0xe8, 0xd0, 0x0e, 0x8d, 0x74, 0xe4, 0xed, 0x0e, 0xa5, 0x1d, 0x07, 0x2b, 0x5c, 0x6a, 0x06, 0xed,
+	0x0a, 0xb3, 0xbb, 0xe8, 0x33, 0xb3, 0xdb, 0x13, 0x0a, 0xab, 0x03, 0xd3, 0x48, 0x56, 0x4c, 0x90,
+	0xf7, 0x90, 0xed, 0x06, 0xad, 0x73, 0x64, 0xa7, 0xe8, 0xf9, 0x36, 0x75, 0x6d, 0xb7, 0xa3, 0xe1,
+	0xb7, 0x01, 0xfa, 0x8c, 0x54, 0x60, 0xe1, 0x0c, 0x4d, 0x0b, 0xbd, 0xc2, 0x5a, 0x51, 0x2a, 0xe5,
+	0xaa, 0xef, 0xc6, 0x9e, 0xcb, 0x42, 0x65, 0x9f, 0x1f, 0x6b, 0x42, 0x8d, 0x10, 0x98, 0x73, 0xcd,
+	0x2e, 0x16, 0xa4, 0xa2, 0x54, 0x5a, 0xd6, 0xf8, 0x5a, 0xe9, 0xc0, 0xbd, 0xa9, 0x2e, 0xfc, 0x1e,
+	0x75, 0x7d, 0x24, 0xfb, 0x00, 0x17, 0xf1, 0x2e, 0x37, 0x5c, 0xad, 0x96, 0xca, 0xd3, 0xb9, 0x96,
+	0x27, 0x6e, 0x19, 0xb1, 0x55, 0x7e, 0x91, 0x40, 0xd6, 0xef, 0x96, 0xcc, 0x15, 0xb4, 0x99, 0xff,
+	0x80, 0x76, 0x0b, 0xee, 0xe9, 0xc9, 0x61, 0x51, 0x7e, 0x95, 0xa0, 0x18, 0x9f, 0xd7, 0xb0, 0x6d,
+	0x06, 0x0e, 0xd3, 0x90, 0xa1, 0xcb, 0x6c, 0xea, 0xde, 0x2a, 0xa5, 0x27, 0x30, 0xd7, 0xa5, 0x16,
+	0x0a, 0x32, 0x0f, 0x93, 0xc8, 0xc4, 0xce, 0x8f, 0xa8, 0x85, 0x1a, 0x37, 0x09, 0xaf, 0xb3, 0xcc,
+	0xbe, 0x5f, 0x98, 0x2d, 0x4a, 0xa5, 0x79, 0x8d, 0xaf, 0x95, 0x07, 0xf0, 0xde, 0x35, 0xb8, 0x05,
+	0xbb, 0xbf, 0x32, 0xb0, 0xa1, 0x23, 0x6b, 0x34, 0x5f, 0x62, 0xeb, 0x16, 0x68, 0xad, 0xc3, 0x42,
+	0x93, 0x3b, 0x14, 0xc4, 0x84, 0x44, 0x3e, 0x84, 0x77, 0xd0, 0x6d, 0x79, 0xfd, 0x1e, 0x43, 0xcb,
+	0xa0, 0xdc, 0x99, 0x71, 0x8e, 0x7d, 0x4e, 0x75, 0x59, 0x23, 0xf1, 0x59, 0x84, 0xe3, 0x39, 0xf6,
+	0x49, 0x01, 0x16, 0x45, 0x8e, 0x38, 0xa9, 0x59, 0x6d, 0x20, 0xc6, 0x61, 0x9a, 0x4b, 0x1f, 0xa6,
+	0x3d, 0x58, 0xf6, 0xb8, 0x8e, 0x11, 0xb8, 0xcc, 0x76, 0x0a, 0xf3, 0x9c, 0x95, 0x5c, 0x8e, 0x1e,
+	0x6b, 0x79, 0xf0, 0x58, 0xcb, 0xc7, 0x83, 0xc7, 0xba, 0xbb, 0xf4, 0xdb, 0x1f, 0xdb, 0x33, 0xaf,
+	0xfe, 0xdc, 0x96, 0xb4, 0x5c, 0x64, 0x79, 0x12, 0x1a, 0x92, 0x0f, 0xe0, 0xad, 0x66, 0xbf, 0x67,
+	0xfa, 0xbe, 0xd1, 0xa1, 0x17, 0xe8, 0xb9, 0xa6, 0xdb, 0xc2, 0xc2, 0x42, 0x51, 0x2a, 0x2d, 0x69,
+	0xf9, 0xe8, 0x60, 0x2f, 0xde, 0x57, 0x36, 0xf9, 0x6b, 0x98, 0x08, 0xb1, 0xc8, 0xc0, 0xef, 0xd2,
+	0x48, 0x06, 0x0e, 0xb1, 0x63, 0x3a, 0xfb, 0xd4, 0xb1, 0xfe, 0xd7, 0x19, 0xd8, 0x02, 0x70, 0x42,
+	0xa0, 0xc6, 0x19, 0x75, 0x2c, 0x9e, 0x87, 0x25, 0x2d, 0xeb, 0x0c, 0xa0, 0x8f, 0xf1, 0x1d, 0x21,
+	0x24, 0xf8, 0xfe, 0x2d, 0xc1, 0xca, 0xa1, 0xdd, 0xc6, 0x56, 0xbf, 0xe5, 0xa0, 0x16, 0x38, 0x48,
+	0x56, 0x21, 0x63, 0x5b, 0x1c, 0x6e, 0x56, 0xcb, 0xd8, 0x56, 0x48, 0xa1, 0xe7, 0x61, 0xdb, 0xbe,
+	0x14, 0xe0, 0x84, 0x44, 0xde, 0x87, 0x35, 0xbc, 0xec, 0xd9, 0x9e, 0x19, 0xc6, 0xcf, 0x18, 0xa9,
+	0xf7, 0xd5, 0xe1, 0x76, 0xcd, 0xec, 0xfb, 0xe4, 0x29, 0x6c, 0x9a, 0x4d, 0xea, 0x31, 0xc3, 0x76,
+	0x5b, 0xb4, 0xdb, 0x73, 0x90, 0xa1, 0x11, 0xf4, 0x1c, 0x6a, 0x5a, 0x91, 0xd5, 0x1c, 0xb7, 0xda,
+	0xe0, 0x3a, 0x07, 0xb1, 0xca, 0x09, 0xd7, 0xe0, 0x17, 0x3c, 0x07, 0xc5, 0xa5, 0x6e, 0x2b, 0xf0,
+	0x3c, 0x74, 0x99, 0x21, 0x68, 0x1b, 0x57, 0x9d, 0xcf, 0xf3, 0x6b, 0xb6, 0x87, 0x9a, 0xa2, 0x7b,
+	0xa8, 0x63, 0x68, 0x94, 0x6f, 0x60, 0x23, 0x6e, 0xbb, 0x43, 0xe2, 0xb7, 0xd9, 0xd8, 0xbf, 0x04,
+	0x79, 0x9a, 0x07, 0xd1, 0xd7, 0x3f, 0x83, 0x79, 0x2f, 0x70, 0xd0, 0x2f, 0x48, 0xc5, 0xd9, 0x52,
+	0x2e, 0xf9, 0xc1, 0x8c, 0x25, 0x45, 0x8b, 0x6c, 0x94, 0x9f, 0xa2, 0xea, 0xbc, 0x43, 0xf4, 0x43,
+	0x7c, 0x99, 0x1b, 0xe0, 0xdb, 0x1c, 0xf9, 0x69, 0x26, 0xa8, 0x2b, 0x3e, 0x2c, 0x7e, 0x81, 0xcd,
+	0x33, 0x4a, 0xcf, 0x27, 0x8a, 0x2c, 0x0f, 0xb3, 0x81, 0xe7, 0xf0, 0x0a, 0xcb, 0x6a, 0xe1, 0x32,
+	0x2c, 0x3b, 0xbc, 0x40, 0x97, 0x85, 0x55, 0x35, 0x5b, 0xca, 0x6a, 0x42, 0x1a, 0x29, 0xc7, 0xb9,
+	0xb1, 0x72, 0x5c, 0x87, 0x05, 0x3f, 0x68, 0x87, 0xfb, 0xf3, 0xd1, 0x7e, 0x24, 0x29, 0x16, 0x6c,
+	0xc5, 0xd9, 0xa8, 0x53, 0x66, 0xb7, 0xed, 0x16, 0x2f, 0x06, 0xff, 0x56, 0x73, 0xfe, 0x35, 0xdc,
+	0x4f, 0xf2, 0x12, 0xe7, 0x7d, 0xe9, 0xbb, 0x88, 0xfc, 0x20, 0xf5, 0xdb, 0x49, 0xa1, 0x15, 0x41,
+	0xd2, 0x62, 0x03, 0xe5, 0x67, 0x09, 0xb6, 0xf4, 0x3b, 0x67, 0x31, 0x86, 0x31, 0x93, 0x16, 0x63,
+	0x11, 0xee, 0xeb, 0xd7, 0x86, 0xe0, 0xd1, 0x53, 0xc8, 0x5f, 0xfd, 0xd7, 0xc9, 0x1a, 0xe4, 0x4e,
+	0xea, 0xa7, 0xaa, 0xa6, 0x1f, 0x34, 0xea, 0x6a, 0x2d, 0x3f, 0x43, 0x72, 0xb0, 0xa8, 0xd6, 0x77,
+	0x76, 0x0f, 0xd5, 0x5a, 0x5e, 0x22, 0x2b, 0x90, 0xd5, 0x4f, 0xf4, 0x17, 0x6a, 0xbd, 0xa6, 0xd6,
+	0xf2, 0x99, 0x47, 0x3b, 0xb0, 0x32, 0xf6, 0x8f, 0x90, 0x3c, 0x2c, 0xd7, 0x1b, 0x86, 0xa6, 0x1e,
+	0xab, 0xf5, 0xe3, 0x83, 0x46, 0x3d, 0x3f, 0x43, 0x56, 0x01, 0xf6, 0x1a, 0xa7, 0xaa, 0x56, 0xdf,
+	0xa9, 0x3f, 0x53, 0xf3, 0x52, 0x28, 0x3f, 0x6b, 0x1c, 0xbd, 0x38, 0x3c, 0xe0, 0x72, 0xa6, 0xfa,
+	0x4f, 0x16, 0x72, 0x47, 0x82, 0x88, 0x7a, 0xc9, 0xc8, 0xe7, 0x40, 0x0e, 0x6d, 0x5f, 0xb4, 0x47,
+	0x81, 0xcb, 0x27, 0x9b, 0xc3, 0xe8, 0x0d, 0x4f, 0x07, 0xb1, 0x96, 0xb7, 0x12, 0x4e, 0x45, 0xa6,
+	0x7f, 0x80, 0xb7, 0xa7, 0x0c, 0x76, 0xa4, 0x9a, 0x14, 0xca, 0xe4, 0x41, 0x53, 0x7e, 0x9c, 0xca,
+	0x66, 0xe8, 0x5f, 0x4f, 0xe3, 0x5f, 0xbf, 0x81, 0xff, 0x6b, 0x46, 0x34, 0xf2, 0x6a, 0xb4, 0x49,
+	0x5d, 0x1d, 0x75, 0xc8, 0xa7, 0xaf, 0xbd, 0x32, 0x61, 0xaa, 0x93, 0x9f, 0xdc, 0xc0, 0x52, 0x40,
+	0xfa, 0x1e, 0xc8, 0xe4, 0x9f, 0x4f, 0x3e, 0xba, 0xe6, 0xc2, 0xe9, 0x23, 0x98, 0x5c, 0x4d, 0x63,
+	0x32, 0xc5, 0x79, 0xfc, 0x01, 0xbf, 0x81, 0xf3, 0xab, 0xd3, 0x87, 0x5c, 0x4d, 0x63, 0x32, 0x74,
+	0x3e, 0xf9, 0x19, 0x25, 0x3b, 0x4f, 0xfc, 0x1a, 0xe5, 0x6a, 0x1a, 0x93, 0x31, 0xe6, 0x6f, 0xec,
+	0x5c, 0x4f, 0xef, 0x3c, 0xf9, 0xb7, 0x21, 0x3f, 0x4a, 0xb0, 0x3e, 0xbd, 0x27, 0x93, 0x8f, 0x5f,
+	0xcb, 0x65, 0x5a, 0x8f, 0x95, 0x3f, 0x49, 0x6b, 0x36, 0x82, 0x44, 0x4f, 0x89, 0x44, 0xbf, 0x19,
+	0x92, 0xeb, 0x3b, 0xf0, 0xee, 0xc3, 0xaf, 0x1e, 0xf8, 0x8c, 0x7a, 0x2f, 0xcb, 0x36, 0xad, 0xf0,
+	0x45, 0x25, 0xbe, 0xa7, 0x62, 0xbb, 0x2c, 0x1c, 0x91, 0x9d, 0x5e, 0xb3, 0xb9, 0xc0, 0x47, 0xef,
+	0xc7, 0xff, 0x0e, 0x00, 0x78, 0xef, 0x61, 0xad, 0x6f, 0x0f, 0x00, 0x00,
+}
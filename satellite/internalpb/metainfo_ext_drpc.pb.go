@@ -0,0 +1,476 @@
+// Code generated by protoc-gen-go-drpc. DO NOT EDIT.
+// protoc-gen-go-drpc version: v0.0.33
+// source: metainfo_ext.proto
+
+package internalpb
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+
+	jsonpb "github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+
+	pb "storj.io/common/pb"
+	drpc "storj.io/drpc"
+	drpcerr "storj.io/drpc/drpcerr"
+)
+
+type drpcEncoding_File_metainfo_ext_proto struct{}
+
+func (drpcEncoding_File_metainfo_ext_proto) Marshal(msg drpc.Message) ([]byte, error) {
+	return proto.Marshal(msg.(proto.Message))
+}
+
+func (drpcEncoding_File_metainfo_ext_proto) Unmarshal(buf []byte, msg drpc.Message) error {
+	return proto.Unmarshal(buf, msg.(proto.Message))
+}
+
+func (drpcEncoding_File_metainfo_ext_proto) JSONMarshal(msg drpc.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := new(jsonpb.Marshaler).Marshal(&buf, msg.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (drpcEncoding_File_metainfo_ext_proto) JSONUnmarshal(buf []byte, msg drpc.Message) error {
+	return jsonpb.Unmarshal(bytes.NewReader(buf), msg.(proto.Message))
+}
+
+type DRPCMetainfoExtClient interface {
+	DRPCConn() drpc.Conn
+
+	ListObjectVersions(ctx context.Context, in *pb.ListObjectsRequest) (*pb.ListObjectsResponse, error)
+	GetBucketVersioning(ctx context.Context, in *GetBucketVersioningRequest) (*GetBucketVersioningResponse, error)
+	SetBucketVersioning(ctx context.Context, in *SetBucketVersioningRequest) (*SetBucketVersioningResponse, error)
+	SetBucketDefaultRetention(ctx context.Context, in *SetBucketDefaultRetentionRequest) (*SetBucketDefaultRetentionResponse, error)
+	SetObjectRetention(ctx context.Context, in *SetObjectRetentionRequest) (*SetObjectRetentionResponse, error)
+	SetObjectLegalHold(ctx context.Context, in *SetObjectLegalHoldRequest) (*SetObjectLegalHoldResponse, error)
+	GetBucketLifecycle(ctx context.Context, in *GetBucketLifecycleRequest) (*GetBucketLifecycleResponse, error)
+	SetBucketLifecycle(ctx context.Context, in *SetBucketLifecycleRequest) (*SetBucketLifecycleResponse, error)
+	GetBucketNotifications(ctx context.Context, in *GetBucketNotificationsRequest) (*GetBucketNotificationsResponse, error)
+	SetBucketNotifications(ctx context.Context, in *SetBucketNotificationsRequest) (*SetBucketNotificationsResponse, error)
+}
+
+type drpcMetainfoExtClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCMetainfoExtClient(cc drpc.Conn) DRPCMetainfoExtClient {
+	return &drpcMetainfoExtClient{cc}
+}
+
+func (c *drpcMetainfoExtClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcMetainfoExtClient) ListObjectVersions(ctx context.Context, in *pb.ListObjectsRequest) (*pb.ListObjectsResponse, error) {
+	out := new(pb.ListObjectsResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo_ext.MetainfoExt/ListObjectVersions", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) GetBucketVersioning(ctx context.Context, in *GetBucketVersioningRequest) (*GetBucketVersioningResponse, error) {
+	out := new(GetBucketVersioningResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo_ext.MetainfoExt/GetBucketVersioning", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) SetBucketVersioning(ctx context.Context, in *SetBucketVersioningRequest) (*SetBucketVersioningResponse, error) {
+	out := new(SetBucketVersioningResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo_ext.MetainfoExt/SetBucketVersioning", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) SetBucketDefaultRetention(ctx context.Context, in *SetBucketDefaultRetentionRequest) (*SetBucketDefaultRetentionResponse, error) {
+	out := new(SetBucketDefaultRetentionResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo_ext.MetainfoExt/SetBucketDefaultRetention", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) SetObjectRetention(ctx context.Context, in *SetObjectRetentionRequest) (*SetObjectRetentionResponse, error) {
+	out := new(SetObjectRetentionResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo_ext.MetainfoExt/SetObjectRetention", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) SetObjectLegalHold(ctx context.Context, in *SetObjectLegalHoldRequest) (*SetObjectLegalHoldResponse, error) {
+	out := new(SetObjectLegalHoldResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo_ext.MetainfoExt/SetObjectLegalHold", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) GetBucketLifecycle(ctx context.Context, in *GetBucketLifecycleRequest) (*GetBucketLifecycleResponse, error) {
+	out := new(GetBucketLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo_ext.MetainfoExt/GetBucketLifecycle", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) SetBucketLifecycle(ctx context.Context, in *SetBucketLifecycleRequest) (*SetBucketLifecycleResponse, error) {
+	out := new(SetBucketLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo_ext.MetainfoExt/SetBucketLifecycle", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) GetBucketNotifications(ctx context.Context, in *GetBucketNotificationsRequest) (*GetBucketNotificationsResponse, error) {
+	out := new(GetBucketNotificationsResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo_ext.MetainfoExt/GetBucketNotifications", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcMetainfoExtClient) SetBucketNotifications(ctx context.Context, in *SetBucketNotificationsRequest) (*SetBucketNotificationsResponse, error) {
+	out := new(SetBucketNotificationsResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo_ext.MetainfoExt/SetBucketNotifications", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCMetainfoExtServer interface {
+	ListObjectVersions(context.Context, *pb.ListObjectsRequest) (*pb.ListObjectsResponse, error)
+	GetBucketVersioning(context.Context, *GetBucketVersioningRequest) (*GetBucketVersioningResponse, error)
+	SetBucketVersioning(context.Context, *SetBucketVersioningRequest) (*SetBucketVersioningResponse, error)
+	SetBucketDefaultRetention(context.Context, *SetBucketDefaultRetentionRequest) (*SetBucketDefaultRetentionResponse, error)
+	SetObjectRetention(context.Context, *SetObjectRetentionRequest) (*SetObjectRetentionResponse, error)
+	SetObjectLegalHold(context.Context, *SetObjectLegalHoldRequest) (*SetObjectLegalHoldResponse, error)
+	GetBucketLifecycle(context.Context, *GetBucketLifecycleRequest) (*GetBucketLifecycleResponse, error)
+	SetBucketLifecycle(context.Context, *SetBucketLifecycleRequest) (*SetBucketLifecycleResponse, error)
+	GetBucketNotifications(context.Context, *GetBucketNotificationsRequest) (*GetBucketNotificationsResponse, error)
+	SetBucketNotifications(context.Context, *SetBucketNotificationsRequest) (*SetBucketNotificationsResponse, error)
+}
+
+type DRPCMetainfoExtUnimplementedServer struct{}
+
+func (s *DRPCMetainfoExtUnimplementedServer) ListObjectVersions(context.Context, *pb.ListObjectsRequest) (*pb.ListObjectsResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) GetBucketVersioning(context.Context, *GetBucketVersioningRequest) (*GetBucketVersioningResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) SetBucketVersioning(context.Context, *SetBucketVersioningRequest) (*SetBucketVersioningResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) SetBucketDefaultRetention(context.Context, *SetBucketDefaultRetentionRequest) (*SetBucketDefaultRetentionResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) SetObjectRetention(context.Context, *SetObjectRetentionRequest) (*SetObjectRetentionResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) SetObjectLegalHold(context.Context, *SetObjectLegalHoldRequest) (*SetObjectLegalHoldResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) GetBucketLifecycle(context.Context, *GetBucketLifecycleRequest) (*GetBucketLifecycleResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) SetBucketLifecycle(context.Context, *SetBucketLifecycleRequest) (*SetBucketLifecycleResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) GetBucketNotifications(context.Context, *GetBucketNotificationsRequest) (*GetBucketNotificationsResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCMetainfoExtUnimplementedServer) SetBucketNotifications(context.Context, *SetBucketNotificationsRequest) (*SetBucketNotificationsResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCMetainfoExtDescription struct{}
+
+func (DRPCMetainfoExtDescription) NumMethods() int { return 10 }
+
+func (DRPCMetainfoExtDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/satellite.metainfo_ext.MetainfoExt/ListObjectVersions", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					ListObjectVersions(
+						ctx,
+						in1.(*pb.ListObjectsRequest),
+					)
+			}, DRPCMetainfoExtServer.ListObjectVersions, true
+	case 1:
+		return "/satellite.metainfo_ext.MetainfoExt/GetBucketVersioning", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					GetBucketVersioning(
+						ctx,
+						in1.(*GetBucketVersioningRequest),
+					)
+			}, DRPCMetainfoExtServer.GetBucketVersioning, true
+	case 2:
+		return "/satellite.metainfo_ext.MetainfoExt/SetBucketVersioning", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					SetBucketVersioning(
+						ctx,
+						in1.(*SetBucketVersioningRequest),
+					)
+			}, DRPCMetainfoExtServer.SetBucketVersioning, true
+	case 3:
+		return "/satellite.metainfo_ext.MetainfoExt/SetBucketDefaultRetention", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					SetBucketDefaultRetention(
+						ctx,
+						in1.(*SetBucketDefaultRetentionRequest),
+					)
+			}, DRPCMetainfoExtServer.SetBucketDefaultRetention, true
+	case 4:
+		return "/satellite.metainfo_ext.MetainfoExt/SetObjectRetention", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					SetObjectRetention(
+						ctx,
+						in1.(*SetObjectRetentionRequest),
+					)
+			}, DRPCMetainfoExtServer.SetObjectRetention, true
+	case 5:
+		return "/satellite.metainfo_ext.MetainfoExt/SetObjectLegalHold", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					SetObjectLegalHold(
+						ctx,
+						in1.(*SetObjectLegalHoldRequest),
+					)
+			}, DRPCMetainfoExtServer.SetObjectLegalHold, true
+	case 6:
+		return "/satellite.metainfo_ext.MetainfoExt/GetBucketLifecycle", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					GetBucketLifecycle(
+						ctx,
+						in1.(*GetBucketLifecycleRequest),
+					)
+			}, DRPCMetainfoExtServer.GetBucketLifecycle, true
+	case 7:
+		return "/satellite.metainfo_ext.MetainfoExt/SetBucketLifecycle", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					SetBucketLifecycle(
+						ctx,
+						in1.(*SetBucketLifecycleRequest),
+					)
+			}, DRPCMetainfoExtServer.SetBucketLifecycle, true
+	case 8:
+		return "/satellite.metainfo_ext.MetainfoExt/GetBucketNotifications", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					GetBucketNotifications(
+						ctx,
+						in1.(*GetBucketNotificationsRequest),
+					)
+			}, DRPCMetainfoExtServer.GetBucketNotifications, true
+	case 9:
+		return "/satellite.metainfo_ext.MetainfoExt/SetBucketNotifications", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMetainfoExtServer).
+					SetBucketNotifications(
+						ctx,
+						in1.(*SetBucketNotificationsRequest),
+					)
+			}, DRPCMetainfoExtServer.SetBucketNotifications, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterMetainfoExt(mux drpc.Mux, impl DRPCMetainfoExtServer) error {
+	return mux.Register(impl, DRPCMetainfoExtDescription{})
+}
+
+type DRPCMetainfoExt_ListObjectVersionsStream interface {
+	drpc.Stream
+	SendAndClose(*pb.ListObjectsResponse) error
+}
+
+type drpcMetainfoExt_ListObjectVersionsStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_ListObjectVersionsStream) SendAndClose(m *pb.ListObjectsResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_GetBucketVersioningStream interface {
+	drpc.Stream
+	SendAndClose(*GetBucketVersioningResponse) error
+}
+
+type drpcMetainfoExt_GetBucketVersioningStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_GetBucketVersioningStream) SendAndClose(m *GetBucketVersioningResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_SetBucketVersioningStream interface {
+	drpc.Stream
+	SendAndClose(*SetBucketVersioningResponse) error
+}
+
+type drpcMetainfoExt_SetBucketVersioningStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_SetBucketVersioningStream) SendAndClose(m *SetBucketVersioningResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_SetBucketDefaultRetentionStream interface {
+	drpc.Stream
+	SendAndClose(*SetBucketDefaultRetentionResponse) error
+}
+
+type drpcMetainfoExt_SetBucketDefaultRetentionStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_SetBucketDefaultRetentionStream) SendAndClose(m *SetBucketDefaultRetentionResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_SetObjectRetentionStream interface {
+	drpc.Stream
+	SendAndClose(*SetObjectRetentionResponse) error
+}
+
+type drpcMetainfoExt_SetObjectRetentionStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_SetObjectRetentionStream) SendAndClose(m *SetObjectRetentionResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_SetObjectLegalHoldStream interface {
+	drpc.Stream
+	SendAndClose(*SetObjectLegalHoldResponse) error
+}
+
+type drpcMetainfoExt_SetObjectLegalHoldStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_SetObjectLegalHoldStream) SendAndClose(m *SetObjectLegalHoldResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_GetBucketLifecycleStream interface {
+	drpc.Stream
+	SendAndClose(*GetBucketLifecycleResponse) error
+}
+
+type drpcMetainfoExt_GetBucketLifecycleStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_GetBucketLifecycleStream) SendAndClose(m *GetBucketLifecycleResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_SetBucketLifecycleStream interface {
+	drpc.Stream
+	SendAndClose(*SetBucketLifecycleResponse) error
+}
+
+type drpcMetainfoExt_SetBucketLifecycleStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_SetBucketLifecycleStream) SendAndClose(m *SetBucketLifecycleResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_GetBucketNotificationsStream interface {
+	drpc.Stream
+	SendAndClose(*GetBucketNotificationsResponse) error
+}
+
+type drpcMetainfoExt_GetBucketNotificationsStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_GetBucketNotificationsStream) SendAndClose(m *GetBucketNotificationsResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCMetainfoExt_SetBucketNotificationsStream interface {
+	drpc.Stream
+	SendAndClose(*SetBucketNotificationsResponse) error
+}
+
+type drpcMetainfoExt_SetBucketNotificationsStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMetainfoExt_SetBucketNotificationsStream) SendAndClose(m *SetBucketNotificationsResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
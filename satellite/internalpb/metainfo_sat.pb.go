@@ -38,10 +38,15 @@ type StreamID struct {
 	Placement            int32                    `protobuf:"varint,13,opt,name=placement,proto3" json:"placement,omitempty"`
 	// temporary field to determine if we should go with new pending_objects table or
 	// fallback to pending object in objects table.
-	UsePendingObjectsTable bool     `protobuf:"varint,14,opt,name=use_pending_objects_table,json=usePendingObjectsTable,proto3" json:"use_pending_objects_table,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_unrecognized       []byte   `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
+	UsePendingObjectsTable bool `protobuf:"varint,14,opt,name=use_pending_objects_table,json=usePendingObjectsTable,proto3" json:"use_pending_objects_table,omitempty"`
+	// versioning state and default retention of the bucket when the upload
+	// began, which are applied when the object is committed.
+	Versioning           int32    `protobuf:"varint,15,opt,name=versioning,proto3" json:"versioning,omitempty"`
+	DefaultRetentionMode int32    `protobuf:"varint,16,opt,name=default_retention_mode,json=defaultRetentionMode,proto3" json:"default_retention_mode,omitempty"`
+	DefaultRetentionDays int32    `protobuf:"varint,17,opt,name=default_retention_days,json=defaultRetentionDays,proto3" json:"default_retention_days,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *StreamID) Reset()         { *m = StreamID{} }
@@ -145,6 +150,27 @@ func (m *StreamID) GetUsePendingObjectsTable() bool {
 	return false
 }
 
+func (m *StreamID) GetVersioning() int32 {
+	if m != nil {
+		return m.Versioning
+	}
+	return 0
+}
+
+func (m *StreamID) GetDefaultRetentionMode() int32 {
+	if m != nil {
+		return m.DefaultRetentionMode
+	}
+	return 0
+}
+
+func (m *StreamID) GetDefaultRetentionDays() int32 {
+	if m != nil {
+		return m.DefaultRetentionDays
+	}
+	return 0
+}
+
 type SegmentID struct {
 	StreamId             *StreamID                 `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
 	PartNumber           int32                     `protobuf:"varint,2,opt,name=part_number,json=partNumber,proto3" json:"part_number,omitempty"`
@@ -232,42 +258,45 @@ func init() {
 func init() { proto.RegisterFile("metainfo_sat.proto", fileDescriptor_47c60bd892d94aaf) }
 
 var fileDescriptor_47c60bd892d94aaf = []byte{
-	// 580 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xcb, 0x6e, 0xd3, 0x40,
-	0x14, 0xc5, 0x84, 0xa4, 0xc9, 0x24, 0x7d, 0x68, 0xfa, 0xd0, 0x10, 0x8a, 0x62, 0x15, 0x21, 0x99,
-	0x8d, 0x8d, 0xda, 0x55, 0x97, 0x44, 0x61, 0x11, 0xf1, 0x68, 0x70, 0xca, 0x86, 0x8d, 0x35, 0xb6,
-	0x6f, 0xad, 0x69, 0xed, 0x19, 0x6b, 0x66, 0x8c, 0x9a, 0x25, 0x7f, 0xc0, 0xaf, 0xf0, 0x17, 0x7c,
-	0x03, 0x8b, 0xf2, 0x2b, 0xc8, 0xe3, 0x47, 0x22, 0xd1, 0x2e, 0x60, 0x37, 0xf7, 0xdc, 0x33, 0x67,
-	0xce, 0x9c, 0x7b, 0x11, 0xce, 0x40, 0x53, 0xc6, 0xaf, 0x44, 0xa0, 0xa8, 0x76, 0x73, 0x29, 0xb4,
-	0xc0, 0x58, 0x51, 0x0d, 0x69, 0xca, 0x34, 0xb8, 0x4d, 0x77, 0xbc, 0x07, 0x3c, 0x92, 0xab, 0x5c,
-	0x33, 0xc1, 0x2b, 0xd6, 0x18, 0x25, 0x22, 0x11, 0xf5, 0x79, 0x92, 0x08, 0x91, 0xa4, 0xe0, 0x99,
-	0x2a, 0x2c, 0xae, 0x3c, 0xcd, 0x32, 0x50, 0x9a, 0x66, 0x79, 0x4d, 0xd8, 0x69, 0x84, 0xaa, 0xfa,
-	0xe4, 0xc7, 0x13, 0xd4, 0x5f, 0x6a, 0x09, 0x34, 0x9b, 0xcf, 0xf0, 0x11, 0xea, 0x85, 0x45, 0x74,
-	0x03, 0x9a, 0x58, 0xb6, 0xe5, 0x8c, 0xfc, 0xba, 0xc2, 0xaf, 0xd1, 0x41, 0xfd, 0x2a, 0xc4, 0x81,
-	0x08, 0xaf, 0x21, 0xd2, 0xc1, 0x0d, 0xac, 0xc8, 0x63, 0xc3, 0xc2, 0x6d, 0xef, 0xc2, 0xb4, 0xde,
-	0xc1, 0x0a, 0x13, 0xb4, 0xf5, 0x15, 0xa4, 0x62, 0x82, 0x93, 0x8e, 0x6d, 0x39, 0x1d, 0xbf, 0x29,
-	0xf1, 0x67, 0x74, 0xb8, 0xfe, 0x41, 0x90, 0x53, 0x49, 0x33, 0xd0, 0x20, 0x15, 0x19, 0xd9, 0x96,
-	0x33, 0x3c, 0xb5, 0xdd, 0x8d, 0xff, 0xbd, 0x6d, 0x8f, 0x8b, 0x96, 0xe7, 0x1f, 0xc0, 0x3d, 0x28,
-	0x9e, 0xa3, 0xed, 0x48, 0x02, 0x35, 0xa2, 0x31, 0xd5, 0x40, 0xba, 0x46, 0x6e, 0xec, 0x56, 0x81,
-	0xb8, 0x4d, 0x20, 0xee, 0x65, 0x13, 0xc8, 0xb4, 0xff, 0xf3, 0x6e, 0xf2, 0xe8, 0xfb, 0xef, 0x89,
-	0xe5, 0x8f, 0x9a, 0xab, 0x33, 0xaa, 0x01, 0x7f, 0x40, 0xbb, 0x70, 0x9b, 0x33, 0xb9, 0x21, 0xd6,
-	0xfb, 0x07, 0xb1, 0x9d, 0xf5, 0x65, 0x23, 0xf7, 0x0a, 0xed, 0x65, 0x45, 0xaa, 0x59, 0x4e, 0xa5,
-	0xae, 0xc3, 0x23, 0x43, 0xdb, 0x72, 0xfa, 0xfe, 0x6e, 0x8b, 0x57, 0xc1, 0x61, 0x0f, 0xed, 0xb7,
-	0x13, 0x0f, 0x14, 0x4b, 0x38, 0xd5, 0x85, 0x04, 0x32, 0xa8, 0x62, 0x6e, 0x5b, 0xcb, 0xa6, 0x83,
-	0x9f, 0xa1, 0x81, 0x32, 0xc3, 0x0b, 0x58, 0x4c, 0x90, 0xa1, 0xf5, 0x2b, 0x60, 0x1e, 0xe3, 0x63,
-	0x34, 0xc8, 0x53, 0x1a, 0x41, 0x06, 0x5c, 0x93, 0x6d, 0xdb, 0x72, 0xba, 0xfe, 0x1a, 0xc0, 0xe7,
-	0xe8, 0x69, 0xa1, 0x20, 0xc8, 0x81, 0xc7, 0x8c, 0x27, 0xb5, 0x31, 0x15, 0x68, 0x1a, 0xa6, 0x40,
-	0x76, 0x8c, 0xbf, 0xa3, 0x42, 0xc1, 0xa2, 0xea, 0x57, 0x06, 0xd5, 0x65, 0xd9, 0x3d, 0xf9, 0xd6,
-	0x41, 0x83, 0x25, 0x24, 0xa5, 0xcc, 0x7c, 0x86, 0xcf, 0x37, 0x3d, 0x58, 0x26, 0xa8, 0x63, 0xf7,
-	0xef, 0xc5, 0x75, 0x9b, 0x2d, 0xdb, 0x70, 0x38, 0x41, 0x43, 0x93, 0x0a, 0x2f, 0xb2, 0x10, 0xa4,
-	0x59, 0xa7, 0xae, 0x8f, 0x4a, 0xe8, 0xa3, 0x41, 0xf0, 0x01, 0xea, 0x32, 0x1e, 0xc3, 0xad, 0x59,
-	0xa2, 0xae, 0x5f, 0x15, 0xf8, 0x0c, 0x6d, 0x4b, 0x21, 0x74, 0x90, 0x33, 0x88, 0xa0, 0x7c, 0xb5,
-	0x9c, 0xf5, 0x68, 0xba, 0x5b, 0x8e, 0xe0, 0xd7, 0xdd, 0x64, 0x6b, 0x51, 0xe2, 0xf3, 0x99, 0x3f,
-	0x2c, 0x59, 0x55, 0x11, 0xe3, 0x4f, 0xe8, 0x50, 0x48, 0x96, 0x30, 0x4e, 0xd3, 0x40, 0xc8, 0x18,
-	0x64, 0x90, 0xb2, 0x8c, 0x69, 0x45, 0x7a, 0x76, 0xc7, 0x19, 0x9e, 0x3e, 0x5f, 0x1b, 0x7d, 0x13,
-	0xc7, 0x12, 0x94, 0x82, 0xf8, 0xa2, 0xa4, 0xbd, 0x2f, 0x59, 0xfe, 0x7e, 0x73, 0x77, 0x8d, 0xdd,
-	0xb3, 0x73, 0x5b, 0xff, 0xbd, 0x73, 0x0f, 0x4c, 0xbe, 0xff, 0xd0, 0xe4, 0xa7, 0x2f, 0xbf, 0xbc,
-	0x50, 0x5a, 0xc8, 0x6b, 0x97, 0x09, 0xcf, 0x1c, 0xbc, 0x96, 0xe4, 0x31, 0xae, 0x41, 0x72, 0x9a,
-	0xe6, 0x61, 0xd8, 0x33, 0x1e, 0xce, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0xec, 0x7c, 0xdf, 0x4d,
-	0x5e, 0x04, 0x00, 0x00,
+	// 631 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xfd, 0xfc, 0x45, 0x49, 0x93, 0x49, 0xda, 0x94, 0x69, 0x5a, 0x0d, 0xa5, 0x10, 0xab, 0x08,
+	0x29, 0x6c, 0x1c, 0xd4, 0xb2, 0xe9, 0x92, 0x2a, 0x2c, 0x22, 0x28, 0x2d, 0x6e, 0xd9, 0xb0, 0xb1,
+	0xc6, 0x9e, 0x5b, 0x6b, 0x5a, 0x7b, 0xc6, 0x9a, 0x19, 0xa3, 0x66, 0xc9, 0x1b, 0xf0, 0x42, 0xec,
+	0x79, 0x06, 0x16, 0xe5, 0x55, 0x90, 0xc7, 0x3f, 0x89, 0x44, 0xba, 0x80, 0xdd, 0xdc, 0x73, 0xce,
+	0x5c, 0xdf, 0x39, 0xf7, 0x18, 0xe1, 0x14, 0x0c, 0xe5, 0xe2, 0x5a, 0x06, 0x9a, 0x1a, 0x2f, 0x53,
+	0xd2, 0x48, 0x8c, 0x35, 0x35, 0x90, 0x24, 0xdc, 0x80, 0x57, 0xb3, 0xfb, 0xdb, 0x20, 0x22, 0xb5,
+	0xc8, 0x0c, 0x97, 0xa2, 0x54, 0xed, 0xa3, 0x58, 0xc6, 0xb2, 0x3a, 0x8f, 0x63, 0x29, 0xe3, 0x04,
+	0xa6, 0xb6, 0x0a, 0xf3, 0xeb, 0xa9, 0xe1, 0x29, 0x68, 0x43, 0xd3, 0xac, 0x12, 0x6c, 0xd5, 0x8d,
+	0xca, 0xfa, 0xf0, 0x7b, 0x1b, 0x75, 0x2f, 0x8d, 0x02, 0x9a, 0xce, 0x67, 0x78, 0x0f, 0x75, 0xc2,
+	0x3c, 0xba, 0x05, 0x43, 0x1c, 0xd7, 0x99, 0x0c, 0xfc, 0xaa, 0xc2, 0xaf, 0xd0, 0xa8, 0xfa, 0x2a,
+	0xb0, 0x40, 0x86, 0x37, 0x10, 0x99, 0xe0, 0x16, 0x16, 0xe4, 0x7f, 0xab, 0xc2, 0x0d, 0x77, 0x6e,
+	0xa9, 0x77, 0xb0, 0xc0, 0x04, 0x6d, 0x7c, 0x01, 0xa5, 0xb9, 0x14, 0xa4, 0xe5, 0x3a, 0x93, 0x96,
+	0x5f, 0x97, 0xf8, 0x13, 0xda, 0x5d, 0xbe, 0x20, 0xc8, 0xa8, 0xa2, 0x29, 0x18, 0x50, 0x9a, 0x0c,
+	0x5c, 0x67, 0xd2, 0x3f, 0x72, 0xbd, 0x95, 0xf7, 0xbd, 0x6d, 0x8e, 0x17, 0x8d, 0xce, 0x1f, 0xc1,
+	0x1a, 0x14, 0xcf, 0xd1, 0x66, 0xa4, 0x80, 0xda, 0xa6, 0x8c, 0x1a, 0x20, 0x6d, 0xdb, 0x6e, 0xdf,
+	0x2b, 0x0d, 0xf1, 0x6a, 0x43, 0xbc, 0xab, 0xda, 0x90, 0xd3, 0xee, 0x8f, 0xfb, 0xf1, 0x7f, 0xdf,
+	0x7e, 0x8d, 0x1d, 0x7f, 0x50, 0x5f, 0x9d, 0x51, 0x03, 0xf8, 0x0c, 0x0d, 0xe1, 0x2e, 0xe3, 0x6a,
+	0xa5, 0x59, 0xe7, 0x2f, 0x9a, 0x6d, 0x2d, 0x2f, 0xdb, 0x76, 0x2f, 0xd1, 0x76, 0x9a, 0x27, 0x86,
+	0x67, 0x54, 0x99, 0xca, 0x3c, 0xd2, 0x77, 0x9d, 0x49, 0xd7, 0x1f, 0x36, 0x78, 0x69, 0x1c, 0x9e,
+	0xa2, 0x9d, 0x66, 0xe3, 0x81, 0xe6, 0xb1, 0xa0, 0x26, 0x57, 0x40, 0x7a, 0xa5, 0xcd, 0x0d, 0x75,
+	0x59, 0x33, 0xf8, 0x09, 0xea, 0x69, 0xbb, 0xbc, 0x80, 0x33, 0x82, 0xac, 0xac, 0x5b, 0x02, 0x73,
+	0x86, 0x0f, 0x50, 0x2f, 0x4b, 0x68, 0x04, 0x29, 0x08, 0x43, 0x36, 0x5d, 0x67, 0xd2, 0xf6, 0x97,
+	0x00, 0x3e, 0x41, 0x8f, 0x73, 0x0d, 0x41, 0x06, 0x82, 0x71, 0x11, 0x57, 0x83, 0xe9, 0xc0, 0xd0,
+	0x30, 0x01, 0xb2, 0x65, 0xe7, 0xdb, 0xcb, 0x35, 0x5c, 0x94, 0x7c, 0x39, 0xa0, 0xbe, 0x2a, 0x58,
+	0xfc, 0x0c, 0xa1, 0x6a, 0x9b, 0x5c, 0xc4, 0x64, 0x68, 0x3b, 0xaf, 0x20, 0xf8, 0x35, 0xda, 0x63,
+	0x70, 0x4d, 0xf3, 0xc4, 0x04, 0x0a, 0x0c, 0x08, 0xeb, 0x63, 0x2a, 0x19, 0x90, 0x6d, 0xab, 0x1d,
+	0x55, 0xac, 0x5f, 0x93, 0x67, 0x92, 0xc1, 0xfa, 0x5b, 0x8c, 0x2e, 0x34, 0x79, 0xb4, 0xfe, 0xd6,
+	0x8c, 0x2e, 0xf4, 0xe1, 0xd7, 0x16, 0xea, 0x5d, 0x42, 0x5c, 0x3c, 0x69, 0x3e, 0xc3, 0x27, 0xab,
+	0x7e, 0x38, 0x76, 0x69, 0x07, 0xde, 0x9f, 0x3f, 0x91, 0x57, 0x27, 0x7e, 0xc5, 0xad, 0x31, 0xea,
+	0xdb, 0x0d, 0x89, 0x3c, 0x0d, 0x41, 0xd9, 0x68, 0xb7, 0x7d, 0x54, 0x40, 0x1f, 0x2c, 0x82, 0x47,
+	0xa8, 0xcd, 0x05, 0x83, 0x3b, 0x1b, 0xe8, 0xb6, 0x5f, 0x16, 0xf8, 0x18, 0x6d, 0x2a, 0x29, 0x4d,
+	0x90, 0x71, 0x88, 0xa0, 0xf8, 0x6a, 0x91, 0xbb, 0xc1, 0xe9, 0xb0, 0x88, 0xc3, 0xcf, 0xfb, 0xf1,
+	0xc6, 0x45, 0x81, 0xcf, 0x67, 0x7e, 0xbf, 0x50, 0x95, 0x05, 0xc3, 0x1f, 0xd1, 0xae, 0x54, 0x3c,
+	0xe6, 0x82, 0x26, 0x81, 0x54, 0x0c, 0x54, 0x90, 0xf0, 0x94, 0x1b, 0x4d, 0x3a, 0x6e, 0x6b, 0xd2,
+	0x3f, 0x7a, 0xba, 0x1c, 0xf4, 0x0d, 0x63, 0x0a, 0xb4, 0x06, 0x76, 0x5e, 0xc8, 0xde, 0x17, 0x2a,
+	0x7f, 0xa7, 0xbe, 0xbb, 0xc4, 0xd6, 0xe4, 0x7f, 0xe3, 0x9f, 0xf3, 0xff, 0x40, 0x0a, 0xbb, 0x0f,
+	0xa5, 0xf0, 0xf4, 0xc5, 0xe7, 0xe7, 0xda, 0x48, 0x75, 0xe3, 0x71, 0x39, 0xb5, 0x87, 0x69, 0x23,
+	0x9a, 0x72, 0x61, 0x40, 0x09, 0x9a, 0x64, 0x61, 0xd8, 0xb1, 0x33, 0x1c, 0xff, 0x1e, 0x00, 0x09,
+	0x3e, 0x50, 0x75, 0xea, 0x04, 0x00, 0x00,
 }
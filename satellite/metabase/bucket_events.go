@@ -0,0 +1,266 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/private/dbutil/txutil"
+	"storj.io/private/tagsql"
+)
+
+// BucketEventType is the kind of change of an object, which is reported
+// as a bucket event.
+type BucketEventType int
+
+const (
+	// BucketEventPut is an object committed by an upload.
+	BucketEventPut BucketEventType = 1
+	// BucketEventCopy is an object created by a server side copy.
+	BucketEventCopy BucketEventType = 2
+	// BucketEventMoveIn is an object created by a server side move.
+	BucketEventMoveIn BucketEventType = 3
+	// BucketEventMoveOut is an object removed by a server side move.
+	BucketEventMoveOut BucketEventType = 4
+	// BucketEventDelete is a deleted object version.
+	BucketEventDelete BucketEventType = 5
+	// BucketEventDeleteMarker is a delete marker hiding an object.
+	BucketEventDeleteMarker BucketEventType = 6
+	// BucketEventExpire is an object version deleted by its expiration or by
+	// the bucket lifecycle.
+	BucketEventExpire BucketEventType = 7
+	// BucketEventExpireMarker is a delete marker inserted by the bucket lifecycle.
+	BucketEventExpireMarker BucketEventType = 8
+)
+
+// BucketEvent is a change of an object.
+type BucketEvent struct {
+	Type   BucketEventType
+	Object Object
+}
+
+// BucketEventEntry is a bucket event waiting to be delivered to a webhook.
+type BucketEventEntry struct {
+	ID            uuid.UUID
+	Bucket        BucketLocation
+	WebhookURL    string
+	Payload       []byte
+	CreatedAt     time.Time
+	NextAttemptAt time.Time
+	Attempts      int
+	LastError     string
+}
+
+// BucketEvents converts the events of a bucket into the entries of the bucket
+// events outbox. The entries are inserted in the same transaction as the
+// change which caused the events, so an event is never lost or sent about a
+// change that was rolled back. It's called while the transaction is open,
+// hence it must not access any database.
+type BucketEvents interface {
+	BucketEventEntries(bucket BucketLocation, events []BucketEvent) ([]BucketEventEntry, error)
+}
+
+// BucketEventsResolver looks up where the events of the buckets are sent.
+// It's called before the transaction of the change. It returns nil when none
+// of the buckets has any events to send.
+type BucketEventsResolver interface {
+	ResolveBucketEvents(ctx context.Context, buckets []BucketLocation) (BucketEvents, error)
+}
+
+// withBucketEvents calls fn with a transaction and inserts the events returned
+// by fn in the same transaction. Without outbox fn is called without a transaction.
+func (db *DB) withBucketEvents(ctx context.Context, outbox BucketEvents, fn func(ctx context.Context, stmt stmt) ([]BucketEvent, error)) error {
+	if outbox == nil {
+		_, err := fn(ctx, db.db)
+		return err
+	}
+	return txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		events, err := fn(ctx, tx)
+		if err != nil {
+			return err
+		}
+		return db.insertBucketEvents(ctx, tx, outbox, events)
+	})
+}
+
+// resolveBucketEvents resolves the bucket events of the buckets of the objects.
+func resolveBucketEvents(ctx context.Context, resolver BucketEventsResolver, objects []ObjectStream) (BucketEvents, error) {
+	if resolver == nil || len(objects) == 0 {
+		return nil, nil
+	}
+
+	var buckets []BucketLocation
+	seen := map[BucketLocation]bool{}
+	for _, object := range objects {
+		bucket := object.Location().Bucket()
+		if !seen[bucket] {
+			seen[bucket] = true
+			buckets = append(buckets, bucket)
+		}
+	}
+
+	outbox, err := resolver.ResolveBucketEvents(ctx, buckets)
+	if err != nil {
+		return nil, Error.New("unable to resolve bucket events: %w", err)
+	}
+	return outbox, nil
+}
+
+// insertBucketEvents inserts the outbox entries of the events.
+func (db *DB) insertBucketEvents(ctx context.Context, tx tagsql.Tx, outbox BucketEvents, events []BucketEvent) (err error) {
+	if outbox == nil || len(events) == 0 {
+		return nil
+	}
+	defer mon.Task()(&ctx)(&err)
+
+	var entries []BucketEventEntry
+	for start := 0; start < len(events); {
+		bucket := events[start].Object.Location().Bucket()
+		end := start + 1
+		for end < len(events) && events[end].Object.Location().Bucket() == bucket {
+			end++
+		}
+
+		bucketEntries, err := outbox.BucketEventEntries(bucket, events[start:end])
+		if err != nil {
+			return Error.New("unable to create bucket events: %w", err)
+		}
+		entries = append(entries, bucketEntries...)
+		start = end
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+
+	var (
+		ids            = make([]uuid.UUID, 0, len(entries))
+		projectIDs     = make([]uuid.UUID, 0, len(entries))
+		bucketNames    = make([][]byte, 0, len(entries))
+		webhookURLs    = make([]string, 0, len(entries))
+		payloads       = make([][]byte, 0, len(entries))
+		nextAttemptAts = make([]time.Time, 0, len(entries))
+	)
+	for _, entry := range entries {
+		ids = append(ids, entry.ID)
+		projectIDs = append(projectIDs, entry.Bucket.ProjectID)
+		bucketNames = append(bucketNames, []byte(entry.Bucket.BucketName))
+		webhookURLs = append(webhookURLs, entry.WebhookURL)
+		payloads = append(payloads, entry.Payload)
+		nextAttemptAts = append(nextAttemptAts, entry.NextAttemptAt)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO bucket_events (
+			id, project_id, bucket_name, webhook_url, payload, next_attempt_at
+		) SELECT
+			unnest($1::BYTEA[]), unnest($2::BYTEA[]), unnest($3::BYTEA[]),
+			unnest($4::TEXT[]), unnest($5::BYTEA[]), unnest($6::TIMESTAMPTZ[])
+	`, pgutil.UUIDArray(ids), pgutil.UUIDArray(projectIDs), pgutil.ByteaArray(bucketNames),
+		pgutil.TextArray(webhookURLs), pgutil.ByteaArray(payloads), pgutil.TimestampTZArray(nextAttemptAts))
+	if err != nil {
+		return Error.New("unable to insert bucket events: %w", err)
+	}
+
+	mon.Meter("bucket_events_queued").Mark(len(entries))
+	return nil
+}
+
+// ClaimBucketEvents contains arguments necessary for claiming the due bucket events.
+type ClaimBucketEvents struct {
+	Now time.Time
+	// Lease postpones the next attempt of the claimed events, so they aren't
+	// claimed again while they are being delivered.
+	Lease time.Duration
+	Limit int
+}
+
+// ClaimBucketEvents returns up to Limit bucket events which are due at Now and
+// postpones their next attempt until Now+Lease.
+func (db *DB) ClaimBucketEvents(ctx context.Context, opts ClaimBucketEvents) (entries []BucketEventEntry, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts.Limit <= 0 {
+		return nil, ErrInvalidRequest.New("Limit is negative or zero")
+	}
+
+	err = withRows(db.db.QueryContext(ctx, `
+		UPDATE bucket_events
+		SET next_attempt_at = $2
+		WHERE id IN (
+			SELECT id FROM bucket_events
+			WHERE next_attempt_at <= $1
+			ORDER BY next_attempt_at
+			LIMIT $3
+		)
+		RETURNING id, project_id, bucket_name, webhook_url, payload, created_at, next_attempt_at, attempts, COALESCE(last_error, '')
+	`, opts.Now, opts.Now.Add(opts.Lease), opts.Limit))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var entry BucketEventEntry
+			err := rows.Scan(&entry.ID, &entry.Bucket.ProjectID, &entry.Bucket.BucketName, &entry.WebhookURL, &entry.Payload,
+				&entry.CreatedAt, &entry.NextAttemptAt, &entry.Attempts, &entry.LastError)
+			if err != nil {
+				return err
+			}
+			entries = append(entries, entry)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to claim bucket events: %w", err)
+	}
+	return entries, nil
+}
+
+// DeleteBucketEvent removes a delivered bucket event from the outbox.
+func (db *DB) DeleteBucketEvent(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `DELETE FROM bucket_events WHERE id = $1`, id)
+	return Error.Wrap(err)
+}
+
+// RescheduleBucketEvent contains arguments necessary for recording a failed delivery of a bucket event.
+type RescheduleBucketEvent struct {
+	ID            uuid.UUID
+	NextAttemptAt time.Time
+	LastError     string
+}
+
+// RescheduleBucketEvent records a failed delivery attempt of a bucket event.
+func (db *DB) RescheduleBucketEvent(ctx context.Context, opts RescheduleBucketEvent) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts.ID.IsZero() {
+		return ErrInvalidRequest.New("ID missing")
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		UPDATE bucket_events
+		SET
+			attempts = attempts + 1,
+			next_attempt_at = $2,
+			last_error = $3
+		WHERE id = $1
+	`, opts.ID, opts.NextAttemptAt, opts.LastError)
+	return Error.Wrap(err)
+}
+
+// deletedObjectEvents returns the events about the deleted objects and the
+// inserted delete markers.
+func deletedObjectEvents(result DeleteObjectResult, deleteType, markerType BucketEventType) []BucketEvent {
+	events := make([]BucketEvent, 0, len(result.Objects)+len(result.Markers))
+	for _, object := range result.Objects {
+		if object.Status == Pending {
+			continue
+		}
+		events = append(events, BucketEvent{Type: deleteType, Object: object})
+	}
+	for _, marker := range result.Markers {
+		events = append(events, BucketEvent{Type: markerType, Object: marker})
+	}
+	return events
+}
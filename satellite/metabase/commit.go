@@ -7,6 +7,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"time"
 
 	pgxerrcode "github.com/jackc/pgerrcode"
@@ -615,6 +616,15 @@ type CommitObject struct {
 	DisallowDelete bool
 
 	UsePendingObjectsTable bool
+
+	// Versioned commits the object as a new version without replacing the
+	// previous versions. It should be set for buckets with versioning enabled.
+	Versioned bool
+
+	Retention Retention // optional
+	LegalHold bool
+
+	BucketEvents BucketEvents // optional
 }
 
 // Verify verifies reqest fields.
@@ -627,6 +637,10 @@ func (c *CommitObject) Verify() error {
 		return ErrInvalidRequest.New("Encryption.BlockSize is negative or zero")
 	}
 
+	if err := c.Retention.Verify(); err != nil {
+		return err
+	}
+
 	if c.OverrideEncryptedMetadata {
 		if c.EncryptedMetadata == nil && (c.EncryptedMetadataNonce != nil || c.EncryptedMetadataEncryptedKey != nil) {
 			return ErrInvalidRequest.New("EncryptedMetadataNonce and EncryptedMetadataEncryptedKey must be not set if EncryptedMetadata is not set")
@@ -637,8 +651,8 @@ func (c *CommitObject) Verify() error {
 	return nil
 }
 
-// CommitObject adds a pending object to the database. If another committed object is under target location
-// it will be deleted.
+// CommitObject adds a pending object to the database. If another unversioned committed object is under
+// target location it will be deleted, unless the object is committed as a new version.
 func (db *DB) CommitObject(ctx context.Context, opts CommitObject) (object Object, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -693,26 +707,45 @@ func (db *DB) CommitObject(ctx context.Context, opts CommitObject) (object Objec
 			encryptionParameters{&opts.Encryption},
 		}
 
+		// versioned commits keep every previous version, unversioned commits
+		// replace the previous unversioned object or delete marker.
 		versionsToDelete := []Version{}
+		keepsVersions := false
+		highestVersion := Version(0)
 		if err := withRows(tx.QueryContext(ctx, `
-			SELECT version
+			SELECT version, status, NOT `+objectUnlockedCondition("false")+`
 			FROM objects
 			WHERE
 				project_id   = $1 AND
 				bucket_name  = $2 AND
 				object_key   = $3 AND
-				status       = `+committedStatus,
+				status       <> `+pendingStatus,
 			opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey))(func(rows tagsql.Rows) error {
 			for rows.Next() {
 				var version Version
-				if err := rows.Scan(&version); err != nil {
+				var status ObjectStatus
+				var locked bool
+				if err := rows.Scan(&version, &status, &locked); err != nil {
 					return Error.New("failed to scan previous object: %w", err)
 				}
 
-				versionsToDelete = append(versionsToDelete, version)
+				if !opts.Versioned && status.IsUnversioned() {
+					if locked {
+						return ErrObjectLock.New("unable to replace locked object")
+					}
+					versionsToDelete = append(versionsToDelete, version)
+				} else {
+					keepsVersions = true
+				}
+				if version > highestVersion {
+					highestVersion = version
+				}
 			}
 			return nil
 		}); err != nil {
+			if ErrObjectLock.Has(err) {
+				return err
+			}
 			return Error.New("failed to find previous objects: %w", err)
 		}
 
@@ -728,8 +761,17 @@ func (db *DB) CommitObject(ctx context.Context, opts CommitObject) (object Objec
 			return ErrPermissionDenied.New("no permissions to delete existing object")
 		}
 
+		status, statusSQL := Committed, committedStatus
+		if opts.Versioned {
+			status, statusSQL = CommittedVersioned, committedVersionedStatus
+		}
+
 		if opts.UsePendingObjectsTable {
 			opts.Version = 1
+			if keepsVersions {
+				// the kept versions must stay older than the new one.
+				opts.Version = highestVersion + 1
+			}
 
 			// we remove from deletion list object with version 1 as we will
 			// override/update instead deleting and inserting new one.
@@ -743,6 +785,7 @@ func (db *DB) CommitObject(ctx context.Context, opts CommitObject) (object Objec
 				opts.EncryptedMetadata,
 				opts.EncryptedMetadataEncryptedKey,
 				opts.OverrideEncryptedMetadata,
+				opts.Retention.Mode, timeOrZero{&opts.Retention.RetainUntil}, opts.LegalHold,
 			)
 
 			err = tx.QueryRowContext(ctx, `
@@ -754,7 +797,7 @@ func (db *DB) CommitObject(ctx context.Context, opts CommitObject) (object Objec
 						stream_id    = $5
 					RETURNING
 						project_id, bucket_name, object_key, $4::INT4 as version, stream_id,
-						`+committedStatus+` as status, $6::INT4 as segment_count, $7::INT8 as total_plain_size, $8::INT8 as total_encrypted_size, $9::INT4 as fixed_segment_size,
+						`+statusSQL+` as status, $6::INT4 as segment_count, $7::INT8 as total_plain_size, $8::INT8 as total_encrypted_size, $9::INT4 as fixed_segment_size,
 						NULL::timestamp as zombie_deletion_deadline,
 						CASE
 							WHEN encryption = 0 AND $10 <> 0 THEN $10
@@ -762,7 +805,8 @@ func (db *DB) CommitObject(ctx context.Context, opts CommitObject) (object Objec
 							ELSE encryption
 						END as
 						encryption,
-						encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key
+						encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
+						$15::INT2 as retention_mode, $16::TIMESTAMPTZ as retain_until, $17::BOOL as legal_hold
 				), object_to_commit AS (
 					SELECT
 						project_id, bucket_name, object_key, version, stream_id,
@@ -782,14 +826,16 @@ func (db *DB) CommitObject(ctx context.Context, opts CommitObject) (object Objec
 							WHEN $14::BOOL = true THEN $13
 							ELSE delete_pending_object.encrypted_metadata_encrypted_key
 						END as
-						encrypted_metadata_encrypted_key
+						encrypted_metadata_encrypted_key,
+						retention_mode, retain_until, legal_hold
 					FROM delete_pending_object
 				)
 				INSERT INTO objects (
 					project_id, bucket_name, object_key, version, stream_id,
 					status, segment_count, total_plain_size, total_encrypted_size, fixed_segment_size, zombie_deletion_deadline,
 					encryption,
-					encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key
+					encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
+					retention_mode, retain_until, legal_hold
 				)
 				SELECT * FROM object_to_commit
 				ON CONFLICT (project_id, bucket_name, object_key, version)
@@ -797,12 +843,14 @@ func (db *DB) CommitObject(ctx context.Context, opts CommitObject) (object Objec
 					stream_id,
 					status, segment_count, total_plain_size, total_encrypted_size, fixed_segment_size, zombie_deletion_deadline,
 					encryption,
-					encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key
+					encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
+					retention_mode, retain_until, legal_hold
 				) = (SELECT
 						stream_id,
 						status, segment_count, total_plain_size, total_encrypted_size, fixed_segment_size, zombie_deletion_deadline,
 						encryption,
-						encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key
+						encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
+						retention_mode, retain_until, legal_hold
 					FROM object_to_commit)
 				RETURNING
 					created_at, expires_at,
@@ -827,9 +875,15 @@ func (db *DB) CommitObject(ctx context.Context, opts CommitObject) (object Objec
 					encrypted_metadata_encrypted_key = $13
 				`
 			}
+			args = append(args, opts.Retention.Mode, timeOrZero{&opts.Retention.RetainUntil}, opts.LegalHold)
+			retentionColumns := fmt.Sprintf(`,
+					retention_mode = $%d,
+					retain_until   = $%d,
+					legal_hold     = $%d
+				`, len(args)-2, len(args)-1, len(args))
 			err = tx.QueryRowContext(ctx, `
 				UPDATE objects SET
-					status =`+committedStatus+`,
+					status =`+statusSQL+`,
 					segment_count = $6,
 
 					total_plain_size     = $7,
@@ -843,7 +897,7 @@ func (db *DB) CommitObject(ctx context.Context, opts CommitObject) (object Objec
 						WHEN objects.encryption = 0 AND $10 = 0 THEN NULL
 						ELSE objects.encryption
 					END
-					`+metadataColumns+`
+					`+metadataColumns+retentionColumns+`
 				WHERE
 					project_id   = $1 AND
 					bucket_name  = $2 AND
@@ -890,12 +944,15 @@ func (db *DB) CommitObject(ctx context.Context, opts CommitObject) (object Objec
 		object.BucketName = opts.BucketName
 		object.ObjectKey = opts.ObjectKey
 		object.Version = opts.Version
-		object.Status = Committed
+		object.Status = status
 		object.SegmentCount = int32(len(segments))
 		object.TotalPlainSize = totalPlainSize
 		object.TotalEncryptedSize = totalEncryptedSize
 		object.FixedSegmentSize = fixedSegmentSize
-		return nil
+		object.Retention = opts.Retention
+		object.LegalHold = opts.LegalHold
+
+		return db.insertBucketEvents(ctx, tx, opts.BucketEvents, []BucketEvent{{Type: BucketEventPut, Object: object}})
 	})
 	if err != nil {
 		return Object{}, err
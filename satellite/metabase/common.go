@@ -354,12 +354,45 @@ const (
 	// The failed upload may be continued in the future.
 	Pending = ObjectStatus(1)
 	// Committed means that the object is finished and should be visible for general listing.
+	// Committed objects are unversioned, committing a new object replaces them.
 	Committed = ObjectStatus(3)
-
-	pendingStatus   = "1"
-	committedStatus = "3"
+	// CommittedVersioned means that the object is finished and was committed into a
+	// bucket with versioning enabled. Committing a new object keeps it as an older version.
+	CommittedVersioned = ObjectStatus(4)
+	// DeleteMarkerVersioned is a version without data that marks the object as deleted
+	// in a bucket with versioning enabled.
+	DeleteMarkerVersioned = ObjectStatus(5)
+	// DeleteMarkerUnversioned is a delete marker that was created in a bucket with
+	// suspended versioning. Like Committed, it is replaced by the next unversioned object.
+	DeleteMarkerUnversioned = ObjectStatus(6)
+
+	pendingStatus                 = "1"
+	committedStatus               = "3"
+	committedVersionedStatus      = "4"
+	deleteMarkerVersionedStatus   = "5"
+	deleteMarkerUnversionedStatus = "6"
+
+	// statusesCommitted matches objects which contain data.
+	statusesCommitted = "(" + committedStatus + "," + committedVersionedStatus + ")"
+	// statusesUnversioned matches versions which are replaced by the next unversioned commit.
+	statusesUnversioned = "(" + committedStatus + "," + deleteMarkerUnversionedStatus + ")"
 )
 
+// IsDeleteMarker returns whether the status is a delete marker.
+func (status ObjectStatus) IsDeleteMarker() bool {
+	return status == DeleteMarkerVersioned || status == DeleteMarkerUnversioned
+}
+
+// IsCommitted returns whether the status is a committed object with data.
+func (status ObjectStatus) IsCommitted() bool {
+	return status == Committed || status == CommittedVersioned
+}
+
+// IsUnversioned returns whether the version is replaced by the next unversioned commit.
+func (status ObjectStatus) IsUnversioned() bool {
+	return status == Committed || status == DeleteMarkerUnversioned
+}
+
 // Pieces defines information for pieces.
 type Pieces []Piece
 
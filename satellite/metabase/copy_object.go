@@ -56,6 +56,13 @@ type FinishCopyObject struct {
 	// if it turns out completing the copy would exceed a limit.
 	// It will be called only once.
 	VerifyLimits func(encryptedObjectSize int64, nSegments int64) error
+
+	// NewVersioned copies the object as a new version without replacing the
+	// versions at the destination. It should be set when the destination
+	// bucket has versioning enabled.
+	NewVersioned bool
+
+	BucketEvents BucketEvents // optional
 }
 
 // Verify verifies metabase.FinishCopyObject data.
@@ -216,7 +223,7 @@ func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (obje
 			copyMetadata = sourceObject.EncryptedMetadata
 		}
 
-		if objectAtDestination != nil {
+		if objectAtDestination != nil && !opts.NewVersioned {
 			version := objectAtDestination.Version
 			deletedObjects, err := db.deleteObjectExactVersion(
 				ctx, DeleteObjectExactVersion{
@@ -229,6 +236,9 @@ func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (obje
 				}, tx,
 			)
 			if err != nil {
+				if ErrObjectLock.Has(err) {
+					return err
+				}
 				return Error.New("unable to delete existing object at copy destination: %w", err)
 			}
 
@@ -240,6 +250,11 @@ func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (obje
 			}
 		}
 
+		status, statusSQL := Committed, committedStatus
+		if opts.NewVersioned {
+			status, statusSQL = CommittedVersioned, committedVersionedStatus
+		}
+
 		// TODO we need to handle metadata correctly (copy from original object or replace)
 		row := tx.QueryRowContext(ctx, `
 			INSERT INTO objects (
@@ -251,7 +266,7 @@ func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (obje
 				zombie_deletion_deadline
 			) VALUES (
 				$1, $2, $3, $4, $5,
-				$6,`+committedStatus+`, $7,
+				$6,`+statusSQL+`, $7,
 				$8,
 				$9, $10, $11,
 				$12, $13, $14, null
@@ -267,6 +282,7 @@ func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (obje
 
 		newObject = sourceObject
 		newObject.Version = nextAvailableVersion
+		newObject.Status = status
 
 		err = row.Scan(&newObject.CreatedAt)
 		if err != nil {
@@ -306,7 +322,11 @@ func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (obje
 			return nil
 		}
 
-		return nil
+		copied := newObject
+		copied.StreamID = opts.NewStreamID
+		copied.BucketName = opts.NewBucket
+		copied.ObjectKey = opts.NewEncryptedObjectKey
+		return db.insertBucketEvents(ctx, tx, opts.BucketEvents, []BucketEvent{{Type: BucketEventCopy, Object: copied}})
 	})
 
 	if err != nil {
@@ -370,7 +390,7 @@ func getObjectAtCopySourceAndDestination(
 			bucket_name  = $3 AND
 			object_key   = $4 AND
 			version      = $2 AND
-			status       = `+committedStatus+`
+			status       IN `+statusesCommitted+`
 		UNION ALL
 		SELECT
 			stream_id,
@@ -386,8 +406,8 @@ func getObjectAtCopySourceAndDestination(
 			project_id  = $1 AND
 			bucket_name = $5 AND
 			object_key  = $6 AND
-			version     = (SELECT version FROM destination_current_versions
-							WHERE status = `+committedStatus+`)`,
+			version     = (SELECT max(version) FROM destination_current_versions
+							WHERE status IN `+statusesUnversioned+`)`,
 		sourceObject.ProjectID, sourceObject.Version,
 		[]byte(sourceObject.BucketName), sourceObject.ObjectKey,
 		opts.NewBucket, opts.NewEncryptedObjectKey)
@@ -424,8 +444,8 @@ func getObjectAtCopySourceAndDestination(
 		destinationObject.ProjectID = opts.ProjectID
 		destinationObject.BucketName = opts.NewBucket
 		destinationObject.ObjectKey = opts.NewEncryptedObjectKey
-		// There is an object at the destination.
-		// We will delete it before doing the copy
+		// There is an unversioned object or delete marker at the destination.
+		// We will delete it before doing the copy, unless the copy is versioned.
 		err := rows.Scan(
 			&destinationObject.StreamID,
 			&destinationObject.ExpiresAt,
@@ -170,7 +170,7 @@ func (db *DB) TestMigrateToLatest(ctx context.Context) error {
 			{
 				DB:          &db.db,
 				Description: "Test snapshot",
-				Version:     17,
+				Version:     19,
 				Action: migrate.SQL{
 					`CREATE TABLE objects (
 						project_id   BYTEA NOT NULL,
@@ -197,6 +197,10 @@ func (db *DB) TestMigrateToLatest(ctx context.Context) error {
 
 						zombie_deletion_deadline TIMESTAMPTZ default now() + '1 day',
 
+						retention_mode INT2 NOT NULL default 0,
+						retain_until   TIMESTAMPTZ,
+						legal_hold     BOOLEAN NOT NULL default false,
+
 						PRIMARY KEY (project_id, bucket_name, object_key, version)
 					);
 
@@ -336,7 +340,37 @@ func (db *DB) TestMigrateToLatest(ctx context.Context) error {
 
 					COMMENT ON COLUMN objects.encryption is 'encryption contains object encryption parameters encoded into a uint32. See metabase.encryptionParameters type for the implementation.';
 
-					COMMENT ON COLUMN objects.zombie_deletion_deadline is 'zombie_deletion_deadline defines when a pending object can be deleted due to a failed upload.';`,
+					COMMENT ON COLUMN objects.zombie_deletion_deadline is 'zombie_deletion_deadline defines when a pending object can be deleted due to a failed upload.';
+
+					COMMENT ON COLUMN objects.retention_mode is 'retention_mode refers to metabase.RetentionMode, where none=0, governance=1 and compliance=2.';
+					COMMENT ON COLUMN objects.retain_until   is 'retain_until is the date until the retention mode prevents the object from being deleted or modified.';
+					COMMENT ON COLUMN objects.legal_hold     is 'legal_hold prevents the object from being deleted or modified until it is removed.';`,
+					`CREATE TABLE bucket_events (
+						id              BYTEA       NOT NULL,
+						project_id      BYTEA       NOT NULL,
+						bucket_name     BYTEA       NOT NULL,
+						webhook_url     TEXT        NOT NULL,
+						payload         BYTEA       NOT NULL,
+						created_at      TIMESTAMPTZ NOT NULL default now(),
+						next_attempt_at TIMESTAMPTZ NOT NULL,
+						attempts        INT4        NOT NULL default 0,
+						last_error      TEXT,
+
+						PRIMARY KEY (id)
+					)`,
+					`CREATE INDEX bucket_events_next_attempt_at_index ON bucket_events (next_attempt_at)`,
+					`
+					COMMENT ON TABLE  bucket_events                 is 'bucket_events is an outbox of bucket event notifications, which are waiting to be delivered to a webhook.';
+					COMMENT ON COLUMN bucket_events.id              is 'id is an UUID for the event.';
+					COMMENT ON COLUMN bucket_events.project_id      is 'project_id is the project the bucket belongs to.';
+					COMMENT ON COLUMN bucket_events.bucket_name     is 'bucket_name is the bucket where the event happened.';
+					COMMENT ON COLUMN bucket_events.webhook_url     is 'webhook_url is the address where the event is delivered.';
+					COMMENT ON COLUMN bucket_events.payload         is 'payload is the JSON encoded bucketevents.Event.';
+					COMMENT ON COLUMN bucket_events.created_at      is 'created_at is when the event was added.';
+					COMMENT ON COLUMN bucket_events.next_attempt_at is 'next_attempt_at is when the delivery is attempted next.';
+					COMMENT ON COLUMN bucket_events.attempts        is 'attempts is the number of failed delivery attempts.';
+					COMMENT ON COLUMN bucket_events.last_error      is 'last_error is the reason of the last failed delivery attempt.';
+				`,
 				},
 			},
 		},
@@ -676,6 +710,53 @@ func (db *DB) PostgresMigration() *migrate.Migration {
 					COMMENT ON COLUMN objects.zombie_deletion_deadline is 'zombie_deletion_deadline defines when a pending object can be deleted due to a failed upload.';
 				`},
 			},
+			{
+				DB:          &db.db,
+				Description: "add object lock columns to objects",
+				Version:     18,
+				Action: migrate.SQL{
+					`ALTER TABLE objects ADD COLUMN retention_mode INT2 NOT NULL default 0`,
+					`ALTER TABLE objects ADD COLUMN retain_until TIMESTAMPTZ`,
+					`ALTER TABLE objects ADD COLUMN legal_hold BOOLEAN NOT NULL default false`,
+					`
+					COMMENT ON COLUMN objects.retention_mode is 'retention_mode refers to metabase.RetentionMode, where none=0, governance=1 and compliance=2.';
+					COMMENT ON COLUMN objects.retain_until   is 'retain_until is the date until the retention mode prevents the object from being deleted or modified.';
+					COMMENT ON COLUMN objects.legal_hold     is 'legal_hold prevents the object from being deleted or modified until it is removed.';
+				`},
+			},
+			{
+				DB:          &db.db,
+				Description: "add bucket_events table",
+				Version:     19,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_events (
+						id              BYTEA       NOT NULL,
+						project_id      BYTEA       NOT NULL,
+						bucket_name     BYTEA       NOT NULL,
+						webhook_url     TEXT        NOT NULL,
+						payload         BYTEA       NOT NULL,
+						created_at      TIMESTAMPTZ NOT NULL default now(),
+						next_attempt_at TIMESTAMPTZ NOT NULL,
+						attempts        INT4        NOT NULL default 0,
+						last_error      TEXT,
+
+						PRIMARY KEY (id)
+					)`,
+					`CREATE INDEX bucket_events_next_attempt_at_index ON bucket_events (next_attempt_at)`,
+					`
+					COMMENT ON TABLE  bucket_events                 is 'bucket_events is an outbox of bucket event notifications, which are waiting to be delivered to a webhook.';
+					COMMENT ON COLUMN bucket_events.id              is 'id is an UUID for the event.';
+					COMMENT ON COLUMN bucket_events.project_id      is 'project_id is the project the bucket belongs to.';
+					COMMENT ON COLUMN bucket_events.bucket_name     is 'bucket_name is the bucket where the event happened.';
+					COMMENT ON COLUMN bucket_events.webhook_url     is 'webhook_url is the address where the event is delivered.';
+					COMMENT ON COLUMN bucket_events.payload         is 'payload is the JSON encoded bucketevents.Event.';
+					COMMENT ON COLUMN bucket_events.created_at      is 'created_at is when the event was added.';
+					COMMENT ON COLUMN bucket_events.next_attempt_at is 'next_attempt_at is when the delivery is attempted next.';
+					COMMENT ON COLUMN bucket_events.attempts        is 'attempts is the number of failed delivery attempts.';
+					COMMENT ON COLUMN bucket_events.last_error      is 'last_error is the reason of the last failed delivery attempt.';
+				`,
+				},
+			},
 		},
 	}
 }
@@ -11,7 +11,9 @@ import (
 	"github.com/zeebo/errs"
 
 	"storj.io/common/storj"
+	"storj.io/common/uuid"
 	"storj.io/private/dbutil/pgutil"
+	"storj.io/private/dbutil/txutil"
 	"storj.io/private/tagsql"
 )
 
@@ -25,6 +27,12 @@ type DeletedSegmentInfo struct {
 type DeleteObjectExactVersion struct {
 	Version Version
 	ObjectLocation
+
+	// BypassGovernance allows deleting an object version under governance
+	// mode retention.
+	BypassGovernance bool
+
+	BucketEvents BucketEvents // optional
 }
 
 // Verify delete object fields.
@@ -41,11 +49,20 @@ func (obj *DeleteObjectExactVersion) Verify() error {
 // DeleteObjectResult result of deleting object.
 type DeleteObjectResult struct {
 	Objects []Object
+	// Markers contains the delete markers that were inserted instead of
+	// deleting the objects.
+	Markers []Object
 }
 
 // DeleteObjectsAllVersions contains arguments necessary for deleting all versions of multiple objects from the same bucket.
 type DeleteObjectsAllVersions struct {
 	Locations []ObjectLocation
+
+	// BypassGovernance allows deleting object versions under governance
+	// mode retention.
+	BypassGovernance bool
+
+	BucketEvents BucketEvents // optional
 }
 
 // Verify delete objects fields.
@@ -86,7 +103,8 @@ WITH deleted_objects AS (
 		project_id   = $1 AND
 		bucket_name  = $2 AND
 		object_key   = $3 AND
-		version      = $4
+		version      = $4 AND
+		` + objectUnlockedCondition("$5") + `
 	RETURNING
 		version, stream_id, created_at, expires_at, status, segment_count, encrypted_metadata_nonce,
 		encrypted_metadata, encrypted_metadata_encrypted_key, total_plain_size, total_encrypted_size,
@@ -113,7 +131,7 @@ WITH deleted_objects AS (
 			project_id   = $1 AND
 			bucket_name  = $2 AND
 			object_key   = $3 AND
-			status       = ` + committedStatus + ` AND
+			status       IN ` + statusesUnversioned + ` AND
 			(expires_at IS NULL OR expires_at > now())
 			ORDER BY version DESC
 		)
@@ -139,7 +157,13 @@ FROM deleted_objects`
 func (db *DB) DeleteObjectExactVersion(
 	ctx context.Context, opts DeleteObjectExactVersion,
 ) (result DeleteObjectResult, err error) {
-	result, err = db.deleteObjectExactVersion(ctx, opts, db.db)
+	err = db.withBucketEvents(ctx, opts.BucketEvents, func(ctx context.Context, stmt stmt) ([]BucketEvent, error) {
+		result, err = db.deleteObjectExactVersion(ctx, opts, stmt)
+		if err != nil {
+			return nil, err
+		}
+		return deletedObjectEvents(result, BucketEventDelete, BucketEventDeleteMarker), nil
+	})
 	if err != nil {
 		return DeleteObjectResult{}, err
 	}
@@ -148,6 +172,7 @@ func (db *DB) DeleteObjectExactVersion(
 
 type stmt interface {
 	QueryContext(ctx context.Context, query string, args ...interface{}) (tagsql.Rows, error)
+	queryRower
 }
 
 // implementation of DB.DeleteObjectExactVersion for re-use internally in metabase package.
@@ -160,7 +185,7 @@ func (db *DB) deleteObjectExactVersion(ctx context.Context, opts DeleteObjectExa
 
 	err = withRows(
 		stmt.QueryContext(ctx, deleteObjectExactVersion,
-			opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version),
+			opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version, opts.BypassGovernance),
 	)(func(rows tagsql.Rows) error {
 		result.Objects, err = db.scanObjectDeletion(ctx, opts.ObjectLocation, rows)
 		return err
@@ -169,6 +194,13 @@ func (db *DB) deleteObjectExactVersion(ctx context.Context, opts DeleteObjectExa
 		return DeleteObjectResult{}, err
 	}
 
+	if len(result.Objects) == 0 {
+		// distinguish a missing version from a locked one.
+		if err := checkObjectLocked(ctx, stmt, opts.ObjectLocation, opts.Version, opts.BypassGovernance); err != nil {
+			return DeleteObjectResult{}, err
+		}
+	}
+
 	mon.Meter("object_delete").Mark(len(result.Objects))
 	for _, object := range result.Objects {
 		mon.Meter("segment_delete").Mark(int(object.SegmentCount))
@@ -274,14 +306,60 @@ func (db *DB) DeleteObjectsAllVersions(ctx context.Context, opts DeleteObjectsAl
 	sort.Slice(objectKeys, func(i, j int) bool {
 		return bytes.Compare(objectKeys[i], objectKeys[j]) < 0
 	})
-	err = withRows(db.db.QueryContext(ctx, `
+
+	err = db.withBucketEvents(ctx, opts.BucketEvents, func(ctx context.Context, stmt stmt) ([]BucketEvent, error) {
+		result, err = db.deleteObjectsAllVersions(ctx, stmt, projectID, bucketName, objectKeys, opts.BypassGovernance)
+		if err != nil {
+			return nil, err
+		}
+		return deletedObjectEvents(result, BucketEventDelete, BucketEventDeleteMarker), nil
+	})
+	if err != nil {
+		return DeleteObjectResult{}, err
+	}
+
+	mon.Meter("object_delete").Mark(len(result.Objects))
+	for _, object := range result.Objects {
+		mon.Meter("segment_delete").Mark(int(object.SegmentCount))
+	}
+
+	return result, nil
+}
+
+// deleteObjectsAllVersions deletes all versions of the objects, unless any of them is locked.
+func (db *DB) deleteObjectsAllVersions(ctx context.Context, stmt stmt, projectID uuid.UUID, bucketName string, objectKeys [][]byte, bypassGovernance bool) (result DeleteObjectResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the whole request is refused when any of the objects is locked,
+	// instead of deleting only some of them.
+	var locked bool
+	err = stmt.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM objects
+			WHERE
+				project_id   = $1 AND
+				bucket_name  = $2 AND
+				object_key   = ANY ($3) AND
+				status       <> `+pendingStatus+` AND
+				NOT `+objectUnlockedCondition("$4")+`
+		)
+	`, projectID, []byte(bucketName), pgutil.ByteaArray(objectKeys), bypassGovernance).Scan(&locked)
+	if err != nil {
+		return DeleteObjectResult{}, Error.New("unable to check object lock: %w", err)
+	}
+	if locked {
+		return DeleteObjectResult{}, ErrObjectLock.New("unable to delete locked objects")
+	}
+
+	err = withRows(stmt.QueryContext(ctx, `
 				WITH deleted_objects AS (
 					DELETE FROM objects
 					WHERE
 					project_id   = $1 AND
 					bucket_name  = $2 AND
 					object_key   = ANY ($3) AND
-					status       = `+committedStatus+`
+					status       <> `+pendingStatus+` AND
+					`+objectUnlockedCondition("$4")+`
 					RETURNING
 						project_id, bucket_name, object_key, version, stream_id, created_at, expires_at,
 						status, segment_count, encrypted_metadata_nonce, encrypted_metadata,
@@ -298,20 +376,13 @@ func (db *DB) DeleteObjectsAllVersions(ctx context.Context, opts DeleteObjectsAl
 					encrypted_metadata_encrypted_key, total_plain_size, total_encrypted_size,
 					fixed_segment_size, encryption
 				FROM deleted_objects
-			`, projectID, []byte(bucketName), pgutil.ByteaArray(objectKeys)))(func(rows tagsql.Rows) error {
+			`, projectID, []byte(bucketName), pgutil.ByteaArray(objectKeys), bypassGovernance))(func(rows tagsql.Rows) error {
 		result.Objects, err = db.scanMultipleObjectsDeletion(ctx, rows)
 		return err
 	})
-
 	if err != nil {
 		return DeleteObjectResult{}, err
 	}
-
-	mon.Meter("object_delete").Mark(len(result.Objects))
-	for _, object := range result.Objects {
-		mon.Meter("segment_delete").Mark(int(object.SegmentCount))
-	}
-
 	return result, nil
 }
 
@@ -384,10 +455,33 @@ func (db *DB) scanMultipleObjectsDeletion(ctx context.Context, rows tagsql.Rows)
 // DeleteObjectLastCommitted contains arguments necessary for deleting last committed version of object.
 type DeleteObjectLastCommitted struct {
 	ObjectLocation
+
+	// Versioned inserts a delete marker instead of deleting the object.
+	Versioned bool
+	// Suspended deletes the unversioned object and inserts an unversioned
+	// delete marker, keeping the older versions.
+	Suspended bool
+
+	BucketEvents BucketEvents // optional
+
+	// ifLatest skips a versioned deletion unless the version is still the
+	// latest one. It's used by the lifecycle expiration.
+	ifLatest Version
+}
+
+// events returns the bucket events about the deletion.
+func (obj *DeleteObjectLastCommitted) events(result DeleteObjectResult) []BucketEvent {
+	if obj.ifLatest != 0 {
+		return deletedObjectEvents(result, BucketEventExpire, BucketEventExpireMarker)
+	}
+	return deletedObjectEvents(result, BucketEventDelete, BucketEventDeleteMarker)
 }
 
 // Verify delete object last committed fields.
 func (obj *DeleteObjectLastCommitted) Verify() error {
+	if obj.Versioned && obj.Suspended {
+		return ErrInvalidRequest.New("Versioned and Suspended cannot be both set")
+	}
 	return obj.ObjectLocation.Verify()
 }
 
@@ -401,12 +495,26 @@ func (db *DB) DeleteObjectLastCommitted(
 		return DeleteObjectResult{}, err
 	}
 
-	err = withRows(
-		db.db.QueryContext(ctx, deleteObjectLastCommitted,
-			opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey),
-	)(func(rows tagsql.Rows) error {
-		result.Objects, err = db.scanObjectDeletion(ctx, opts.ObjectLocation, rows)
-		return err
+	if opts.Versioned || opts.Suspended {
+		return db.deleteObjectLastCommittedVersioned(ctx, opts)
+	}
+
+	err = db.withBucketEvents(ctx, opts.BucketEvents, func(ctx context.Context, stmt stmt) ([]BucketEvent, error) {
+		if err := db.checkLastCommittedLocked(ctx, stmt, opts.ObjectLocation); err != nil {
+			return nil, err
+		}
+
+		err = withRows(
+			stmt.QueryContext(ctx, deleteObjectLastCommitted,
+				opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey),
+		)(func(rows tagsql.Rows) error {
+			result.Objects, err = db.scanObjectDeletion(ctx, opts.ObjectLocation, rows)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return opts.events(result), nil
 	})
 	if err != nil {
 		return DeleteObjectResult{}, err
@@ -419,3 +527,132 @@ func (db *DB) DeleteObjectLastCommitted(
 
 	return result, nil
 }
+
+// deleteObjectLastCommittedVersioned hides the object behind a new delete
+// marker. With suspended versioning the previous unversioned object and
+// delete marker are replaced by the new delete marker.
+func (db *DB) deleteObjectLastCommittedVersioned(ctx context.Context, opts DeleteObjectLastCommitted) (result DeleteObjectResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	status := DeleteMarkerVersioned
+	if opts.Suspended {
+		status = DeleteMarkerUnversioned
+	}
+
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		result = DeleteObjectResult{}
+
+		if opts.ifLatest != 0 {
+			var latest Version
+			err := tx.QueryRowContext(ctx, `
+				SELECT coalesce(max(version), 0)
+				FROM objects
+				WHERE
+					project_id  = $1 AND
+					bucket_name = $2 AND
+					object_key  = $3 AND
+					status      <> `+pendingStatus,
+				opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey).Scan(&latest)
+			if err != nil {
+				return Error.New("unable to query latest version: %w", err)
+			}
+			if latest != opts.ifLatest {
+				return nil
+			}
+		}
+
+		if opts.Suspended {
+			if err := db.checkLastCommittedLocked(ctx, tx, opts.ObjectLocation); err != nil {
+				return err
+			}
+
+			err := withRows(
+				tx.QueryContext(ctx, deleteObjectLastCommitted,
+					opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey),
+			)(func(rows tagsql.Rows) error {
+				result.Objects, err = db.scanObjectDeletion(ctx, opts.ObjectLocation, rows)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		streamID, err := uuid.New()
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		marker := Object{
+			ObjectStream: ObjectStream{
+				ProjectID:  opts.ProjectID,
+				BucketName: opts.BucketName,
+				ObjectKey:  opts.ObjectKey,
+				StreamID:   streamID,
+			},
+			Status: status,
+		}
+
+		err = tx.QueryRowContext(ctx, `
+			INSERT INTO objects (
+				project_id, bucket_name, object_key, version, stream_id,
+				status, zombie_deletion_deadline
+			)
+			SELECT
+				$1, $2, $3,
+				coalesce((
+					SELECT version + 1
+					FROM objects
+					WHERE project_id = $1 AND bucket_name = $2 AND object_key = $3
+					ORDER BY version DESC
+					LIMIT 1
+				), 1),
+				$4, $5, NULL
+			RETURNING version, created_at
+		`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, streamID, status,
+		).Scan(&marker.Version, &marker.CreatedAt)
+		if err != nil {
+			return Error.New("unable to insert delete marker: %w", err)
+		}
+
+		result.Markers = append(result.Markers, marker)
+
+		return db.insertBucketEvents(ctx, tx, opts.BucketEvents, opts.events(result))
+	})
+	if err != nil {
+		return DeleteObjectResult{}, err
+	}
+
+	mon.Meter("object_delete").Mark(len(result.Objects))
+	for _, object := range result.Objects {
+		mon.Meter("segment_delete").Mark(int(object.SegmentCount))
+	}
+
+	return result, nil
+}
+
+// checkLastCommittedLocked returns ErrObjectLock when the unversioned object,
+// which would be deleted by DeleteObjectLastCommitted, is locked.
+func (db *DB) checkLastCommittedLocked(ctx context.Context, stmt queryRower, location ObjectLocation) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var locked bool
+	err = stmt.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM objects
+			WHERE
+				project_id  = $1 AND
+				bucket_name = $2 AND
+				object_key  = $3 AND
+				status      IN `+statusesUnversioned+` AND
+				NOT `+objectUnlockedCondition("false")+`
+		)
+	`, location.ProjectID, []byte(location.BucketName), location.ObjectKey).Scan(&locked)
+	if err != nil {
+		return Error.New("unable to check object lock: %w", err)
+	}
+	if locked {
+		return ErrObjectLock.New("%s", location.ObjectKey)
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"storj.io/private/dbutil"
+	"storj.io/private/tagsql"
 )
 
 const (
@@ -17,12 +18,18 @@ const (
 type DeleteBucketObjects struct {
 	Bucket    BucketLocation
 	BatchSize int
+
+	// BypassGovernance allows deleting objects under governance mode retention.
+	BypassGovernance bool
+
+	BucketEvents BucketEvents // optional
 }
 
 // DeleteBucketObjects deletes all objects in the specified bucket.
 // Deletion performs in batches, so in case of error while processing,
 // this method will return the number of objects deleted to the moment
-// when an error occurs.
+// when an error occurs. Nothing is deleted when the bucket contains locked
+// objects.
 func (db *DB) DeleteBucketObjects(ctx context.Context, opts DeleteBucketObjects) (deletedObjectCount int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -32,6 +39,23 @@ func (db *DB) DeleteBucketObjects(ctx context.Context, opts DeleteBucketObjects)
 
 	deleteBatchSizeLimit.Ensure(&opts.BatchSize)
 
+	var locked bool
+	err = db.db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM objects
+			WHERE
+				project_id  = $1 AND
+				bucket_name = $2 AND
+				NOT `+objectUnlockedCondition("$3")+`
+		)
+	`, opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName), opts.BypassGovernance).Scan(&locked)
+	if err != nil {
+		return 0, Error.New("unable to check object lock: %w", err)
+	}
+	if locked {
+		return 0, ErrObjectLock.New("bucket %q contains locked objects", opts.Bucket.BucketName)
+	}
+
 	for {
 		if err := ctx.Err(); err != nil {
 			return deletedObjectCount, err
@@ -56,14 +80,16 @@ func (db *DB) deleteBucketObjects(ctx context.Context, opts DeleteBucketObjects)
 		query = `
 		WITH deleted_objects AS (
 			DELETE FROM objects
-			WHERE project_id = $1 AND bucket_name = $2 LIMIT $3
-			RETURNING objects.stream_id, objects.segment_count
+			WHERE project_id = $1 AND bucket_name = $2 AND ` + objectUnlockedCondition("$4") + ` LIMIT $3
+			RETURNING
+				objects.object_key, objects.version, objects.stream_id, objects.status,
+				objects.segment_count, objects.total_encrypted_size
 		), deleted_segments AS (
 			DELETE FROM segments
 			WHERE segments.stream_id IN (SELECT deleted_objects.stream_id FROM deleted_objects)
 			RETURNING segments.stream_id
 		)
-		SELECT COUNT(1), COALESCE(SUM(segment_count), 0) FROM deleted_objects
+		SELECT object_key, version, stream_id, status, segment_count, total_encrypted_size FROM deleted_objects
 	`
 	case dbutil.Postgres:
 		query = `
@@ -71,23 +97,45 @@ func (db *DB) deleteBucketObjects(ctx context.Context, opts DeleteBucketObjects)
 			DELETE FROM objects
 			WHERE stream_id IN (
 				SELECT stream_id FROM objects
-				WHERE project_id = $1 AND bucket_name = $2
+				WHERE project_id = $1 AND bucket_name = $2 AND ` + objectUnlockedCondition("$4") + `
 				LIMIT $3
 			)
-			RETURNING objects.stream_id, objects.segment_count
+			RETURNING
+				objects.object_key, objects.version, objects.stream_id, objects.status,
+				objects.segment_count, objects.total_encrypted_size
 		), deleted_segments AS (
 			DELETE FROM segments
 			WHERE segments.stream_id IN (SELECT deleted_objects.stream_id FROM deleted_objects)
 			RETURNING segments.stream_id
 		)
-		SELECT COUNT(1), COALESCE(SUM(segment_count), 0) FROM deleted_objects
+		SELECT object_key, version, stream_id, status, segment_count, total_encrypted_size FROM deleted_objects
 	`
 	default:
 		return 0, Error.New("unhandled database: %v", db.impl)
 	}
 
 	var deletedSegmentCount int64
-	err = db.db.QueryRowContext(ctx, query, opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName), opts.BatchSize).Scan(&deletedObjectCount, &deletedSegmentCount)
+	err = db.withBucketEvents(ctx, opts.BucketEvents, func(ctx context.Context, stmt stmt) (events []BucketEvent, err error) {
+		deletedObjectCount, deletedSegmentCount = 0, 0
+		err = withRows(stmt.QueryContext(ctx, query, opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName), opts.BatchSize, opts.BypassGovernance))(func(rows tagsql.Rows) error {
+			for rows.Next() {
+				object := Object{ObjectStream: ObjectStream{ProjectID: opts.Bucket.ProjectID, BucketName: opts.Bucket.BucketName}}
+				err := rows.Scan(&object.ObjectKey, &object.Version, &object.StreamID, &object.Status,
+					&object.SegmentCount, &object.TotalEncryptedSize)
+				if err != nil {
+					return err
+				}
+
+				deletedObjectCount++
+				deletedSegmentCount += int64(object.SegmentCount)
+				if object.Status != Pending {
+					events = append(events, BucketEvent{Type: BucketEventDelete, Object: object})
+				}
+			}
+			return nil
+		})
+		return events, err
+	})
 	if err != nil {
 		return 0, Error.Wrap(err)
 	}
@@ -0,0 +1,295 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/private/tagsql"
+)
+
+// LifecycleAction defines which objects are deleted by DeleteLifecycleObjects.
+type LifecycleAction int
+
+const (
+	// ExpireObjects deletes the latest version of the objects created before
+	// the deadline. In versioned buckets the objects are hidden behind delete
+	// markers instead.
+	ExpireObjects LifecycleAction = 1
+	// AbortIncompleteUploads deletes pending objects created before the deadline,
+	// including the ones in the pending objects table.
+	AbortIncompleteUploads LifecycleAction = 2
+	// ExpireNoncurrentVersions deletes the versions that were replaced by a
+	// newer version before the deadline.
+	ExpireNoncurrentVersions LifecycleAction = 3
+)
+
+// DeleteLifecycleObjects contains all the information necessary to delete the objects matching a bucket lifecycle rule.
+type DeleteLifecycleObjects struct {
+	Bucket BucketLocation
+	Prefix ObjectKey
+	Action LifecycleAction
+	Before time.Time
+
+	// Versioned and Suspended define how ExpireObjects deletes the objects,
+	// see DeleteObjectLastCommitted.
+	Versioned bool
+	Suspended bool
+
+	AsOfSystemInterval time.Duration
+	BatchSize          int
+
+	BucketEvents BucketEvents // optional
+}
+
+// Verify verifies delete lifecycle objects fields.
+func (opts *DeleteLifecycleObjects) Verify() error {
+	if err := opts.Bucket.Verify(); err != nil {
+		return err
+	}
+	switch opts.Action {
+	case ExpireObjects, AbortIncompleteUploads, ExpireNoncurrentVersions:
+	default:
+		return ErrInvalidRequest.New("invalid lifecycle action %d", opts.Action)
+	}
+	if opts.Before.IsZero() {
+		return ErrInvalidRequest.New("Before not set")
+	}
+	if opts.Versioned && opts.Suspended {
+		return ErrInvalidRequest.New("Versioned and Suspended cannot be both set")
+	}
+	return nil
+}
+
+// DeleteLifecycleObjects deletes the objects of a bucket matching the lifecycle action.
+// Objects under retention or legal hold are skipped.
+func (db *DB) DeleteLifecycleObjects(ctx context.Context, opts DeleteLifecycleObjects) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return err
+	}
+
+	var condition string
+	switch opts.Action {
+	case ExpireObjects:
+		// only the latest version expires, the older versions are handled
+		// by ExpireNoncurrentVersions.
+		condition = `
+			status IN ` + statusesCommitted + ` AND
+			created_at < $5 AND
+			` + objectUnlockedCondition("false") + ` AND
+			NOT EXISTS (
+				SELECT 1 FROM objects AS newer
+				WHERE
+					newer.project_id  = objects.project_id AND
+					newer.bucket_name = objects.bucket_name AND
+					newer.object_key  = objects.object_key AND
+					newer.version     > objects.version AND
+					newer.status      <> ` + pendingStatus + `
+			)`
+	case AbortIncompleteUploads:
+		condition = `
+			status = ` + pendingStatus + ` AND
+			created_at < $5`
+	case ExpireNoncurrentVersions:
+		condition = `
+			status <> ` + pendingStatus + ` AND
+			` + objectUnlockedCondition("false") + ` AND
+			EXISTS (
+				SELECT 1 FROM objects AS newer
+				WHERE
+					newer.project_id  = objects.project_id AND
+					newer.bucket_name = objects.bucket_name AND
+					newer.object_key  = objects.object_key AND
+					newer.version     > objects.version AND
+					newer.status      <> ` + pendingStatus + ` AND
+					newer.created_at  < $5
+			)`
+	}
+
+	prefixCondition := ""
+	if opts.Prefix != "" {
+		prefixCondition = `object_key >= $7 AND object_key < $8 AND`
+	}
+
+	err = db.deleteObjectsAndSegmentsBatch(ctx, opts.BatchSize, func(startAfter ObjectStream, batchsize int) (last ObjectStream, err error) {
+		if startAfter.ObjectKey == "" {
+			startAfter.ObjectKey = opts.Prefix
+		}
+
+		query := `
+			SELECT
+				project_id, bucket_name, object_key, version, stream_id
+			FROM objects
+			` + db.impl.AsOfSystemInterval(opts.AsOfSystemInterval) + `
+			WHERE
+				project_id  = $1 AND
+				bucket_name = $2 AND
+				(object_key, version) > ($3, $4) AND
+				` + prefixCondition + condition + `
+			ORDER BY project_id, bucket_name, object_key, version
+			LIMIT $6;`
+
+		args := []interface{}{
+			opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName), []byte(startAfter.ObjectKey), startAfter.Version,
+			opts.Before,
+			batchsize,
+		}
+		if opts.Prefix != "" {
+			args = append(args, []byte(opts.Prefix), []byte(prefixLimit(opts.Prefix)))
+		}
+
+		objects := make([]ObjectStream, 0, batchsize)
+
+		scanErrClass := errs.Class("DB rows scan has failed")
+		err = withRows(db.db.QueryContext(ctx, query, args...))(func(rows tagsql.Rows) error {
+			for rows.Next() {
+				err = rows.Scan(&last.ProjectID, &last.BucketName, &last.ObjectKey, &last.Version, &last.StreamID)
+				if err != nil {
+					return scanErrClass.Wrap(err)
+				}
+
+				db.log.Debug("Deleting object by bucket lifecycle",
+					zap.Stringer("Project", last.ProjectID),
+					zap.String("Bucket", last.BucketName),
+					zap.String("Object Key", string(last.ObjectKey)),
+					zap.Int64("Version", int64(last.Version)),
+					zap.String("StreamID", hex.EncodeToString(last.StreamID[:])),
+					zap.Int("Action", int(opts.Action)),
+				)
+				objects = append(objects, last)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return ObjectStream{}, Error.New("unable to select objects for lifecycle deletion: %w", err)
+		}
+
+		if opts.Action == ExpireObjects && (opts.Versioned || opts.Suspended) {
+			err = db.expireObjectsVersioned(ctx, objects, opts)
+		} else {
+			err = db.deleteObjectsAndSegments(ctx, objects, opts.BucketEvents, BucketEventExpire)
+		}
+		if err != nil {
+			return ObjectStream{}, err
+		}
+
+		mon.Meter("lifecycle_object_delete").Mark(len(objects))
+
+		return last, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if opts.Action == AbortIncompleteUploads {
+		return db.deleteLifecyclePendingObjects(ctx, opts)
+	}
+	return nil
+}
+
+// expireObjectsVersioned hides the expired objects behind delete markers.
+// Objects which got a newer version or became locked since they were selected
+// are skipped.
+func (db *DB) expireObjectsVersioned(ctx context.Context, objects []ObjectStream, opts DeleteLifecycleObjects) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, object := range objects {
+		_, err := db.DeleteObjectLastCommitted(ctx, DeleteObjectLastCommitted{
+			ObjectLocation: object.Location(),
+			Versioned:      opts.Versioned,
+			Suspended:      opts.Suspended,
+			BucketEvents:   opts.BucketEvents,
+			ifLatest:       object.Version,
+		})
+		if err != nil && !ErrObjectLock.Has(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteLifecyclePendingObjects deletes the uploads from the pending objects
+// table, which were started before the deadline, and their segments.
+func (db *DB) deleteLifecyclePendingObjects(ctx context.Context, opts DeleteLifecycleObjects) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	prefixCondition := ""
+	if opts.Prefix != "" {
+		prefixCondition = `object_key >= $7 AND object_key < $8 AND`
+	}
+
+	return db.deleteObjectsAndSegmentsBatch(ctx, opts.BatchSize, func(startAfter ObjectStream, batchsize int) (last ObjectStream, err error) {
+		if startAfter.ObjectKey == "" {
+			startAfter.ObjectKey = opts.Prefix
+		}
+
+		args := []interface{}{
+			opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName), []byte(startAfter.ObjectKey), startAfter.StreamID,
+			opts.Before,
+			batchsize,
+		}
+		if opts.Prefix != "" {
+			args = append(args, []byte(opts.Prefix), []byte(prefixLimit(opts.Prefix)))
+		}
+
+		var streamIDs [][]byte
+		err = withRows(db.db.QueryContext(ctx, `
+			SELECT object_key, stream_id
+			FROM pending_objects
+			`+db.impl.AsOfSystemInterval(opts.AsOfSystemInterval)+`
+			WHERE
+				project_id  = $1 AND
+				bucket_name = $2 AND
+				(object_key, stream_id) > ($3, $4) AND
+				`+prefixCondition+`
+				created_at < $5
+			ORDER BY project_id, bucket_name, object_key, stream_id
+			LIMIT $6
+		`, args...))(func(rows tagsql.Rows) error {
+			for rows.Next() {
+				if err := rows.Scan(&last.ObjectKey, &last.StreamID); err != nil {
+					return err
+				}
+				streamIDs = append(streamIDs, last.StreamID.Bytes())
+			}
+			return nil
+		})
+		if err != nil {
+			return ObjectStream{}, Error.New("unable to select pending objects for lifecycle deletion: %w", err)
+		}
+		if len(streamIDs) == 0 {
+			return ObjectStream{}, nil
+		}
+
+		_, err = db.db.ExecContext(ctx, `
+			WITH deleted_objects AS (
+				DELETE FROM pending_objects
+				WHERE
+					project_id  = $1 AND
+					bucket_name = $2 AND
+					stream_id   = ANY($3::BYTEA[]) AND
+					created_at  < $4
+				RETURNING stream_id
+			)
+			DELETE FROM segments
+			WHERE segments.stream_id IN (SELECT stream_id FROM deleted_objects)
+		`, opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName), pgutil.ByteaArray(streamIDs), opts.Before)
+		if err != nil {
+			return ObjectStream{}, Error.New("unable to delete pending objects: %w", err)
+		}
+
+		mon.Meter("lifecycle_object_delete").Mark(len(streamIDs))
+
+		return last, nil
+	})
+}
@@ -0,0 +1,211 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestDeleteLifecycleObjects(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		future := time.Now().Add(time.Hour)
+
+		remainingKeys := func() (keys []metabase.ObjectKey) {
+			objects, err := db.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			for _, object := range objects {
+				keys = append(keys, object.ObjectKey)
+			}
+			return keys
+		}
+
+		t.Run("invalid", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			err := db.DeleteLifecycleObjects(ctx, metabase.DeleteLifecycleObjects{
+				Bucket: obj.Location().Bucket(),
+				Before: future,
+			})
+			require.True(t, metabase.ErrInvalidRequest.Has(err))
+
+			err = db.DeleteLifecycleObjects(ctx, metabase.DeleteLifecycleObjects{
+				Bucket: obj.Location().Bucket(),
+				Action: metabase.ExpireObjects,
+			})
+			require.True(t, metabase.ErrInvalidRequest.Has(err))
+		})
+
+		t.Run("expire with prefix", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			for _, key := range []metabase.ObjectKey{"logs/a", "logs/b", "data/a"} {
+				obj.ObjectKey = key
+				obj.StreamID[0]++
+				metabasetest.CreateObject(ctx, t, db, obj, 1)
+			}
+
+			obj.ObjectKey = "logs/pending"
+			obj.StreamID[0]++
+			metabasetest.CreatePendingObject(ctx, t, db, obj, 0)
+
+			err := db.DeleteLifecycleObjects(ctx, metabase.DeleteLifecycleObjects{
+				Bucket: obj.Location().Bucket(),
+				Prefix: "logs/",
+				Action: metabase.ExpireObjects,
+				Before: time.Now().Add(-time.Hour),
+			})
+			require.NoError(t, err)
+			require.Len(t, remainingKeys(), 4)
+
+			err = db.DeleteLifecycleObjects(ctx, metabase.DeleteLifecycleObjects{
+				Bucket:    obj.Location().Bucket(),
+				Prefix:    "logs/",
+				Action:    metabase.ExpireObjects,
+				Before:    future,
+				BatchSize: 1,
+			})
+			require.NoError(t, err)
+			require.Equal(t, []metabase.ObjectKey{"data/a", "logs/pending"}, remainingKeys())
+
+			err = db.DeleteLifecycleObjects(ctx, metabase.DeleteLifecycleObjects{
+				Bucket: obj.Location().Bucket(),
+				Action: metabase.AbortIncompleteUploads,
+				Before: future,
+			})
+			require.NoError(t, err)
+			require.Equal(t, []metabase.ObjectKey{"data/a"}, remainingKeys())
+		})
+
+		t.Run("noncurrent versions", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			var versions []metabase.Object
+			for version := metabase.Version(1); version <= 3; version++ {
+				obj.Version = version
+				obj.StreamID[0]++
+				object, _ := metabasetest.CreateTestObject{
+					CommitObject: &metabase.CommitObject{
+						ObjectStream: obj,
+						Versioned:    true,
+					},
+				}.Run(ctx, t, db, obj, 1)
+				versions = append(versions, object)
+			}
+
+			err := db.DeleteLifecycleObjects(ctx, metabase.DeleteLifecycleObjects{
+				Bucket: obj.Location().Bucket(),
+				Action: metabase.ExpireNoncurrentVersions,
+				Before: future,
+			})
+			require.NoError(t, err)
+
+			objects, err := db.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			require.Len(t, objects, 1)
+			require.Equal(t, versions[2].StreamID, objects[0].StreamID)
+		})
+
+		t.Run("expire locked", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			obj.Version = 1
+			metabasetest.CreateTestObject{
+				CommitObject: &metabase.CommitObject{
+					ObjectStream: obj,
+					LegalHold:    true,
+				},
+			}.Run(ctx, t, db, obj, 1)
+
+			err := db.DeleteLifecycleObjects(ctx, metabase.DeleteLifecycleObjects{
+				Bucket: obj.Location().Bucket(),
+				Action: metabase.ExpireObjects,
+				Before: future,
+			})
+			require.NoError(t, err)
+			require.Equal(t, []metabase.ObjectKey{obj.ObjectKey}, remainingKeys())
+		})
+
+		t.Run("expire versioned", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			var versions []metabase.Object
+			for version := metabase.Version(1); version <= 2; version++ {
+				obj.Version = version
+				obj.StreamID[0]++
+				object, _ := metabasetest.CreateTestObject{
+					CommitObject: &metabase.CommitObject{
+						ObjectStream: obj,
+						Versioned:    true,
+					},
+				}.Run(ctx, t, db, obj, 1)
+				versions = append(versions, object)
+			}
+
+			// running the rule again doesn't hide the delete marker.
+			for i := 0; i < 2; i++ {
+				err := db.DeleteLifecycleObjects(ctx, metabase.DeleteLifecycleObjects{
+					Bucket:    obj.Location().Bucket(),
+					Action:    metabase.ExpireObjects,
+					Before:    future,
+					Versioned: true,
+				})
+				require.NoError(t, err)
+			}
+
+			objects, err := db.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			require.Len(t, objects, 3)
+			require.Equal(t, versions[0].StreamID, objects[0].StreamID)
+			require.Equal(t, versions[1].StreamID, objects[1].StreamID)
+			require.Equal(t, metabase.DeleteMarkerVersioned, objects[2].Status)
+
+			_, err = db.GetObjectLastCommitted(ctx, metabase.GetObjectLastCommitted{
+				ObjectLocation: obj.Location(),
+			})
+			require.True(t, metabase.ErrObjectNotFound.Has(err))
+		})
+
+		t.Run("abort pending objects table", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			obj.Version = metabase.NextVersion
+			metabasetest.BeginObjectNextVersion{
+				Opts: metabase.BeginObjectNextVersion{
+					ObjectStream:           obj,
+					Encryption:             metabasetest.DefaultEncryption,
+					UsePendingObjectsTable: true,
+				},
+				Version: metabase.DefaultVersion,
+			}.Check(ctx, t, db)
+
+			state, err := db.TestingGetState(ctx)
+			require.NoError(t, err)
+			require.Len(t, state.PendingObjects, 1)
+
+			err = db.DeleteLifecycleObjects(ctx, metabase.DeleteLifecycleObjects{
+				Bucket: obj.Location().Bucket(),
+				Action: metabase.AbortIncompleteUploads,
+				Before: future,
+			})
+			require.NoError(t, err)
+
+			state, err = db.TestingGetState(ctx)
+			require.NoError(t, err)
+			require.Empty(t, state.PendingObjects)
+		})
+	})
+}
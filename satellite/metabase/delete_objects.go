@@ -12,6 +12,8 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
 	"storj.io/private/dbutil/pgxutil"
 	"storj.io/private/tagsql"
 )
@@ -25,9 +27,12 @@ type DeleteExpiredObjects struct {
 	ExpiredBefore      time.Time
 	AsOfSystemInterval time.Duration
 	BatchSize          int
+
+	BucketEvents BucketEventsResolver // optional
 }
 
 // DeleteExpiredObjects deletes all objects that expired before expiredBefore.
+// Objects under retention or legal hold are skipped.
 func (db *DB) DeleteExpiredObjects(ctx context.Context, opts DeleteExpiredObjects) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -41,6 +46,7 @@ func (db *DB) DeleteExpiredObjects(ctx context.Context, opts DeleteExpiredObject
 			WHERE
 				(project_id, bucket_name, object_key, version) > ($1, $2, $3, $4)
 				AND expires_at < $5
+				AND ` + objectUnlockedCondition("false") + `
 				ORDER BY project_id, bucket_name, object_key, version
 			LIMIT $6;`
 
@@ -83,7 +89,13 @@ func (db *DB) DeleteExpiredObjects(ctx context.Context, opts DeleteExpiredObject
 			return ObjectStream{}, nil
 		}
 
-		err = db.deleteObjectsAndSegments(ctx, expiredObjects)
+		outbox, err := resolveBucketEvents(ctx, opts.BucketEvents, expiredObjects)
+		if err != nil {
+			db.log.Warn("unable to resolve bucket events of expired objects", zap.Error(err))
+			return ObjectStream{}, nil
+		}
+
+		err = db.deleteObjectsAndSegments(ctx, expiredObjects, outbox, BucketEventExpire)
 		if err != nil {
 			db.log.Warn("delete from DB expired objects", zap.Error(err))
 			return ObjectStream{}, nil
@@ -183,13 +195,20 @@ func (db *DB) deleteObjectsAndSegmentsBatch(ctx context.Context, batchsize int,
 	}
 }
 
-func (db *DB) deleteObjectsAndSegments(ctx context.Context, objects []ObjectStream) (err error) {
+// deleteObjectsAndSegments deletes the objects and their segments. The objects may
+// be selected with AS OF SYSTEM TIME, so the objects locked in the meantime are skipped.
+// With outbox the bucket events of the given type are inserted for the deleted objects.
+func (db *DB) deleteObjectsAndSegments(ctx context.Context, objects []ObjectStream, outbox BucketEvents, eventType BucketEventType) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	if len(objects) == 0 {
 		return nil
 	}
 
+	if outbox != nil {
+		return db.deleteObjectsAndSegmentsWithEvents(ctx, objects, outbox, eventType)
+	}
+
 	err = pgxutil.Conn(ctx, db.db, func(conn *pgx.Conn) error {
 		var batch pgx.Batch
 		for _, obj := range objects {
@@ -198,11 +217,13 @@ func (db *DB) deleteObjectsAndSegments(ctx context.Context, objects []ObjectStre
 			batch.Queue(`
 				WITH deleted_objects AS (
 					DELETE FROM objects
-					WHERE (project_id, bucket_name, object_key, version, stream_id) = ($1::BYTEA, $2, $3, $4, $5::BYTEA)
+					WHERE
+						(project_id, bucket_name, object_key, version, stream_id) = ($1::BYTEA, $2, $3, $4, $5::BYTEA) AND
+						`+objectUnlockedCondition("false")+`
 					RETURNING stream_id
 				)
 				DELETE FROM segments
-				WHERE segments.stream_id = $5::BYTEA
+				WHERE segments.stream_id IN (SELECT stream_id FROM deleted_objects)
 			`, obj.ProjectID, []byte(obj.BucketName), []byte(obj.ObjectKey), obj.Version, obj.StreamID)
 		}
 
@@ -237,6 +258,79 @@ func (db *DB) deleteObjectsAndSegments(ctx context.Context, objects []ObjectStre
 	return nil
 }
 
+// deleteObjectsAndSegmentsWithEvents deletes the objects and their segments
+// in a single transaction together with inserting the bucket events.
+func (db *DB) deleteObjectsAndSegmentsWithEvents(ctx context.Context, objects []ObjectStream, outbox BucketEvents, eventType BucketEventType) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var (
+		projectIDs  = make([]uuid.UUID, 0, len(objects))
+		bucketNames = make([][]byte, 0, len(objects))
+		objectKeys  = make([][]byte, 0, len(objects))
+		versions    = make([]int64, 0, len(objects))
+		streamIDs   = make([]uuid.UUID, 0, len(objects))
+	)
+	for _, obj := range objects {
+		projectIDs = append(projectIDs, obj.ProjectID)
+		bucketNames = append(bucketNames, []byte(obj.BucketName))
+		objectKeys = append(objectKeys, []byte(obj.ObjectKey))
+		versions = append(versions, int64(obj.Version))
+		streamIDs = append(streamIDs, obj.StreamID)
+	}
+
+	var objectsDeleted, segmentsDeleted int64
+	err = db.withBucketEvents(ctx, outbox, func(ctx context.Context, stmt stmt) (events []BucketEvent, err error) {
+		objectsDeleted, segmentsDeleted = 0, 0
+		err = withRows(stmt.QueryContext(ctx, `
+			WITH deleted_objects AS (
+				DELETE FROM objects
+				WHERE
+					(project_id, bucket_name, object_key, version, stream_id) IN (
+						SELECT unnest($1::BYTEA[]), unnest($2::BYTEA[]), unnest($3::BYTEA[]), unnest($4::INT8[]), unnest($5::BYTEA[])
+					) AND
+					`+objectUnlockedCondition("false")+`
+				RETURNING
+					project_id, bucket_name, object_key, version, stream_id,
+					status, segment_count, total_encrypted_size
+			), deleted_segments AS (
+				DELETE FROM segments
+				WHERE segments.stream_id IN (SELECT stream_id FROM deleted_objects)
+				RETURNING segments.stream_id
+			)
+			SELECT
+				project_id, bucket_name, object_key, version, stream_id,
+				status, segment_count, total_encrypted_size
+			FROM deleted_objects
+		`, pgutil.UUIDArray(projectIDs), pgutil.ByteaArray(bucketNames), pgutil.ByteaArray(objectKeys),
+			pgutil.Int8Array(versions), pgutil.UUIDArray(streamIDs)))(func(rows tagsql.Rows) error {
+			for rows.Next() {
+				var object Object
+				err := rows.Scan(&object.ProjectID, &object.BucketName, &object.ObjectKey, &object.Version, &object.StreamID,
+					&object.Status, &object.SegmentCount, &object.TotalEncryptedSize)
+				if err != nil {
+					return err
+				}
+
+				objectsDeleted++
+				segmentsDeleted += int64(object.SegmentCount)
+				if object.Status != Pending {
+					events = append(events, BucketEvent{Type: eventType, Object: object})
+				}
+			}
+			return nil
+		})
+		return events, err
+	})
+	if err != nil {
+		return Error.New("unable to delete expired objects: %w", err)
+	}
+
+	mon.Meter("object_delete").Mark64(objectsDeleted)
+	mon.Meter("segment_delete").Mark64(segmentsDeleted)
+
+	return nil
+}
+
 func (db *DB) deleteInactiveObjectsAndSegments(ctx context.Context, objects []ObjectStream, opts DeleteZombieObjects) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
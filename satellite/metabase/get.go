@@ -10,10 +10,8 @@ import (
 	"time"
 
 	"github.com/zeebo/errs"
-	"go.uber.org/zap"
 
 	"storj.io/common/uuid"
-	"storj.io/private/tagsql"
 )
 
 // ErrSegmentNotFound is an error class for non-existing segment.
@@ -80,28 +78,30 @@ func (db *DB) GetObjectExactVersion(ctx context.Context, opts GetObjectExactVers
 	object := Object{}
 	err = db.db.QueryRowContext(ctx, `
 		SELECT
-			stream_id,
+			stream_id, status,
 			created_at, expires_at,
 			segment_count,
 			encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
 			total_plain_size, total_encrypted_size, fixed_segment_size,
-			encryption
+			encryption,
+			retention_mode, retain_until, legal_hold
 		FROM objects
 		WHERE
 			project_id   = $1 AND
 			bucket_name  = $2 AND
 			object_key   = $3 AND
 			version      = $4 AND
-			status       = `+committedStatus+` AND
+			status       IN `+statusesCommitted+` AND
 			(expires_at IS NULL OR expires_at > now())`,
 		opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version).
 		Scan(
-			&object.StreamID,
+			&object.StreamID, &object.Status,
 			&object.CreatedAt, &object.ExpiresAt,
 			&object.SegmentCount,
 			&object.EncryptedMetadataNonce, &object.EncryptedMetadata, &object.EncryptedMetadataEncryptedKey,
 			&object.TotalPlainSize, &object.TotalEncryptedSize, &object.FixedSegmentSize,
 			encryptionParameters{&object.Encryption},
+			&object.Retention.Mode, timeOrZero{&object.Retention.RetainUntil}, &object.LegalHold,
 		)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -115,8 +115,6 @@ func (db *DB) GetObjectExactVersion(ctx context.Context, opts GetObjectExactVers
 	object.ObjectKey = opts.ObjectKey
 	object.Version = opts.Version
 
-	object.Status = Committed
-
 	return object, nil
 }
 
@@ -127,6 +125,7 @@ type GetObjectLastCommitted struct {
 }
 
 // GetObjectLastCommitted returns object information for last committed version.
+// When the latest version is a delete marker the object is not found.
 func (db *DB) GetObjectLastCommitted(ctx context.Context, opts GetObjectLastCommitted) (_ Object, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -135,57 +134,37 @@ func (db *DB) GetObjectLastCommitted(ctx context.Context, opts GetObjectLastComm
 	}
 
 	var object Object
-
-	err = withRows(db.db.QueryContext(ctx, `
+	err = db.db.QueryRowContext(ctx, `
 		SELECT
-			stream_id, version,
+			stream_id, version, status,
 			created_at, expires_at,
 			segment_count,
 			encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
 			total_plain_size, total_encrypted_size, fixed_segment_size,
-			encryption
+			encryption,
+			retention_mode, retain_until, legal_hold
 		FROM objects
 		WHERE
 			project_id   = $1 AND
 			bucket_name  = $2 AND
 			object_key   = $3 AND
-			status       = `+committedStatus+` AND
+			status       <> `+pendingStatus+` AND
 			(expires_at IS NULL OR expires_at > now())
-		ORDER BY version desc
-		`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey))(func(rows tagsql.Rows) error {
-		objectFound := false
-		for rows.Next() {
-			var scannedObject Object
-			if err = rows.Scan(
-				&scannedObject.StreamID, &scannedObject.Version,
-				&scannedObject.CreatedAt, &scannedObject.ExpiresAt,
-				&scannedObject.SegmentCount,
-				&scannedObject.EncryptedMetadataNonce, &scannedObject.EncryptedMetadata, &scannedObject.EncryptedMetadataEncryptedKey,
-				&scannedObject.TotalPlainSize, &scannedObject.TotalEncryptedSize, &scannedObject.FixedSegmentSize,
-				encryptionParameters{&scannedObject.Encryption},
-			); err != nil {
-				return Error.New("unable to query object status: %w", err)
-			}
-
-			if objectFound {
-				db.log.Warn("object with multiple committed versions were found!",
-					zap.Stringer("Project ID", opts.ProjectID), zap.String("Bucket Name", opts.BucketName),
-					zap.ByteString("Object Key", []byte(opts.ObjectKey)), zap.Int("Version", int(scannedObject.Version)),
-					zap.Stringer("Stream ID", scannedObject.StreamID), zap.Stack("stacktrace"))
-				mon.Meter("multiple_committed_versions").Mark(1)
-				continue
-			}
-			object = scannedObject
-
-			objectFound = true
-		}
-
-		if !objectFound {
-			return sql.ErrNoRows
-		}
-
-		return nil
-	})
+		ORDER BY version DESC
+		LIMIT 1
+		`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey).
+		Scan(
+			&object.StreamID, &object.Version, &object.Status,
+			&object.CreatedAt, &object.ExpiresAt,
+			&object.SegmentCount,
+			&object.EncryptedMetadataNonce, &object.EncryptedMetadata, &object.EncryptedMetadataEncryptedKey,
+			&object.TotalPlainSize, &object.TotalEncryptedSize, &object.FixedSegmentSize,
+			encryptionParameters{&object.Encryption},
+			&object.Retention.Mode, timeOrZero{&object.Retention.RetainUntil}, &object.LegalHold,
+		)
+	if err == nil && object.Status.IsDeleteMarker() {
+		err = sql.ErrNoRows
+	}
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return Object{}, ErrObjectNotFound.Wrap(Error.Wrap(err))
@@ -197,7 +176,6 @@ func (db *DB) GetObjectLastCommitted(ctx context.Context, opts GetObjectLastComm
 	object.ProjectID = opts.ProjectID
 	object.BucketName = opts.BucketName
 	object.ObjectKey = opts.ObjectKey
-	object.Status = Committed
 
 	return object, nil
 }
@@ -298,7 +276,7 @@ func (db *DB) GetLatestObjectLastSegment(ctx context.Context, opts GetLatestObje
 				project_id   = $1 AND
 				bucket_name  = $2 AND
 				object_key   = $3 AND
-				status       = `+committedStatus+`
+				status       <> `+pendingStatus+`
 				ORDER BY version DESC
 				LIMIT 1
 			)
@@ -28,6 +28,11 @@ type objectsIterator struct {
 	includeCustomMetadata bool
 	includeSystemMetadata bool
 
+	// allVersions iterates every committed version and delete marker.
+	allVersions bool
+	// latestOnly iterates only the latest visible version of each object.
+	latestOnly bool
+
 	curIndex int
 	curRows  tagsql.Rows
 	cursor   iterateCursor // not relative to prefix
@@ -35,6 +40,10 @@ type objectsIterator struct {
 	skipPrefix  ObjectKey // relative to prefix
 	doNextQuery func(context.Context, *objectsIterator) (_ tagsql.Rows, err error)
 
+	// peeked is the row read ahead while looking for the latest version of an object.
+	peeked    ObjectEntry
+	hasPeeked bool
+
 	// failErr is set when either scan or next query fails during iteration.
 	failErr error
 }
@@ -61,6 +70,8 @@ func iterateAllVersionsWithStatus(ctx context.Context, db *DB, opts IterateObjec
 		recursive:             opts.Recursive,
 		includeCustomMetadata: opts.IncludeCustomMetadata,
 		includeSystemMetadata: opts.IncludeSystemMetadata,
+		allVersions:           opts.allVersions,
+		latestOnly:            opts.latestOnly,
 
 		curIndex: 0,
 		cursor:   firstIterateCursor(opts.Recursive, opts.Cursor, opts.Prefix),
@@ -78,6 +89,24 @@ func iterateAllVersionsWithStatus(ctx context.Context, db *DB, opts IterateObjec
 	return iterate(ctx, it, fn)
 }
 
+func iterateObjectVersions(ctx context.Context, db *DB, opts IterateObjectVersions, fn func(context.Context, ObjectsIterator) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return iterateAllVersionsWithStatus(ctx, db, IterateObjectsWithStatus{
+		ProjectID:             opts.ProjectID,
+		BucketName:            opts.BucketName,
+		Recursive:             opts.Recursive,
+		BatchSize:             opts.BatchSize,
+		Prefix:                opts.Prefix,
+		Cursor:                opts.Cursor,
+		Status:                Committed,
+		IncludeCustomMetadata: opts.IncludeCustomMetadata,
+		IncludeSystemMetadata: opts.IncludeSystemMetadata,
+
+		allVersions: true,
+	}, fn)
+}
+
 func iteratePendingObjectsByKey(ctx context.Context, db *DB, opts IteratePendingObjectsByKey, fn func(context.Context, ObjectsIterator) error) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -171,6 +200,47 @@ func (it *objectsIterator) Next(ctx context.Context, item *ObjectEntry) bool {
 
 // next returns true if there was another item and copy it in item.
 func (it *objectsIterator) next(ctx context.Context, item *ObjectEntry) bool {
+	if it.latestOnly {
+		return it.nextLatest(ctx, item)
+	}
+	return it.nextRow(ctx, item)
+}
+
+// nextLatest returns the latest version of the next object. Objects whose
+// latest version is a delete marker are skipped.
+//
+// The rows are ordered by version, so the latest version is the last row
+// before the object key changes.
+func (it *objectsIterator) nextLatest(ctx context.Context, item *ObjectEntry) bool {
+	for {
+		if !it.hasPeeked && !it.nextRow(ctx, &it.peeked) {
+			return false
+		}
+
+		latest := it.peeked
+		it.hasPeeked = false
+		for it.nextRow(ctx, &it.peeked) {
+			if it.peeked.ObjectKey != latest.ObjectKey {
+				it.hasPeeked = true
+				break
+			}
+			latest = it.peeked
+		}
+		if it.failErr != nil {
+			return false
+		}
+
+		if latest.Status.IsDeleteMarker() {
+			continue
+		}
+
+		*item = latest
+		return true
+	}
+}
+
+// nextRow returns true if there was another row and copy it in item.
+func (it *objectsIterator) nextRow(ctx context.Context, item *ObjectEntry) bool {
 	next := it.curRows.Next()
 	if !next {
 		if it.curIndex < it.batchSize {
@@ -181,7 +251,9 @@ func (it *objectsIterator) next(ctx context.Context, item *ObjectEntry) bool {
 			return false
 		}
 
-		if !it.recursive {
+		// the latest listing can't skip the rest of the prefix, because all
+		// the versions read so far may have been delete markers.
+		if !it.recursive && !it.latestOnly {
 			afterPrefix := it.cursor.Key[len(it.prefix):]
 			p := bytes.IndexByte([]byte(afterPrefix), Delimiter)
 			if p >= 0 {
@@ -231,6 +303,18 @@ func doNextQueryAllVersionsWithStatus(ctx context.Context, it *objectsIterator)
 		cursorCompare = ">="
 	}
 
+	// listing versions or the latest version needs every version and delete
+	// marker of an object, so only pending objects are excluded.
+	statusCondition := "status = $3"
+	queryStatus := it.status
+	switch {
+	case it.allVersions || it.latestOnly:
+		statusCondition = "status <> $3"
+		queryStatus = Pending
+	case it.status == Committed:
+		statusCondition = "status IN ($3, " + committedVersionedStatus + ")"
+	}
+
 	if it.prefixLimit == "" {
 		querySelectFields := querySelectorFields("object_key", it)
 		return it.db.db.QueryContext(ctx, `
@@ -240,12 +324,12 @@ func doNextQueryAllVersionsWithStatus(ctx context.Context, it *objectsIterator)
 			WHERE
 				(project_id, bucket_name, object_key, version) `+cursorCompare+` ($1, $2, $4, $5)
 				AND (project_id, bucket_name) < ($1, $7)
-				AND status = $3
+				AND `+statusCondition+`
 				AND (expires_at IS NULL OR expires_at > now())
 				ORDER BY (project_id, bucket_name, object_key, version) ASC
 			LIMIT $6
 			`, it.projectID, it.bucketName,
-			it.status,
+			queryStatus,
 			[]byte(it.cursor.Key), int(it.cursor.Version),
 			it.batchSize,
 			nextBucket(it.bucketName),
@@ -265,12 +349,12 @@ func doNextQueryAllVersionsWithStatus(ctx context.Context, it *objectsIterator)
 		WHERE
 			(project_id, bucket_name, object_key, version) `+cursorCompare+` ($1, $2, $4, $5)
 			AND (project_id, bucket_name, object_key) < ($1, $2, $6)
-			AND status = $3
+			AND `+statusCondition+`
 			AND (expires_at IS NULL OR expires_at > now())
 			ORDER BY (project_id, bucket_name, object_key, version) ASC
 		LIMIT $7
 		`, it.projectID, it.bucketName,
-		it.status,
+		queryStatus,
 		[]byte(it.cursor.Key), int(it.cursor.Version),
 		[]byte(it.prefixLimit),
 		it.batchSize,
@@ -282,6 +366,7 @@ func querySelectorFields(objectKeyColumn string, it *objectsIterator) string {
 	querySelectFields := objectKeyColumn + `
 		,stream_id
 		,version
+		,status
 		,encryption`
 
 	if it.includeSystemMetadata {
@@ -317,7 +402,7 @@ func doNextQueryStreamsByKey(ctx context.Context, it *objectsIterator) (_ tagsql
 
 	return it.db.db.QueryContext(ctx, `
 			SELECT
-				object_key, stream_id, version, encryption,
+				object_key, stream_id, version, status, encryption,
 				created_at, expires_at,
 				segment_count,
 				total_plain_size, total_encrypted_size, fixed_segment_size,
@@ -340,12 +425,12 @@ func doNextQueryStreamsByKey(ctx context.Context, it *objectsIterator) (_ tagsql
 // scanItem scans doNextQuery results into ObjectEntry.
 func (it *objectsIterator) scanItem(item *ObjectEntry) (err error) {
 	item.IsPrefix = false
-	item.Status = it.status
 
 	fields := []interface{}{
 		&item.ObjectKey,
 		&item.StreamID,
 		&item.Version,
+		&item.Status,
 		encryptionParameters{&item.Encryption},
 	}
 
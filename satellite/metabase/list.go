@@ -72,9 +72,15 @@ type IterateObjectsWithStatus struct {
 	Status                ObjectStatus
 	IncludeCustomMetadata bool
 	IncludeSystemMetadata bool
+
+	// allVersions is used by IterateObjectVersions.
+	allVersions bool
+	// latestOnly is used by IterateObjectsLatestVersion.
+	latestOnly bool
 }
 
 // IterateObjectsAllVersionsWithStatus iterates through all versions of all objects with specified status.
+// For committed objects both unversioned and versioned objects are returned, but not delete markers.
 func (db *DB) IterateObjectsAllVersionsWithStatus(ctx context.Context, opts IterateObjectsWithStatus, fn func(context.Context, ObjectsIterator) error) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	if err = opts.Verify(); err != nil {
@@ -83,6 +89,17 @@ func (db *DB) IterateObjectsAllVersionsWithStatus(ctx context.Context, opts Iter
 	return iterateAllVersionsWithStatus(ctx, db, opts, fn)
 }
 
+// IterateObjectsLatestVersion iterates through the latest version of all objects with specified status.
+// For committed objects the objects whose latest version is a delete marker are skipped.
+func (db *DB) IterateObjectsLatestVersion(ctx context.Context, opts IterateObjectsWithStatus, fn func(context.Context, ObjectsIterator) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if err = opts.Verify(); err != nil {
+		return err
+	}
+	opts.latestOnly = opts.Status != Pending
+	return iterateAllVersionsWithStatus(ctx, db, opts, fn)
+}
+
 // Verify verifies get object request fields.
 func (opts *IterateObjectsWithStatus) Verify() error {
 	switch {
@@ -118,3 +135,107 @@ func (opts *IteratePendingObjectsByKey) Verify() error {
 	}
 	return nil
 }
+
+// IterateObjectVersions contains arguments necessary for listing every version of objects in a bucket.
+type IterateObjectVersions struct {
+	ProjectID             uuid.UUID
+	BucketName            string
+	Recursive             bool
+	BatchSize             int
+	Prefix                ObjectKey
+	Cursor                IterateCursor
+	IncludeCustomMetadata bool
+	IncludeSystemMetadata bool
+}
+
+// IterateObjectVersions iterates through every committed version and delete marker of
+// all objects, ordered by object key and version.
+func (db *DB) IterateObjectVersions(ctx context.Context, opts IterateObjectVersions, fn func(context.Context, ObjectsIterator) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return err
+	}
+	return iterateObjectVersions(ctx, db, opts, fn)
+}
+
+// Verify verifies iterate object versions request fields.
+func (opts *IterateObjectVersions) Verify() error {
+	switch {
+	case opts.ProjectID.IsZero():
+		return ErrInvalidRequest.New("ProjectID missing")
+	case opts.BucketName == "":
+		return ErrInvalidRequest.New("BucketName missing")
+	case opts.BatchSize < 0:
+		return ErrInvalidRequest.New("BatchSize is negative")
+	}
+	return nil
+}
+
+// ListObjectVersions contains arguments necessary for listing a page of object versions.
+type ListObjectVersions struct {
+	ProjectID             uuid.UUID
+	BucketName            string
+	Recursive             bool
+	Limit                 int
+	Prefix                ObjectKey
+	Cursor                IterateCursor
+	IncludeCustomMetadata bool
+	IncludeSystemMetadata bool
+}
+
+// Verify verifies list object versions request fields.
+func (opts *ListObjectVersions) Verify() error {
+	switch {
+	case opts.ProjectID.IsZero():
+		return ErrInvalidRequest.New("ProjectID missing")
+	case opts.BucketName == "":
+		return ErrInvalidRequest.New("BucketName missing")
+	case opts.Limit < 0:
+		return ErrInvalidRequest.New("Invalid limit: %d", opts.Limit)
+	}
+	return nil
+}
+
+// ListObjectVersionsResult result of listing object versions.
+type ListObjectVersionsResult struct {
+	Objects []ObjectEntry
+	More    bool
+}
+
+// ListObjectVersions lists a page of committed versions and delete markers of objects.
+func (db *DB) ListObjectVersions(ctx context.Context, opts ListObjectVersions) (result ListObjectVersionsResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return ListObjectVersionsResult{}, err
+	}
+
+	limit := opts.Limit
+	ListLimit.Ensure(&limit)
+
+	err = iterateObjectVersions(ctx, db, IterateObjectVersions{
+		ProjectID:             opts.ProjectID,
+		BucketName:            opts.BucketName,
+		Recursive:             opts.Recursive,
+		BatchSize:             limit + 1,
+		Prefix:                opts.Prefix,
+		Cursor:                opts.Cursor,
+		IncludeCustomMetadata: opts.IncludeCustomMetadata,
+		IncludeSystemMetadata: opts.IncludeSystemMetadata,
+	}, func(ctx context.Context, it ObjectsIterator) error {
+		var entry ObjectEntry
+		for it.Next(ctx, &entry) {
+			if len(result.Objects) >= limit {
+				result.More = true
+				return nil
+			}
+			result.Objects = append(result.Objects, entry)
+		}
+		return nil
+	})
+	if err != nil {
+		return ListObjectVersionsResult{}, err
+	}
+	return result, nil
+}
@@ -93,13 +93,32 @@ func (opts *ListObjects) getSQLQuery() string {
 	WHERE
 		(project_id, bucket_name, object_key, version) > ($1, $2, $3, $4)
 		AND ` + opts.stopCondition() + `
-		AND status = $6
+		AND ` + opts.statusCondition() + `
 		AND (expires_at IS NULL OR expires_at > now())
 	ORDER BY ` + opts.orderBy() + `
 	LIMIT $7
 	`
 }
 
+// statusCondition selects the pending objects or the latest version of the
+// committed objects, skipping objects whose latest version is a delete marker.
+func (opts *ListObjects) statusCondition() string {
+	if opts.Status == Pending {
+		return "status = $6"
+	}
+	return `status IN ($6, ` + committedVersionedStatus + `)
+		AND NOT EXISTS (
+			SELECT 1 FROM objects AS newer
+			WHERE
+				newer.project_id  = objects.project_id AND
+				newer.bucket_name = objects.bucket_name AND
+				newer.object_key  = objects.object_key AND
+				newer.version     > objects.version AND
+				newer.status      <> ` + pendingStatus + ` AND
+				(newer.expires_at IS NULL OR newer.expires_at > now())
+		)`
+}
+
 func (opts *ListObjects) stopKey() []byte {
 	if opts.Prefix != "" {
 		return []byte(prefixLimit(opts.Prefix))
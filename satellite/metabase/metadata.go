@@ -64,12 +64,13 @@ func (db *DB) UpdateObjectMetadata(ctx context.Context, opts UpdateObjectMetadat
 				project_id   = $1 AND
 				bucket_name  = $2 AND
 				object_key   = $3 AND
-				status       = `+committedStatus+` AND
+				status       IN `+statusesCommitted+` AND
 				(expires_at IS NULL OR expires_at > now())
 				ORDER BY version desc
 			) AND
 			stream_id    = $4 AND
-			status       = `+committedStatus,
+			status       IN `+statusesCommitted+` AND
+			`+objectUnlockedCondition("false"),
 		opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.StreamID,
 		opts.EncryptedMetadataNonce, opts.EncryptedMetadata, opts.EncryptedMetadataEncryptedKey)
 	if err != nil {
@@ -82,6 +83,24 @@ func (db *DB) UpdateObjectMetadata(ctx context.Context, opts UpdateObjectMetadat
 	}
 
 	if affected == 0 {
+		var locked bool
+		err := db.db.QueryRowContext(ctx, `
+			SELECT EXISTS (
+				SELECT 1 FROM objects
+				WHERE
+					project_id   = $1 AND
+					bucket_name  = $2 AND
+					object_key   = $3 AND
+					stream_id    = $4 AND
+					NOT `+objectUnlockedCondition("false")+`
+			)
+		`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.StreamID).Scan(&locked)
+		if err != nil {
+			return Error.New("unable to check object lock: %w", err)
+		}
+		if locked {
+			return ErrObjectLock.New("unable to update metadata of locked object")
+		}
 		return ErrObjectNotFound.New("object with specified version and committed status is missing")
 	}
 
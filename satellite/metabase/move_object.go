@@ -127,6 +127,13 @@ type FinishMoveObject struct {
 	// Optional. Required if object has metadata.
 	NewEncryptedMetadataKeyNonce storj.Nonce
 	NewEncryptedMetadataKey      []byte
+
+	// NewVersioned moves the object as a new version without replacing the
+	// versions at the target location. It should be set when the target bucket
+	// has versioning enabled.
+	NewVersioned bool
+
+	BucketEvents BucketEvents // optional
 }
 
 // Verify verifies metabase.FinishMoveObject data.
@@ -156,7 +163,9 @@ func (db *DB) FinishMoveObject(ctx context.Context, opts FinishMoveObject) (err
 	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
 		targetVersion := opts.Version
 
-		useNewVersion := false
+		// versioned targets keep every version, unversioned targets replace
+		// the unversioned delete marker but never an existing object.
+		markersToDelete := []Version{}
 		highestVersion := Version(0)
 		err = withRows(tx.QueryContext(ctx, `
 			SELECT version, status
@@ -176,10 +185,13 @@ func (db *DB) FinishMoveObject(ctx context.Context, opts FinishMoveObject) (err
 					return Error.New("failed to scan objects: %w", err)
 				}
 
-				if status == Committed {
-					return ErrObjectAlreadyExists.New("")
-				} else if status == Pending && version == opts.Version {
-					useNewVersion = true
+				if !opts.NewVersioned {
+					switch status {
+					case Committed:
+						return ErrObjectAlreadyExists.New("")
+					case DeleteMarkerUnversioned:
+						markersToDelete = append(markersToDelete, version)
+					}
 				}
 				highestVersion = version
 			}
@@ -190,15 +202,36 @@ func (db *DB) FinishMoveObject(ctx context.Context, opts FinishMoveObject) (err
 			return Error.Wrap(err)
 		}
 
-		if useNewVersion {
+		for _, version := range markersToDelete {
+			_, err := db.deleteObjectExactVersion(ctx, DeleteObjectExactVersion{
+				ObjectLocation: ObjectLocation{
+					ProjectID:  opts.ProjectID,
+					BucketName: opts.NewBucket,
+					ObjectKey:  ObjectKey(opts.NewEncryptedObjectKey),
+				},
+				Version: version,
+			}, tx)
+			if err != nil {
+				return Error.New("failed to delete existing delete marker: %w", err)
+			}
+		}
+
+		// the moved object must be newer than the versions at the target location.
+		if highestVersion >= targetVersion {
 			targetVersion = highestVersion + 1
 		}
 
+		statusSQL := committedStatus
+		if opts.NewVersioned {
+			statusSQL = committedVersionedStatus
+		}
+
 		updateObjectsQuery := `
 			UPDATE objects SET
 				bucket_name = $1,
 				object_key = $2,
 				version = $9,
+				status = ` + statusSQL + `,
 				encrypted_metadata_encrypted_key = CASE WHEN objects.encrypted_metadata IS NOT NULL
 				THEN $3
 				ELSE objects.encrypted_metadata_encrypted_key
@@ -211,7 +244,8 @@ func (db *DB) FinishMoveObject(ctx context.Context, opts FinishMoveObject) (err
 				project_id = $5 AND
 				bucket_name = $6 AND
 				object_key = $7 AND
-				version = $8
+				version = $8 AND
+				` + objectUnlockedCondition("false") + `
 			RETURNING
 				segment_count,
 				objects.encrypted_metadata IS NOT NULL AND LENGTH(objects.encrypted_metadata) > 0 AS has_metadata,
@@ -227,6 +261,9 @@ func (db *DB) FinishMoveObject(ctx context.Context, opts FinishMoveObject) (err
 			if code := pgerrcode.FromError(err); code == pgxerrcode.UniqueViolation {
 				return Error.Wrap(ErrObjectAlreadyExists.New(""))
 			} else if errors.Is(err, sql.ErrNoRows) {
+				if err := checkObjectLocked(ctx, tx, opts.Location(), opts.Version, false); err != nil {
+					return err
+				}
 				return ErrObjectNotFound.New("object not found")
 			}
 			return Error.New("unable to update object: %w", err)
@@ -279,7 +316,15 @@ func (db *DB) FinishMoveObject(ctx context.Context, opts FinishMoveObject) (err
 		if affected != int64(len(newSegmentKeys.Positions)) {
 			return Error.New("segment is missing")
 		}
-		return nil
+
+		target := opts.ObjectStream
+		target.BucketName = opts.NewBucket
+		target.ObjectKey = ObjectKey(opts.NewEncryptedObjectKey)
+		target.Version = targetVersion
+		return db.insertBucketEvents(ctx, tx, opts.BucketEvents, []BucketEvent{
+			{Type: BucketEventMoveOut, Object: Object{ObjectStream: opts.ObjectStream}},
+			{Type: BucketEventMoveIn, Object: Object{ObjectStream: target}},
+		})
 	})
 	if err != nil {
 		return err
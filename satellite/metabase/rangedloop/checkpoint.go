@@ -0,0 +1,340 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rangedloop
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+)
+
+// CheckpointObserver is an Observer whose partial results can be persisted,
+// so that an interrupted loop iteration can be resumed instead of starting
+// over. A loop iteration is only checkpointed when every observer
+// implements this interface.
+type CheckpointObserver interface {
+	Observer
+
+	// CheckpointPartial serializes the state of a partial returned by Fork
+	// or RestorePartial. It is called between calls to Process of the
+	// partial, when every stream given to the partial has been completely
+	// processed.
+	CheckpointPartial(context.Context, Partial) ([]byte, error)
+
+	// RestorePartial recreates a partial from the state returned by
+	// CheckpointPartial. It is called instead of Fork when resuming. Every
+	// checkpointed partial is restored before Fork is called for the ranges
+	// without progress, so it may also restore the state of the loop iteration
+	// which is shared by the partials.
+	RestorePartial(context.Context, []byte) (Partial, error)
+}
+
+// ResumableSegmentProvider is a SegmentProvider that can efficiently skip
+// the segments which have already been processed. Providers which don't
+// implement it are resumed by skipping the processed segments as they are
+// iterated.
+type ResumableSegmentProvider interface {
+	SegmentProvider
+
+	// ResumeAfter returns a provider for the segments of the streams after
+	// streamID within the same range.
+	ResumeAfter(streamID uuid.UUID) SegmentProvider
+}
+
+// Checkpoint is the persisted progress of a loop iteration.
+type Checkpoint struct {
+	StartedAt time.Time         `json:"started_at"`
+	Observers []string          `json:"observers"`
+	Ranges    []RangeCheckpoint `json:"ranges"`
+}
+
+// RangeCheckpoint is the persisted progress of a single range.
+type RangeCheckpoint struct {
+	Start *uuid.UUID `json:"start,omitempty"`
+	End   *uuid.UUID `json:"end,omitempty"`
+
+	// LastStreamID is the last stream whose segments were all processed.
+	LastStreamID uuid.UUID `json:"last_stream_id"`
+	// Done is set when the whole range has been processed.
+	Done bool `json:"done"`
+	// Partials contains the serialized partial of every observer.
+	Partials [][]byte `json:"partials"`
+}
+
+// CheckpointStore persists the checkpoint of the loop iteration in progress.
+type CheckpointStore interface {
+	// Load returns the stored checkpoint, or nil if there is none.
+	Load(ctx context.Context) (*Checkpoint, error)
+	// Save replaces the stored checkpoint.
+	Save(ctx context.Context, checkpoint *Checkpoint) error
+	// Delete removes the stored checkpoint.
+	Delete(ctx context.Context) error
+}
+
+// FileCheckpointStore stores the checkpoint as JSON in a local file.
+type FileCheckpointStore struct {
+	path string
+}
+
+var _ CheckpointStore = (*FileCheckpointStore)(nil)
+
+// NewFileCheckpointStore creates a checkpoint store that uses the file at path.
+func NewFileCheckpointStore(path string) *FileCheckpointStore {
+	return &FileCheckpointStore{path: path}
+}
+
+// Load returns the checkpoint in the file, or nil if the file doesn't exist.
+func (store *FileCheckpointStore) Load(ctx context.Context) (_ *Checkpoint, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	data, err := os.ReadFile(store.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var checkpoint Checkpoint
+	if err := json.Unmarshal(data, &checkpoint); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &checkpoint, nil
+}
+
+// Save atomically replaces the file with the checkpoint.
+func (store *FileCheckpointStore) Save(ctx context.Context, checkpoint *Checkpoint) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(store.path), 0755); err != nil {
+		return Error.Wrap(err)
+	}
+
+	tmp := store.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(os.Rename(tmp, store.path))
+}
+
+// Delete removes the file. It is not an error if it doesn't exist.
+func (store *FileCheckpointStore) Delete(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = os.Remove(store.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return Error.Wrap(err)
+}
+
+// observerNames returns the names used to check that a checkpoint was
+// created by the same observers.
+func observerNames(observers []Observer) []string {
+	names := make([]string, 0, len(observers))
+	for _, observer := range observers {
+		names = append(names, fmt.Sprintf("%T", observer))
+	}
+	return names
+}
+
+// checkpointObservers returns the observers as CheckpointObservers, or false
+// if any of them doesn't support checkpoints.
+func checkpointObservers(observers []Observer) ([]CheckpointObserver, bool) {
+	result := make([]CheckpointObserver, 0, len(observers))
+	for _, observer := range observers {
+		checkpointer, ok := observer.(CheckpointObserver)
+		if !ok {
+			return nil, false
+		}
+		result = append(result, checkpointer)
+	}
+	return result, true
+}
+
+// compatible returns true if the checkpoint can be used to resume a loop
+// iteration with the observers and ranges.
+func (checkpoint *Checkpoint) compatible(observers []Observer, providers []SegmentProvider) bool {
+	names := observerNames(observers)
+	if len(checkpoint.Observers) != len(names) || len(checkpoint.Ranges) != len(providers) {
+		return false
+	}
+	for i, name := range names {
+		if checkpoint.Observers[i] != name {
+			return false
+		}
+	}
+	for i, provider := range providers {
+		saved := checkpoint.Ranges[i]
+		if !sameUUID(saved.Start, provider.Range().Start) || !sameUUID(saved.End, provider.Range().End) {
+			return false
+		}
+		// ranges without any progress don't have partials yet.
+		if len(saved.Partials) != 0 && len(saved.Partials) != len(observers) {
+			return false
+		}
+	}
+	return true
+}
+
+func sameUUID(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// checkpointTracker collects the progress of every range and saves it.
+type checkpointTracker struct {
+	log       *zap.Logger
+	store     CheckpointStore
+	observers []CheckpointObserver
+	interval  time.Duration
+
+	mu         sync.Mutex
+	checkpoint Checkpoint
+	lastSave   time.Time
+	disabled   bool
+}
+
+func newCheckpointTracker(log *zap.Logger, store CheckpointStore, interval time.Duration, startTime time.Time, observers []Observer, checkpointers []CheckpointObserver, providers []SegmentProvider) *checkpointTracker {
+	tracker := &checkpointTracker{
+		log:       log,
+		store:     store,
+		observers: checkpointers,
+		interval:  interval,
+		checkpoint: Checkpoint{
+			StartedAt: startTime,
+			Observers: observerNames(observers),
+			Ranges:    make([]RangeCheckpoint, len(providers)),
+		},
+		lastSave: time.Now(),
+	}
+	for i, provider := range providers {
+		tracker.checkpoint.Ranges[i].Start = provider.Range().Start
+		tracker.checkpoint.Ranges[i].End = provider.Range().End
+	}
+	return tracker
+}
+
+// restore sets the progress of a range from an earlier checkpoint.
+func (tracker *checkpointTracker) restore(index int, saved RangeCheckpoint) {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	tracker.checkpoint.Ranges[index] = saved
+}
+
+// progress records that every stream up to and including lastStreamID has
+// been processed by the partials of the range. The partials are only
+// serialized when the checkpoint is due, or when the range is done.
+func (tracker *checkpointTracker) progress(ctx context.Context, index int, states []*rangeObserverState, lastStreamID uuid.UUID, done bool) {
+	tracker.mu.Lock()
+	due := !tracker.disabled && (done || time.Since(tracker.lastSave) >= tracker.interval)
+	if due {
+		// claim the save, so that the other ranges don't serialize their
+		// partials for the same checkpoint.
+		tracker.lastSave = time.Now()
+	}
+	tracker.mu.Unlock()
+
+	if !due {
+		return
+	}
+
+	partials := make([][]byte, len(states))
+	for i, state := range states {
+		if state.err != nil {
+			tracker.disable("observer failed", state.err)
+			return
+		}
+		data, err := tracker.observers[i].CheckpointPartial(ctx, state.rangeObserver)
+		if err != nil {
+			tracker.disable("unable to checkpoint partial", err)
+			return
+		}
+		partials[i] = data
+	}
+
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	if tracker.disabled {
+		return
+	}
+
+	saved := &tracker.checkpoint.Ranges[index]
+	saved.LastStreamID = lastStreamID
+	saved.Done = done
+	saved.Partials = partials
+
+	if err := tracker.store.Save(ctx, &tracker.checkpoint); err != nil {
+		tracker.log.Warn("unable to save ranged loop checkpoint", zap.Error(err))
+	}
+}
+
+// disable stops saving checkpoints for the rest of the loop iteration,
+// because the partial results can't be persisted consistently.
+func (tracker *checkpointTracker) disable(reason string, err error) {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	if !tracker.disabled {
+		tracker.log.Warn("ranged loop checkpoints disabled for this iteration", zap.String("reason", reason), zap.Error(err))
+	}
+	tracker.disabled = true
+}
+
+// finish removes the checkpoint once the loop iteration has completed.
+func (tracker *checkpointTracker) finish(ctx context.Context) {
+	if err := tracker.store.Delete(ctx); err != nil {
+		tracker.log.Warn("unable to delete ranged loop checkpoint", zap.Error(err))
+	}
+}
+
+// skippingProvider resumes a provider which doesn't implement
+// ResumableSegmentProvider by skipping the processed streams.
+type skippingProvider struct {
+	SegmentProvider
+	after uuid.UUID
+}
+
+// Iterate calls fn with the segments of the streams after the skipped ones.
+func (provider *skippingProvider) Iterate(ctx context.Context, fn func([]Segment) error) error {
+	return provider.SegmentProvider.Iterate(ctx, func(segments []Segment) error {
+		for len(segments) > 0 && segments[0].StreamID.Compare(provider.after) <= 0 {
+			segments = segments[1:]
+		}
+		if len(segments) == 0 {
+			return nil
+		}
+		return fn(segments)
+	})
+}
+
+// resumeAfter returns a provider for the segments of the range after the
+// stream.
+func resumeAfter(provider SegmentProvider, streamID uuid.UUID) SegmentProvider {
+	if streamID.IsZero() {
+		return provider
+	}
+	if resumable, ok := provider.(ResumableSegmentProvider); ok {
+		return resumable.ResumeAfter(streamID)
+	}
+	return &skippingProvider{SegmentProvider: provider, after: streamID}
+}
@@ -0,0 +1,253 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rangedloop_test
+
+import (
+	"context"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/metabase/rangedloop/rangedlooptest"
+)
+
+// resumableCountObserver counts segments and can be checkpointed. It
+// records every processed segment to detect segments processed twice.
+type resumableCountObserver struct {
+	mu        sync.Mutex
+	processed map[string]int
+	failAfter int
+	startTime time.Time
+	// calls records the calls of Fork, RestorePartial and CheckpointPartial.
+	calls []string
+
+	count int
+}
+
+func (obs *resumableCountObserver) record(call string) {
+	obs.mu.Lock()
+	defer obs.mu.Unlock()
+	obs.calls = append(obs.calls, call)
+}
+
+func (obs *resumableCountObserver) Start(ctx context.Context, startTime time.Time) error {
+	obs.startTime = startTime
+	obs.count = 0
+	return nil
+}
+
+func (obs *resumableCountObserver) Fork(ctx context.Context) (rangedloop.Partial, error) {
+	obs.record("fork")
+	return &resumableCountPartial{observer: obs}, nil
+}
+
+func (obs *resumableCountObserver) Join(ctx context.Context, partial rangedloop.Partial) error {
+	obs.count += partial.(*resumableCountPartial).count
+	return nil
+}
+
+func (obs *resumableCountObserver) Finish(ctx context.Context) error { return nil }
+
+func (obs *resumableCountObserver) CheckpointPartial(ctx context.Context, partial rangedloop.Partial) ([]byte, error) {
+	obs.record("checkpoint")
+	return []byte(strconv.Itoa(partial.(*resumableCountPartial).count)), nil
+}
+
+func (obs *resumableCountObserver) RestorePartial(ctx context.Context, data []byte) (rangedloop.Partial, error) {
+	obs.record("restore")
+	count, err := strconv.Atoi(string(data))
+	if err != nil {
+		return nil, err
+	}
+	return &resumableCountPartial{observer: obs, count: count}, nil
+}
+
+type resumableCountPartial struct {
+	observer *resumableCountObserver
+	count    int
+}
+
+func (partial *resumableCountPartial) Process(ctx context.Context, segments []rangedloop.Segment) error {
+	obs := partial.observer
+
+	obs.mu.Lock()
+	defer obs.mu.Unlock()
+
+	for _, segment := range segments {
+		if obs.failAfter == 0 {
+			// simulate the satellite stopping in the middle of the loop.
+			return context.Canceled
+		}
+		obs.failAfter--
+
+		obs.processed[segment.StreamID.String()+"/"+strconv.Itoa(int(segment.Position.Index))]++
+		partial.count++
+	}
+	return nil
+}
+
+func TestRunOnceResume(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	var segments []rangedloop.Segment
+	for i := 0; i < 20; i++ {
+		streamID := testrand.UUID()
+		for index := 0; index < 3; index++ {
+			segments = append(segments, rangedloop.Segment{
+				StreamID: streamID,
+				Position: metabase.SegmentPosition{Index: uint32(index)},
+			})
+		}
+	}
+
+	path := filepath.Join(ctx.Dir("checkpoint"), "rangedloop.json")
+	config := rangedloop.Config{
+		Parallelism:    2,
+		BatchSize:      4,
+		CheckpointPath: path,
+	}
+
+	observer := &resumableCountObserver{
+		processed: map[string]int{},
+		failAfter: 25,
+	}
+	newService := func() *rangedloop.Service {
+		return rangedloop.NewService(zaptest.NewLogger(t), config, &rangedlooptest.RangeSplitter{Segments: segments}, []rangedloop.Observer{observer})
+	}
+
+	// the first run is interrupted and leaves a checkpoint behind.
+	_, err := newService().RunOnce(ctx)
+	require.Error(t, err)
+
+	store := rangedloop.NewFileCheckpointStore(path)
+	checkpoint, err := store.Load(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, checkpoint)
+	require.Len(t, checkpoint.Ranges, 2)
+	firstStart := observer.startTime
+
+	// the second run continues where the first one stopped.
+	observer.failAfter = -1
+	observer.calls = nil
+	durations, err := newService().RunOnce(ctx)
+	require.NoError(t, err)
+	require.Len(t, durations, 1)
+	require.True(t, durations[0].Duration >= 0)
+
+	// the checkpointed partials are restored before the other ranges are forked.
+	require.Equal(t, "restore", observer.calls[0])
+	for i := 1; i < len(observer.calls); i++ {
+		if observer.calls[i] == "restore" {
+			require.NotContains(t, observer.calls[:i], "fork")
+		}
+	}
+
+	require.Equal(t, len(segments), observer.count)
+	require.True(t, firstStart.Equal(observer.startTime))
+	require.Len(t, observer.processed, len(segments))
+
+	// segments which were not checkpointed before the interruption are
+	// processed again, but the count only includes them once.
+	twice := 0
+	for _, n := range observer.processed {
+		require.LessOrEqual(t, n, 2)
+		if n == 2 {
+			twice++
+		}
+	}
+	require.Less(t, twice, len(segments))
+
+	// a completed run removes the checkpoint.
+	checkpoint, err = store.Load(ctx)
+	require.NoError(t, err)
+	require.Nil(t, checkpoint)
+}
+
+func TestRunOnceCheckpointIncompatible(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	segments := []rangedloop.Segment{
+		{StreamID: uuid.UUID{1}},
+		{StreamID: uuid.UUID{2}},
+		{StreamID: uuid.UUID{3}},
+	}
+
+	path := filepath.Join(ctx.Dir("checkpoint"), "rangedloop.json")
+	store := rangedloop.NewFileCheckpointStore(path)
+
+	// a checkpoint for a different number of ranges is ignored.
+	require.NoError(t, store.Save(ctx, &rangedloop.Checkpoint{
+		StartedAt: time.Now().Add(-time.Hour),
+		Observers: []string{"*rangedloop_test.resumableCountObserver"},
+		Ranges:    []rangedloop.RangeCheckpoint{{Done: true, Partials: [][]byte{[]byte("100")}}},
+	}))
+
+	observer := &resumableCountObserver{processed: map[string]int{}, failAfter: -1}
+	service := rangedloop.NewService(zaptest.NewLogger(t), rangedloop.Config{
+		Parallelism:    2,
+		BatchSize:      2,
+		CheckpointPath: path,
+	}, &rangedlooptest.RangeSplitter{Segments: segments}, []rangedloop.Observer{observer})
+
+	_, err := service.RunOnce(ctx)
+	require.NoError(t, err)
+	require.Equal(t, len(segments), observer.count)
+
+	// observers that don't support checkpoints disable them.
+	require.NoError(t, store.Save(ctx, &rangedloop.Checkpoint{}))
+	counter := &rangedlooptest.CountObserver{}
+	service = rangedloop.NewService(zaptest.NewLogger(t), rangedloop.Config{
+		Parallelism:    2,
+		BatchSize:      2,
+		CheckpointPath: path,
+	}, &rangedlooptest.RangeSplitter{Segments: segments}, []rangedloop.Observer{counter})
+
+	_, err = service.RunOnce(ctx)
+	require.NoError(t, err)
+	require.Equal(t, len(segments), counter.NumSegments)
+
+	checkpoint, err := store.Load(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, checkpoint, "checkpoint is left alone when checkpoints are disabled")
+}
+
+func TestRunOnceCheckpointInterval(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	var segments []rangedloop.Segment
+	for i := 0; i < 20; i++ {
+		segments = append(segments, rangedloop.Segment{StreamID: testrand.UUID()})
+	}
+
+	observer := &resumableCountObserver{processed: map[string]int{}, failAfter: -1}
+	service := rangedloop.NewService(zaptest.NewLogger(t), rangedloop.Config{
+		Parallelism:        2,
+		BatchSize:          2,
+		CheckpointPath:     filepath.Join(ctx.Dir("checkpoint"), "rangedloop.json"),
+		CheckpointInterval: time.Hour,
+	}, &rangedlooptest.RangeSplitter{Segments: segments}, []rangedloop.Observer{observer})
+
+	_, err := service.RunOnce(ctx)
+	require.NoError(t, err)
+	require.Equal(t, len(segments), observer.count)
+
+	// the partials are only serialized when a range is done, not after every batch.
+	checkpoints := 0
+	for _, call := range observer.calls {
+		if call == "checkpoint" {
+			checkpoints++
+		}
+	}
+	require.Equal(t, 2, checkpoints)
+}
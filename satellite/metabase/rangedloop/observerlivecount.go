@@ -5,6 +5,7 @@ package rangedloop
 
 import (
 	"context"
+	"strconv"
 	"sync/atomic"
 	"time"
 
@@ -14,8 +15,8 @@ import (
 )
 
 var _ monkit.StatSource = (*LiveCountObserver)(nil)
-var _ Observer = (*LiveCountObserver)(nil)
-var _ Partial = (*LiveCountObserver)(nil)
+var _ CheckpointObserver = (*LiveCountObserver)(nil)
+var _ Partial = (*liveCountPartial)(nil)
 
 // LiveCountObserver reports a count of segments during loop execution.
 // This can be used to report the rate and progress of the loop.
@@ -60,19 +61,47 @@ func (o *LiveCountObserver) Start(ctx context.Context, startTime time.Time) (err
 	return nil
 }
 
-// Fork returns a shared instance so we have a view of all loop ranges.
+// Fork returns a partial which updates the shared count so we have a view
+// of all loop ranges.
 func (o *LiveCountObserver) Fork(ctx context.Context) (Partial, error) {
-	return o, nil
+	return &liveCountPartial{observer: o}, nil
 }
 
-// Join does nothing because the instance is shared across ranges.
+// Join does nothing because the partials update the shared count.
 func (o *LiveCountObserver) Join(ctx context.Context, partial Partial) error {
 	return nil
 }
 
+// CheckpointPartial returns the number of segments processed by the partial.
+func (o *LiveCountObserver) CheckpointPartial(ctx context.Context, partial Partial) ([]byte, error) {
+	p, ok := partial.(*liveCountPartial)
+	if !ok {
+		return nil, Error.New("expected %T but got %T", p, partial)
+	}
+	return []byte(strconv.FormatInt(p.processed, 10)), nil
+}
+
+// RestorePartial adds the number of segments processed by the checkpointed
+// partial to the shared count.
+func (o *LiveCountObserver) RestorePartial(ctx context.Context, data []byte) (Partial, error) {
+	processed, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	atomic.AddInt64(&o.segmentsProcessed, processed)
+	return &liveCountPartial{observer: o, processed: processed}, nil
+}
+
+// liveCountPartial counts the segments of a single range.
+type liveCountPartial struct {
+	observer  *LiveCountObserver
+	processed int64
+}
+
 // Process increments the counter.
-func (o *LiveCountObserver) Process(ctx context.Context, segments []Segment) error {
-	processed := atomic.AddInt64(&o.segmentsProcessed, int64(len(segments)))
+func (p *liveCountPartial) Process(ctx context.Context, segments []Segment) error {
+	p.processed += int64(len(segments))
+	processed := atomic.AddInt64(&p.observer.segmentsProcessed, int64(len(segments)))
 
 	mon.IntVal("segmentsProcessed").Observe(processed)
 	return nil
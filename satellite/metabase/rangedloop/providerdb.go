@@ -66,6 +66,13 @@ func (provider *MetabaseSegmentProvider) Range() UUIDRange {
 	return provider.uuidRange
 }
 
+// ResumeAfter returns a provider for the streams of the range after streamID.
+func (provider *MetabaseSegmentProvider) ResumeAfter(streamID uuid.UUID) SegmentProvider {
+	resumed := *provider
+	resumed.uuidRange.Start = &streamID
+	return &resumed
+}
+
 // Iterate loops over a part of the segment table.
 func (provider *MetabaseSegmentProvider) Iterate(ctx context.Context, fn func([]Segment) error) error {
 	var startStreamID uuid.UUID
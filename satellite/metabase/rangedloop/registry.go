@@ -0,0 +1,79 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rangedloop
+
+import (
+	"sort"
+	"sync"
+)
+
+// ObserverFactory creates an observer that was enabled from configuration.
+type ObserverFactory func() (Observer, error)
+
+// Registry contains named observers which can be enabled from
+// configuration instead of being hard-coded into the ranged loop peer.
+type Registry struct {
+	mu        sync.Mutex
+	factories map[string]ObserverFactory
+}
+
+// NewRegistry creates an empty observer registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		factories: map[string]ObserverFactory{},
+	}
+}
+
+// Register adds a named observer factory to the registry. Registering the
+// same name twice is an error.
+func (registry *Registry) Register(name string, factory ObserverFactory) error {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	if _, exists := registry.factories[name]; exists {
+		return Error.New("observer %q is already registered", name)
+	}
+	registry.factories[name] = factory
+	return nil
+}
+
+// Names returns the sorted names of all registered observers.
+func (registry *Registry) Names() []string {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	names := make([]string, 0, len(registry.factories))
+	for name := range registry.factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Create creates the observers with the given names in the same order.
+// Unknown names are an error so that typos in configuration are noticed.
+func (registry *Registry) Create(names []string) (observers []Observer, err error) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	seen := map[string]bool{}
+	for _, name := range names {
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		factory, ok := registry.factories[name]
+		if !ok {
+			return nil, Error.New("unknown observer %q", name)
+		}
+
+		observer, err := factory()
+		if err != nil {
+			return nil, Error.New("unable to create observer %q: %v", name, err)
+		}
+		observers = append(observers, observer)
+	}
+	return observers, nil
+}
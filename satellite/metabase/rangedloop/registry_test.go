@@ -0,0 +1,42 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rangedloop_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/metabase/rangedloop/rangedlooptest"
+)
+
+func TestRegistry(t *testing.T) {
+	registry := rangedloop.NewRegistry()
+
+	first := &rangedlooptest.CountObserver{}
+	second := &rangedlooptest.CountObserver{}
+
+	require.NoError(t, registry.Register("first", func() (rangedloop.Observer, error) { return first, nil }))
+	require.NoError(t, registry.Register("second", func() (rangedloop.Observer, error) { return second, nil }))
+	require.NoError(t, registry.Register("broken", func() (rangedloop.Observer, error) { return nil, errors.New("broken") }))
+	require.Error(t, registry.Register("first", func() (rangedloop.Observer, error) { return first, nil }))
+
+	require.Equal(t, []string{"broken", "first", "second"}, registry.Names())
+
+	observers, err := registry.Create(nil)
+	require.NoError(t, err)
+	require.Empty(t, observers)
+
+	observers, err = registry.Create([]string{"second", "", "first", "second"})
+	require.NoError(t, err)
+	require.Equal(t, []rangedloop.Observer{second, first}, observers)
+
+	_, err = registry.Create([]string{"first", "unknown"})
+	require.Error(t, err)
+
+	_, err = registry.Create([]string{"broken"})
+	require.Error(t, err)
+}
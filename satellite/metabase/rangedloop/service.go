@@ -15,6 +15,7 @@ import (
 
 	"storj.io/common/errs2"
 	"storj.io/common/sync2"
+	"storj.io/common/uuid"
 )
 
 var (
@@ -33,6 +34,11 @@ type Config struct {
 	Interval           time.Duration `help:"how often to run the loop" releaseDefault:"2h" devDefault:"10s" testDefault:"10s"`
 
 	SuspiciousProcessedRatio float64 `help:"ratio where to consider processed count as supicious" default:"0.03"`
+
+	Observers []string `help:"list of names of additional registered observers to run" default:""`
+
+	CheckpointPath     string        `help:"path of the file used to persist the progress of the loop so that an interrupted iteration can resume, disabled when empty" default:""`
+	CheckpointInterval time.Duration `help:"how often to persist the progress of the loop" default:"1m" testDefault:"0"`
 }
 
 // Service iterates through all segments and calls the attached observers for every segment
@@ -44,18 +50,24 @@ type Service struct {
 	provider  RangeSplitter
 	observers []Observer
 
+	checkpoints CheckpointStore
+
 	Loop *sync2.Cycle
 }
 
 // NewService creates a new instance of the ranged loop service.
 func NewService(log *zap.Logger, config Config, provider RangeSplitter, observers []Observer) *Service {
-	return &Service{
+	service := &Service{
 		log:       log,
 		config:    config,
 		provider:  provider,
 		observers: observers,
 		Loop:      sync2.NewCycle(config.Interval),
 	}
+	if config.CheckpointPath != "" {
+		service.checkpoints = NewFileCheckpointStore(config.CheckpointPath)
+	}
+	return service
 }
 
 // observerState contains information to manage an observer during a loop iteration.
@@ -124,38 +136,116 @@ func (service *Service) Run(ctx context.Context) (err error) {
 func (service *Service) RunOnce(ctx context.Context) (observerDurations []ObserverDuration, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	observerStates, err := startObservers(ctx, service.log, service.observers)
+	checkpointers, canCheckpoint := checkpointObservers(service.observers)
+	canCheckpoint = canCheckpoint && service.checkpoints != nil
+
+	var checkpoint *Checkpoint
+	startTime := time.Now()
+	if canCheckpoint {
+		checkpoint, err = service.checkpoints.Load(ctx)
+		if err != nil {
+			service.log.Warn("unable to load ranged loop checkpoint, starting from the beginning", zap.Error(err))
+			checkpoint = nil
+		}
+	}
+
+	rangeProviders, err := service.provider.CreateRanges(service.config.Parallelism, service.config.BatchSize)
 	if err != nil {
 		return nil, err
 	}
 
-	rangeProviders, err := service.provider.CreateRanges(service.config.Parallelism, service.config.BatchSize)
+	if checkpoint != nil && !checkpoint.compatible(service.observers, rangeProviders) {
+		service.log.Info("ranged loop checkpoint does not match the current configuration, starting from the beginning")
+		checkpoint = nil
+	}
+	if checkpoint != nil {
+		startTime = checkpoint.StartedAt
+	}
+
+	observerStates, err := startObservers(ctx, service.log, startTime, service.observers)
 	if err != nil {
 		return nil, err
 	}
 
+	var tracker *checkpointTracker
+	if canCheckpoint {
+		for _, observerState := range observerStates {
+			if observerState.err != nil {
+				// the partials of every observer are needed to resume.
+				canCheckpoint, checkpoint = false, nil
+				break
+			}
+		}
+	}
+	if canCheckpoint {
+		tracker = newCheckpointTracker(service.log, service.checkpoints, service.config.CheckpointInterval, startTime, service.observers, checkpointers, rangeProviders)
+	}
+	if checkpoint != nil {
+		service.log.Info("resuming ranged loop from checkpoint", zap.Time("started", checkpoint.StartedAt))
+	}
+
+	// The checkpointed partials are restored before forking the other ranges,
+	// so that the observers can restore the state shared by their partials.
+	restored := make([][]*rangeObserverState, len(rangeProviders))
+	if checkpoint != nil {
+		for index := range rangeProviders {
+			saved := checkpoint.Ranges[index]
+			if len(saved.Partials) == 0 {
+				continue
+			}
+			tracker.restore(index, saved)
+
+			for i := range observerStates {
+				rangeObserver, err := checkpointers[i].RestorePartial(ctx, saved.Partials[i])
+				restored[index] = append(restored[index], &rangeObserverState{
+					rangeObserver: rangeObserver,
+					err:           err,
+				})
+			}
+		}
+	}
+
 	group := errs2.Group{}
 	for index, rangeProvider := range rangeProviders {
 		uuidRange := rangeProvider.Range()
 		service.log.Debug("creating range", zap.Int("index", index), zap.Stringer("start", uuidRange.Start), zap.Stringer("end", uuidRange.End))
 
+		var saved *RangeCheckpoint
+		if restored[index] != nil {
+			saved = &checkpoint.Ranges[index]
+		}
+
 		rangeObservers := []*rangeObserverState{}
 		for i, observerState := range observerStates {
 			if observerState.err != nil {
 				service.log.Debug("observer returned error", zap.Error(observerState.err))
 				continue
 			}
-			rangeObserver, err := observerState.observer.Fork(ctx)
-			rangeState := &rangeObserverState{
-				rangeObserver: rangeObserver,
-				err:           err,
+
+			var rangeState *rangeObserverState
+			if saved != nil {
+				rangeState = restored[index][i]
+			} else {
+				rangeObserver, err := observerState.observer.Fork(ctx)
+				rangeState = &rangeObserverState{
+					rangeObserver: rangeObserver,
+					err:           err,
+				}
 			}
 			rangeObservers = append(rangeObservers, rangeState)
 			observerStates[i].rangeObservers = append(observerStates[i].rangeObservers, rangeState)
 		}
 
+		if saved != nil {
+			if saved.Done {
+				// the partials already contain the results of the whole range.
+				continue
+			}
+			rangeProvider = resumeAfter(rangeProvider, saved.LastStreamID)
+		}
+
 		// Create closure to capture loop variables.
-		group.Go(createGoroutineClosure(ctx, rangeProvider, rangeObservers))
+		group.Go(createGoroutineClosure(ctx, rangeProvider, rangeObservers, tracker, index))
 	}
 
 	// Improvement: stop all ranges when one has an error.
@@ -164,28 +254,79 @@ func (service *Service) RunOnce(ctx context.Context) (observerDurations []Observ
 		return nil, errs.Combine(errList...)
 	}
 
-	return finishObservers(ctx, service.log, observerStates), nil
+	observerDurations = finishObservers(ctx, service.log, observerStates)
+
+	if tracker != nil {
+		tracker.finish(ctx)
+	}
+
+	return observerDurations, nil
 }
 
-func createGoroutineClosure(ctx context.Context, rangeProvider SegmentProvider, states []*rangeObserverState) func() error {
+func createGoroutineClosure(ctx context.Context, rangeProvider SegmentProvider, states []*rangeObserverState, tracker *checkpointTracker, index int) func() error {
 	return func() (err error) {
 		defer mon.Task()(&ctx)(&err)
 
-		return rangeProvider.Iterate(ctx, func(segments []Segment) error {
-			// check for cancellation every segment batch
+		if tracker == nil {
+			return rangeProvider.Iterate(ctx, func(segments []Segment) error {
+				// check for cancellation every segment batch
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				default:
+					return processBatch(ctx, states, segments)
+				}
+			})
+		}
+
+		// The partials can only be checkpointed when every stream they have
+		// seen is complete, so the segments of the last stream in a batch are
+		// held back until the next batch.
+		var held []Segment
+		var lastStreamID uuid.UUID
+		err = rangeProvider.Iterate(ctx, func(segments []Segment) error {
 			select {
 			case <-ctx.Done():
 				return ctx.Err()
 			default:
-				return processBatch(ctx, states, segments)
 			}
+
+			held = append(held, segments...)
+
+			last := held[len(held)-1].StreamID
+			complete := len(held)
+			for complete > 0 && held[complete-1].StreamID == last {
+				complete--
+			}
+			if complete == 0 {
+				return nil
+			}
+
+			if err := processBatch(ctx, states, held[:complete]); err != nil {
+				return err
+			}
+			lastStreamID = held[complete-1].StreamID
+			held = append(held[:0], held[complete:]...)
+
+			tracker.progress(ctx, index, states, lastStreamID, false)
+			return nil
 		})
+		if err != nil {
+			return err
+		}
+
+		if len(held) > 0 {
+			if err := processBatch(ctx, states, held); err != nil {
+				return err
+			}
+			lastStreamID = held[len(held)-1].StreamID
+		}
+		tracker.progress(ctx, index, states, lastStreamID, true)
+		return nil
 	}
 }
 
-func startObservers(ctx context.Context, log *zap.Logger, observers []Observer) (observerStates []observerState, err error) {
-	startTime := time.Now()
-
+func startObservers(ctx context.Context, log *zap.Logger, startTime time.Time, observers []Observer) (observerStates []observerState, err error) {
 	for _, obs := range observers {
 		observerStates = append(observerStates, startObserver(ctx, log, startTime, obs))
 	}
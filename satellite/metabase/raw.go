@@ -39,6 +39,11 @@ type RawObject struct {
 	// This is as a safeguard against objects that failed to upload and the client has not indicated
 	// whether they want to continue uploading or delete the already uploaded data.
 	ZombieDeletionDeadline *time.Time
+
+	// Retention prevents the object from being deleted or modified until it expires.
+	Retention Retention
+	// LegalHold prevents the object from being deleted or modified until it is removed.
+	LegalHold bool
 }
 
 // RawPendingObject defines the full pending object that is stored in the database. It should be rarely used directly.
@@ -130,6 +135,7 @@ func (db *DB) TestingDeleteAll(ctx context.Context) (err error) {
 		WITH ignore_full_scan_for_test AS (SELECT 1) DELETE FROM pending_objects;
 		WITH ignore_full_scan_for_test AS (SELECT 1) DELETE FROM segments;
 		WITH ignore_full_scan_for_test AS (SELECT 1) DELETE FROM node_aliases;
+		WITH ignore_full_scan_for_test AS (SELECT 1) DELETE FROM bucket_events;
 		WITH ignore_full_scan_for_test AS (SELECT 1) SELECT setval('node_alias_seq', 1, false);
 	`)
 	db.aliasCache = NewNodeAliasCache(db)
@@ -149,7 +155,8 @@ func (db *DB) testingGetAllObjects(ctx context.Context) (_ []RawObject, err erro
 			encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
 			total_plain_size, total_encrypted_size, fixed_segment_size,
 			encryption,
-			zombie_deletion_deadline
+			zombie_deletion_deadline,
+			retention_mode, retain_until, legal_hold
 		FROM objects
 		ORDER BY project_id ASC, bucket_name ASC, object_key ASC, version ASC
 	`)
@@ -182,6 +189,10 @@ func (db *DB) testingGetAllObjects(ctx context.Context) (_ []RawObject, err erro
 
 			encryptionParameters{&obj.Encryption},
 			&obj.ZombieDeletionDeadline,
+
+			&obj.Retention.Mode,
+			timeOrZero{&obj.Retention.RetainUntil},
+			&obj.LegalHold,
 		)
 		if err != nil {
 			return nil, Error.New("testingGetAllObjects scan failed: %w", err)
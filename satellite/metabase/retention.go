@@ -0,0 +1,286 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/private/dbutil/txutil"
+	"storj.io/private/tagsql"
+)
+
+// ErrObjectLock is used when an operation is refused because the object is
+// under retention or legal hold.
+var ErrObjectLock = errs.Class("object is locked")
+
+// RetentionMode defines whether the retention of an object can be shortened.
+type RetentionMode byte
+
+const (
+	// NoRetention means that the object isn't retained.
+	NoRetention = RetentionMode(0)
+	// GovernanceMode retention can be shortened or removed by requests that
+	// are authorized to bypass governance.
+	GovernanceMode = RetentionMode(1)
+	// ComplianceMode retention can't be shortened or removed by anyone.
+	ComplianceMode = RetentionMode(2)
+
+	governanceModeSQL = "1"
+)
+
+// String returns a human readable representation of the retention mode.
+func (mode RetentionMode) String() string {
+	switch mode {
+	case NoRetention:
+		return "none"
+	case GovernanceMode:
+		return "governance"
+	case ComplianceMode:
+		return "compliance"
+	default:
+		return fmt.Sprintf("RetentionMode(%d)", byte(mode))
+	}
+}
+
+// Retention prevents an object version from being deleted or modified
+// until RetainUntil.
+type Retention struct {
+	Mode        RetentionMode
+	RetainUntil time.Time
+}
+
+// Verify verifies retention fields.
+func (retention Retention) Verify() error {
+	switch retention.Mode {
+	case NoRetention:
+		if !retention.RetainUntil.IsZero() {
+			return ErrInvalidRequest.New("RetainUntil must not be set without retention mode")
+		}
+	case GovernanceMode, ComplianceMode:
+		if retention.RetainUntil.IsZero() {
+			return ErrInvalidRequest.New("RetainUntil missing")
+		}
+	default:
+		return ErrInvalidRequest.New("invalid retention mode %d", retention.Mode)
+	}
+	return nil
+}
+
+// Active returns whether the retention prevents changes at the specified time.
+func (retention Retention) Active(now time.Time) bool {
+	return retention.Mode != NoRetention && now.Before(retention.RetainUntil)
+}
+
+// Locked returns whether the object version can't be deleted or modified at
+// the specified time.
+func (obj *Object) Locked(now time.Time, bypassGovernance bool) bool {
+	if obj.LegalHold {
+		return true
+	}
+	if !obj.Retention.Active(now) {
+		return false
+	}
+	return !(obj.Retention.Mode == GovernanceMode && bypassGovernance)
+}
+
+// objectUnlockedCondition returns an SQL condition matching the objects that
+// can be deleted or modified. bypassGovernance is an SQL boolean expression.
+func objectUnlockedCondition(bypassGovernance string) string {
+	return `(NOT legal_hold AND (
+		retain_until IS NULL OR retain_until <= now() OR
+		(retention_mode = ` + governanceModeSQL + ` AND ` + bypassGovernance + `)
+	))`
+}
+
+// checkObjectLocked returns ErrObjectLock when the object version is locked.
+// A zero version checks every version of the object.
+func checkObjectLocked(ctx context.Context, stmt queryRower, location ObjectLocation, version Version, bypassGovernance bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var locked bool
+	err = stmt.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM objects
+			WHERE
+				project_id  = $1 AND
+				bucket_name = $2 AND
+				object_key  = $3 AND
+				($4 = 0 OR version = $4) AND
+				NOT `+objectUnlockedCondition("$5")+`
+		)
+	`, location.ProjectID, []byte(location.BucketName), location.ObjectKey, version, bypassGovernance).Scan(&locked)
+	if err != nil {
+		return Error.New("unable to check object lock: %w", err)
+	}
+	if locked {
+		return ErrObjectLock.New("%s", location.ObjectKey)
+	}
+	return nil
+}
+
+type queryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// SetObjectRetention contains arguments necessary for changing the retention of an object version.
+type SetObjectRetention struct {
+	ObjectLocation
+	Version   Version
+	Retention Retention
+
+	// BypassGovernance allows shortening or removing a governance mode retention.
+	BypassGovernance bool
+}
+
+// Verify verifies set object retention fields.
+func (opts *SetObjectRetention) Verify() error {
+	if err := opts.ObjectLocation.Verify(); err != nil {
+		return err
+	}
+	if opts.Version <= 0 {
+		return ErrInvalidRequest.New("Version invalid: %v", opts.Version)
+	}
+	return opts.Retention.Verify()
+}
+
+// SetObjectRetention changes the retention of a committed object version.
+// An active retention can only be extended, unless it's in governance mode
+// and the request bypasses governance.
+func (db *DB) SetObjectRetention(ctx context.Context, opts SetObjectRetention) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return err
+	}
+
+	return txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		var current Retention
+		var now time.Time
+		err := tx.QueryRowContext(ctx, `
+			SELECT retention_mode, retain_until, now()
+			FROM objects
+			WHERE
+				project_id   = $1 AND
+				bucket_name  = $2 AND
+				object_key   = $3 AND
+				version      = $4 AND
+				status       IN `+statusesCommitted+`
+		`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version,
+		).Scan(&current.Mode, timeOrZero{&current.RetainUntil}, &now)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrObjectNotFound.Wrap(Error.New("object version %d", opts.Version))
+			}
+			return Error.New("unable to query object retention: %w", err)
+		}
+
+		if current.Active(now) {
+			shortened := opts.Retention.RetainUntil.Before(current.RetainUntil)
+			switch {
+			case current.Mode == ComplianceMode && (opts.Retention.Mode != ComplianceMode || shortened):
+				return ErrObjectLock.New("compliance mode retention can only be extended")
+			case current.Mode == GovernanceMode && (opts.Retention.Mode == NoRetention || shortened) && !opts.BypassGovernance:
+				return ErrObjectLock.New("governance mode retention can only be shortened when bypassing governance")
+			}
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			UPDATE objects SET
+				retention_mode = $5,
+				retain_until   = $6
+			WHERE
+				project_id   = $1 AND
+				bucket_name  = $2 AND
+				object_key   = $3 AND
+				version      = $4
+		`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version,
+			opts.Retention.Mode, timeOrZero{&opts.Retention.RetainUntil})
+		if err != nil {
+			return Error.New("unable to update object retention: %w", err)
+		}
+		return nil
+	})
+}
+
+// SetObjectLegalHold contains arguments necessary for placing or removing a legal hold.
+type SetObjectLegalHold struct {
+	ObjectLocation
+	Version   Version
+	LegalHold bool
+}
+
+// Verify verifies set object legal hold fields.
+func (opts *SetObjectLegalHold) Verify() error {
+	if err := opts.ObjectLocation.Verify(); err != nil {
+		return err
+	}
+	if opts.Version <= 0 {
+		return ErrInvalidRequest.New("Version invalid: %v", opts.Version)
+	}
+	return nil
+}
+
+// SetObjectLegalHold places or removes the legal hold of a committed object version.
+func (db *DB) SetObjectLegalHold(ctx context.Context, opts SetObjectLegalHold) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return err
+	}
+
+	result, err := db.db.ExecContext(ctx, `
+		UPDATE objects SET
+			legal_hold = $5
+		WHERE
+			project_id   = $1 AND
+			bucket_name  = $2 AND
+			object_key   = $3 AND
+			version      = $4 AND
+			status       IN `+statusesCommitted+`
+	`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version, opts.LegalHold)
+	if err != nil {
+		return Error.New("unable to update object legal hold: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return Error.New("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return ErrObjectNotFound.Wrap(Error.New("object version %d", opts.Version))
+	}
+	return nil
+}
+
+// timeOrZero stores a zero time as NULL.
+type timeOrZero struct{ *time.Time }
+
+// Value implements sql/driver.Valuer interface.
+func (t timeOrZero) Value() (driver.Value, error) {
+	if t.Time == nil || t.Time.IsZero() {
+		return nil, nil
+	}
+	return *t.Time, nil
+}
+
+// Scan implements sql.Scanner interface.
+func (t timeOrZero) Scan(value interface{}) error {
+	switch value := value.(type) {
+	case nil:
+		*t.Time = time.Time{}
+		return nil
+	case time.Time:
+		*t.Time = value
+		return nil
+	default:
+		return Error.New("unable to scan %T into time", value)
+	}
+}
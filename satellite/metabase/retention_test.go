@@ -0,0 +1,242 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestObjectLock(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		now := time.Now()
+
+		createRetained := func(obj metabase.ObjectStream, retention metabase.Retention, legalHold bool) metabase.Object {
+			object, _ := metabasetest.CreateTestObject{
+				CommitObject: &metabase.CommitObject{
+					ObjectStream: obj,
+					Versioned:    true,
+					Retention:    retention,
+					LegalHold:    legalHold,
+				},
+			}.Run(ctx, t, db, obj, 1)
+			return object
+		}
+
+		t.Run("verify", func(t *testing.T) {
+			require.NoError(t, metabase.Retention{}.Verify())
+			require.Error(t, metabase.Retention{RetainUntil: now}.Verify())
+			require.Error(t, metabase.Retention{Mode: metabase.GovernanceMode}.Verify())
+			require.Error(t, metabase.Retention{Mode: 5, RetainUntil: now}.Verify())
+			require.NoError(t, metabase.Retention{Mode: metabase.ComplianceMode, RetainUntil: now}.Verify())
+		})
+
+		t.Run("compliance", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			obj.Version = 1
+			retention := metabase.Retention{Mode: metabase.ComplianceMode, RetainUntil: now.Add(time.Hour)}
+			object := createRetained(obj, retention, false)
+			require.Equal(t, metabase.ComplianceMode, object.Retention.Mode)
+			require.True(t, object.Locked(now, true))
+
+			_, err := db.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+				ObjectLocation:   obj.Location(),
+				Version:          obj.Version,
+				BypassGovernance: true,
+			})
+			require.True(t, metabase.ErrObjectLock.Has(err))
+
+			_, err = db.DeleteObjectsAllVersions(ctx, metabase.DeleteObjectsAllVersions{
+				Locations: []metabase.ObjectLocation{obj.Location()},
+			})
+			require.True(t, metabase.ErrObjectLock.Has(err))
+
+			_, err = db.DeleteBucketObjects(ctx, metabase.DeleteBucketObjects{
+				Bucket:           obj.Location().Bucket(),
+				BypassGovernance: true,
+			})
+			require.True(t, metabase.ErrObjectLock.Has(err))
+
+			err = db.UpdateObjectMetadata(ctx, metabase.UpdateObjectMetadata{
+				ProjectID:  obj.ProjectID,
+				BucketName: obj.BucketName,
+				ObjectKey:  obj.ObjectKey,
+				StreamID:   obj.StreamID,
+			})
+			require.True(t, metabase.ErrObjectLock.Has(err))
+
+			// compliance retention can only be extended.
+			err = db.SetObjectRetention(ctx, metabase.SetObjectRetention{
+				ObjectLocation:   obj.Location(),
+				Version:          obj.Version,
+				Retention:        metabase.Retention{Mode: metabase.ComplianceMode, RetainUntil: now.Add(time.Minute)},
+				BypassGovernance: true,
+			})
+			require.True(t, metabase.ErrObjectLock.Has(err))
+
+			err = db.SetObjectRetention(ctx, metabase.SetObjectRetention{
+				ObjectLocation: obj.Location(),
+				Version:        obj.Version,
+				Retention:      metabase.Retention{Mode: metabase.ComplianceMode, RetainUntil: now.Add(2 * time.Hour)},
+			})
+			require.NoError(t, err)
+
+			err = db.SetObjectRetention(ctx, metabase.SetObjectRetention{
+				ObjectLocation: obj.Location(),
+				Version:        obj.Version,
+				Retention:      metabase.Retention{Mode: metabase.ComplianceMode, RetainUntil: now.Add(-time.Hour)},
+			})
+			require.True(t, metabase.ErrObjectLock.Has(err))
+		})
+
+		t.Run("governance", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			obj.Version = 1
+			createRetained(obj, metabase.Retention{Mode: metabase.GovernanceMode, RetainUntil: now.Add(time.Hour)}, false)
+
+			_, err := db.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+				ObjectLocation: obj.Location(),
+				Version:        obj.Version,
+			})
+			require.True(t, metabase.ErrObjectLock.Has(err))
+
+			err = db.SetObjectRetention(ctx, metabase.SetObjectRetention{
+				ObjectLocation: obj.Location(),
+				Version:        obj.Version,
+			})
+			require.True(t, metabase.ErrObjectLock.Has(err))
+
+			result, err := db.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+				ObjectLocation:   obj.Location(),
+				Version:          obj.Version,
+				BypassGovernance: true,
+			})
+			require.NoError(t, err)
+			require.Len(t, result.Objects, 1)
+		})
+
+		t.Run("legal hold", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			obj.Version = 1
+			object := createRetained(obj, metabase.Retention{}, true)
+			require.True(t, object.LegalHold)
+
+			_, err := db.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+				ObjectLocation:   obj.Location(),
+				Version:          obj.Version,
+				BypassGovernance: true,
+			})
+			require.True(t, metabase.ErrObjectLock.Has(err))
+
+			require.NoError(t, db.SetObjectLegalHold(ctx, metabase.SetObjectLegalHold{
+				ObjectLocation: obj.Location(),
+				Version:        obj.Version,
+				LegalHold:      false,
+			}))
+
+			_, err = db.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+				ObjectLocation: obj.Location(),
+				Version:        obj.Version,
+			})
+			require.NoError(t, err)
+		})
+
+		t.Run("expired", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			expiresAt := now.Add(-time.Hour)
+			createExpired := func(retention metabase.Retention, legalHold bool) metabase.Object {
+				obj := metabasetest.RandObjectStream()
+				obj.Version = 1
+				object, _ := metabasetest.CreateTestObject{
+					BeginObjectExactVersion: &metabase.BeginObjectExactVersion{
+						ObjectStream: obj,
+						Encryption:   metabasetest.DefaultEncryption,
+						ExpiresAt:    &expiresAt,
+					},
+					CommitObject: &metabase.CommitObject{
+						ObjectStream: obj,
+						Versioned:    true,
+						Retention:    retention,
+						LegalHold:    legalHold,
+					},
+				}.Run(ctx, t, db, obj, 1)
+				return object
+			}
+
+			retained := createExpired(metabase.Retention{Mode: metabase.GovernanceMode, RetainUntil: now.Add(time.Hour)}, false)
+			held := createExpired(metabase.Retention{}, true)
+			createExpired(metabase.Retention{}, false)
+
+			require.NoError(t, db.DeleteExpiredObjects(ctx, metabase.DeleteExpiredObjects{
+				ExpiredBefore: now,
+			}))
+
+			objects, err := db.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			require.Len(t, objects, 2)
+			require.ElementsMatch(t, []metabase.ObjectStream{retained.ObjectStream, held.ObjectStream},
+				[]metabase.ObjectStream{objects[0].ObjectStream, objects[1].ObjectStream})
+
+			segments, err := db.TestingAllSegments(ctx)
+			require.NoError(t, err)
+			require.Len(t, segments, 2)
+
+			require.NoError(t, db.SetObjectLegalHold(ctx, metabase.SetObjectLegalHold{
+				ObjectLocation: held.Location(),
+				Version:        held.Version,
+			}))
+			_, err = db.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+				ObjectLocation:   retained.Location(),
+				Version:          retained.Version,
+				BypassGovernance: true,
+			})
+			require.NoError(t, err)
+		})
+
+		t.Run("unversioned commit", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			obj.Version = 1
+			object, _ := metabasetest.CreateTestObject{
+				CommitObject: &metabase.CommitObject{
+					ObjectStream: obj,
+					LegalHold:    true,
+				},
+			}.Run(ctx, t, db, obj, 1)
+			require.True(t, object.LegalHold)
+
+			// committing a new unversioned object would replace the locked one.
+			obj.StreamID[0]++
+			obj.Version = 2
+			metabasetest.BeginObjectExactVersion{
+				Opts: metabase.BeginObjectExactVersion{
+					ObjectStream: obj,
+					Encryption:   metabasetest.DefaultEncryption,
+				},
+				Version: obj.Version,
+			}.Check(ctx, t, db)
+			metabasetest.CommitObject{
+				Opts: metabase.CommitObject{
+					ObjectStream: obj,
+				},
+				ErrClass: &metabase.ErrObjectLock,
+				ErrText:  "unable to replace locked object",
+			}.Check(ctx, t, db)
+		})
+	})
+}
@@ -0,0 +1,345 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestVersioning(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		createVersion := func(obj metabase.ObjectStream, versioned bool) metabase.Object {
+			object, _ := metabasetest.CreateTestObject{
+				CommitObject: &metabase.CommitObject{
+					ObjectStream: obj,
+					Versioned:    versioned,
+				},
+			}.Run(ctx, t, db, obj, 1)
+			return object
+		}
+
+		listVersions := func(obj metabase.ObjectStream) []metabase.ObjectEntry {
+			result, err := db.ListObjectVersions(ctx, metabase.ListObjectVersions{
+				ProjectID:  obj.ProjectID,
+				BucketName: obj.BucketName,
+				Recursive:  true,
+			})
+			require.NoError(t, err)
+			return result.Objects
+		}
+
+		listLatest := func(obj metabase.ObjectStream) (entries []metabase.ObjectEntry) {
+			err := db.IterateObjectsLatestVersion(ctx, metabase.IterateObjectsWithStatus{
+				ProjectID:  obj.ProjectID,
+				BucketName: obj.BucketName,
+				Recursive:  true,
+				Status:     metabase.Committed,
+			}, func(ctx context.Context, it metabase.ObjectsIterator) error {
+				var entry metabase.ObjectEntry
+				for it.Next(ctx, &entry) {
+					entries = append(entries, entry)
+				}
+				return nil
+			})
+			require.NoError(t, err)
+			return entries
+		}
+
+		listCommitted := func(obj metabase.ObjectStream) (entries []metabase.ObjectEntry) {
+			err := db.IterateObjectsAllVersionsWithStatus(ctx, metabase.IterateObjectsWithStatus{
+				ProjectID:  obj.ProjectID,
+				BucketName: obj.BucketName,
+				Recursive:  true,
+				Status:     metabase.Committed,
+			}, func(ctx context.Context, it metabase.ObjectsIterator) error {
+				var entry metabase.ObjectEntry
+				for it.Next(ctx, &entry) {
+					entries = append(entries, entry)
+				}
+				return nil
+			})
+			require.NoError(t, err)
+			return entries
+		}
+
+		listObjects := func(obj metabase.ObjectStream, recursive bool) []metabase.ObjectEntry {
+			result, err := db.ListObjects(ctx, metabase.ListObjects{
+				ProjectID:  obj.ProjectID,
+				BucketName: obj.BucketName,
+				Recursive:  recursive,
+				Status:     metabase.Committed,
+			})
+			require.NoError(t, err)
+			return result.Objects
+		}
+
+		t.Run("keep versions", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			obj.Version = 1
+			first := createVersion(obj, true)
+			require.Equal(t, metabase.CommittedVersioned, first.Status)
+
+			obj.StreamID[0]++
+			obj.Version = 2
+			second := createVersion(obj, true)
+
+			versions := listVersions(obj)
+			require.Len(t, versions, 2)
+			require.Equal(t, first.Version, versions[0].Version)
+			require.Equal(t, second.Version, versions[1].Version)
+
+			latest, err := db.GetObjectLastCommitted(ctx, metabase.GetObjectLastCommitted{
+				ObjectLocation: obj.Location(),
+			})
+			require.NoError(t, err)
+			require.Equal(t, second.StreamID, latest.StreamID)
+
+			older, err := db.GetObjectExactVersion(ctx, metabase.GetObjectExactVersion{
+				ObjectLocation: obj.Location(),
+				Version:        first.Version,
+			})
+			require.NoError(t, err)
+			require.Equal(t, first.StreamID, older.StreamID)
+
+			entries := listLatest(obj)
+			require.Len(t, entries, 1)
+			require.Equal(t, second.Version, entries[0].Version)
+
+			for _, recursive := range []bool{false, true} {
+				entries = listObjects(obj, recursive)
+				require.Len(t, entries, 1)
+				require.Equal(t, second.Version, entries[0].Version)
+			}
+
+			// iterating all versions returns every committed version.
+			entries = listCommitted(obj)
+			require.Len(t, entries, 2)
+			require.Equal(t, first.Version, entries[0].Version)
+			require.Equal(t, second.Version, entries[1].Version)
+		})
+
+		t.Run("unversioned replaces only unversioned", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			obj.Version = 1
+			createVersion(obj, false)
+
+			obj.StreamID[0]++
+			obj.Version = 2
+			versioned := createVersion(obj, true)
+
+			obj.StreamID[0]++
+			obj.Version = 3
+			createVersion(obj, false)
+
+			obj.StreamID[0]++
+			obj.Version = 4
+			unversioned := createVersion(obj, false)
+			require.Equal(t, metabase.Committed, unversioned.Status)
+
+			versions := listVersions(obj)
+			require.Len(t, versions, 2)
+			require.Equal(t, versioned.StreamID, versions[0].StreamID)
+			require.Equal(t, unversioned.StreamID, versions[1].StreamID)
+		})
+
+		t.Run("delete marker", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			obj.Version = 1
+			object := createVersion(obj, true)
+
+			result, err := db.DeleteObjectLastCommitted(ctx, metabase.DeleteObjectLastCommitted{
+				ObjectLocation: obj.Location(),
+				Versioned:      true,
+			})
+			require.NoError(t, err)
+			require.Empty(t, result.Objects)
+			require.Len(t, result.Markers, 1)
+			marker := result.Markers[0]
+			require.Equal(t, metabase.DeleteMarkerVersioned, marker.Status)
+			require.Equal(t, object.Version+1, marker.Version)
+
+			_, err = db.GetObjectLastCommitted(ctx, metabase.GetObjectLastCommitted{
+				ObjectLocation: obj.Location(),
+			})
+			require.True(t, metabase.ErrObjectNotFound.Has(err))
+			require.Empty(t, listLatest(obj))
+			require.Empty(t, listObjects(obj, true))
+			require.Len(t, listCommitted(obj), 1)
+
+			versions := listVersions(obj)
+			require.Len(t, versions, 2)
+			require.Equal(t, metabase.DeleteMarkerVersioned, versions[1].Status)
+
+			// removing the delete marker makes the object visible again.
+			_, err = db.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+				ObjectLocation: obj.Location(),
+				Version:        marker.Version,
+			})
+			require.NoError(t, err)
+
+			latest, err := db.GetObjectLastCommitted(ctx, metabase.GetObjectLastCommitted{
+				ObjectLocation: obj.Location(),
+			})
+			require.NoError(t, err)
+			require.Equal(t, object.StreamID, latest.StreamID)
+		})
+
+		t.Run("suspended delete marker", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			obj.Version = 1
+			versioned := createVersion(obj, true)
+
+			obj.StreamID[0]++
+			obj.Version = 2
+			unversioned := createVersion(obj, false)
+
+			result, err := db.DeleteObjectLastCommitted(ctx, metabase.DeleteObjectLastCommitted{
+				ObjectLocation: obj.Location(),
+				Suspended:      true,
+			})
+			require.NoError(t, err)
+			require.Len(t, result.Objects, 1)
+			require.Equal(t, unversioned.StreamID, result.Objects[0].StreamID)
+			require.Len(t, result.Markers, 1)
+			require.Equal(t, metabase.DeleteMarkerUnversioned, result.Markers[0].Status)
+
+			versions := listVersions(obj)
+			require.Len(t, versions, 2)
+			require.Equal(t, versioned.StreamID, versions[0].StreamID)
+			require.Equal(t, metabase.DeleteMarkerUnversioned, versions[1].Status)
+
+			// the next unversioned commit replaces the delete marker.
+			obj.StreamID[0]++
+			obj.Version = 4
+			createVersion(obj, false)
+
+			versions = listVersions(obj)
+			require.Len(t, versions, 2)
+			require.Equal(t, metabase.Committed, versions[1].Status)
+		})
+
+		t.Run("versioned copy", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			source := metabasetest.RandObjectStream()
+			sourceObject := createVersion(source, false)
+
+			destination := metabasetest.RandObjectStream()
+			destination.ProjectID = source.ProjectID
+			destination.Version = 1
+			existing := createVersion(destination, true)
+
+			copied, err := db.FinishCopyObject(ctx, metabase.FinishCopyObject{
+				ObjectStream:          sourceObject.ObjectStream,
+				NewBucket:             destination.BucketName,
+				NewEncryptedObjectKey: destination.ObjectKey,
+				NewStreamID:           testrand.UUID(),
+				NewSegmentKeys:        []metabase.EncryptedKeyAndNonce{metabasetest.RandEncryptedKeyAndNonce(0)},
+				NewVersioned:          true,
+			})
+			require.NoError(t, err)
+			require.Equal(t, metabase.CommittedVersioned, copied.Status)
+			require.Equal(t, existing.Version+1, copied.Version)
+
+			versions := listVersions(destination)
+			require.Len(t, versions, 2)
+			require.Equal(t, existing.StreamID, versions[0].StreamID)
+			require.Equal(t, copied.StreamID, versions[1].StreamID)
+			require.Equal(t, metabase.CommittedVersioned, versions[1].Status)
+		})
+
+		t.Run("versioned move", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			source := metabasetest.RandObjectStream()
+			source.Version = 1
+			sourceObject := createVersion(source, false)
+
+			destination := metabasetest.RandObjectStream()
+			destination.ProjectID = source.ProjectID
+			destination.Version = 5
+			existing := createVersion(destination, true)
+
+			finishMove := metabase.FinishMoveObject{
+				ObjectStream:          sourceObject.ObjectStream,
+				NewBucket:             destination.BucketName,
+				NewEncryptedObjectKey: []byte(destination.ObjectKey),
+				NewSegmentKeys:        []metabase.EncryptedKeyAndNonce{metabasetest.RandEncryptedKeyAndNonce(0)},
+			}
+
+			// an unversioned move doesn't replace the existing versions.
+			err := db.FinishMoveObject(ctx, finishMove)
+			require.NoError(t, err)
+
+			versions := listVersions(destination)
+			require.Len(t, versions, 2)
+			require.Equal(t, existing.StreamID, versions[0].StreamID)
+			require.Equal(t, sourceObject.StreamID, versions[1].StreamID)
+			require.Equal(t, metabase.Committed, versions[1].Status)
+			require.Equal(t, existing.Version+1, versions[1].Version)
+
+			// but it doesn't overwrite an unversioned object.
+			source.StreamID = testrand.UUID()
+			sourceObject = createVersion(source, false)
+			finishMove.ObjectStream = sourceObject.ObjectStream
+			err = db.FinishMoveObject(ctx, finishMove)
+			require.True(t, metabase.ErrObjectAlreadyExists.Has(err))
+
+			finishMove.NewVersioned = true
+			err = db.FinishMoveObject(ctx, finishMove)
+			require.NoError(t, err)
+
+			versions = listVersions(destination)
+			require.Len(t, versions, 3)
+			require.Equal(t, sourceObject.StreamID, versions[2].StreamID)
+			require.Equal(t, metabase.CommittedVersioned, versions[2].Status)
+			require.Equal(t, existing.Version+2, versions[2].Version)
+		})
+	})
+
+	metabasetest.RunWithConfig(t, noServerSideCopyConfig, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("delete all versions", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			obj.Version = 1
+			metabasetest.CreateTestObject{
+				CommitObject: &metabase.CommitObject{
+					ObjectStream: obj,
+					Versioned:    true,
+				},
+			}.Run(ctx, t, db, obj, 1)
+
+			_, err := db.DeleteObjectLastCommitted(ctx, metabase.DeleteObjectLastCommitted{
+				ObjectLocation: obj.Location(),
+				Versioned:      true,
+			})
+			require.NoError(t, err)
+
+			result, err := db.DeleteObjectsAllVersions(ctx, metabase.DeleteObjectsAllVersions{
+				Locations: []metabase.ObjectLocation{obj.Location()},
+			})
+			require.NoError(t, err)
+			require.Len(t, result.Objects, 2)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+	})
+}
@@ -0,0 +1,164 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketlifecycle
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	// Error defines the bucketlifecycle chore errors class.
+	Error = errs.Class("bucket lifecycle")
+	mon   = monkit.Package()
+)
+
+// Config contains configurable values for the bucket lifecycle chore.
+type Config struct {
+	Interval           time.Duration `help:"the time between each attempt to apply the bucket lifecycle rules" releaseDefault:"24h" devDefault:"10s" testDefault:"$TESTINTERVAL"`
+	Enabled            bool          `help:"set if bucket lifecycle rules are applied or not" releaseDefault:"false" devDefault:"true"`
+	BucketsBatchSize   int           `help:"how many buckets with lifecycle rules to query in a batch" default:"100"`
+	ListLimit          int           `help:"how many objects to delete in a batch" default:"100"`
+	AsOfSystemInterval time.Duration `help:"as of system interval" releaseDefault:"-5m" devDefault:"-1us" testDefault:"-1us" hidden:"true"`
+}
+
+// Chore implements the bucket lifecycle chore.
+//
+// architecture: Chore
+type Chore struct {
+	log      *zap.Logger
+	config   Config
+	buckets  buckets.DB
+	metabase *metabase.DB
+	// bucketEvents is optional.
+	bucketEvents metabase.BucketEventsResolver
+
+	nowFn func() time.Time
+	Loop  *sync2.Cycle
+}
+
+// NewChore creates a new instance of the bucket lifecycle chore.
+func NewChore(log *zap.Logger, config Config, buckets buckets.DB, metabase *metabase.DB, bucketEvents metabase.BucketEventsResolver) *Chore {
+	return &Chore{
+		log:          log,
+		config:       config,
+		buckets:      buckets,
+		metabase:     metabase,
+		bucketEvents: bucketEvents,
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the bucket lifecycle loop service.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.config.Enabled {
+		return nil
+	}
+
+	return chore.Loop.Run(ctx, chore.applyLifecycles)
+}
+
+// Close stops the bucket lifecycle chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// SetNow allows tests to have the server act as if the current time is whatever they want.
+func (chore *Chore) SetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
+
+func (chore *Chore) applyLifecycles(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	chore.log.Debug("applying bucket lifecycle rules")
+
+	now := chore.nowFn()
+
+	var after metabase.BucketLocation
+	for {
+		more, err := chore.buckets.IterateBucketLifecycles(ctx, after, chore.config.BucketsBatchSize, func(lifecycles []buckets.BucketLifecycle) error {
+			for _, lifecycle := range lifecycles {
+				chore.applyLifecycle(ctx, now, lifecycle)
+				after = lifecycle.Bucket
+			}
+			return nil
+		})
+		if err != nil {
+			// TODO log error instead of crashing core until we will be sure
+			// that lifecycle rules are applied reliably.
+			chore.log.Error("listing bucket lifecycles failed", zap.Error(err))
+			return nil
+		}
+		if !more {
+			return nil
+		}
+	}
+}
+
+// applyLifecycle deletes the objects matching the rules of a single bucket.
+// Failures are logged so that a single bucket doesn't prevent the others
+// from being processed.
+func (chore *Chore) applyLifecycle(ctx context.Context, now time.Time, lifecycle buckets.BucketLifecycle) {
+	var outbox metabase.BucketEvents
+	if chore.bucketEvents != nil {
+		var err error
+		outbox, err = chore.bucketEvents.ResolveBucketEvents(ctx, []metabase.BucketLocation{lifecycle.Bucket})
+		if err != nil {
+			chore.log.Error("resolving bucket events failed",
+				zap.Stringer("Project", lifecycle.Bucket.ProjectID),
+				zap.String("Bucket", lifecycle.Bucket.BucketName),
+				zap.Error(err))
+			return
+		}
+	}
+
+	for _, rule := range lifecycle.Lifecycle.Rules {
+		actions := []struct {
+			action metabase.LifecycleAction
+			days   int
+		}{
+			{metabase.ExpireObjects, rule.ExpirationDays},
+			{metabase.AbortIncompleteUploads, rule.AbortIncompleteUploadDays},
+			{metabase.ExpireNoncurrentVersions, rule.NoncurrentVersionExpirationDays},
+		}
+
+		for _, action := range actions {
+			if action.days <= 0 {
+				continue
+			}
+
+			err := chore.metabase.DeleteLifecycleObjects(ctx, metabase.DeleteLifecycleObjects{
+				Bucket:             lifecycle.Bucket,
+				Prefix:             metabase.ObjectKey(rule.Prefix),
+				Action:             action.action,
+				Before:             now.AddDate(0, 0, -action.days),
+				Versioned:          lifecycle.Versioning == buckets.VersioningEnabled,
+				Suspended:          lifecycle.Versioning == buckets.VersioningSuspended,
+				AsOfSystemInterval: chore.config.AsOfSystemInterval,
+				BatchSize:          chore.config.ListLimit,
+				BucketEvents:       outbox,
+			})
+			if err != nil {
+				chore.log.Error("applying bucket lifecycle rule failed",
+					zap.Stringer("Project", lifecycle.Bucket.ProjectID),
+					zap.String("Bucket", lifecycle.Bucket.BucketName),
+					zap.String("Rule", rule.ID),
+					zap.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketlifecycle_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/buckets"
+)
+
+func TestBucketLifecycle(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.BucketLifecycle.Enabled = true
+				config.BucketLifecycle.Interval = 500 * time.Millisecond
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		projectID := upl.Projects[0].ID
+		chore := sat.Core.BucketLifecycle.Chore
+
+		chore.Loop.Pause()
+
+		for _, bucket := range []string{"expiring", "kept"} {
+			err := upl.Upload(ctx, sat, bucket, "inline", testrand.Bytes(1*memory.KiB))
+			require.NoError(t, err)
+			err = upl.Upload(ctx, sat, bucket, "remote", testrand.Bytes(8*memory.KiB))
+			require.NoError(t, err)
+		}
+
+		err := sat.API.Buckets.Service.SetBucketLifecycle(ctx, []byte("expiring"), projectID, buckets.Lifecycle{
+			Rules: []buckets.LifecycleRule{{ID: "expire", ExpirationDays: 1}},
+		})
+		require.NoError(t, err)
+
+		lifecycle, err := sat.API.Buckets.Service.GetBucketLifecycle(ctx, []byte("expiring"), projectID)
+		require.NoError(t, err)
+		require.Len(t, lifecycle.Rules, 1)
+
+		// rules without any action are rejected.
+		err = sat.API.Buckets.Service.SetBucketLifecycle(ctx, []byte("kept"), projectID, buckets.Lifecycle{
+			Rules: []buckets.LifecycleRule{{ID: "empty"}},
+		})
+		require.True(t, buckets.ErrInvalidLifecycle.Has(err))
+
+		// objects are kept until they are old enough.
+		chore.Loop.TriggerWait()
+
+		objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 4)
+
+		chore.SetNow(func() time.Time {
+			return time.Now().Add(48 * time.Hour)
+		})
+		chore.Loop.TriggerWait()
+
+		objects, err = sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+		for _, object := range objects {
+			require.Equal(t, "kept", object.BucketName)
+		}
+	})
+}
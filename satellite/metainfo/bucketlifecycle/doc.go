@@ -0,0 +1,11 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+/*
+Package bucketlifecycle contains the chore which applies bucket lifecycle rules.
+
+The chore iterates over the buckets that have a lifecycle configuration and,
+for every rule, deletes the matching expired objects, incomplete uploads and
+noncurrent versions from the metabase in batches.
+*/
+package bucketlifecycle
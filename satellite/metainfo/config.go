@@ -108,6 +108,15 @@ type RateLimiterConfig struct {
 	Rate            float64       `help:"request rate per project per second." releaseDefault:"100" devDefault:"100" testDefault:"1000"`
 	CacheCapacity   int           `help:"number of projects to cache." releaseDefault:"10000" devDefault:"10" testDefault:"100"`
 	CacheExpiration time.Duration `help:"how long to cache the projects limiter." releaseDefault:"10m" devDefault:"10s"`
+
+	ListRate     float64     `help:"listing request rate per bucket per second, zero means only the project rate applies." default:"0"`
+	UploadRate   float64     `help:"upload begin request rate per bucket per second, zero means only the project rate applies." default:"0"`
+	DownloadRate float64     `help:"download request rate per bucket per second, zero means only the project rate applies." default:"0"`
+	DeleteRate   float64     `help:"delete request rate per bucket per second, zero means only the project rate applies." default:"0"`
+	EgressRate   memory.Size `help:"egress bandwidth per project per second, zero means unlimited." default:"0B"`
+	Backend      string      `help:"where the rate limiter state is kept, empty for in memory or a redis:// url to share it between API instances." default:""`
+
+	EgressMaxDelay time.Duration `help:"how long a download is delayed at most by the egress rate, longer delays are rejected." default:"10s"`
 }
 
 // UploadLimiterConfig is a configuration struct for endpoint upload limiting.
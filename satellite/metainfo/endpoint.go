@@ -13,7 +13,6 @@ import (
 	"github.com/spacemonkeygo/monkit/v3"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
-	"golang.org/x/time/rate"
 
 	"storj.io/common/encryption"
 	"storj.io/common/lrucache"
@@ -24,6 +23,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/attribution"
+	"storj.io/storj/satellite/bucketevents"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/internalpb"
@@ -65,6 +65,7 @@ type Endpoint struct {
 
 	log                    *zap.Logger
 	buckets                *buckets.Service
+	bucketEvents           *bucketevents.Service
 	metabase               *metabase.DB
 	orders                 *orders.Service
 	overlay                *overlay.Service
@@ -75,7 +76,8 @@ type Endpoint struct {
 	projects               console.Projects
 	apiKeys                APIKeys
 	satellite              signing.Signer
-	limiterCache           *lrucache.ExpiringLRUOf[*rate.Limiter]
+	rateLimiter            rateLimiter
+	bucketRateLimits       *lrucache.ExpiringLRUOf[buckets.RateLimits]
 	singleObjectLimitCache *lrucache.ExpiringLRUOf[struct{}]
 	encInlineSegmentSize   int64 // max inline segment size + encryption overhead
 	revocations            revocation.DB
@@ -88,7 +90,7 @@ type Endpoint struct {
 func NewEndpoint(log *zap.Logger, buckets *buckets.Service, metabaseDB *metabase.DB,
 	orders *orders.Service, cache *overlay.Service, attributions attribution.DB, peerIdentities overlay.PeerIdentities,
 	apiKeys APIKeys, projectUsage *accounting.Service, projectLimits *accounting.ProjectLimitCache, projects console.Projects,
-	satellite signing.Signer, revocations revocation.DB, config Config) (*Endpoint, error) {
+	satellite signing.Signer, revocations revocation.DB, bucketEvents *bucketevents.Service, config Config) (*Endpoint, error) {
 	// TODO do something with too many params
 
 	encInlineSegmentSize, err := encryption.CalcEncryptedSize(config.MaxInlineSegmentSize.Int64(), storj.EncryptionParameters{
@@ -99,6 +101,11 @@ func NewEndpoint(log *zap.Logger, buckets *buckets.Service, metabaseDB *metabase
 		return nil, err
 	}
 
+	rateLimiter, err := openRateLimiter(log, config.RateLimiter)
+	if err != nil {
+		return nil, err
+	}
+
 	defaultRSScheme := &pb.RedundancyScheme{
 		Type:             pb.RedundancyScheme_RS,
 		MinReq:           int32(config.RS.Min),
@@ -111,6 +118,7 @@ func NewEndpoint(log *zap.Logger, buckets *buckets.Service, metabaseDB *metabase
 	return &Endpoint{
 		log:                 log,
 		buckets:             buckets,
+		bucketEvents:        bucketEvents,
 		metabase:            metabaseDB,
 		orders:              orders,
 		overlay:             cache,
@@ -121,11 +129,8 @@ func NewEndpoint(log *zap.Logger, buckets *buckets.Service, metabaseDB *metabase
 		projectLimits:       projectLimits,
 		projects:            projects,
 		satellite:           satellite,
-		limiterCache: lrucache.NewOf[*rate.Limiter](lrucache.Options{
-			Capacity:   config.RateLimiter.CacheCapacity,
-			Expiration: config.RateLimiter.CacheExpiration,
-			Name:       "metainfo-ratelimit",
-		}),
+		rateLimiter:         rateLimiter,
+		bucketRateLimits:    newBucketRateLimitsCache(config.RateLimiter),
 		singleObjectLimitCache: lrucache.NewOf[struct{}](lrucache.Options{
 			Expiration: config.UploadLimiter.SingleObjectLimit,
 			Capacity:   config.UploadLimiter.CacheCapacity,
@@ -139,7 +144,9 @@ func NewEndpoint(log *zap.Logger, buckets *buckets.Service, metabaseDB *metabase
 }
 
 // Close closes resources.
-func (endpoint *Endpoint) Close() error { return nil }
+func (endpoint *Endpoint) Close() error {
+	return endpoint.rateLimiter.Close()
+}
 
 // ProjectInfo returns allowed ProjectInfo for the provided API key.
 func (endpoint *Endpoint) ProjectInfo(ctx context.Context, req *pb.ProjectInfoRequest) (_ *pb.ProjectInfoResponse, err error) {
@@ -317,12 +324,33 @@ func (endpoint *Endpoint) convertMetabaseErr(err error) error {
 		return rpcstatus.Error(rpcstatus.NotFound, err.Error())
 	case metabase.ErrPermissionDenied.Has(err):
 		return rpcstatus.Error(rpcstatus.PermissionDenied, err.Error())
+	case metabase.ErrObjectLock.Has(err):
+		return rpcstatus.Error(rpcstatus.PermissionDenied, err.Error())
 	default:
 		endpoint.log.Error("internal", zap.Error(err))
 		return rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 }
 
+// bucketEventsOutbox returns the outbox of the bucket events of the buckets.
+// The webhooks of the buckets are looked up before the metabase transaction.
+// When they can't be looked up, the change is made without bucket events, so
+// that an unavailable satellite database doesn't fail uploads and deletes.
+func (endpoint *Endpoint) bucketEventsOutbox(ctx context.Context, bucketLocations ...metabase.BucketLocation) metabase.BucketEvents {
+	resolver := endpoint.bucketEvents.Resolver()
+	if resolver == nil {
+		return nil
+	}
+
+	outbox, err := resolver.ResolveBucketEvents(ctx, bucketLocations)
+	if err != nil {
+		mon.Event("metainfo_bucket_events_unavailable")
+		endpoint.log.Warn("unable to look up bucket events, continuing without them", zap.Error(err))
+		return nil
+	}
+	return outbox
+}
+
 func (endpoint *Endpoint) usageTracking(keyInfo *console.APIKeyInfo, header *pb.RequestHeader, name string, tags ...eventkit.Tag) {
 	evs.Event("usage", append([]eventkit.Tag{
 		eventkit.Bytes("project-public-id", keyInfo.ProjectPublicID[:]),
@@ -16,6 +16,7 @@ import (
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metabase"
 )
 
@@ -263,6 +264,9 @@ func (endpoint *Endpoint) isBucketEmpty(ctx context.Context, projectID uuid.UUID
 func (endpoint *Endpoint) deleteBucketNotEmpty(ctx context.Context, projectID uuid.UUID, bucketName []byte) ([]byte, int64, error) {
 	deletedCount, err := endpoint.deleteBucketObjects(ctx, projectID, bucketName)
 	if err != nil {
+		if metabase.ErrObjectLock.Has(err) {
+			return nil, deletedCount, rpcstatus.Error(rpcstatus.FailedPrecondition, "cannot delete the bucket because it contains locked objects")
+		}
 		endpoint.log.Error("internal", zap.Error(err))
 		return nil, 0, rpcstatus.Error(rpcstatus.Internal, "internal error")
 	}
@@ -288,7 +292,8 @@ func (endpoint *Endpoint) deleteBucketObjects(ctx context.Context, projectID uui
 
 	bucketLocation := metabase.BucketLocation{ProjectID: projectID, BucketName: string(bucketName)}
 	deletedObjects, err := endpoint.metabase.DeleteBucketObjects(ctx, metabase.DeleteBucketObjects{
-		Bucket: bucketLocation,
+		Bucket:       bucketLocation,
+		BucketEvents: endpoint.bucketEventsOutbox(ctx, bucketLocation),
 	})
 
 	return deletedObjects, Error.Wrap(err)
@@ -352,6 +357,269 @@ func (endpoint *Endpoint) CountBuckets(ctx context.Context, projectID uuid.UUID)
 	return count, nil
 }
 
+// GetBucketVersioning returns the versioning state of a bucket.
+func (endpoint *Endpoint) GetBucketVersioning(ctx context.Context, req *internalpb.GetBucketVersioningRequest) (resp *internalpb.GetBucketVersioningResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionRead,
+		Bucket: req.Name,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	endpoint.usageTracking(keyInfo, req.Header, fmt.Sprintf("%T", req))
+
+	versioning, err := endpoint.buckets.GetBucketVersioning(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		if buckets.ErrBucketNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		}
+		endpoint.log.Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	return &internalpb.GetBucketVersioningResponse{
+		Versioning: internalpb.BucketVersioning(versioning),
+	}, nil
+}
+
+// SetBucketVersioning enables or suspends versioning of a bucket.
+func (endpoint *Endpoint) SetBucketVersioning(ctx context.Context, req *internalpb.SetBucketVersioningRequest) (resp *internalpb.SetBucketVersioningResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Name,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	endpoint.usageTracking(keyInfo, req.Header, fmt.Sprintf("%T", req))
+
+	_, err = endpoint.buckets.SetBucketVersioning(ctx, req.Name, keyInfo.ProjectID, buckets.Versioning(req.Versioning))
+	if err != nil {
+		switch {
+		case buckets.ErrBucketNotFound.Has(err):
+			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		case buckets.ErrInvalidVersioning.Has(err), buckets.ErrInvalidRetention.Has(err):
+			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		}
+		endpoint.log.Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	return &internalpb.SetBucketVersioningResponse{}, nil
+}
+
+// SetBucketDefaultRetention changes the retention applied to new objects of a bucket.
+func (endpoint *Endpoint) SetBucketDefaultRetention(ctx context.Context, req *internalpb.SetBucketDefaultRetentionRequest) (resp *internalpb.SetBucketDefaultRetentionResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Name,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	endpoint.usageTracking(keyInfo, req.Header, fmt.Sprintf("%T", req))
+
+	_, err = endpoint.buckets.SetBucketDefaultRetention(ctx, req.Name, keyInfo.ProjectID, buckets.DefaultRetention{
+		Mode: metabase.RetentionMode(req.Mode),
+		Days: int(req.Days),
+	})
+	if err != nil {
+		switch {
+		case buckets.ErrBucketNotFound.Has(err):
+			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		case buckets.ErrInvalidRetention.Has(err):
+			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		}
+		endpoint.log.Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	return &internalpb.SetBucketDefaultRetentionResponse{}, nil
+}
+
+// GetBucketLifecycle returns the lifecycle rules of a bucket.
+func (endpoint *Endpoint) GetBucketLifecycle(ctx context.Context, req *internalpb.GetBucketLifecycleRequest) (resp *internalpb.GetBucketLifecycleResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionRead,
+		Bucket: req.Name,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	endpoint.usageTracking(keyInfo, req.Header, fmt.Sprintf("%T", req))
+
+	lifecycle, err := endpoint.buckets.GetBucketLifecycle(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		if buckets.ErrBucketNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		}
+		endpoint.log.Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	resp = &internalpb.GetBucketLifecycleResponse{}
+	for _, rule := range lifecycle.Rules {
+		resp.Rules = append(resp.Rules, &internalpb.LifecycleRule{
+			Id:                              rule.ID,
+			Prefix:                          rule.Prefix,
+			ExpirationDays:                  int32(rule.ExpirationDays),
+			AbortIncompleteUploadDays:       int32(rule.AbortIncompleteUploadDays),
+			NoncurrentVersionExpirationDays: int32(rule.NoncurrentVersionExpirationDays),
+		})
+	}
+	return resp, nil
+}
+
+// SetBucketLifecycle replaces the lifecycle rules of a bucket.
+func (endpoint *Endpoint) SetBucketLifecycle(ctx context.Context, req *internalpb.SetBucketLifecycleRequest) (resp *internalpb.SetBucketLifecycleResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Name,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	endpoint.usageTracking(keyInfo, req.Header, fmt.Sprintf("%T", req))
+
+	var lifecycle buckets.Lifecycle
+	for _, rule := range req.Rules {
+		lifecycle.Rules = append(lifecycle.Rules, buckets.LifecycleRule{
+			ID:                              rule.Id,
+			Prefix:                          rule.Prefix,
+			ExpirationDays:                  int(rule.ExpirationDays),
+			AbortIncompleteUploadDays:       int(rule.AbortIncompleteUploadDays),
+			NoncurrentVersionExpirationDays: int(rule.NoncurrentVersionExpirationDays),
+		})
+	}
+
+	err = endpoint.buckets.SetBucketLifecycle(ctx, req.Name, keyInfo.ProjectID, lifecycle)
+	if err != nil {
+		switch {
+		case buckets.ErrBucketNotFound.Has(err):
+			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		case buckets.ErrInvalidLifecycle.Has(err):
+			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		}
+		endpoint.log.Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	return &internalpb.SetBucketLifecycleResponse{}, nil
+}
+
+// GetBucketNotifications returns the webhooks notified about the events of a bucket.
+func (endpoint *Endpoint) GetBucketNotifications(ctx context.Context, req *internalpb.GetBucketNotificationsRequest) (resp *internalpb.GetBucketNotificationsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionRead,
+		Bucket: req.Name,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	endpoint.usageTracking(keyInfo, req.Header, fmt.Sprintf("%T", req))
+
+	configuration, err := endpoint.buckets.GetBucketNotifications(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		if buckets.ErrBucketNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		}
+		endpoint.log.Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	resp = &internalpb.GetBucketNotificationsResponse{}
+	for _, webhook := range configuration.Webhooks {
+		events := make([]string, 0, len(webhook.Events))
+		for _, event := range webhook.Events {
+			events = append(events, string(event))
+		}
+		resp.Webhooks = append(resp.Webhooks, &internalpb.Webhook{
+			Id:     webhook.ID,
+			Url:    webhook.URL,
+			Events: events,
+			Prefix: webhook.Prefix,
+			Suffix: webhook.Suffix,
+		})
+	}
+	return resp, nil
+}
+
+// SetBucketNotifications replaces the webhooks notified about the events of a bucket.
+func (endpoint *Endpoint) SetBucketNotifications(ctx context.Context, req *internalpb.SetBucketNotificationsRequest) (resp *internalpb.SetBucketNotificationsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Name,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	endpoint.usageTracking(keyInfo, req.Header, fmt.Sprintf("%T", req))
+
+	var configuration buckets.NotificationConfiguration
+	for _, webhook := range req.Webhooks {
+		events := make([]buckets.EventType, 0, len(webhook.Events))
+		for _, event := range webhook.Events {
+			events = append(events, buckets.EventType(event))
+		}
+		configuration.Webhooks = append(configuration.Webhooks, buckets.Webhook{
+			ID:     webhook.Id,
+			URL:    webhook.Url,
+			Events: events,
+			Prefix: webhook.Prefix,
+			Suffix: webhook.Suffix,
+		})
+	}
+
+	err = endpoint.buckets.SetBucketNotifications(ctx, req.Name, keyInfo.ProjectID, configuration)
+	if err != nil {
+		switch {
+		case buckets.ErrBucketNotFound.Has(err):
+			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		case buckets.ErrInvalidNotification.Has(err):
+			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		}
+		endpoint.log.Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	return &internalpb.SetBucketNotificationsResponse{}, nil
+}
+
 func getAllowedBuckets(ctx context.Context, header *pb.RequestHeader, action macaroon.Action) (_ macaroon.AllowedBuckets, err error) {
 	key, err := getAPIKey(ctx, header)
 	if err != nil {
@@ -22,6 +22,7 @@ import (
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/internalpb"
 	"storj.io/uplink"
 	"storj.io/uplink/private/metaclient"
 )
@@ -297,3 +298,226 @@ func TestBucketCreationWithDefaultPlacement(t *testing.T) {
 
 	})
 }
+
+func TestBucketVersioning(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[satellite.ID()]
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, satellite, "bucket"))
+
+		conn, err := planet.Uplinks[0].Dialer.DialNodeURL(ctx, satellite.NodeURL())
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+
+		client := internalpb.NewDRPCMetainfoExtClient(conn)
+
+		getResp, err := client.GetBucketVersioning(ctx, &internalpb.GetBucketVersioningRequest{
+			Header: header,
+			Name:   []byte("bucket"),
+		})
+		require.NoError(t, err)
+		require.Equal(t, internalpb.BucketVersioning_UNVERSIONED, getResp.Versioning)
+
+		_, err = client.SetBucketVersioning(ctx, &internalpb.SetBucketVersioningRequest{
+			Header:     header,
+			Name:       []byte("bucket"),
+			Versioning: internalpb.BucketVersioning_ENABLED,
+		})
+		require.NoError(t, err)
+
+		for i := 0; i < 2; i++ {
+			require.NoError(t, planet.Uplinks[0].Upload(ctx, satellite, "bucket", "object", testrand.Bytes(memory.KiB)))
+		}
+
+		objects, err := planet.Uplinks[0].ListObjects(ctx, satellite, "bucket")
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+
+		listResp, err := client.ListObjectVersions(ctx, &pb.ObjectListRequest{
+			Header:    header,
+			Bucket:    []byte("bucket"),
+			Recursive: true,
+		})
+		require.NoError(t, err)
+		require.Len(t, listResp.Items, 2)
+		require.Less(t, listResp.Items[0].Version, listResp.Items[1].Version)
+
+		// a versioned bucket can only be suspended.
+		_, err = client.SetBucketVersioning(ctx, &internalpb.SetBucketVersioningRequest{
+			Header:     header,
+			Name:       []byte("bucket"),
+			Versioning: internalpb.BucketVersioning_UNVERSIONED,
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+
+		_, err = client.GetBucketVersioning(ctx, &internalpb.GetBucketVersioningRequest{
+			Header: header,
+			Name:   []byte("missing"),
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+	})
+}
+
+func TestBucketLifecycle(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[satellite.ID()]
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, satellite, "bucket"))
+
+		conn, err := planet.Uplinks[0].Dialer.DialNodeURL(ctx, satellite.NodeURL())
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+
+		client := internalpb.NewDRPCMetainfoExtClient(conn)
+
+		getResp, err := client.GetBucketLifecycle(ctx, &internalpb.GetBucketLifecycleRequest{
+			Header: header,
+			Name:   []byte("bucket"),
+		})
+		require.NoError(t, err)
+		require.Empty(t, getResp.Rules)
+
+		rule := &internalpb.LifecycleRule{
+			Id:                        "logs",
+			Prefix:                    []byte("logs/"),
+			ExpirationDays:            30,
+			AbortIncompleteUploadDays: 7,
+		}
+		_, err = client.SetBucketLifecycle(ctx, &internalpb.SetBucketLifecycleRequest{
+			Header: header,
+			Name:   []byte("bucket"),
+			Rules:  []*internalpb.LifecycleRule{rule},
+		})
+		require.NoError(t, err)
+
+		getResp, err = client.GetBucketLifecycle(ctx, &internalpb.GetBucketLifecycleRequest{
+			Header: header,
+			Name:   []byte("bucket"),
+		})
+		require.NoError(t, err)
+		require.Len(t, getResp.Rules, 1)
+		require.Equal(t, rule.Id, getResp.Rules[0].Id)
+		require.Equal(t, rule.Prefix, getResp.Rules[0].Prefix)
+		require.Equal(t, rule.ExpirationDays, getResp.Rules[0].ExpirationDays)
+		require.Equal(t, rule.AbortIncompleteUploadDays, getResp.Rules[0].AbortIncompleteUploadDays)
+
+		_, err = client.SetBucketLifecycle(ctx, &internalpb.SetBucketLifecycleRequest{
+			Header: header,
+			Name:   []byte("bucket"),
+			Rules:  []*internalpb.LifecycleRule{{Id: "empty"}},
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+
+		_, err = client.SetBucketLifecycle(ctx, &internalpb.SetBucketLifecycleRequest{
+			Header: header,
+			Name:   []byte("missing"),
+			Rules:  []*internalpb.LifecycleRule{rule},
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+
+		// no rules disable the lifecycle.
+		_, err = client.SetBucketLifecycle(ctx, &internalpb.SetBucketLifecycleRequest{
+			Header: header,
+			Name:   []byte("bucket"),
+		})
+		require.NoError(t, err)
+
+		getResp, err = client.GetBucketLifecycle(ctx, &internalpb.GetBucketLifecycleRequest{
+			Header: header,
+			Name:   []byte("bucket"),
+		})
+		require.NoError(t, err)
+		require.Empty(t, getResp.Rules)
+	})
+}
+
+func TestBucketNotifications(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[satellite.ID()]
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, satellite, "bucket"))
+
+		conn, err := planet.Uplinks[0].Dialer.DialNodeURL(ctx, satellite.NodeURL())
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+
+		client := internalpb.NewDRPCMetainfoExtClient(conn)
+
+		getResp, err := client.GetBucketNotifications(ctx, &internalpb.GetBucketNotificationsRequest{
+			Header: header,
+			Name:   []byte("bucket"),
+		})
+		require.NoError(t, err)
+		require.Empty(t, getResp.Webhooks)
+
+		webhook := &internalpb.Webhook{
+			Id:     "uploads",
+			Url:    "https://example.com/hook",
+			Events: []string{"ObjectCreated:*"},
+			Prefix: []byte("logs/"),
+		}
+		_, err = client.SetBucketNotifications(ctx, &internalpb.SetBucketNotificationsRequest{
+			Header:   header,
+			Name:     []byte("bucket"),
+			Webhooks: []*internalpb.Webhook{webhook},
+		})
+		require.NoError(t, err)
+
+		getResp, err = client.GetBucketNotifications(ctx, &internalpb.GetBucketNotificationsRequest{
+			Header: header,
+			Name:   []byte("bucket"),
+		})
+		require.NoError(t, err)
+		require.Len(t, getResp.Webhooks, 1)
+		require.Equal(t, webhook.Id, getResp.Webhooks[0].Id)
+		require.Equal(t, webhook.Url, getResp.Webhooks[0].Url)
+		require.Equal(t, webhook.Events, getResp.Webhooks[0].Events)
+		require.Equal(t, webhook.Prefix, getResp.Webhooks[0].Prefix)
+
+		for _, invalid := range []*internalpb.Webhook{
+			{Url: "https://example.com/hook"},
+			{Url: "https://example.com/hook", Events: []string{"Unknown:*"}},
+			{Url: "http://169.254.169.254/latest/meta-data", Events: []string{"ObjectCreated:*"}},
+		} {
+			_, err = client.SetBucketNotifications(ctx, &internalpb.SetBucketNotificationsRequest{
+				Header:   header,
+				Name:     []byte("bucket"),
+				Webhooks: []*internalpb.Webhook{invalid},
+			})
+			require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument), invalid.Url)
+		}
+
+		_, err = client.SetBucketNotifications(ctx, &internalpb.SetBucketNotificationsRequest{
+			Header:   header,
+			Name:     []byte("missing"),
+			Webhooks: []*internalpb.Webhook{webhook},
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+
+		// no webhooks disable the notifications.
+		_, err = client.SetBucketNotifications(ctx, &internalpb.SetBucketNotificationsRequest{
+			Header: header,
+			Name:   []byte("bucket"),
+		})
+		require.NoError(t, err)
+
+		getResp, err = client.GetBucketNotifications(ctx, &internalpb.GetBucketNotificationsRequest{
+			Header: header,
+			Name:   []byte("bucket"),
+		})
+		require.NoError(t, err)
+		require.Empty(t, getResp.Webhooks)
+	})
+}
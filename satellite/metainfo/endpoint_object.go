@@ -72,6 +72,10 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
+	if err := endpoint.checkOperationRate(ctx, keyInfo.ProjectID, req.Bucket, rateLimitUpload); err != nil {
+		return nil, err
+	}
+
 	objectKeyLength := len(req.EncryptedObjectKey)
 	if objectKeyLength > endpoint.config.MaxEncryptedObjectKeyLength {
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, fmt.Sprintf("key length is too big, got %v, maximum allowed is %v", objectKeyLength, endpoint.config.MaxEncryptedObjectKeyLength))
@@ -87,7 +91,7 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 	}
 
 	// TODO this needs to be optimized to avoid DB call on each request
-	placement, err := endpoint.buckets.GetBucketPlacement(ctx, req.Bucket, keyInfo.ProjectID)
+	bucket, err := endpoint.buckets.GetBucket(ctx, req.Bucket, keyInfo.ProjectID)
 	if err != nil {
 		if buckets.ErrBucketNotFound.Has(err) {
 			return nil, rpcstatus.Errorf(rpcstatus.NotFound, "bucket not found: %s", req.Bucket)
@@ -155,9 +159,15 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 		StreamId:             object.StreamID[:],
 		MultipartObject:      object.FixedSegmentSize <= 0,
 		EncryptionParameters: req.EncryptionParameters,
-		Placement:            int32(placement),
+		Placement:            int32(bucket.Placement),
 
 		UsePendingObjectsTable: endpoint.config.UsePendingObjectsTable,
+
+		// the settings of the bucket are carried to CommitObject, so that
+		// it doesn't need to look up the bucket again.
+		Versioning:           int32(bucket.Versioning),
+		DefaultRetentionMode: int32(bucket.DefaultRetention.Mode),
+		DefaultRetentionDays: int32(bucket.DefaultRetention.Days),
 	})
 	if err != nil {
 		endpoint.log.Error("internal", zap.Error(err))
@@ -244,6 +254,12 @@ func (endpoint *Endpoint) CommitObject(ctx context.Context, req *pb.ObjectCommit
 		encryption.BlockSize = streamMeta.EncryptionBlockSize
 	}
 
+	versioning := buckets.Versioning(streamID.Versioning)
+	defaultRetention := buckets.DefaultRetention{
+		Mode: metabase.RetentionMode(streamID.DefaultRetentionMode),
+		Days: int(streamID.DefaultRetentionDays),
+	}
+
 	request := metabase.CommitObject{
 		ObjectStream: metabase.ObjectStream{
 			ProjectID:  keyInfo.ProjectID,
@@ -257,6 +273,14 @@ func (endpoint *Endpoint) CommitObject(ctx context.Context, req *pb.ObjectCommit
 		DisallowDelete: !allowDelete,
 
 		UsePendingObjectsTable: streamID.UsePendingObjectsTable,
+
+		Versioned: versioning.IsVersioned(),
+		Retention: defaultRetention.Retention(time.Now()),
+
+		BucketEvents: endpoint.bucketEventsOutbox(ctx, metabase.BucketLocation{
+			ProjectID:  keyInfo.ProjectID,
+			BucketName: string(streamID.Bucket),
+		}),
 	}
 	// uplink can send empty metadata with not empty key/nonce
 	// we need to fix it on uplink side but that part will be
@@ -322,13 +346,23 @@ func (endpoint *Endpoint) GetObject(ctx context.Context, req *pb.ObjectGetReques
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
-	mbObject, err := endpoint.metabase.GetObjectLastCommitted(ctx, metabase.GetObjectLastCommitted{
-		ObjectLocation: metabase.ObjectLocation{
-			ProjectID:  keyInfo.ProjectID,
-			BucketName: string(req.Bucket),
-			ObjectKey:  metabase.ObjectKey(req.EncryptedObjectKey),
-		},
-	})
+	location := metabase.ObjectLocation{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(req.Bucket),
+		ObjectKey:  metabase.ObjectKey(req.EncryptedObjectKey),
+	}
+
+	var mbObject metabase.Object
+	if req.Version > 0 {
+		mbObject, err = endpoint.metabase.GetObjectExactVersion(ctx, metabase.GetObjectExactVersion{
+			ObjectLocation: location,
+			Version:        metabase.Version(req.Version),
+		})
+	} else {
+		mbObject, err = endpoint.metabase.GetObjectLastCommitted(ctx, metabase.GetObjectLastCommitted{
+			ObjectLocation: location,
+		})
+	}
 	if err != nil {
 		return nil, endpoint.convertMetabaseErr(err)
 	}
@@ -421,6 +455,10 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
+	if err := endpoint.checkOperationRate(ctx, keyInfo.ProjectID, req.Bucket, rateLimitDownload); err != nil {
+		return nil, err
+	}
+
 	if exceeded, limit, err := endpoint.projectUsage.ExceedsBandwidthUsage(ctx, keyInfo.ProjectID); err != nil {
 		if errs2.IsCanceled(err) {
 			return nil, rpcstatus.Wrap(rpcstatus.Canceled, err)
@@ -494,6 +532,10 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 		segment := segments.Segments[0]
 		downloadSizes := endpoint.calculateDownloadSizes(streamRange, segment, object.Encryption)
 
+		if err := endpoint.checkEgressRate(ctx, keyInfo.ProjectID, req.Bucket, downloadSizes.encryptedSize); err != nil {
+			return nil, err
+		}
+
 		// Update the current bandwidth cache value incrementing the SegmentSize.
 		err = endpoint.projectUsage.UpdateProjectBandwidthUsage(ctx, keyInfo.ProjectID, downloadSizes.encryptedSize)
 		if err != nil {
@@ -814,6 +856,10 @@ func (endpoint *Endpoint) ListObjects(ctx context.Context, req *pb.ObjectListReq
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
+	if err := endpoint.checkOperationRate(ctx, keyInfo.ProjectID, req.Bucket, rateLimitList); err != nil {
+		return nil, err
+	}
+
 	// TODO this needs to be optimized to avoid DB call on each request
 	placement, err := endpoint.buckets.GetBucketPlacement(ctx, req.Bucket, keyInfo.ProjectID)
 	if err != nil {
@@ -898,7 +944,7 @@ func (endpoint *Endpoint) ListObjects(ctx context.Context, req *pb.ObjectListReq
 		}
 		resp.More = result.More
 	} else {
-		err = endpoint.metabase.IterateObjectsAllVersionsWithStatus(ctx,
+		err = endpoint.metabase.IterateObjectsLatestVersion(ctx,
 			metabase.IterateObjectsWithStatus{
 				ProjectID:             keyInfo.ProjectID,
 				BucketName:            string(req.Bucket),
@@ -932,6 +978,102 @@ func (endpoint *Endpoint) ListObjects(ctx context.Context, req *pb.ObjectListReq
 	return resp, nil
 }
 
+// ListObjectVersions lists every committed version and delete marker of the
+// objects, ordered by object key and version. The cursor is exclusive and
+// starts after all versions of the cursor key.
+//
+// The public metainfo protocol doesn't expose it yet, so it's part of the
+// MetainfoExt service.
+func (endpoint *Endpoint) ListObjectVersions(ctx context.Context, req *pb.ObjectListRequest) (resp *pb.ObjectListResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:            macaroon.ActionList,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.EncryptedPrefix,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	endpoint.usageTracking(keyInfo, req.Header, fmt.Sprintf("%T", req))
+
+	err = endpoint.validateBucketNameLength(req.Bucket)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	if err := endpoint.checkOperationRate(ctx, keyInfo.ProjectID, req.Bucket, rateLimitList); err != nil {
+		return nil, err
+	}
+
+	placement, err := endpoint.buckets.GetBucketPlacement(ctx, req.Bucket, keyInfo.ProjectID)
+	if err != nil {
+		if buckets.ErrBucketNotFound.Has(err) {
+			return nil, rpcstatus.Errorf(rpcstatus.NotFound, "bucket not found: %s", req.Bucket)
+		}
+		endpoint.log.Error("unable to check bucket", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	limit := int(req.Limit)
+	if limit < 0 {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "limit is negative")
+	}
+	metabase.ListLimit.Ensure(&limit)
+
+	var prefix metabase.ObjectKey
+	if len(req.EncryptedPrefix) != 0 {
+		prefix = metabase.ObjectKey(req.EncryptedPrefix)
+		if prefix[len(prefix)-1] != metabase.Delimiter {
+			prefix += metabase.ObjectKey(metabase.Delimiter)
+		}
+	}
+
+	cursor := metabase.IterateCursor{
+		Key: metabase.ObjectKey(req.EncryptedCursor),
+	}
+	if len(cursor.Key) != 0 {
+		cursor.Key = prefix + cursor.Key
+		cursor.Version = metabase.MaxVersion
+	}
+
+	includeCustomMetadata := true
+	includeSystemMetadata := true
+	if req.UseObjectIncludes {
+		includeCustomMetadata = req.ObjectIncludes.Metadata
+		includeSystemMetadata = !req.ObjectIncludes.ExcludeSystemMetadata
+	}
+
+	result, err := endpoint.metabase.ListObjectVersions(ctx, metabase.ListObjectVersions{
+		ProjectID:             keyInfo.ProjectID,
+		BucketName:            string(req.Bucket),
+		Prefix:                prefix,
+		Cursor:                cursor,
+		Recursive:             req.Recursive,
+		Limit:                 limit,
+		IncludeCustomMetadata: includeCustomMetadata,
+		IncludeSystemMetadata: includeSystemMetadata,
+	})
+	if err != nil {
+		return nil, endpoint.convertMetabaseErr(err)
+	}
+
+	resp = &pb.ObjectListResponse{More: result.More}
+	for _, entry := range result.Objects {
+		item, err := endpoint.objectEntryToProtoListItem(ctx, req.Bucket, entry, prefix, includeSystemMetadata, includeCustomMetadata, placement)
+		if err != nil {
+			return nil, endpoint.convertMetabaseErr(err)
+		}
+		resp.Items = append(resp.Items, item)
+	}
+
+	endpoint.log.Info("Object Versions List", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "list_versions"), zap.String("type", "object"))
+	mon.Meter("req_list_object_versions").Mark(1)
+
+	return resp, nil
+}
+
 // ListPendingObjectStreams list pending objects according to specific parameters.
 func (endpoint *Endpoint) ListPendingObjectStreams(ctx context.Context, req *pb.ObjectListPendingStreamsRequest) (resp *pb.ObjectListPendingStreamsResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -954,6 +1096,10 @@ func (endpoint *Endpoint) ListPendingObjectStreams(ctx context.Context, req *pb.
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
+	if err := endpoint.checkOperationRate(ctx, keyInfo.ProjectID, req.Bucket, rateLimitList); err != nil {
+		return nil, err
+	}
+
 	placement, err := endpoint.buckets.GetBucketPlacement(ctx, req.Bucket, keyInfo.ProjectID)
 	if err != nil {
 		if buckets.ErrBucketNotFound.Has(err) {
@@ -1067,6 +1213,10 @@ func (endpoint *Endpoint) BeginDeleteObject(ctx context.Context, req *pb.ObjectB
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
+	if err := endpoint.checkOperationRate(ctx, keyInfo.ProjectID, req.Bucket, rateLimitDelete); err != nil {
+		return nil, err
+	}
+
 	var deletedObjects []*pb.Object
 
 	if req.GetStatus() == int32(metabase.Pending) {
@@ -1089,6 +1239,12 @@ func (endpoint *Endpoint) BeginDeleteObject(ctx context.Context, req *pb.ObjectB
 					})
 			}
 		}
+	} else if req.Version > 0 {
+		deletedObjects, err = endpoint.DeleteObjectVersion(ctx, metabase.ObjectLocation{
+			ProjectID:  keyInfo.ProjectID,
+			BucketName: string(req.Bucket),
+			ObjectKey:  metabase.ObjectKey(req.EncryptedObjectKey),
+		}, metabase.Version(req.Version))
 	} else {
 		deletedObjects, err = endpoint.DeleteCommittedObject(ctx, keyInfo.ProjectID, string(req.Bucket), metabase.ObjectKey(req.EncryptedObjectKey))
 	}
@@ -1373,6 +1529,15 @@ func (endpoint *Endpoint) objectToProto(ctx context.Context, object metabase.Obj
 	return result, nil
 }
 
+// objectStatusToProto converts the object status for the protocol, which
+// doesn't distinguish versioned and unversioned committed objects.
+func objectStatusToProto(status metabase.ObjectStatus) pb.Object_Status {
+	if status == metabase.CommittedVersioned {
+		return pb.Object_COMMITTED
+	}
+	return pb.Object_Status(status)
+}
+
 func (endpoint *Endpoint) objectEntryToProtoListItem(ctx context.Context, bucket []byte,
 	entry metabase.ObjectEntry, prefixToPrependInSatStreamID metabase.ObjectKey,
 	includeSystem, includeMetadata bool, placement storj.PlacementConstraint) (item *pb.ObjectListItem, err error) {
@@ -1380,7 +1545,7 @@ func (endpoint *Endpoint) objectEntryToProtoListItem(ctx context.Context, bucket
 	item = &pb.ObjectListItem{
 		EncryptedObjectKey: []byte(entry.ObjectKey),
 		Version:            int32(entry.Version), // TODO incompatible types
-		Status:             pb.Object_Status(entry.Status),
+		Status:             objectStatusToProto(entry.Status),
 	}
 
 	expiresAt := time.Time{}
@@ -1478,13 +1643,27 @@ func (endpoint *Endpoint) DeleteCommittedObject(
 		ObjectKey:  object,
 	}
 
+	versioning, err := endpoint.buckets.GetBucketVersioning(ctx, []byte(bucket), projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	outbox := endpoint.bucketEventsOutbox(ctx, req.Bucket())
+
 	var result metabase.DeleteObjectResult
-	if endpoint.config.ServerSideCopy {
+	if endpoint.config.ServerSideCopy || versioning != buckets.Unversioned {
+		// versioned buckets keep the object and hide it behind a delete marker.
 		result, err = endpoint.metabase.DeleteObjectLastCommitted(ctx, metabase.DeleteObjectLastCommitted{
 			ObjectLocation: req,
+			Versioned:      versioning == buckets.VersioningEnabled,
+			Suspended:      versioning == buckets.VersioningSuspended,
+			BucketEvents:   outbox,
 		})
 	} else {
-		result, err = endpoint.metabase.DeleteObjectsAllVersions(ctx, metabase.DeleteObjectsAllVersions{Locations: []metabase.ObjectLocation{req}})
+		result, err = endpoint.metabase.DeleteObjectsAllVersions(ctx, metabase.DeleteObjectsAllVersions{
+			Locations:    []metabase.ObjectLocation{req},
+			BucketEvents: outbox,
+		})
 	}
 	if err != nil {
 		return nil, Error.Wrap(err)
@@ -1504,6 +1683,26 @@ func (endpoint *Endpoint) DeleteCommittedObject(
 	return deletedObjects, nil
 }
 
+// DeleteObjectVersion deletes an exact version of an object, which can also
+// be a delete marker.
+func (endpoint *Endpoint) DeleteObjectVersion(ctx context.Context, location metabase.ObjectLocation, version metabase.Version) (deletedObjects []*pb.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := endpoint.metabase.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+		ObjectLocation: location,
+		Version:        version,
+		BucketEvents:   endpoint.bucketEventsOutbox(ctx, location.Bucket()),
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if len(result.Objects) == 0 {
+		return nil, metabase.ErrObjectNotFound.New("version %d", version)
+	}
+
+	return endpoint.deleteObjectResultToProto(ctx, result)
+}
+
 // DeletePendingObject deletes all the pieces of the storage nodes that belongs
 // to the specified pending object.
 //
@@ -1735,6 +1934,12 @@ func (endpoint *Endpoint) FinishMoveObject(ctx context.Context, req *pb.ObjectFi
 		return nil, rpcstatus.Errorf(rpcstatus.NotFound, "target bucket not found: %s", req.NewBucket)
 	}
 
+	versioning, err := endpoint.buckets.GetBucketVersioning(ctx, req.NewBucket, keyInfo.ProjectID)
+	if err != nil {
+		endpoint.log.Error("unable to get bucket versioning", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
 	streamUUID, err := uuid.FromBytes(streamID.StreamId)
 	if err != nil {
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
@@ -1753,6 +1958,11 @@ func (endpoint *Endpoint) FinishMoveObject(ctx context.Context, req *pb.ObjectFi
 		NewEncryptedObjectKey:        req.NewEncryptedObjectKey,
 		NewEncryptedMetadataKeyNonce: req.NewEncryptedMetadataKeyNonce,
 		NewEncryptedMetadataKey:      req.NewEncryptedMetadataKey,
+		NewVersioned:                 versioning == buckets.VersioningEnabled,
+
+		BucketEvents: endpoint.bucketEventsOutbox(ctx,
+			metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(streamID.Bucket)},
+			metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(req.NewBucket)}),
 	})
 	if err != nil {
 		return nil, endpoint.convertMetabaseErr(err)
@@ -1930,6 +2140,12 @@ func (endpoint *Endpoint) FinishCopyObject(ctx context.Context, req *pb.ObjectFi
 		return nil, rpcstatus.Errorf(rpcstatus.NotFound, "target bucket not found: %s", req.NewBucket)
 	}
 
+	versioning, err := endpoint.buckets.GetBucketVersioning(ctx, req.NewBucket, keyInfo.ProjectID)
+	if err != nil {
+		endpoint.log.Error("unable to get bucket versioning", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
 	streamUUID, err := uuid.FromBytes(streamID.StreamId)
 	if err != nil {
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
@@ -1956,10 +2172,15 @@ func (endpoint *Endpoint) FinishCopyObject(ctx context.Context, req *pb.ObjectFi
 		NewEncryptedMetadata:         req.NewEncryptedMetadata,
 		NewEncryptedMetadataKeyNonce: req.NewEncryptedMetadataKeyNonce,
 		NewEncryptedMetadataKey:      req.NewEncryptedMetadataKey,
+		NewVersioned:                 versioning == buckets.VersioningEnabled,
 
 		VerifyLimits: func(encryptedObjectSize int64, nSegments int64) error {
 			return endpoint.addStorageUsageUpToLimit(ctx, keyInfo.ProjectID, encryptedObjectSize, nSegments)
 		},
+		BucketEvents: endpoint.bucketEventsOutbox(ctx, metabase.BucketLocation{
+			ProjectID:  keyInfo.ProjectID,
+			BucketName: string(req.NewBucket),
+		}),
 	})
 	if err != nil {
 		return nil, endpoint.convertMetabaseErr(err)
@@ -2002,3 +2223,119 @@ func protobufkeysToMetabase(protoKeys []*pb.EncryptedKeyAndNonce) []metabase.Enc
 
 	return keys
 }
+
+// SetObjectRetention changes the retention of an object version. Shortening or
+// removing a governance mode retention requires the delete permission.
+func (endpoint *Endpoint) SetObjectRetention(ctx context.Context, req *internalpb.SetObjectRetentionRequest) (resp *internalpb.SetObjectRetentionResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	now := time.Now()
+	permissions := []verifyPermission{{
+		action: macaroon.Action{
+			Op:            macaroon.ActionWrite,
+			Bucket:        req.Bucket,
+			EncryptedPath: req.EncryptedObjectKey,
+			Time:          now,
+		},
+	}}
+	if req.BypassGovernance {
+		permissions = append(permissions, verifyPermission{
+			action: macaroon.Action{
+				Op:            macaroon.ActionDelete,
+				Bucket:        req.Bucket,
+				EncryptedPath: req.EncryptedObjectKey,
+				Time:          now,
+			},
+		})
+	}
+	keyInfo, err := endpoint.validateAuthN(ctx, req.Header, permissions...)
+	if err != nil {
+		return nil, err
+	}
+	endpoint.usageTracking(keyInfo, req.Header, fmt.Sprintf("%T", req))
+
+	location, version, err := endpoint.objectVersionLocation(ctx, keyInfo.ProjectID, req.Bucket, req.EncryptedObjectKey, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	retention := metabase.Retention{Mode: metabase.RetentionMode(req.Mode)}
+	if retention.Mode != metabase.NoRetention {
+		retention.RetainUntil = req.RetainUntil
+	}
+
+	err = endpoint.metabase.SetObjectRetention(ctx, metabase.SetObjectRetention{
+		ObjectLocation:   location,
+		Version:          version,
+		Retention:        retention,
+		BypassGovernance: req.BypassGovernance,
+	})
+	if err != nil {
+		return nil, endpoint.convertMetabaseErr(err)
+	}
+
+	return &internalpb.SetObjectRetentionResponse{}, nil
+}
+
+// SetObjectLegalHold places or removes the legal hold of an object version.
+func (endpoint *Endpoint) SetObjectLegalHold(ctx context.Context, req *internalpb.SetObjectLegalHoldRequest) (resp *internalpb.SetObjectLegalHoldResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:            macaroon.ActionWrite,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.EncryptedObjectKey,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	endpoint.usageTracking(keyInfo, req.Header, fmt.Sprintf("%T", req))
+
+	location, version, err := endpoint.objectVersionLocation(ctx, keyInfo.ProjectID, req.Bucket, req.EncryptedObjectKey, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.metabase.SetObjectLegalHold(ctx, metabase.SetObjectLegalHold{
+		ObjectLocation: location,
+		Version:        version,
+		LegalHold:      req.LegalHold,
+	})
+	if err != nil {
+		return nil, endpoint.convertMetabaseErr(err)
+	}
+
+	return &internalpb.SetObjectLegalHoldResponse{}, nil
+}
+
+// objectVersionLocation returns the location of the object and the requested
+// version, where zero selects the latest committed version.
+func (endpoint *Endpoint) objectVersionLocation(ctx context.Context, projectID uuid.UUID, bucket, encryptedObjectKey []byte, version int64) (_ metabase.ObjectLocation, _ metabase.Version, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := endpoint.validateBucketNameLength(bucket); err != nil {
+		return metabase.ObjectLocation{}, 0, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	location := metabase.ObjectLocation{
+		ProjectID:  projectID,
+		BucketName: string(bucket),
+		ObjectKey:  metabase.ObjectKey(encryptedObjectKey),
+	}
+	if version != 0 {
+		return location, metabase.Version(version), nil
+	}
+
+	object, err := endpoint.metabase.GetObjectLastCommitted(ctx, metabase.GetObjectLastCommitted{
+		ObjectLocation: location,
+	})
+	if err != nil {
+		return metabase.ObjectLocation{}, 0, endpoint.convertMetabaseErr(err)
+	}
+	return location, object.Version, nil
+}
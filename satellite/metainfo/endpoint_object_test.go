@@ -2499,3 +2499,102 @@ func TestPlacements(t *testing.T) {
 		},
 	)
 }
+
+func TestEndpoint_ObjectLock(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		projectID := planet.Uplinks[0].Projects[0].ID
+		header := &pb.RequestHeader{ApiKey: planet.Uplinks[0].APIKey[satellite.ID()].SerializeRaw()}
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, satellite, "bucket"))
+
+		conn, err := planet.Uplinks[0].Dialer.DialNodeURL(ctx, satellite.NodeURL())
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+
+		client := internalpb.NewDRPCMetainfoExtClient(conn)
+
+		// default retention requires versioning.
+		_, err = client.SetBucketDefaultRetention(ctx, &internalpb.SetBucketDefaultRetentionRequest{
+			Header: header,
+			Name:   []byte("bucket"),
+			Mode:   internalpb.RetentionMode_GOVERNANCE,
+			Days:   1,
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+
+		_, err = client.SetBucketVersioning(ctx, &internalpb.SetBucketVersioningRequest{
+			Header:     header,
+			Name:       []byte("bucket"),
+			Versioning: internalpb.BucketVersioning_ENABLED,
+		})
+		require.NoError(t, err)
+
+		_, err = client.SetBucketDefaultRetention(ctx, &internalpb.SetBucketDefaultRetentionRequest{
+			Header: header,
+			Name:   []byte("bucket"),
+			Mode:   internalpb.RetentionMode_GOVERNANCE,
+			Days:   1,
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, satellite, "bucket", "object", testrand.Bytes(memory.KiB)))
+
+		objects, err := satellite.Metabase.DB.TestingAllCommittedObjects(ctx, projectID, "bucket")
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		encryptedKey := []byte(objects[0].ObjectKey)
+
+		getObject := func() metabase.Object {
+			object, err := satellite.Metabase.DB.GetObjectLastCommitted(ctx, metabase.GetObjectLastCommitted{
+				ObjectLocation: metabase.ObjectLocation{
+					ProjectID:  projectID,
+					BucketName: "bucket",
+					ObjectKey:  metabase.ObjectKey(encryptedKey),
+				},
+			})
+			require.NoError(t, err)
+			return object
+		}
+		require.Equal(t, metabase.GovernanceMode, getObject().Retention.Mode)
+
+		// removing a governance retention requires bypassing governance.
+		_, err = client.SetObjectRetention(ctx, &internalpb.SetObjectRetentionRequest{
+			Header:             header,
+			Bucket:             []byte("bucket"),
+			EncryptedObjectKey: encryptedKey,
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+
+		_, err = client.SetObjectRetention(ctx, &internalpb.SetObjectRetentionRequest{
+			Header:             header,
+			Bucket:             []byte("bucket"),
+			EncryptedObjectKey: encryptedKey,
+			BypassGovernance:   true,
+		})
+		require.NoError(t, err)
+
+		_, err = client.SetObjectLegalHold(ctx, &internalpb.SetObjectLegalHoldRequest{
+			Header:             header,
+			Bucket:             []byte("bucket"),
+			EncryptedObjectKey: encryptedKey,
+			Version:            int64(objects[0].Version),
+			LegalHold:          true,
+		})
+		require.NoError(t, err)
+
+		object := getObject()
+		require.Equal(t, metabase.NoRetention, object.Retention.Mode)
+		require.True(t, object.LegalHold)
+
+		_, err = client.SetObjectLegalHold(ctx, &internalpb.SetObjectLegalHoldRequest{
+			Header:             header,
+			Bucket:             []byte("bucket"),
+			EncryptedObjectKey: []byte("missing"),
+			LegalHold:          true,
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+	})
+}
@@ -679,6 +679,10 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 		return nil, endpoint.convertMetabaseErr(err)
 	}
 
+	if err := endpoint.checkEgressRate(ctx, keyInfo.ProjectID, streamID.Bucket, int64(segment.EncryptedSize)); err != nil {
+		return nil, err
+	}
+
 	// Update the current bandwidth cache value incrementing the SegmentSize.
 	err = endpoint.projectUsage.UpdateProjectBandwidthUsage(ctx, keyInfo.ProjectID, int64(segment.EncryptedSize))
 	if err != nil {
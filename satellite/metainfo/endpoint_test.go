@@ -13,6 +13,7 @@ import (
 
 	"storj.io/common/errs2"
 	"storj.io/common/macaroon"
+	"storj.io/common/memory"
 	"storj.io/common/pb"
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/signing"
@@ -21,6 +22,7 @@ import (
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/uplink"
@@ -584,3 +586,54 @@ func TestRateLimit_ExceededBurstLimit(t *testing.T) {
 
 	})
 }
+
+func TestRateLimit_BucketOperations(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.RateLimiter.DeleteRate = 0.001
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		ul := planet.Uplinks[0]
+		satellite := planet.Satellites[0]
+		projectID := ul.Projects[0].ID
+
+		require.NoError(t, ul.CreateBucket(ctx, satellite, "limited"))
+		require.NoError(t, ul.CreateBucket(ctx, satellite, "unlimited"))
+
+		listRate := 0.001
+		err := satellite.API.Buckets.Service.SetBucketRateLimits(ctx, []byte("limited"), projectID, buckets.RateLimits{
+			List: &listRate,
+		})
+		require.NoError(t, err)
+
+		negativeRate := -1.0
+		err = satellite.API.Buckets.Service.SetBucketRateLimits(ctx, []byte("limited"), projectID, buckets.RateLimits{
+			Upload: &negativeRate,
+		})
+		require.True(t, buckets.ErrInvalidRateLimits.Has(err))
+
+		// the bucket override limits listing, while other buckets aren't affected.
+		_, err = ul.ListObjects(ctx, satellite, "limited")
+		require.NoError(t, err)
+		_, err = ul.ListObjects(ctx, satellite, "limited")
+		require.ErrorIs(t, err, uplink.ErrTooManyRequests)
+
+		for i := 0; i < 3; i++ {
+			_, err = ul.ListObjects(ctx, satellite, "unlimited")
+			require.NoError(t, err)
+		}
+
+		// the configured delete rate applies to every bucket.
+		for _, bucket := range []string{"limited", "unlimited"} {
+			require.NoError(t, ul.Upload(ctx, satellite, bucket, "first", testrand.Bytes(memory.KiB)))
+			require.NoError(t, ul.Upload(ctx, satellite, bucket, "second", testrand.Bytes(memory.KiB)))
+
+			require.NoError(t, ul.DeleteObject(ctx, satellite, bucket, "first"))
+			err = ul.DeleteObject(ctx, satellite, bucket, "second")
+			require.ErrorIs(t, err, uplink.ErrTooManyRequests)
+		}
+	})
+}
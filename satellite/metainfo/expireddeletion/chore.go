@@ -36,17 +36,20 @@ type Chore struct {
 	log      *zap.Logger
 	config   Config
 	metabase *metabase.DB
+	// bucketEvents is optional.
+	bucketEvents metabase.BucketEventsResolver
 
 	nowFn func() time.Time
 	Loop  *sync2.Cycle
 }
 
 // NewChore creates a new instance of the expireddeletion chore.
-func NewChore(log *zap.Logger, config Config, metabase *metabase.DB) *Chore {
+func NewChore(log *zap.Logger, config Config, metabase *metabase.DB, bucketEvents metabase.BucketEventsResolver) *Chore {
 	return &Chore{
-		log:      log,
-		config:   config,
-		metabase: metabase,
+		log:          log,
+		config:       config,
+		metabase:     metabase,
+		bucketEvents: bucketEvents,
 
 		nowFn: time.Now,
 		Loop:  sync2.NewCycle(config.Interval),
@@ -85,6 +88,7 @@ func (chore *Chore) deleteExpiredObjects(ctx context.Context) (err error) {
 		ExpiredBefore:      chore.nowFn(),
 		BatchSize:          chore.config.ListLimit,
 		AsOfSystemInterval: chore.config.AsOfSystemInterval,
+		BucketEvents:       chore.bucketEvents,
 	})
 	if err != nil {
 		chore.log.Error("deleting expired objects failed", zap.Error(err))
@@ -0,0 +1,266 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"storj.io/common/lrucache"
+	"storj.io/storj/satellite/buckets"
+)
+
+// rateLimiter keeps a token bucket for every key.
+type rateLimiter interface {
+	// AllowN returns whether n tokens can be taken from the token bucket of
+	// the key. limits returns the rate and the burst of the token bucket; it
+	// may be called only when the token bucket is (re)created.
+	AllowN(ctx context.Context, key string, n int, limits func() (rate.Limit, int, error)) (bool, error)
+	// ReserveN takes n tokens from the token bucket of the key, even when they
+	// aren't available yet, and returns how long the caller has to wait until
+	// they are. When that is longer than maxDelay the tokens aren't taken and
+	// ok is false. n must not be larger than the burst.
+	ReserveN(ctx context.Context, key string, n int, maxDelay time.Duration, limits func() (rate.Limit, int, error)) (delay time.Duration, ok bool, err error)
+	// Close releases the resources of the rate limiter.
+	Close() error
+}
+
+// openRateLimiter returns the rate limiter for the configured backend.
+func openRateLimiter(log *zap.Logger, config RateLimiterConfig) (rateLimiter, error) {
+	switch {
+	case config.Backend == "":
+		return newLocalRateLimiter(config), nil
+	case strings.HasPrefix(config.Backend, "redis://"):
+		return openRedisRateLimiter(log, config)
+	default:
+		return nil, Error.New("unsupported rate limiter backend %q", config.Backend)
+	}
+}
+
+// newBucketRateLimitsCache returns the cache of the bucket rate limits overrides.
+func newBucketRateLimitsCache(config RateLimiterConfig) *lrucache.ExpiringLRUOf[buckets.RateLimits] {
+	return lrucache.NewOf[buckets.RateLimits](lrucache.Options{
+		Capacity:   config.CacheCapacity,
+		Expiration: config.CacheExpiration,
+		Name:       "metainfo-bucket-ratelimits",
+	})
+}
+
+// localRateLimiter keeps the token buckets in memory, hence every API
+// instance limits the requests independently.
+type localRateLimiter struct {
+	limiters *lrucache.ExpiringLRUOf[*rate.Limiter]
+}
+
+func newLocalRateLimiter(config RateLimiterConfig) *localRateLimiter {
+	return &localRateLimiter{
+		limiters: lrucache.NewOf[*rate.Limiter](lrucache.Options{
+			Capacity:   config.CacheCapacity,
+			Expiration: config.CacheExpiration,
+			Name:       "metainfo-ratelimit",
+		}),
+	}
+}
+
+// AllowN implements rateLimiter.
+func (limiter *localRateLimiter) AllowN(ctx context.Context, key string, n int, limits func() (rate.Limit, int, error)) (bool, error) {
+	bucket, err := limiter.limiters.Get(ctx, key, func() (*rate.Limiter, error) {
+		limit, burst, err := limits()
+		if err != nil {
+			return nil, err
+		}
+		return rate.NewLimiter(limit, burst), nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return bucket.AllowN(time.Now(), n), nil
+}
+
+// ReserveN implements rateLimiter.
+func (limiter *localRateLimiter) ReserveN(ctx context.Context, key string, n int, maxDelay time.Duration, limits func() (rate.Limit, int, error)) (time.Duration, bool, error) {
+	bucket, err := limiter.limiters.Get(ctx, key, func() (*rate.Limiter, error) {
+		limit, burst, err := limits()
+		if err != nil {
+			return nil, err
+		}
+		return rate.NewLimiter(limit, burst), nil
+	})
+	if err != nil {
+		return 0, false, err
+	}
+
+	now := time.Now()
+	reservation := bucket.ReserveN(now, n)
+	if !reservation.OK() {
+		return 0, false, Error.New("unable to reserve %d tokens", n)
+	}
+	delay := reservation.DelayFrom(now)
+	if delay > maxDelay {
+		reservation.CancelAt(now)
+		return delay, false, nil
+	}
+	return delay, true, nil
+}
+
+// Close implements rateLimiter.
+func (limiter *localRateLimiter) Close() error { return nil }
+
+// redisRateLimiter keeps the token buckets in Redis, so the limits are
+// shared by all the API instances.
+type redisRateLimiter struct {
+	log        *zap.Logger
+	client     *redis.Client
+	expiration time.Duration
+}
+
+// redisTokenBucket takes ARGV[3] tokens from the token bucket stored in
+// KEYS[1], after refilling it with ARGV[1] tokens per second up to ARGV[2]
+// since the last call. ARGV[4] is how long the token bucket is kept in
+// milliseconds. When ARGV[5] is "reserve" the tokens are taken even when
+// they aren't available yet and the script returns how many milliseconds
+// it takes until they are; when that is more than ARGV[6] milliseconds the
+// tokens aren't taken and -1 is returned. Otherwise the script returns
+// whether the tokens were taken.
+//
+// The time of the Redis server is used, so that the clocks of the API
+// instances sharing the token bucket don't need to be in sync.
+var redisTokenBucket = redis.NewScript(`
+if redis.replicate_commands then
+	redis.replicate_commands()
+end
+
+local limit = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local n = tonumber(ARGV[3])
+local reserve = ARGV[5] == "reserve"
+
+local time = redis.call("time")
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+local state = redis.call("hmget", KEYS[1], "tokens", "ts")
+local tokens = tonumber(state[1])
+local ts = tonumber(state[2])
+if tokens == nil or ts == nil then
+	tokens = burst
+	ts = now
+end
+if now > ts then
+	tokens = math.min(burst, tokens + (now - ts) * limit / 1000)
+	ts = now
+end
+
+local result = 0
+if reserve then
+	if tokens < n then
+		result = math.ceil((n - tokens) * 1000 / limit)
+	end
+	if result > tonumber(ARGV[6]) then
+		result = -1
+	else
+		tokens = tokens - n
+	end
+elseif tokens >= n then
+	tokens = tokens - n
+	result = 1
+end
+
+redis.call("hset", KEYS[1], "tokens", tostring(tokens), "ts", tostring(ts))
+redis.call("pexpire", KEYS[1], ARGV[4])
+return result
+`)
+
+func openRedisRateLimiter(log *zap.Logger, config RateLimiterConfig) (*redisRateLimiter, error) {
+	opts, err := redis.ParseURL(config.Backend)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &redisRateLimiter{
+		log:        log,
+		client:     redis.NewClient(opts),
+		expiration: config.CacheExpiration,
+	}, nil
+}
+
+// AllowN implements rateLimiter. The requests are allowed when Redis is
+// unavailable, because failing all of them would be worse than not
+// limiting them for a while.
+func (limiter *redisRateLimiter) AllowN(ctx context.Context, key string, n int, limits func() (rate.Limit, int, error)) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limit, burst, err := limits()
+	if err != nil {
+		return false, err
+	}
+	if limit == rate.Inf {
+		return true, nil
+	}
+
+	allowed, err := limiter.run(ctx, key, limit, burst, n, "allow", 0)
+	if err != nil {
+		return true, nil
+	}
+	return allowed == 1, nil
+}
+
+// ReserveN implements rateLimiter. Like AllowN it doesn't delay anything,
+// when Redis is unavailable.
+func (limiter *redisRateLimiter) ReserveN(ctx context.Context, key string, n int, maxDelay time.Duration, limits func() (rate.Limit, int, error)) (_ time.Duration, _ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limit, burst, err := limits()
+	if err != nil {
+		return 0, false, err
+	}
+	if limit == rate.Inf {
+		return 0, true, nil
+	}
+	if limit <= 0 || n > burst {
+		return 0, false, Error.New("unable to reserve %d tokens", n)
+	}
+
+	delay, err := limiter.run(ctx, key, limit, burst, n, "reserve", maxDelay.Milliseconds())
+	if err != nil {
+		return 0, true, nil
+	}
+	if delay < 0 {
+		return maxDelay, false, nil
+	}
+	return time.Duration(delay) * time.Millisecond, true, nil
+}
+
+// run runs the token bucket script for the key.
+func (limiter *redisRateLimiter) run(ctx context.Context, key string, limit rate.Limit, burst, n int, mode string, maxDelay int64) (int64, error) {
+	// keep the token bucket at least until it's refilled.
+	ttl := limiter.expiration
+	if limit > 0 {
+		if refill := time.Duration(math.Ceil(float64(burst) / float64(limit) * float64(time.Second))); refill > ttl {
+			ttl = refill
+		}
+	}
+	if ttl < time.Second {
+		ttl = time.Second
+	}
+
+	result, err := redisTokenBucket.Run(ctx, limiter.client, []string{"metainfo:ratelimit:" + key},
+		float64(limit), burst, n, ttl.Milliseconds(), mode, maxDelay).Int64()
+	if err != nil {
+		mon.Event("metainfo_rate_limit_backend_error")
+		limiter.log.Warn("unable to check rate limit", zap.String("key", key), zap.Error(err))
+		return 0, err
+	}
+	return result, nil
+}
+
+// Close implements rateLimiter.
+func (limiter *redisRateLimiter) Close() error {
+	return errs.Wrap(limiter.client.Close())
+}
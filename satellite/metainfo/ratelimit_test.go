@@ -0,0 +1,104 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"golang.org/x/time/rate"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testredis"
+)
+
+func TestRateLimiter(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	redis, err := testredis.Mini(ctx)
+	require.NoError(t, err)
+	defer ctx.Check(redis.Close)
+
+	config := RateLimiterConfig{
+		CacheCapacity:   10,
+		CacheExpiration: time.Minute,
+	}
+
+	for _, tt := range []struct {
+		name    string
+		backend string
+	}{
+		{name: "memory", backend: ""},
+		{name: "redis", backend: "redis://" + redis.Addr()},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			config := config
+			config.Backend = tt.backend
+
+			limiter, err := openRateLimiter(zaptest.NewLogger(t), config)
+			require.NoError(t, err)
+			defer ctx.Check(limiter.Close)
+
+			limits := func(limit rate.Limit, burst int) func() (rate.Limit, int, error) {
+				return func() (rate.Limit, int, error) { return limit, burst, nil }
+			}
+
+			// the burst is available immediately, but isn't refilled that fast.
+			for i := 0; i < 3; i++ {
+				allowed, err := limiter.AllowN(ctx, "project", 1, limits(0.001, 3))
+				require.NoError(t, err)
+				require.True(t, allowed)
+			}
+			allowed, err := limiter.AllowN(ctx, "project", 1, limits(0.001, 3))
+			require.NoError(t, err)
+			require.False(t, allowed)
+
+			// keys are limited independently.
+			allowed, err = limiter.AllowN(ctx, "other", 2, limits(0.001, 3))
+			require.NoError(t, err)
+			require.True(t, allowed)
+			allowed, err = limiter.AllowN(ctx, "other", 2, limits(0.001, 3))
+			require.NoError(t, err)
+			require.False(t, allowed)
+
+			// an infinite rate allows everything.
+			for i := 0; i < 10; i++ {
+				allowed, err := limiter.AllowN(ctx, "unlimited", 100, limits(rate.Inf, 0))
+				require.NoError(t, err)
+				require.True(t, allowed)
+			}
+
+			// reserving tokens, which aren't available yet, returns how long to wait for them.
+			delay, ok, err := limiter.ReserveN(ctx, "egress", 3, time.Minute, limits(1, 3))
+			require.NoError(t, err)
+			require.True(t, ok)
+			require.Zero(t, delay)
+			delay, ok, err = limiter.ReserveN(ctx, "egress", 1, time.Minute, limits(1, 3))
+			require.NoError(t, err)
+			require.True(t, ok)
+			require.InDelta(t, time.Second, delay, float64(100*time.Millisecond))
+
+			// tokens, which would take longer than the maximum delay, aren't taken.
+			_, ok, err = limiter.ReserveN(ctx, "egress", 3, 2*time.Second, limits(1, 3))
+			require.NoError(t, err)
+			require.False(t, ok)
+
+			delay, ok, err = limiter.ReserveN(ctx, "egress", 2, time.Minute, limits(1, 3))
+			require.NoError(t, err)
+			require.True(t, ok)
+			require.InDelta(t, 3*time.Second, delay, float64(100*time.Millisecond))
+
+			delay, ok, err = limiter.ReserveN(ctx, "unlimited", 100, 0, limits(rate.Inf, 0))
+			require.NoError(t, err)
+			require.True(t, ok)
+			require.Zero(t, delay)
+		})
+	}
+
+	_, err = openRateLimiter(zaptest.NewLogger(t), RateLimiterConfig{Backend: "memcached://localhost"})
+	require.Error(t, err)
+}
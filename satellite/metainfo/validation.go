@@ -7,12 +7,14 @@ import (
 	"bytes"
 	"context"
 	"crypto/subtle"
+	"math"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/jtolio/eventkit"
+	"github.com/spacemonkeygo/monkit/v3"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"golang.org/x/time/rate"
@@ -24,6 +26,7 @@ import (
 	"storj.io/common/pb"
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
+	"storj.io/common/sync2"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/buckets"
@@ -210,13 +213,16 @@ func (endpoint *Endpoint) checkRate(ctx context.Context, projectID uuid.UUID) (e
 	if !endpoint.config.RateLimiter.Enabled {
 		return nil
 	}
-	limiter, err := endpoint.limiterCache.Get(ctx, projectID.String(), func() (*rate.Limiter, error) {
-		rateLimit := rate.Limit(endpoint.config.RateLimiter.Rate)
-		burstLimit := int(endpoint.config.RateLimiter.Rate)
+
+	var rateLimit rate.Limit
+	var burstLimit int
+	allowed, err := endpoint.rateLimiter.AllowN(ctx, projectID.String(), 1, func() (rate.Limit, int, error) {
+		rateLimit = rate.Limit(endpoint.config.RateLimiter.Rate)
+		burstLimit = int(endpoint.config.RateLimiter.Rate)
 
 		limits, err := endpoint.projectLimits.GetLimits(ctx, projectID)
 		if err != nil {
-			return nil, err
+			return 0, 0, err
 		}
 		if limits.RateLimit != nil {
 			rateLimit = rate.Limit(*limits.RateLimit)
@@ -227,17 +233,17 @@ func (endpoint *Endpoint) checkRate(ctx context.Context, projectID uuid.UUID) (e
 			burstLimit = *limits.BurstLimit
 		}
 
-		return rate.NewLimiter(rateLimit, burstLimit), nil
+		return rateLimit, burstLimit, nil
 	})
 	if err != nil {
 		return rpcstatus.Error(rpcstatus.Unavailable, err.Error())
 	}
 
-	if !limiter.Allow() {
+	if !allowed {
 		endpoint.log.Warn("too many requests for project",
 			zap.Stringer("projectID", projectID),
-			zap.Float64("rate limit", float64(limiter.Limit())),
-			zap.Float64("burst limit", float64(limiter.Burst())))
+			zap.Float64("rate limit", float64(rateLimit)),
+			zap.Float64("burst limit", float64(burstLimit)))
 
 		mon.Event("metainfo_rate_limit_exceeded") //mon:locked
 
@@ -247,6 +253,158 @@ func (endpoint *Endpoint) checkRate(ctx context.Context, projectID uuid.UUID) (e
 	return nil
 }
 
+// rateLimitOperation is a kind of request, which is limited separately from
+// the other requests to a bucket.
+type rateLimitOperation string
+
+const (
+	rateLimitList     rateLimitOperation = "list"
+	rateLimitUpload   rateLimitOperation = "upload"
+	rateLimitDownload rateLimitOperation = "download"
+	rateLimitDelete   rateLimitOperation = "delete"
+)
+
+// checkOperationRate checks the rate limit of the operation in the bucket,
+// which is applied in addition to the rate limit of the project.
+func (endpoint *Endpoint) checkOperationRate(ctx context.Context, projectID uuid.UUID, bucketName []byte, operation rateLimitOperation) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !endpoint.config.RateLimiter.Enabled {
+		return nil
+	}
+
+	var limit float64
+	allowed, err := endpoint.rateLimiter.AllowN(ctx, string(operation)+":"+projectID.String()+"/"+string(bucketName), 1, func() (rate.Limit, int, error) {
+		limits, err := endpoint.getBucketRateLimits(ctx, projectID, bucketName)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		var override *float64
+		switch operation {
+		case rateLimitList:
+			limit, override = endpoint.config.RateLimiter.ListRate, limits.List
+		case rateLimitUpload:
+			limit, override = endpoint.config.RateLimiter.UploadRate, limits.Upload
+		case rateLimitDownload:
+			limit, override = endpoint.config.RateLimiter.DownloadRate, limits.Download
+		case rateLimitDelete:
+			limit, override = endpoint.config.RateLimiter.DeleteRate, limits.Delete
+		}
+		if override != nil {
+			limit = *override
+		}
+		if limit <= 0 {
+			return rate.Inf, 0, nil
+		}
+		return rate.Limit(limit), int(math.Max(1, math.Ceil(limit))), nil
+	})
+	if err != nil {
+		return rpcstatus.Error(rpcstatus.Unavailable, err.Error())
+	}
+
+	if !allowed {
+		endpoint.log.Warn("too many requests for bucket",
+			zap.Stringer("projectID", projectID),
+			zap.String("operation", string(operation)),
+			zap.Float64("rate limit", limit))
+
+		mon.Event("metainfo_operation_rate_limit_exceeded", monkit.NewSeriesTag("operation", string(operation)))
+
+		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Too Many Requests")
+	}
+
+	return nil
+}
+
+// checkEgressRate checks whether size bytes can be downloaded from the
+// bucket without exceeding the egress rate of the project or of the bucket.
+func (endpoint *Endpoint) checkEgressRate(ctx context.Context, projectID uuid.UUID, bucketName []byte, size int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !endpoint.config.RateLimiter.Enabled || size <= 0 {
+		return nil
+	}
+
+	limits, err := endpoint.getBucketRateLimits(ctx, projectID, bucketName)
+	if err != nil {
+		return rpcstatus.Error(rpcstatus.Unavailable, err.Error())
+	}
+
+	if err := endpoint.checkEgress(ctx, "egress:"+projectID.String(), endpoint.config.RateLimiter.EgressRate.Int64(), size); err != nil {
+		return err
+	}
+	if limits.Egress != nil {
+		if err := endpoint.checkEgress(ctx, "egress:"+projectID.String()+"/"+string(bucketName), *limits.Egress, size); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkEgress takes size tokens from the egress token bucket of the key. The
+// download is delayed, instead of rejected, until the tokens are available,
+// which limits the egress bandwidth to the rate. Downloads which would have
+// to wait longer than the configured maximum, or than the request deadline,
+// are rejected without taking the tokens.
+func (endpoint *Endpoint) checkEgress(ctx context.Context, key string, bytesPerSecond, size int64) error {
+	if bytesPerSecond <= 0 {
+		return nil
+	}
+
+	// a single segment must fit into the token bucket, otherwise it could
+	// never be downloaded.
+	burst := bytesPerSecond
+	if maxSegmentSize := endpoint.config.MaxSegmentSize.Int64(); burst < maxSegmentSize {
+		burst = maxSegmentSize
+	}
+	if size > burst {
+		size = burst
+	}
+
+	maxDelay := endpoint.config.RateLimiter.EgressMaxDelay
+	if deadline, ok := ctx.Deadline(); ok {
+		if untilDeadline := time.Until(deadline); untilDeadline < maxDelay {
+			maxDelay = untilDeadline
+		}
+	}
+
+	delay, ok, err := endpoint.rateLimiter.ReserveN(ctx, key, int(size), maxDelay, func() (rate.Limit, int, error) {
+		return rate.Limit(bytesPerSecond), int(burst), nil
+	})
+	if err != nil {
+		return rpcstatus.Error(rpcstatus.Unavailable, err.Error())
+	}
+	if !ok {
+		endpoint.log.Warn("egress rate limit exceeded",
+			zap.String("key", key),
+			zap.Int64("bytes per second", bytesPerSecond),
+			zap.Duration("delay", delay))
+
+		mon.Event("metainfo_egress_rate_limit_exceeded")
+
+		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Too Many Requests")
+	}
+	if delay > 0 {
+		mon.Event("metainfo_egress_rate_limit_throttled")
+		mon.DurationVal("metainfo_egress_rate_limit_delay").Observe(delay)
+		if !sync2.Sleep(ctx, delay) {
+			return rpcstatus.Wrap(rpcstatus.Canceled, ctx.Err())
+		}
+	}
+	return nil
+}
+
+// getBucketRateLimits returns the rate limits overrides of the bucket.
+func (endpoint *Endpoint) getBucketRateLimits(ctx context.Context, projectID uuid.UUID, bucketName []byte) (buckets.RateLimits, error) {
+	return endpoint.bucketRateLimits.Get(ctx, projectID.String()+"/"+string(bucketName), func() (buckets.RateLimits, error) {
+		limits, err := endpoint.buckets.GetBucketRateLimits(ctx, bucketName, projectID)
+		if buckets.ErrBucketNotFound.Has(err) {
+			// the request fails later anyway.
+			return buckets.RateLimits{}, nil
+		}
+		return limits, err
+	})
+}
+
 func (endpoint *Endpoint) validateBucketNameLength(bucket []byte) (err error) {
 	if len(bucket) == 0 {
 		return Error.Wrap(buckets.ErrNoBucket.New(""))
@@ -5,6 +5,7 @@ package metrics
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -26,7 +27,7 @@ type Observer struct {
 	metrics Metrics
 }
 
-var _ rangedloop.Observer = (*Observer)(nil)
+var _ rangedloop.CheckpointObserver = (*Observer)(nil)
 
 // NewObserver instantiates a new rangedloop observer which aggregates
 // object statistics from observed segments.
@@ -74,6 +75,30 @@ func (obs *Observer) Finish(ctx context.Context) error {
 	return nil
 }
 
+// CheckpointPartial returns the metrics aggregated by the partial.
+func (obs *Observer) CheckpointPartial(ctx context.Context, partial rangedloop.Partial) ([]byte, error) {
+	fork, ok := partial.(*observerFork)
+	if !ok {
+		return nil, Error.New("expected %T but got %T", fork, partial)
+	}
+
+	// all streams seen by the fork are complete, so the last one can be
+	// counted now.
+	fork.Flush()
+	data, err := json.Marshal(fork.totals)
+	return data, Error.Wrap(err)
+}
+
+// RestorePartial returns a partial which continues from the checkpointed
+// metrics.
+func (obs *Observer) RestorePartial(ctx context.Context, data []byte) (rangedloop.Partial, error) {
+	fork := &observerFork{}
+	if err := json.Unmarshal(data, &fork.totals); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return fork, nil
+}
+
 // TestingMetrics returns the accumulated metrics. It is intended to be called
 // from tests.
 func (obs *Observer) TestingMetrics() Metrics {
@@ -5,6 +5,12 @@ package nodeselection
 
 import (
 	"bytes"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"storj.io/common/storj"
 	"storj.io/common/storj/location"
@@ -32,6 +38,9 @@ type ExcludeAllFilter struct{}
 // MatchInclude implements NodeFilter interface.
 func (ExcludeAllFilter) MatchInclude(node *SelectedNode) bool { return false }
 
+// String returns the placement definition of the filter.
+func (ExcludeAllFilter) String() string { return "none()" }
+
 // MatchInclude implements NodeFilter interface.
 func (n NodeFilters) MatchInclude(node *SelectedNode) bool {
 	for _, filter := range n {
@@ -42,6 +51,14 @@ func (n NodeFilters) MatchInclude(node *SelectedNode) bool {
 	return true
 }
 
+// String returns the placement definition of the filters.
+func (n NodeFilters) String() string {
+	if len(n) == 1 {
+		return filterString(n[0])
+	}
+	return "all(" + joinFilters(n) + ")"
+}
+
 // WithCountryFilter is a helper to create a new filter with additional CountryFilter.
 func (n NodeFilters) WithCountryFilter(permit location.Set) NodeFilters {
 	return append(n, NewCountryFilter(permit))
@@ -76,6 +93,33 @@ func (p *CountryFilter) MatchInclude(node *SelectedNode) bool {
 	return p.permit.Contains(node.CountryCode)
 }
 
+// String returns the placement definition of the filter. Sets with most of
+// the countries are described by the excluded countries.
+func (p *CountryFilter) String() string {
+	switch p.permit {
+	case EuCountries:
+		return `country("EU")`
+	case EeaCountries:
+		return `country("EEA")`
+	}
+
+	var included, excluded []string
+	for first := 'A'; first <= 'Z'; first++ {
+		for second := 'A'; second <= 'Z'; second++ {
+			code := string([]rune{first, second})
+			if p.permit.Contains(location.ToCountryCode(code)) {
+				included = append(included, strconv.Quote(code))
+			} else {
+				excluded = append(excluded, strconv.Quote("!"+code))
+			}
+		}
+	}
+	if len(included) > len(excluded) {
+		return "country(" + strings.Join(append([]string{`"*"`}, excluded...), ",") + ")"
+	}
+	return "country(" + strings.Join(included, ",") + ")"
+}
+
 var _ NodeFilter = &CountryFilter{}
 
 // AutoExcludeSubnets pick at most one node from network.
@@ -160,4 +204,144 @@ func (t TagFilter) MatchInclude(node *SelectedNode) bool {
 	return false
 }
 
+// String returns the placement definition of the filter. Values which are
+// not printable are hex encoded.
+func (t TagFilter) String() string {
+	value := quote(string(t.value))
+	if !printable(t.value) {
+		value = fmt.Sprintf(`hex("%x")`, t.value)
+	}
+	return fmt.Sprintf("tag(%s,%s,%s)", quote(t.signer.String()), quote(t.name), value)
+}
+
 var _ NodeFilter = TagFilter{}
+
+// OrFilter matches nodes which are matched by any of the filters.
+type OrFilter []NodeFilter
+
+// MatchInclude implements NodeFilter interface.
+func (o OrFilter) MatchInclude(node *SelectedNode) bool {
+	for _, filter := range o {
+		if filter.MatchInclude(node) {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the placement definition of the filter.
+func (o OrFilter) String() string {
+	return "any(" + joinFilters(o) + ")"
+}
+
+var _ NodeFilter = OrFilter{}
+
+// NotFilter matches nodes which are not matched by the filter.
+type NotFilter struct {
+	filter NodeFilter
+}
+
+// NewNotFilter creates a filter with the negated condition of filter.
+func NewNotFilter(filter NodeFilter) NotFilter {
+	return NotFilter{filter: filter}
+}
+
+// MatchInclude implements NodeFilter interface.
+func (n NotFilter) MatchInclude(node *SelectedNode) bool {
+	return !n.filter.MatchInclude(node)
+}
+
+// String returns the placement definition of the filter.
+func (n NotFilter) String() string {
+	return "not(" + filterString(n.filter) + ")"
+}
+
+var _ NodeFilter = NotFilter{}
+
+// LastNetFilter matches nodes whose last network is part of the subnet.
+type LastNetFilter struct {
+	subnet *net.IPNet
+}
+
+// NewLastNetFilter creates a filter from a subnet in CIDR notation.
+func NewLastNetFilter(cidr string) (LastNetFilter, error) {
+	_, subnet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return LastNetFilter{}, err
+	}
+	return LastNetFilter{subnet: subnet}, nil
+}
+
+// MatchInclude implements NodeFilter interface.
+func (l LastNetFilter) MatchInclude(node *SelectedNode) bool {
+	ip := net.ParseIP(node.LastNet)
+	return ip != nil && l.subnet.Contains(ip)
+}
+
+// String returns the placement definition of the filter.
+func (l LastNetFilter) String() string {
+	return fmt.Sprintf("last_net(%s)", quote(l.subnet.String()))
+}
+
+var _ NodeFilter = LastNetFilter{}
+
+// VettedFilter matches vetted nodes.
+type VettedFilter struct{}
+
+// MatchInclude implements NodeFilter interface.
+func (VettedFilter) MatchInclude(node *SelectedNode) bool { return node.Vetted }
+
+// String returns the placement definition of the filter.
+func (VettedFilter) String() string { return "vetted()" }
+
+var _ NodeFilter = VettedFilter{}
+
+// FreeDiskFilter matches nodes with at least the given free disk space in bytes.
+type FreeDiskFilter int64
+
+// MatchInclude implements NodeFilter interface.
+func (f FreeDiskFilter) MatchInclude(node *SelectedNode) bool {
+	return node.FreeDisk >= int64(f)
+}
+
+// String returns the placement definition of the filter.
+func (f FreeDiskFilter) String() string {
+	return fmt.Sprintf("free_disk(%d)", int64(f))
+}
+
+var _ NodeFilter = FreeDiskFilter(0)
+
+// filterString returns the placement definition of a filter, or its type
+// when the filter can't be described.
+func filterString(filter NodeFilter) string {
+	if stringer, ok := filter.(fmt.Stringer); ok {
+		return stringer.String()
+	}
+	return fmt.Sprintf("%T", filter)
+}
+
+func joinFilters(filters []NodeFilter) string {
+	parts := make([]string, 0, len(filters))
+	for _, filter := range filters {
+		parts = append(parts, filterString(filter))
+	}
+	return strings.Join(parts, ",")
+}
+
+// quote quotes s with the escape sequences understood by the placement parser.
+func quote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\t", `\t`).Replace(s) + `"`
+}
+
+// printable returns true if the value can be written as a string literal.
+func printable(value []byte) bool {
+	if !utf8.Valid(value) {
+		return false
+	}
+	for _, r := range string(value) {
+		if !unicode.IsPrint(r) && r != '\n' && r != '\t' {
+			return false
+		}
+	}
+	return true
+}
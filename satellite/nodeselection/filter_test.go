@@ -123,6 +123,51 @@ func TestCriteria_Geofencing(t *testing.T) {
 	}
 }
 
+func TestCriteria_Composition(t *testing.T) {
+	lastNet, err := NewLastNetFilter("10.1.0.0/16")
+	require.NoError(t, err)
+
+	_, err = NewLastNetFilter("10.1.0.0")
+	require.Error(t, err)
+
+	criteria := OrFilter{
+		NodeFilters{NewCountryFilter(location.NewSet(location.Germany)), VettedFilter{}},
+		NewNotFilter(lastNet),
+	}
+
+	assert.True(t, criteria.MatchInclude(&SelectedNode{CountryCode: location.Germany, Vetted: true, LastNet: "10.1.2.0"}))
+	assert.False(t, criteria.MatchInclude(&SelectedNode{CountryCode: location.Germany, LastNet: "10.1.2.0"}))
+	assert.True(t, criteria.MatchInclude(&SelectedNode{LastNet: "10.2.2.0"}))
+	assert.False(t, criteria.MatchInclude(&SelectedNode{LastNet: "10.1.2.0"}))
+
+	freeDisk := FreeDiskFilter(100)
+	assert.True(t, freeDisk.MatchInclude(&SelectedNode{FreeDisk: 100}))
+	assert.False(t, freeDisk.MatchInclude(&SelectedNode{FreeDisk: 99}))
+}
+
+func TestFilterString(t *testing.T) {
+	signer, err := storj.NodeIDFromString("12whfK1EDvHJtajBiAUeajQLYcWqxcQmdYQU5zX5cCf6bAxfgu4")
+	require.NoError(t, err)
+	lastNet, err := NewLastNetFilter("10.1.0.0/16")
+	require.NoError(t, err)
+
+	for _, c := range []struct {
+		filter   NodeFilter
+		expected string
+	}{
+		{NodeFilters{}, `all()`},
+		{NewCountryFilter(location.NewSet(location.Germany, location.Austria)), `country("AT","DE")`},
+		{NewCountryFilter(EuCountries), `country("EU")`},
+		{NewCountryFilter(location.NewFullSet().Without(location.None, location.Russia)), `country("*","!RU")`},
+		{NewTagFilter(signer, "tier", []byte(`s"d`)), `tag("12whfK1EDvHJtajBiAUeajQLYcWqxcQmdYQU5zX5cCf6bAxfgu4","tier","s\"d")`},
+		{NewTagFilter(signer, "bin", []byte{0, 1}), `tag("12whfK1EDvHJtajBiAUeajQLYcWqxcQmdYQU5zX5cCf6bAxfgu4","bin",hex("0001"))`},
+		{OrFilter{VettedFilter{}, NewNotFilter(lastNet)}, `any(vetted(),not(last_net("10.1.0.0/16")))`},
+		{NodeFilters{FreeDiskFilter(1000), ExcludeAllFilter{}}, `all(free_disk(1000),none())`},
+	} {
+		assert.Equal(t, c.expected, c.filter.(fmt.Stringer).String())
+	}
+}
+
 // BenchmarkNodeFilterFullTable checks performances of rule evaluation on ALL storage nodes.
 func BenchmarkNodeFilterFullTable(b *testing.B) {
 	filters := NodeFilters{}
@@ -46,6 +46,8 @@ type SelectedNode struct {
 	Exiting     bool
 	Suspended   bool
 	Online      bool
+	Vetted      bool
+	FreeDisk    int64
 	Tags        NodeTags
 }
 
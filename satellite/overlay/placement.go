@@ -4,7 +4,10 @@
 package overlay
 
 import (
+	"encoding/hex"
 	"fmt"
+	"os"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -13,11 +16,15 @@ import (
 	"github.com/zeebo/errs"
 	"golang.org/x/exp/slices"
 
+	"storj.io/common/memory"
 	"storj.io/common/storj"
 	"storj.io/common/storj/location"
 	"storj.io/storj/satellite/nodeselection"
 )
 
+// ErrPlacement is used when placement definitions are invalid.
+var ErrPlacement = errs.Class("placement")
+
 // PlacementRules can crate filter based on the placement identifier.
 type PlacementRules func(constraint storj.PlacementConstraint) (filter nodeselection.NodeFilters)
 
@@ -28,23 +35,32 @@ type ConfigurablePlacementRule struct {
 
 // String implements pflag.Value.
 func (d *ConfigurablePlacementRule) String() string {
-	parts := []string{}
-	for id, filter := range d.placements {
+	ids := make([]storj.PlacementConstraint, 0, len(d.placements))
+	for id := range d.placements {
 		// we can hide the internal rules...
 		if id > 9 {
-			// TODO: we need proper String implementation for all the used filters
-			parts = append(parts, fmt.Sprintf("%d:%s", id, filter))
+			ids = append(ids, id)
 		}
 	}
+	slices.Sort(ids)
+
+	parts := make([]string, 0, len(ids))
+	for _, id := range ids {
+		parts = append(parts, fmt.Sprintf("%d:%s", id, d.placements[id]))
+	}
 	return strings.Join(parts, ";")
 }
 
-// Set implements pflag.Value.
+// Set implements pflag.Value. The value is either the placement definitions
+// or the path of a file containing them.
 func (d *ConfigurablePlacementRule) Set(s string) error {
 	if d.placements == nil {
 		d.placements = make(map[storj.PlacementConstraint]nodeselection.NodeFilters)
 	}
 	d.AddLegacyStaticRules()
+	if info, err := os.Stat(s); err == nil && info.Mode().IsRegular() {
+		return d.AddPlacementFromFile(s)
+	}
 	return d.AddPlacementFromString(s)
 }
 
@@ -77,18 +93,121 @@ func (d *ConfigurablePlacementRule) AddPlacementRule(id storj.PlacementConstrain
 }
 
 // AddPlacementFromString parses placement definition form string representations from id:definition;id:definition;...
+//
+// Besides placements, a definition may name a filter with name=definition,
+// which can be used by the definitions after it.
 func (d *ConfigurablePlacementRule) AddPlacementFromString(definitions string) error {
-	env := map[any]any{
+	return d.addPlacements(strings.Split(definitions, ";"))
+}
+
+// AddPlacementFromFile parses placement definitions from a file. Every line
+// contains definitions in the same format as AddPlacementFromString. Lines
+// starting with # are ignored.
+func (d *ConfigurablePlacementRule) AddPlacementFromFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ErrPlacement.Wrap(err)
+	}
+
+	var definitions []string
+	for _, line := range strings.Split(string(data), "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+		definitions = append(definitions, strings.Split(line, ";")...)
+	}
+	if err := d.addPlacements(definitions); err != nil {
+		return ErrPlacement.New("%s: %v", path, err)
+	}
+	return nil
+}
+
+// namedFilter matches the name=definition form of a named filter.
+var namedFilter = regexp.MustCompile(`^([a-zA-Z_][a-zA-Z0-9_]*)\s*=([^=].*)$`)
+
+func (d *ConfigurablePlacementRule) addPlacements(definitions []string) error {
+	env := placementEnv()
+	for _, definition := range definitions {
+		definition = strings.TrimSpace(definition)
+		if definition == "" {
+			continue
+		}
+
+		if match := namedFilter.FindStringSubmatch(definition); match != nil {
+			if _, exists := env[match[1]]; exists {
+				return ErrPlacement.New("%q is already defined", match[1])
+			}
+			filters, err := evalPlacement(match[2], env)
+			if err != nil {
+				return ErrPlacement.New("invalid definition of %q: %v", match[1], err)
+			}
+			env[match[1]] = filters
+			continue
+		}
+
+		idDef := strings.SplitN(definition, ":", 2)
+		if len(idDef) != 2 {
+			return ErrPlacement.New("invalid placement %q: expected id:definition or name=definition", definition)
+		}
+		id, err := strconv.ParseUint(strings.TrimSpace(idDef[0]), 10, 16)
+		if err != nil {
+			return ErrPlacement.New("invalid placement id %q", idDef[0])
+		}
+		filters, err := evalPlacement(idDef[1], env)
+		if err != nil {
+			return ErrPlacement.New("invalid definition of placement %d: %v", id, err)
+		}
+		d.placements[storj.PlacementConstraint(id)] = filters
+	}
+	return nil
+}
+
+// evalPlacement evaluates a single definition to filters.
+func evalPlacement(definition string, env map[any]any) (nodeselection.NodeFilters, error) {
+	val, err := mito.Eval(strings.TrimSpace(definition), env)
+	if err != nil {
+		// parser errors include a stack trace after the message.
+		message, _, _ := strings.Cut(err.Error(), "\n")
+		return nil, errs.New("%s", message)
+	}
+	filters, ok := val.(nodeselection.NodeFilters)
+	if !ok {
+		return nil, errs.New("%q is not a placement filter", definition)
+	}
+	return filters, nil
+}
+
+// placementEnv returns the functions and operators of the placement language.
+func placementEnv() map[any]any {
+	return map[any]any{
 		"country": func(countries ...string) (nodeselection.NodeFilters, error) {
 			var set location.Set
+			var excluded []location.CountryCode
 			for _, country := range countries {
-				code := location.ToCountryCode(country)
+				switch country {
+				case "*":
+					set = location.NewFullSet().Without(location.None)
+					continue
+				case "EU":
+					set = setUnion(set, nodeselection.EuCountries)
+					continue
+				case "EEA":
+					set = setUnion(set, nodeselection.EeaCountries)
+					continue
+				}
+
+				exclude := strings.HasPrefix(country, "!")
+				code := location.ToCountryCode(strings.TrimPrefix(country, "!"))
 				if code == location.None {
-					return nil, errs.New("invalid country code %q", code)
+					return nil, errs.New("invalid country code %q", country)
+				}
+				if exclude {
+					excluded = append(excluded, code)
+				} else {
+					set.Include(code)
 				}
-				set.Include(code)
 			}
-			return nodeselection.NodeFilters{nodeselection.NewCountryFilter(set)}, nil
+			return nodeselection.NodeFilters{nodeselection.NewCountryFilter(set.Without(excluded...))}, nil
 		},
 		"all": func(filters ...nodeselection.NodeFilters) (nodeselection.NodeFilters, error) {
 			res := nodeselection.NodeFilters{}
@@ -97,6 +216,12 @@ func (d *ConfigurablePlacementRule) AddPlacementFromString(definitions string) e
 			}
 			return res, nil
 		},
+		"any": func(filters ...nodeselection.NodeFilters) (nodeselection.NodeFilters, error) {
+			return anyFilter(filters...), nil
+		},
+		"none": func() (nodeselection.NodeFilters, error) {
+			return nodeselection.NodeFilters{nodeselection.ExcludeAllFilter{}}, nil
+		},
 		"tag": func(nodeIDstr string, key string, value any) (nodeselection.NodeFilters, error) {
 			nodeID, err := storj.NodeIDFromString(nodeIDstr)
 			if err != nil {
@@ -116,25 +241,97 @@ func (d *ConfigurablePlacementRule) AddPlacementFromString(definitions string) e
 			}
 			return res, nil
 		},
+		"hex": func(value string) ([]byte, error) {
+			return hex.DecodeString(value)
+		},
+		"last_net": func(cidr string) (nodeselection.NodeFilters, error) {
+			filter, err := nodeselection.NewLastNetFilter(cidr)
+			if err != nil {
+				return nil, err
+			}
+			return nodeselection.NodeFilters{filter}, nil
+		},
+		"vetted": func() (nodeselection.NodeFilters, error) {
+			return nodeselection.NodeFilters{nodeselection.VettedFilter{}}, nil
+		},
+		"free_disk": func(size any) (nodeselection.NodeFilters, error) {
+			var bytes int64
+			switch v := size.(type) {
+			case int64:
+				bytes = v
+			case string:
+				parsed, err := memory.ParseString(v)
+				if err != nil {
+					return nil, err
+				}
+				bytes = parsed
+			default:
+				return nil, errs.New("argument of free_disk() should be a number of bytes or a size like \"1TB\"")
+			}
+			return nodeselection.NodeFilters{nodeselection.FreeDiskFilter(bytes)}, nil
+		},
+		mito.OpAnd: func(env map[any]any, a, b any) (any, error) {
+			x, y, err := filterOperands("and", a, b)
+			if err != nil {
+				return nil, err
+			}
+			return append(slices.Clone(x), y...), nil
+		},
+		mito.OpOr: func(env map[any]any, a, b any) (any, error) {
+			x, y, err := filterOperands("or", a, b)
+			if err != nil {
+				return nil, err
+			}
+			return anyFilter(x, y), nil
+		},
+		mito.ModNot: func(env map[any]any, a any) (any, error) {
+			x, _, err := filterOperands("not", a, nodeselection.NodeFilters{})
+			if err != nil {
+				return nil, err
+			}
+			return nodeselection.NodeFilters{nodeselection.NewNotFilter(unwrapFilters(x))}, nil
+		},
 	}
-	for _, definition := range strings.Split(definitions, ";") {
-		definition = strings.TrimSpace(definition)
-		if definition == "" {
+}
+
+// anyFilter creates a filter that matches when any of the filters match.
+// Nested alternatives are flattened.
+func anyFilter(filters ...nodeselection.NodeFilters) nodeselection.NodeFilters {
+	var alternatives nodeselection.OrFilter
+	for _, filter := range filters {
+		unwrapped := unwrapFilters(filter)
+		if nested, ok := unwrapped.(nodeselection.OrFilter); ok {
+			alternatives = append(alternatives, nested...)
 			continue
 		}
-		idDef := strings.SplitN(definition, ":", 2)
+		alternatives = append(alternatives, unwrapped)
+	}
+	return nodeselection.NodeFilters{alternatives}
+}
 
-		val, err := mito.Eval(idDef[1], env)
-		if err != nil {
-			return errs.Wrap(err)
-		}
-		id, err := strconv.Atoi(idDef[0])
-		if err != nil {
-			return errs.Wrap(err)
-		}
-		d.placements[storj.PlacementConstraint(id)] = val.(nodeselection.NodeFilters)
+// unwrapFilters returns the only filter of filters, or filters itself.
+func unwrapFilters(filters nodeselection.NodeFilters) nodeselection.NodeFilter {
+	if len(filters) == 1 {
+		return filters[0]
 	}
-	return nil
+	return filters
+}
+
+// filterOperands checks that both operands of an operator are filters.
+func filterOperands(op string, a, b any) (x, y nodeselection.NodeFilters, err error) {
+	x, aok := a.(nodeselection.NodeFilters)
+	y, bok := b.(nodeselection.NodeFilters)
+	if !aok || !bok {
+		return nil, nil, errs.New("operands of %s should be placement filters", op)
+	}
+	return x, y, nil
+}
+
+func setUnion(a, b location.Set) location.Set {
+	for i := range a {
+		a[i] |= b[i]
+	}
+	return a
 }
 
 // CreateFilters implements PlacementCondition.
@@ -4,12 +4,14 @@
 package overlay
 
 import (
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"storj.io/common/storj"
 	"storj.io/common/storj/location"
+	"storj.io/common/testcontext"
 	"storj.io/storj/satellite/nodeselection"
 )
 
@@ -144,4 +146,103 @@ func TestPlacementFromString(t *testing.T) {
 
 	})
 
+	t.Run("boolean operators", func(t *testing.T) {
+		p := NewPlacementRules()
+		err := p.AddPlacementFromString(`11:country("EU","!DE") || tag("12whfK1EDvHJtajBiAUeajQLYcWqxcQmdYQU5zX5cCf6bAxfgu4","tier","ssd");12:any(country("GB"),country("US")) && not(country("US"))`)
+		require.NoError(t, err)
+
+		ssd := nodeselection.NodeTags{{Signer: signer, Name: "tier", Value: []byte("ssd")}}
+
+		filters := p.placements[storj.PlacementConstraint(11)]
+		require.True(t, filters.MatchInclude(&nodeselection.SelectedNode{CountryCode: location.France}))
+		require.False(t, filters.MatchInclude(&nodeselection.SelectedNode{CountryCode: location.Germany}))
+		require.True(t, filters.MatchInclude(&nodeselection.SelectedNode{CountryCode: location.Germany, Tags: ssd}))
+		require.False(t, filters.MatchInclude(&nodeselection.SelectedNode{CountryCode: location.UnitedStates}))
+
+		filters = p.placements[storj.PlacementConstraint(12)]
+		require.True(t, filters.MatchInclude(&nodeselection.SelectedNode{CountryCode: location.UnitedKingdom}))
+		require.False(t, filters.MatchInclude(&nodeselection.SelectedNode{CountryCode: location.UnitedStates}))
+	})
+
+	t.Run("node attributes", func(t *testing.T) {
+		p := NewPlacementRules()
+		err := p.AddPlacementFromString(`11:vetted() && last_net("10.0.0.0/8") && free_disk("1 GB");12:free_disk(100)`)
+		require.NoError(t, err)
+
+		filters := p.placements[storj.PlacementConstraint(11)]
+		require.True(t, filters.MatchInclude(&nodeselection.SelectedNode{Vetted: true, LastNet: "10.1.2.0", FreeDisk: 1e9}))
+		require.False(t, filters.MatchInclude(&nodeselection.SelectedNode{LastNet: "10.1.2.0", FreeDisk: 1e9}))
+		require.False(t, filters.MatchInclude(&nodeselection.SelectedNode{Vetted: true, LastNet: "11.1.2.0", FreeDisk: 1e9}))
+		require.False(t, filters.MatchInclude(&nodeselection.SelectedNode{Vetted: true, LastNet: "10.1.2.0", FreeDisk: 1e9 - 1}))
+
+		filters = p.placements[storj.PlacementConstraint(12)]
+		require.True(t, filters.MatchInclude(&nodeselection.SelectedNode{FreeDisk: 100}))
+	})
+
+	t.Run("named filters", func(t *testing.T) {
+		p := NewPlacementRules()
+		err := p.AddPlacementFromString(`eu = country("EU");11:eu && !country("DE")`)
+		require.NoError(t, err)
+		require.Len(t, p.placements, 1)
+
+		filters := p.placements[storj.PlacementConstraint(11)]
+		require.True(t, filters.MatchInclude(&nodeselection.SelectedNode{CountryCode: location.France}))
+		require.False(t, filters.MatchInclude(&nodeselection.SelectedNode{CountryCode: location.Germany}))
+
+		err = p.AddPlacementFromString(`country = country("DE")`)
+		require.ErrorContains(t, err, `"country" is already defined`)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for definition, message := range map[string]string{
+			`country("DE")`:         "expected id:definition",
+			`x1:country("DE")`:      `invalid placement id "x1"`,
+			`11:country("DE"`:       "invalid definition of placement 11",
+			`11:unknown()`:          "invalid definition of placement 11",
+			`11:"DE"`:               "is not a placement filter",
+			`11:country("DE") || 1`: "operands of or should be placement filters",
+			`11:last_net("10.0.0")`: "invalid CIDR address",
+		} {
+			err := NewPlacementRules().AddPlacementFromString(definition)
+			require.ErrorContains(t, err, message, definition)
+			require.NotContains(t, err.Error(), "goroutine", definition)
+		}
+	})
+
+	t.Run("from file", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		path := ctx.File("placement.txt")
+		require.NoError(t, os.WriteFile(path, []byte(`
+# placements for the EU
+eu = country("EU")
+11:eu
+12:eu && vetted()
+`), 0644))
+
+		p := NewPlacementRules()
+		require.NoError(t, p.Set(path))
+		require.True(t, p.placements[storj.PlacementConstraint(11)].MatchInclude(&nodeselection.SelectedNode{CountryCode: location.France}))
+		require.False(t, p.placements[storj.PlacementConstraint(12)].MatchInclude(&nodeselection.SelectedNode{CountryCode: location.France}))
+		// legacy rules are added by Set too.
+		require.NotNil(t, p.placements[storj.EU])
+
+		require.NoError(t, os.WriteFile(path, []byte("11:country(\"DE\")\n12:\n"), 0644))
+		require.ErrorContains(t, NewPlacementRules().AddPlacementFromFile(path), "placement.txt")
+	})
+
+	t.Run("string round-trip", func(t *testing.T) {
+		definitions := `10:country("GB");` +
+			`11:any(country("EU"),tag("12whfK1EDvHJtajBiAUeajQLYcWqxcQmdYQU5zX5cCf6bAxfgu4","tier","ssd"));` +
+			`12:all(not(last_net("10.0.0.0/8")),vetted(),free_disk(1000000000));` +
+			`13:country("*","!BY","!RU")`
+
+		p := NewPlacementRules()
+		require.NoError(t, p.Set(definitions))
+		require.Equal(t, definitions, p.String())
+
+		reparsed := NewPlacementRules()
+		require.NoError(t, reparsed.Set(p.String()))
+		require.Equal(t, p.String(), reparsed.String())
+		require.Equal(t, p.placements[storj.NR], reparsed.placements[storj.PlacementConstraint(13)])
+	})
 }
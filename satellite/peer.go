@@ -32,6 +32,7 @@ import (
 	"storj.io/storj/satellite/analytics"
 	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/bucketevents"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/compensation"
 	"storj.io/storj/satellite/console"
@@ -40,6 +41,7 @@ import (
 	"storj.io/storj/satellite/console/dbcleanup"
 	"storj.io/storj/satellite/console/emailreminders"
 	"storj.io/storj/satellite/console/restkeys"
+	"storj.io/storj/satellite/console/usagealerts"
 	"storj.io/storj/satellite/console/userinfo"
 	"storj.io/storj/satellite/contact"
 	"storj.io/storj/satellite/gc/bloomfilter"
@@ -51,6 +53,7 @@ import (
 	"storj.io/storj/satellite/metabase/rangedloop"
 	"storj.io/storj/satellite/metabase/zombiedeletion"
 	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/metainfo/bucketlifecycle"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
 	"storj.io/storj/satellite/nodeapiversion"
 	"storj.io/storj/satellite/nodeevents"
@@ -69,6 +72,7 @@ import (
 	"storj.io/storj/satellite/repair/repairer"
 	"storj.io/storj/satellite/reputation"
 	"storj.io/storj/satellite/revocation"
+	"storj.io/storj/satellite/segmentanalysis"
 	"storj.io/storj/satellite/snopayouts"
 )
 
@@ -162,7 +166,7 @@ type Config struct {
 	Server   server.Config
 	Debug    debug.Config
 
-	Placement overlay.ConfigurablePlacementRule `help:"detailed placement rules in the form 'id:definition;id:definition;...' where id is a 16 bytes integer (use >10 for backward compatibility), definition is a combination of the following functions:country(2 letter country codes,...), tag(nodeId, key, bytes(value)) all(...,...)."`
+	Placement overlay.ConfigurablePlacementRule `help:"detailed placement rules in the form 'id:definition;id:definition;...' or the path of a file with one definition per line, where id is a 16 bytes integer (use >10 for backward compatibility), definition is a combination of the following functions:country(2 letter country codes, EU, EEA, * or !code to exclude,...), tag(nodeId, key, bytes(value)), last_net(cidr), vetted(), free_disk(size), all(...,...), any(...,...), not(...) and the && || ! operators. name=definition defines a filter which can be used by the following definitions."`
 
 	Admin admin.Config
 
@@ -186,10 +190,14 @@ type Config struct {
 	GarbageCollection   sender.Config
 	GarbageCollectionBF bloomfilter.Config
 
-	RangedLoop rangedloop.Config
+	RangedLoop      rangedloop.Config
+	SegmentAnalysis segmentanalysis.Config
 
 	ExpiredDeletion expireddeletion.Config
 	ZombieDeletion  zombiedeletion.Config
+	BucketLifecycle bucketlifecycle.Config
+
+	BucketEvents bucketevents.Config
 
 	Tally            tally.Config
 	Rollup           rollup.Config
@@ -209,6 +217,8 @@ type Config struct {
 
 	AccountFreeze accountfreeze.Config
 
+	UsageAlerts usagealerts.Config
+
 	Version version_checker.Config
 
 	GracefulExit gracefulexit.Config
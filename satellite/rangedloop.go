@@ -25,6 +25,7 @@ import (
 	"storj.io/storj/satellite/metrics"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/repair/checker"
+	"storj.io/storj/satellite/segmentanalysis"
 )
 
 // RangedLoop is the satellite ranged loop process.
@@ -71,7 +72,8 @@ type RangedLoop struct {
 	}
 
 	RangedLoop struct {
-		Service *rangedloop.Service
+		Registry *rangedloop.Registry
+		Service  *rangedloop.Service
 	}
 }
 
@@ -164,6 +166,21 @@ func NewRangedLoop(log *zap.Logger, db DB, metabaseDB *metabase.DB, config *Conf
 		)
 	}
 
+	{ // setup observers which can be enabled from configuration
+		peer.RangedLoop.Registry = rangedloop.NewRegistry()
+
+		placementChecker := checker.NewReliabilityCache(
+			peer.Overlay.Service,
+			config.Checker.ReliabilityCacheStaleness,
+			config.Placement.CreateFilters,
+			config.Checker.RepairExcludedCountryCodes,
+		)
+		err = segmentanalysis.Register(peer.RangedLoop.Registry, log.Named("segmentanalysis"), config.SegmentAnalysis, placementChecker)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+	}
+
 	{ // setup ranged loop
 		observers := []rangedloop.Observer{
 			rangedloop.NewLiveCountObserver(metabaseDB, config.RangedLoop.SuspiciousProcessedRatio, config.RangedLoop.AsOfSystemInterval),
@@ -190,6 +207,12 @@ func NewRangedLoop(log *zap.Logger, db DB, metabaseDB *metabase.DB, config *Conf
 			observers = append(observers, peer.PieceTracker.Observer)
 		}
 
+		registered, err := peer.RangedLoop.Registry.Create(config.RangedLoop.Observers)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		observers = append(observers, registered...)
+
 		segments := rangedloop.NewMetabaseRangeSplitter(metabaseDB, config.RangedLoop.AsOfSystemInterval, config.RangedLoop.BatchSize)
 		peer.RangedLoop.Service = rangedloop.NewService(log.Named("rangedloop"), config.RangedLoop, segments, observers)
 
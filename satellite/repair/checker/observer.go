@@ -5,6 +5,7 @@ package checker
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"sort"
@@ -25,7 +26,7 @@ import (
 	"storj.io/storj/satellite/repair/queue"
 )
 
-var _ rangedloop.Observer = (*Observer)(nil)
+var _ rangedloop.CheckpointObserver = (*Observer)(nil)
 var _ rangedloop.Partial = (*observerFork)(nil)
 
 // Observer implements the ranged loop Observer interface.
@@ -162,6 +163,59 @@ func (observer *Observer) Join(ctx context.Context, partial rangedloop.Partial)
 	return nil
 }
 
+// forkCheckpoint is the persisted state of an observerFork.
+type forkCheckpoint struct {
+	TotalStats aggregateStatsCheckpoint            `json:"total_stats"`
+	RSStats    map[string]aggregateStatsCheckpoint `json:"rs_stats"`
+}
+
+// CheckpointPartial flushes the injured segments found by the partial to the
+// repair queue and returns its stats.
+func (observer *Observer) CheckpointPartial(ctx context.Context, partial rangedloop.Partial) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	repPartial, ok := partial.(*observerFork)
+	if !ok {
+		return nil, Error.New("expected partial type %T but got %T", repPartial, partial)
+	}
+
+	// the buffered segments would be lost when resuming from the checkpoint.
+	if err := repPartial.repairQueue.Flush(ctx); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	saved := forkCheckpoint{
+		TotalStats: repPartial.totalStats.checkpoint(),
+		RSStats:    make(map[string]aggregateStatsCheckpoint, len(repPartial.rsStats)),
+	}
+	for rs, partialStats := range repPartial.rsStats {
+		saved.RSStats[rs] = partialStats.iterationAggregates.checkpoint()
+	}
+
+	data, err := json.Marshal(saved)
+	return data, Error.Wrap(err)
+}
+
+// RestorePartial returns a partial which continues from the checkpointed stats.
+func (observer *Observer) RestorePartial(ctx context.Context, data []byte) (_ rangedloop.Partial, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var saved forkCheckpoint
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	fork := newObserverFork(observer).(*observerFork)
+	fork.totalStats = saved.TotalStats.restore()
+	for rs, stats := range saved.RSStats {
+		fork.rsStats[rs] = &partialRSStats{
+			iterationAggregates: stats.restore(),
+			segmentStats:        observer.getObserverStats(rs).segmentStats,
+		}
+	}
+	return fork, nil
+}
+
 // Finish is called after all segments are processed by all observers.
 func (observer *Observer) Finish(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -178,6 +178,44 @@ func (a *aggregateStats) combine(stats aggregateStats) {
 	a.remoteSegmentsOverThreshold[4] += stats.remoteSegmentsOverThreshold[4]
 }
 
+// aggregateStatsCheckpoint is the persisted form of aggregateStats.
+type aggregateStatsCheckpoint struct {
+	ObjectsChecked                 int64       `json:"objects_checked"`
+	RemoteSegmentsChecked          int64       `json:"remote_segments_checked"`
+	RemoteSegmentsNeedingRepair    int64       `json:"remote_segments_needing_repair"`
+	NewRemoteSegmentsNeedingRepair int64       `json:"new_remote_segments_needing_repair"`
+	RemoteSegmentsLost             int64       `json:"remote_segments_lost"`
+	RemoteSegmentsFailedToCheck    int64       `json:"remote_segments_failed_to_check"`
+	ObjectsLost                    []uuid.UUID `json:"objects_lost"`
+	RemoteSegmentsOverThreshold    [5]int64    `json:"remote_segments_over_threshold"`
+}
+
+func (a *aggregateStats) checkpoint() aggregateStatsCheckpoint {
+	return aggregateStatsCheckpoint{
+		ObjectsChecked:                 a.objectsChecked,
+		RemoteSegmentsChecked:          a.remoteSegmentsChecked,
+		RemoteSegmentsNeedingRepair:    a.remoteSegmentsNeedingRepair,
+		NewRemoteSegmentsNeedingRepair: a.newRemoteSegmentsNeedingRepair,
+		RemoteSegmentsLost:             a.remoteSegmentsLost,
+		RemoteSegmentsFailedToCheck:    a.remoteSegmentsFailedToCheck,
+		ObjectsLost:                    a.objectsLost,
+		RemoteSegmentsOverThreshold:    a.remoteSegmentsOverThreshold,
+	}
+}
+
+func (saved *aggregateStatsCheckpoint) restore() aggregateStats {
+	return aggregateStats{
+		objectsChecked:                 saved.ObjectsChecked,
+		remoteSegmentsChecked:          saved.RemoteSegmentsChecked,
+		remoteSegmentsNeedingRepair:    saved.RemoteSegmentsNeedingRepair,
+		newRemoteSegmentsNeedingRepair: saved.NewRemoteSegmentsNeedingRepair,
+		remoteSegmentsLost:             saved.RemoteSegmentsLost,
+		remoteSegmentsFailedToCheck:    saved.RemoteSegmentsFailedToCheck,
+		objectsLost:                    saved.ObjectsLost,
+		remoteSegmentsOverThreshold:    saved.RemoteSegmentsOverThreshold,
+	}
+}
+
 func getRSString(min, repair, success, total int) string {
 	return fmt.Sprintf("%d/%d/%d/%d", min, repair, success, total)
 }
@@ -0,0 +1,217 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/storj/satellite/console"
+)
+
+// ensures that auditEvents implements console.AuditEvents.
+var _ console.AuditEvents = (*auditEvents)(nil)
+
+// auditEvents is an implementation of console.AuditEvents.
+type auditEvents struct {
+	db *satelliteDB
+}
+
+// Insert is a method for inserting an audit event into the database.
+func (events *auditEvents) Insert(ctx context.Context, event *console.AuditEvent) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if event == nil {
+		return Error.New("event is nil")
+	}
+
+	_, err = events.db.ExecContext(ctx, `
+		INSERT INTO console_audit_events (
+			id, created_at, operation, user_id, email, source_ip, forwarded_for_ip, request_id, project_id, api_key_id
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`, event.ID, event.CreatedAt, event.Operation, nullUUID(event.UserID), event.Email,
+		event.SourceIP, event.ForwardedForIP, event.RequestID, nullUUID(event.ProjectID), nullUUID(event.APIKeyID))
+	return Error.Wrap(err)
+}
+
+// InsertBatch is a method for inserting multiple audit events into the database at once.
+func (events *auditEvents) InsertBatch(ctx context.Context, batch []console.AuditEvent) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(batch) == 0 {
+		return nil
+	}
+
+	var (
+		ids             = make([]uuid.UUID, len(batch))
+		createdAts      = make([]time.Time, len(batch))
+		operations      = make([]string, len(batch))
+		userIDs         = make([][]byte, len(batch))
+		emails          = make([]string, len(batch))
+		sourceIPs       = make([]string, len(batch))
+		forwardedForIPs = make([]string, len(batch))
+		requestIDs      = make([]string, len(batch))
+		projectIDs      = make([][]byte, len(batch))
+		apiKeyIDs       = make([][]byte, len(batch))
+	)
+	for i, event := range batch {
+		ids[i] = event.ID
+		createdAts[i] = event.CreatedAt
+		operations[i] = event.Operation
+		userIDs[i] = uuidBytesOrNil(event.UserID)
+		emails[i] = event.Email
+		sourceIPs[i] = event.SourceIP
+		forwardedForIPs[i] = event.ForwardedForIP
+		requestIDs[i] = event.RequestID
+		projectIDs[i] = uuidBytesOrNil(event.ProjectID)
+		apiKeyIDs[i] = uuidBytesOrNil(event.APIKeyID)
+	}
+
+	_, err = events.db.ExecContext(ctx, `
+		INSERT INTO console_audit_events (
+			id, created_at, operation, user_id, email, source_ip, forwarded_for_ip, request_id, project_id, api_key_id
+		) SELECT
+			unnest($1::bytea[]), unnest($2::timestamptz[]), unnest($3::text[]), unnest($4::bytea[]), unnest($5::text[]),
+			unnest($6::text[]), unnest($7::text[]), unnest($8::text[]), unnest($9::bytea[]), unnest($10::bytea[])
+	`, pgutil.UUIDArray(ids), pgutil.TimestampTZArray(createdAts), pgutil.TextArray(operations), pgutil.NullByteaArray(userIDs),
+		pgutil.TextArray(emails), pgutil.TextArray(sourceIPs), pgutil.TextArray(forwardedForIPs), pgutil.TextArray(requestIDs),
+		pgutil.NullByteaArray(projectIDs), pgutil.NullByteaArray(apiKeyIDs))
+	return Error.Wrap(err)
+}
+
+// GetPaged is a method for querying the audit events matching the cursor, newest first.
+func (events *auditEvents) GetPaged(ctx context.Context, cursor console.AuditEventsCursor) (_ *console.AuditEventsPage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if cursor.Limit > 1000 {
+		cursor.Limit = 1000
+	}
+	if cursor.Limit == 0 {
+		return nil, Error.New("limit can not be 0")
+	}
+	if cursor.Page == 0 {
+		return nil, Error.New("page can not be 0")
+	}
+
+	page := &console.AuditEventsPage{
+		Limit:       cursor.Limit,
+		CurrentPage: cursor.Page,
+		Offset:      uint64((cursor.Page - 1) * cursor.Limit),
+	}
+
+	// Zero values disable the filters, so a single query covers every combination of them.
+	filter := `
+		WHERE ($1::bytea IS NULL OR project_id = $1 OR project_id = (SELECT public_id FROM projects WHERE id = $1))
+		AND ($2::bytea IS NULL OR user_id = $2)
+		AND ($3::timestamptz IS NULL OR created_at >= $3)
+		AND ($4::timestamptz IS NULL OR created_at < $4)
+	`
+	args := []interface{}{nullUUID(cursor.ProjectID), nullUUID(cursor.UserID), nullTime(cursor.Since), nullTime(cursor.Before)}
+
+	err = events.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM console_audit_events `+filter, args...).Scan(&page.TotalCount)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	page.PageCount = uint((page.TotalCount + uint64(cursor.Limit) - 1) / uint64(cursor.Limit))
+	if page.Offset >= page.TotalCount {
+		return page, nil
+	}
+
+	rows, err := events.db.QueryContext(ctx, `
+		SELECT id, created_at, operation, user_id, email, source_ip, forwarded_for_ip, request_id, project_id, api_key_id
+		FROM console_audit_events
+		`+filter+`
+		ORDER BY created_at DESC, id
+		LIMIT $5 OFFSET $6
+	`, append(args, page.Limit, page.Offset)...)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(rows.Close())) }()
+
+	for rows.Next() {
+		var event console.AuditEvent
+		var userID, projectID, apiKeyID uuid.NullUUID
+		err := rows.Scan(&event.ID, &event.CreatedAt, &event.Operation, &userID, &event.Email,
+			&event.SourceIP, &event.ForwardedForIP, &event.RequestID, &projectID, &apiKeyID)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		event.UserID = uuidOrNil(userID)
+		event.ProjectID = uuidOrNil(projectID)
+		event.APIKeyID = uuidOrNil(apiKeyID)
+		page.Events = append(page.Events, event)
+	}
+
+	return page, Error.Wrap(rows.Err())
+}
+
+// DeleteBefore is a method for deleting the audit events created before the given time.
+func (events *auditEvents) DeleteBefore(ctx context.Context, before time.Time, asOfSystemTimeInterval time.Duration, pageSize int) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if pageSize <= 0 {
+		return Error.New("expected page size to be positive; got %d", pageSize)
+	}
+
+	aost := events.db.impl.AsOfSystemInterval(asOfSystemTimeInterval)
+	for {
+		result, err := events.db.ExecContext(ctx, `
+			DELETE FROM console_audit_events
+			WHERE id IN (
+				SELECT id FROM console_audit_events
+				`+aost+`
+				WHERE created_at < $1
+				LIMIT $2
+			)
+		`, before, pageSize)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		deleted, err := result.RowsAffected()
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if deleted < int64(pageSize) {
+			return nil
+		}
+	}
+}
+
+// nullUUID converts an optional UUID to a nullable database value.
+func nullUUID(id *uuid.UUID) uuid.NullUUID {
+	if id == nil {
+		return uuid.NullUUID{}
+	}
+	return uuid.NullUUID{UUID: *id, Valid: true}
+}
+
+// uuidBytesOrNil converts an optional UUID to its bytes, nil becomes NULL in the database.
+func uuidBytesOrNil(id *uuid.UUID) []byte {
+	if id == nil {
+		return nil
+	}
+	return id.Bytes()
+}
+
+// uuidOrNil converts a nullable database value to an optional UUID.
+func uuidOrNil(id uuid.NullUUID) *uuid.UUID {
+	if !id.Valid {
+		return nil
+	}
+	return &id.UUID
+}
+
+// nullTime converts the zero time to NULL.
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
@@ -6,6 +6,7 @@ package satellitedb
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 
 	"github.com/zeebo/errs"
@@ -33,6 +34,9 @@ func (db *bucketsDB) CreateBucket(ctx context.Context, bucket buckets.Bucket) (_
 		}
 	}
 	optionalFields.Placement = dbx.BucketMetainfo_Placement(int(bucket.Placement))
+	optionalFields.Versioning = dbx.BucketMetainfo_Versioning(int(bucket.Versioning))
+	optionalFields.DefaultRetentionMode = dbx.BucketMetainfo_DefaultRetentionMode(int(bucket.DefaultRetention.Mode))
+	optionalFields.DefaultRetentionDays = dbx.BucketMetainfo_DefaultRetentionDays(bucket.DefaultRetention.Days)
 
 	row, err := db.db.Create_BucketMetainfo(ctx,
 		dbx.BucketMetainfo_Id(bucket.ID[:]),
@@ -98,6 +102,176 @@ func (db *bucketsDB) GetBucketPlacement(ctx context.Context, bucketName []byte,
 	return placement, nil
 }
 
+// GetBucketVersioning returns the versioning state of the bucket.
+func (db *bucketsDB) GetBucketVersioning(ctx context.Context, bucketName []byte, projectID uuid.UUID) (versioning buckets.Versioning, err error) {
+	defer mon.Task()(&ctx)(&err)
+	dbxVersioning, err := db.db.Get_BucketMetainfo_Versioning_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return buckets.Unversioned, buckets.ErrBucketNotFound.New("%s", bucketName)
+		}
+		return buckets.Unversioned, buckets.ErrBucket.Wrap(err)
+	}
+	if dbxVersioning.Versioning != nil {
+		versioning = buckets.Versioning(*dbxVersioning.Versioning)
+	}
+
+	return versioning, nil
+}
+
+// GetBucketLifecycle returns the lifecycle configuration of the bucket.
+func (db *bucketsDB) GetBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID) (lifecycle buckets.Lifecycle, err error) {
+	defer mon.Task()(&ctx)(&err)
+	dbxLifecycle, err := db.db.Get_BucketMetainfo_LifecycleConfiguration_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return buckets.Lifecycle{}, buckets.ErrBucketNotFound.New("%s", bucketName)
+		}
+		return buckets.Lifecycle{}, buckets.ErrBucket.Wrap(err)
+	}
+	if len(dbxLifecycle.LifecycleConfiguration) > 0 {
+		if err := json.Unmarshal(dbxLifecycle.LifecycleConfiguration, &lifecycle); err != nil {
+			return buckets.Lifecycle{}, buckets.ErrBucket.Wrap(err)
+		}
+	}
+
+	return lifecycle, nil
+}
+
+// SetBucketLifecycle replaces the lifecycle configuration of the bucket.
+func (db *bucketsDB) SetBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID, lifecycle buckets.Lifecycle) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	configuration := dbx.BucketMetainfo_LifecycleConfiguration_Null()
+	if len(lifecycle.Rules) > 0 {
+		data, err := json.Marshal(lifecycle)
+		if err != nil {
+			return buckets.ErrBucket.Wrap(err)
+		}
+		configuration = dbx.BucketMetainfo_LifecycleConfiguration(data)
+	}
+
+	dbxBucket, err := db.db.Update_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+		dbx.BucketMetainfo_Update_Fields{
+			LifecycleConfiguration: configuration,
+		})
+	if err != nil {
+		return buckets.ErrBucket.Wrap(err)
+	}
+	if dbxBucket == nil {
+		return buckets.ErrBucketNotFound.New("%s", bucketName)
+	}
+	return nil
+}
+
+// GetBucketNotifications returns the notification configuration of the bucket.
+func (db *bucketsDB) GetBucketNotifications(ctx context.Context, bucketName []byte, projectID uuid.UUID) (configuration buckets.NotificationConfiguration, err error) {
+	defer mon.Task()(&ctx)(&err)
+	dbxConfiguration, err := db.db.Get_BucketMetainfo_NotificationConfiguration_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return buckets.NotificationConfiguration{}, buckets.ErrBucketNotFound.New("%s", bucketName)
+		}
+		return buckets.NotificationConfiguration{}, buckets.ErrBucket.Wrap(err)
+	}
+	if len(dbxConfiguration.NotificationConfiguration) > 0 {
+		if err := json.Unmarshal(dbxConfiguration.NotificationConfiguration, &configuration); err != nil {
+			return buckets.NotificationConfiguration{}, buckets.ErrBucket.Wrap(err)
+		}
+	}
+
+	return configuration, nil
+}
+
+// SetBucketNotifications replaces the notification configuration of the bucket.
+func (db *bucketsDB) SetBucketNotifications(ctx context.Context, bucketName []byte, projectID uuid.UUID, configuration buckets.NotificationConfiguration) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	value := dbx.BucketMetainfo_NotificationConfiguration_Null()
+	if len(configuration.Webhooks) > 0 {
+		data, err := json.Marshal(configuration)
+		if err != nil {
+			return buckets.ErrBucket.Wrap(err)
+		}
+		value = dbx.BucketMetainfo_NotificationConfiguration(data)
+	}
+
+	dbxBucket, err := db.db.Update_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+		dbx.BucketMetainfo_Update_Fields{
+			NotificationConfiguration: value,
+		})
+	if err != nil {
+		return buckets.ErrBucket.Wrap(err)
+	}
+	if dbxBucket == nil {
+		return buckets.ErrBucketNotFound.New("%s", bucketName)
+	}
+	return nil
+}
+
+// GetBucketRateLimits returns the rate limits overrides of the bucket.
+func (db *bucketsDB) GetBucketRateLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID) (limits buckets.RateLimits, err error) {
+	defer mon.Task()(&ctx)(&err)
+	dbxLimits, err := db.db.Get_BucketMetainfo_RateLimits_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return buckets.RateLimits{}, buckets.ErrBucketNotFound.New("%s", bucketName)
+		}
+		return buckets.RateLimits{}, buckets.ErrBucket.Wrap(err)
+	}
+	if len(dbxLimits.RateLimits) > 0 {
+		if err := json.Unmarshal(dbxLimits.RateLimits, &limits); err != nil {
+			return buckets.RateLimits{}, buckets.ErrBucket.Wrap(err)
+		}
+	}
+
+	return limits, nil
+}
+
+// SetBucketRateLimits replaces the rate limits overrides of the bucket.
+func (db *bucketsDB) SetBucketRateLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID, limits buckets.RateLimits) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	value := dbx.BucketMetainfo_RateLimits_Null()
+	if !limits.IsZero() {
+		data, err := json.Marshal(limits)
+		if err != nil {
+			return buckets.ErrBucket.Wrap(err)
+		}
+		value = dbx.BucketMetainfo_RateLimits(data)
+	}
+
+	dbxBucket, err := db.db.Update_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+		dbx.BucketMetainfo_Update_Fields{
+			RateLimits: value,
+		})
+	if err != nil {
+		return buckets.ErrBucket.Wrap(err)
+	}
+	if dbxBucket == nil {
+		return buckets.ErrBucketNotFound.New("%s", bucketName)
+	}
+	return nil
+}
+
 // GetMinimalBucket returns existing bucket with minimal number of fields.
 func (db *bucketsDB) GetMinimalBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ buckets.MinimalBucket, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -160,6 +334,9 @@ func (db *bucketsDB) UpdateBucket(ctx context.Context, bucket buckets.Bucket) (_
 	}
 
 	updateFields.Placement = dbx.BucketMetainfo_Placement(int(bucket.Placement))
+	updateFields.Versioning = dbx.BucketMetainfo_Versioning(int(bucket.Versioning))
+	updateFields.DefaultRetentionMode = dbx.BucketMetainfo_DefaultRetentionMode(int(bucket.DefaultRetention.Mode))
+	updateFields.DefaultRetentionDays = dbx.BucketMetainfo_DefaultRetentionDays(bucket.DefaultRetention.Days)
 
 	dbxBucket, err := db.db.Update_BucketMetainfo_By_ProjectId_And_Name(ctx, dbx.BucketMetainfo_ProjectId(bucket.ProjectID[:]), dbx.BucketMetainfo_Name([]byte(bucket.Name)), updateFields)
 	if err != nil {
@@ -319,6 +496,18 @@ func convertDBXtoBucket(dbxBucket *dbx.BucketMetainfo) (bucket buckets.Bucket, e
 		bucket.Placement = storj.PlacementConstraint(*dbxBucket.Placement)
 	}
 
+	if dbxBucket.Versioning != nil {
+		bucket.Versioning = buckets.Versioning(*dbxBucket.Versioning)
+	}
+
+	if dbxBucket.DefaultRetentionMode != nil {
+		bucket.DefaultRetention.Mode = metabase.RetentionMode(*dbxBucket.DefaultRetentionMode)
+	}
+
+	if dbxBucket.DefaultRetentionDays != nil {
+		bucket.DefaultRetention.Days = *dbxBucket.DefaultRetentionDays
+	}
+
 	if dbxBucket.UserAgent != nil {
 		bucket.UserAgent = dbxBucket.UserAgent
 	}
@@ -371,3 +560,52 @@ func (db *bucketsDB) IterateBucketLocations(ctx context.Context, projectID uuid.
 
 	return false, Error.Wrap(fn(result))
 }
+
+// IterateBucketLifecycles iterates through the buckets which have lifecycle rules, starting after the bucket location.
+func (db *bucketsDB) IterateBucketLifecycles(ctx context.Context, after metabase.BucketLocation, limit int, fn func([]buckets.BucketLifecycle) error) (more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var result []buckets.BucketLifecycle
+
+	moreLimit := limit + 1
+	rows, err := db.db.QueryContext(ctx, `
+			SELECT project_id, name, lifecycle_configuration, coalesce(versioning, 0)
+			FROM bucket_metainfos
+			WHERE (project_id, name) > ($1, $2) AND lifecycle_configuration IS NOT NULL
+			ORDER BY (project_id, name) ASC LIMIT $3
+	`, after.ProjectID, []byte(after.BucketName), moreLimit)
+	if err != nil {
+		return false, buckets.ErrBucket.New("IterateBucketLifecycles query error: %s", err)
+	}
+	defer func() {
+		err = errs.Combine(err, Error.Wrap(rows.Close()))
+	}()
+
+	for rows.Next() {
+		var bucket buckets.BucketLifecycle
+		var configuration []byte
+
+		if err = rows.Scan(&bucket.Bucket.ProjectID, &bucket.Bucket.BucketName, &configuration, &bucket.Versioning); err != nil {
+			return false, buckets.ErrBucket.New("bucket lifecycle scan error: %s", err)
+		}
+		if err = json.Unmarshal(configuration, &bucket.Lifecycle); err != nil {
+			return false, buckets.ErrBucket.New("invalid lifecycle configuration for %s: %s", bucket.Bucket.BucketName, err)
+		}
+
+		result = append(result, bucket)
+	}
+
+	if err = rows.Err(); err != nil {
+		return false, buckets.ErrBucket.Wrap(err)
+	}
+
+	if len(result) == 0 {
+		return false, nil
+	}
+
+	if len(result) > limit {
+		return true, Error.Wrap(fn(result[:len(result)-1]))
+	}
+
+	return false, Error.Wrap(fn(result))
+}
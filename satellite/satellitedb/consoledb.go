@@ -86,6 +86,31 @@ func (db *ConsoleDB) AccountFreezeEvents() console.AccountFreezeEvents {
 	return &accountFreezeEvents{db.db}
 }
 
+// AuditEvents is a getter for AuditEvents repository.
+func (db *ConsoleDB) AuditEvents() console.AuditEvents {
+	return &auditEvents{db.db}
+}
+
+// SSOIdentities is a getter for SSOIdentities repository.
+func (db *ConsoleDB) SSOIdentities() console.SSOIdentities {
+	return &ssoIdentities{db.db}
+}
+
+// WebAuthnCredentials is a getter for WebAuthnCredentials repository.
+func (db *ConsoleDB) WebAuthnCredentials() console.WebAuthnCredentials {
+	return &webAuthnCredentials{db.db}
+}
+
+// WebAuthnChallenges is a getter for WebAuthnChallenges repository.
+func (db *ConsoleDB) WebAuthnChallenges() console.WebAuthnChallenges {
+	return &webAuthnChallenges{db.db}
+}
+
+// ProjectUsageAlerts is a getter for ProjectUsageAlerts repository.
+func (db *ConsoleDB) ProjectUsageAlerts() console.ProjectUsageAlerts {
+	return &projectUsageAlerts{db.db}
+}
+
 // WithTx is a method for executing and retrying transaction.
 func (db *ConsoleDB) WithTx(ctx context.Context, fn func(context.Context, console.DBTx) error) error {
 	if db.db == nil {
@@ -411,6 +411,19 @@ CREATE TABLE coinpayments_transactions (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE console_audit_events (
+	id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	operation text NOT NULL,
+	user_id bytea,
+	email text NOT NULL,
+	source_ip text NOT NULL,
+	forwarded_for_ip text NOT NULL,
+	request_id text NOT NULL,
+	project_id bytea,
+	api_key_id bytea,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE graceful_exit_progress (
 	node_id bytea NOT NULL,
 	bytes_transferred bigint NOT NULL,
@@ -640,6 +653,13 @@ CREATE TABLE segment_pending_audits (
 	reverify_count bigint NOT NULL,
 	PRIMARY KEY ( node_id )
 );
+CREATE TABLE sso_identities (
+	provider text NOT NULL,
+	subject text NOT NULL,
+	user_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( provider, subject )
+);
 CREATE TABLE storagenode_bandwidth_rollups (
 	storagenode_id bytea NOT NULL,
 	interval_start timestamp with time zone NOT NULL,
@@ -824,6 +844,23 @@ CREATE TABLE webapp_sessions (
 	expires_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE webauthn_challenges (
+	nonce bytea NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( nonce )
+);
+CREATE TABLE webauthn_credentials (
+	id bytea NOT NULL,
+	user_id bytea NOT NULL,
+	name text NOT NULL,
+	credential_id bytea NOT NULL,
+	public_key bytea NOT NULL,
+	sign_count bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	last_used_at timestamp with time zone,
+	PRIMARY KEY ( id ),
+	UNIQUE ( user_id, credential_id )
+);
 CREATE TABLE api_keys (
 	id bytea NOT NULL,
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
@@ -853,6 +890,12 @@ CREATE TABLE bucket_metainfos (
 	default_redundancy_optimal_shares integer NOT NULL,
 	default_redundancy_total_shares integer NOT NULL,
 	placement integer,
+	versioning integer,
+	default_retention_mode integer,
+	default_retention_days integer,
+	lifecycle_configuration bytea,
+	notification_configuration bytea,
+	rate_limits bytea,
 	PRIMARY KEY ( id ),
 	UNIQUE ( project_id, name )
 );
@@ -861,14 +904,28 @@ CREATE TABLE project_invitations (
 	email text NOT NULL,
 	inviter_id bytea REFERENCES users( id ) ON DELETE SET NULL,
 	created_at timestamp with time zone NOT NULL,
+	role integer,
 	PRIMARY KEY ( project_id, email )
 );
 CREATE TABLE project_members (
 	member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
 	created_at timestamp with time zone NOT NULL,
+	role integer,
 	PRIMARY KEY ( member_id, project_id )
 );
+CREATE TABLE project_usage_alerts (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	kind text NOT NULL,
+	threshold bigint NOT NULL,
+	webhook_url text,
+	notified_at timestamp with time zone,
+	webhook_delivered_at timestamp with time zone,
+	webhook_attempts integer NOT NULL DEFAULT 0,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE stripecoinpayments_apply_balance_intents (
 	tx_id text NOT NULL REFERENCES coinpayments_transactions( id ) ON DELETE CASCADE,
 	state integer NOT NULL,
@@ -882,6 +939,9 @@ CREATE INDEX bucket_bandwidth_rollups_action_interval_project_id_index ON bucket
 CREATE INDEX bucket_bandwidth_rollups_archive_project_id_action_interval_index ON bucket_bandwidth_rollup_archives ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_archive_action_interval_project_id_index ON bucket_bandwidth_rollup_archives ( action, interval_start, project_id ) ;
 CREATE INDEX bucket_storage_tallies_project_id_interval_start_index ON bucket_storage_tallies ( project_id, interval_start ) ;
+CREATE INDEX console_audit_events_project_id_created_at_index ON console_audit_events ( project_id, created_at ) ;
+CREATE INDEX console_audit_events_user_id_created_at_index ON console_audit_events ( user_id, created_at ) ;
+CREATE INDEX console_audit_events_created_at_index ON console_audit_events ( created_at ) ;
 CREATE INDEX graceful_exit_segment_transfer_nid_dr_qa_fa_lfa_index ON graceful_exit_segment_transfer_queue ( node_id, durability_ratio, queued_at, finished_at, last_failed_at ) ;
 CREATE INDEX node_last_ip ON nodes ( last_net ) ;
 CREATE INDEX nodes_dis_unk_off_exit_fin_last_success_index ON nodes ( disqualified, unknown_audit_suspended, offline_suspended, exit_finished_at, last_contact_success ) ;
@@ -899,6 +959,7 @@ CREATE INDEX project_bandwidth_daily_rollup_interval_day_index ON project_bandwi
 CREATE INDEX repair_queue_updated_at_index ON repair_queue ( updated_at ) ;
 CREATE INDEX repair_queue_num_healthy_pieces_attempted_at_index ON repair_queue ( segment_health, attempted_at ) ;
 CREATE INDEX reverification_audits_inserted_at_index ON reverification_audits ( inserted_at ) ;
+CREATE INDEX sso_identities_user_id_index ON sso_identities ( user_id ) ;
 CREATE INDEX storagenode_bandwidth_rollups_interval_start_index ON storagenode_bandwidth_rollups ( interval_start ) ;
 CREATE INDEX storagenode_bandwidth_rollup_archives_interval_start_index ON storagenode_bandwidth_rollup_archives ( interval_start ) ;
 CREATE INDEX storagenode_payments_node_id_period_index ON storagenode_payments ( node_id, period ) ;
@@ -910,7 +971,8 @@ CREATE INDEX users_email_status_index ON users ( normalized_email, status ) ;
 CREATE INDEX webapp_sessions_user_id_index ON webapp_sessions ( user_id ) ;
 CREATE INDEX project_invitations_project_id_index ON project_invitations ( project_id ) ;
 CREATE INDEX project_invitations_email_index ON project_invitations ( email ) ;
-CREATE INDEX project_members_project_id_index ON project_members ( project_id ) ;`
+CREATE INDEX project_members_project_id_index ON project_members ( project_id ) ;
+CREATE INDEX project_usage_alerts_project_id_index ON project_usage_alerts ( project_id ) ;`
 }
 
 func (obj *pgxDB) wrapTx(tx tagsql.Tx) txMethods {
@@ -1102,6 +1164,19 @@ CREATE TABLE coinpayments_transactions (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE console_audit_events (
+	id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	operation text NOT NULL,
+	user_id bytea,
+	email text NOT NULL,
+	source_ip text NOT NULL,
+	forwarded_for_ip text NOT NULL,
+	request_id text NOT NULL,
+	project_id bytea,
+	api_key_id bytea,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE graceful_exit_progress (
 	node_id bytea NOT NULL,
 	bytes_transferred bigint NOT NULL,
@@ -1331,6 +1406,13 @@ CREATE TABLE segment_pending_audits (
 	reverify_count bigint NOT NULL,
 	PRIMARY KEY ( node_id )
 );
+CREATE TABLE sso_identities (
+	provider text NOT NULL,
+	subject text NOT NULL,
+	user_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( provider, subject )
+);
 CREATE TABLE storagenode_bandwidth_rollups (
 	storagenode_id bytea NOT NULL,
 	interval_start timestamp with time zone NOT NULL,
@@ -1515,6 +1597,23 @@ CREATE TABLE webapp_sessions (
 	expires_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE webauthn_challenges (
+	nonce bytea NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( nonce )
+);
+CREATE TABLE webauthn_credentials (
+	id bytea NOT NULL,
+	user_id bytea NOT NULL,
+	name text NOT NULL,
+	credential_id bytea NOT NULL,
+	public_key bytea NOT NULL,
+	sign_count bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	last_used_at timestamp with time zone,
+	PRIMARY KEY ( id ),
+	UNIQUE ( user_id, credential_id )
+);
 CREATE TABLE api_keys (
 	id bytea NOT NULL,
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
@@ -1544,6 +1643,12 @@ CREATE TABLE bucket_metainfos (
 	default_redundancy_optimal_shares integer NOT NULL,
 	default_redundancy_total_shares integer NOT NULL,
 	placement integer,
+	versioning integer,
+	default_retention_mode integer,
+	default_retention_days integer,
+	lifecycle_configuration bytea,
+	notification_configuration bytea,
+	rate_limits bytea,
 	PRIMARY KEY ( id ),
 	UNIQUE ( project_id, name )
 );
@@ -1552,14 +1657,28 @@ CREATE TABLE project_invitations (
 	email text NOT NULL,
 	inviter_id bytea REFERENCES users( id ) ON DELETE SET NULL,
 	created_at timestamp with time zone NOT NULL,
+	role integer,
 	PRIMARY KEY ( project_id, email )
 );
 CREATE TABLE project_members (
 	member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
 	created_at timestamp with time zone NOT NULL,
+	role integer,
 	PRIMARY KEY ( member_id, project_id )
 );
+CREATE TABLE project_usage_alerts (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	kind text NOT NULL,
+	threshold bigint NOT NULL,
+	webhook_url text,
+	notified_at timestamp with time zone,
+	webhook_delivered_at timestamp with time zone,
+	webhook_attempts integer NOT NULL DEFAULT 0,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE stripecoinpayments_apply_balance_intents (
 	tx_id text NOT NULL REFERENCES coinpayments_transactions( id ) ON DELETE CASCADE,
 	state integer NOT NULL,
@@ -1573,6 +1692,9 @@ CREATE INDEX bucket_bandwidth_rollups_action_interval_project_id_index ON bucket
 CREATE INDEX bucket_bandwidth_rollups_archive_project_id_action_interval_index ON bucket_bandwidth_rollup_archives ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_archive_action_interval_project_id_index ON bucket_bandwidth_rollup_archives ( action, interval_start, project_id ) ;
 CREATE INDEX bucket_storage_tallies_project_id_interval_start_index ON bucket_storage_tallies ( project_id, interval_start ) ;
+CREATE INDEX console_audit_events_project_id_created_at_index ON console_audit_events ( project_id, created_at ) ;
+CREATE INDEX console_audit_events_user_id_created_at_index ON console_audit_events ( user_id, created_at ) ;
+CREATE INDEX console_audit_events_created_at_index ON console_audit_events ( created_at ) ;
 CREATE INDEX graceful_exit_segment_transfer_nid_dr_qa_fa_lfa_index ON graceful_exit_segment_transfer_queue ( node_id, durability_ratio, queued_at, finished_at, last_failed_at ) ;
 CREATE INDEX node_last_ip ON nodes ( last_net ) ;
 CREATE INDEX nodes_dis_unk_off_exit_fin_last_success_index ON nodes ( disqualified, unknown_audit_suspended, offline_suspended, exit_finished_at, last_contact_success ) ;
@@ -1590,6 +1712,7 @@ CREATE INDEX project_bandwidth_daily_rollup_interval_day_index ON project_bandwi
 CREATE INDEX repair_queue_updated_at_index ON repair_queue ( updated_at ) ;
 CREATE INDEX repair_queue_num_healthy_pieces_attempted_at_index ON repair_queue ( segment_health, attempted_at ) ;
 CREATE INDEX reverification_audits_inserted_at_index ON reverification_audits ( inserted_at ) ;
+CREATE INDEX sso_identities_user_id_index ON sso_identities ( user_id ) ;
 CREATE INDEX storagenode_bandwidth_rollups_interval_start_index ON storagenode_bandwidth_rollups ( interval_start ) ;
 CREATE INDEX storagenode_bandwidth_rollup_archives_interval_start_index ON storagenode_bandwidth_rollup_archives ( interval_start ) ;
 CREATE INDEX storagenode_payments_node_id_period_index ON storagenode_payments ( node_id, period ) ;
@@ -1601,7 +1724,8 @@ CREATE INDEX users_email_status_index ON users ( normalized_email, status ) ;
 CREATE INDEX webapp_sessions_user_id_index ON webapp_sessions ( user_id ) ;
 CREATE INDEX project_invitations_project_id_index ON project_invitations ( project_id ) ;
 CREATE INDEX project_invitations_email_index ON project_invitations ( email ) ;
-CREATE INDEX project_members_project_id_index ON project_members ( project_id ) ;`
+CREATE INDEX project_members_project_id_index ON project_members ( project_id ) ;
+CREATE INDEX project_usage_alerts_project_id_index ON project_usage_alerts ( project_id ) ;`
 }
 
 func (obj *pgxcockroachDB) wrapTx(tx tagsql.Tx) txMethods {
@@ -3093,6 +3217,262 @@ func (f CoinpaymentsTransaction_CreatedAt_Field) value() interface{} {
 
 func (CoinpaymentsTransaction_CreatedAt_Field) _Column() string { return "created_at" }
 
+type ConsoleAuditEvent struct {
+	Id             []byte
+	CreatedAt      time.Time
+	Operation      string
+	UserId         *[]byte
+	Email          string
+	SourceIp       string
+	ForwardedForIp string
+	RequestId      string
+	ProjectId      *[]byte
+	ApiKeyId       *[]byte
+}
+
+func (ConsoleAuditEvent) _Table() string { return "console_audit_events" }
+
+type ConsoleAuditEvent_Create_Fields struct {
+	CreatedAt ConsoleAuditEvent_CreatedAt_Field
+	UserId    ConsoleAuditEvent_UserId_Field
+	ProjectId ConsoleAuditEvent_ProjectId_Field
+	ApiKeyId  ConsoleAuditEvent_ApiKeyId_Field
+}
+
+type ConsoleAuditEvent_Update_Fields struct {
+}
+
+type ConsoleAuditEvent_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ConsoleAuditEvent_Id(v []byte) ConsoleAuditEvent_Id_Field {
+	return ConsoleAuditEvent_Id_Field{_set: true, _value: v}
+}
+
+func (f ConsoleAuditEvent_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ConsoleAuditEvent_Id_Field) _Column() string { return "id" }
+
+type ConsoleAuditEvent_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ConsoleAuditEvent_CreatedAt(v time.Time) ConsoleAuditEvent_CreatedAt_Field {
+	return ConsoleAuditEvent_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ConsoleAuditEvent_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ConsoleAuditEvent_CreatedAt_Field) _Column() string { return "created_at" }
+
+type ConsoleAuditEvent_Operation_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ConsoleAuditEvent_Operation(v string) ConsoleAuditEvent_Operation_Field {
+	return ConsoleAuditEvent_Operation_Field{_set: true, _value: v}
+}
+
+func (f ConsoleAuditEvent_Operation_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ConsoleAuditEvent_Operation_Field) _Column() string { return "operation" }
+
+type ConsoleAuditEvent_UserId_Field struct {
+	_set   bool
+	_null  bool
+	_value *[]byte
+}
+
+func ConsoleAuditEvent_UserId(v []byte) ConsoleAuditEvent_UserId_Field {
+	return ConsoleAuditEvent_UserId_Field{_set: true, _value: &v}
+}
+
+func ConsoleAuditEvent_UserId_Raw(v *[]byte) ConsoleAuditEvent_UserId_Field {
+	if v == nil {
+		return ConsoleAuditEvent_UserId_Null()
+	}
+	return ConsoleAuditEvent_UserId(*v)
+}
+
+func ConsoleAuditEvent_UserId_Null() ConsoleAuditEvent_UserId_Field {
+	return ConsoleAuditEvent_UserId_Field{_set: true, _null: true}
+}
+
+func (f ConsoleAuditEvent_UserId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f ConsoleAuditEvent_UserId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ConsoleAuditEvent_UserId_Field) _Column() string { return "user_id" }
+
+type ConsoleAuditEvent_Email_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ConsoleAuditEvent_Email(v string) ConsoleAuditEvent_Email_Field {
+	return ConsoleAuditEvent_Email_Field{_set: true, _value: v}
+}
+
+func (f ConsoleAuditEvent_Email_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ConsoleAuditEvent_Email_Field) _Column() string { return "email" }
+
+type ConsoleAuditEvent_SourceIp_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ConsoleAuditEvent_SourceIp(v string) ConsoleAuditEvent_SourceIp_Field {
+	return ConsoleAuditEvent_SourceIp_Field{_set: true, _value: v}
+}
+
+func (f ConsoleAuditEvent_SourceIp_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ConsoleAuditEvent_SourceIp_Field) _Column() string { return "source_ip" }
+
+type ConsoleAuditEvent_ForwardedForIp_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ConsoleAuditEvent_ForwardedForIp(v string) ConsoleAuditEvent_ForwardedForIp_Field {
+	return ConsoleAuditEvent_ForwardedForIp_Field{_set: true, _value: v}
+}
+
+func (f ConsoleAuditEvent_ForwardedForIp_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ConsoleAuditEvent_ForwardedForIp_Field) _Column() string { return "forwarded_for_ip" }
+
+type ConsoleAuditEvent_RequestId_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ConsoleAuditEvent_RequestId(v string) ConsoleAuditEvent_RequestId_Field {
+	return ConsoleAuditEvent_RequestId_Field{_set: true, _value: v}
+}
+
+func (f ConsoleAuditEvent_RequestId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ConsoleAuditEvent_RequestId_Field) _Column() string { return "request_id" }
+
+type ConsoleAuditEvent_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value *[]byte
+}
+
+func ConsoleAuditEvent_ProjectId(v []byte) ConsoleAuditEvent_ProjectId_Field {
+	return ConsoleAuditEvent_ProjectId_Field{_set: true, _value: &v}
+}
+
+func ConsoleAuditEvent_ProjectId_Raw(v *[]byte) ConsoleAuditEvent_ProjectId_Field {
+	if v == nil {
+		return ConsoleAuditEvent_ProjectId_Null()
+	}
+	return ConsoleAuditEvent_ProjectId(*v)
+}
+
+func ConsoleAuditEvent_ProjectId_Null() ConsoleAuditEvent_ProjectId_Field {
+	return ConsoleAuditEvent_ProjectId_Field{_set: true, _null: true}
+}
+
+func (f ConsoleAuditEvent_ProjectId_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f ConsoleAuditEvent_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ConsoleAuditEvent_ProjectId_Field) _Column() string { return "project_id" }
+
+type ConsoleAuditEvent_ApiKeyId_Field struct {
+	_set   bool
+	_null  bool
+	_value *[]byte
+}
+
+func ConsoleAuditEvent_ApiKeyId(v []byte) ConsoleAuditEvent_ApiKeyId_Field {
+	return ConsoleAuditEvent_ApiKeyId_Field{_set: true, _value: &v}
+}
+
+func ConsoleAuditEvent_ApiKeyId_Raw(v *[]byte) ConsoleAuditEvent_ApiKeyId_Field {
+	if v == nil {
+		return ConsoleAuditEvent_ApiKeyId_Null()
+	}
+	return ConsoleAuditEvent_ApiKeyId(*v)
+}
+
+func ConsoleAuditEvent_ApiKeyId_Null() ConsoleAuditEvent_ApiKeyId_Field {
+	return ConsoleAuditEvent_ApiKeyId_Field{_set: true, _null: true}
+}
+
+func (f ConsoleAuditEvent_ApiKeyId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f ConsoleAuditEvent_ApiKeyId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ConsoleAuditEvent_ApiKeyId_Field) _Column() string { return "api_key_id" }
+
 type GracefulExitProgress struct {
 	NodeId            []byte
 	BytesTransferred  int64
@@ -7482,105 +7862,197 @@ func (f SegmentPendingAudits_ReverifyCount_Field) value() interface{} {
 
 func (SegmentPendingAudits_ReverifyCount_Field) _Column() string { return "reverify_count" }
 
-type StoragenodeBandwidthRollup struct {
-	StoragenodeId   []byte
-	IntervalStart   time.Time
-	IntervalSeconds uint
-	Action          uint
-	Allocated       *uint64
-	Settled         uint64
+type SsoIdentity struct {
+	Provider  string
+	Subject   string
+	UserId    []byte
+	CreatedAt time.Time
 }
 
-func (StoragenodeBandwidthRollup) _Table() string { return "storagenode_bandwidth_rollups" }
+func (SsoIdentity) _Table() string { return "sso_identities" }
 
-type StoragenodeBandwidthRollup_Create_Fields struct {
-	Allocated StoragenodeBandwidthRollup_Allocated_Field
+type SsoIdentity_Create_Fields struct {
+	CreatedAt SsoIdentity_CreatedAt_Field
 }
 
-type StoragenodeBandwidthRollup_Update_Fields struct {
-	Allocated StoragenodeBandwidthRollup_Allocated_Field
-	Settled   StoragenodeBandwidthRollup_Settled_Field
+type SsoIdentity_Update_Fields struct {
 }
 
-type StoragenodeBandwidthRollup_StoragenodeId_Field struct {
+type SsoIdentity_Provider_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func StoragenodeBandwidthRollup_StoragenodeId(v []byte) StoragenodeBandwidthRollup_StoragenodeId_Field {
-	return StoragenodeBandwidthRollup_StoragenodeId_Field{_set: true, _value: v}
+func SsoIdentity_Provider(v string) SsoIdentity_Provider_Field {
+	return SsoIdentity_Provider_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollup_StoragenodeId_Field) value() interface{} {
+func (f SsoIdentity_Provider_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_StoragenodeId_Field) _Column() string { return "storagenode_id" }
+func (SsoIdentity_Provider_Field) _Column() string { return "provider" }
 
-type StoragenodeBandwidthRollup_IntervalStart_Field struct {
+type SsoIdentity_Subject_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func StoragenodeBandwidthRollup_IntervalStart(v time.Time) StoragenodeBandwidthRollup_IntervalStart_Field {
-	return StoragenodeBandwidthRollup_IntervalStart_Field{_set: true, _value: v}
+func SsoIdentity_Subject(v string) SsoIdentity_Subject_Field {
+	return SsoIdentity_Subject_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollup_IntervalStart_Field) value() interface{} {
+func (f SsoIdentity_Subject_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_IntervalStart_Field) _Column() string { return "interval_start" }
+func (SsoIdentity_Subject_Field) _Column() string { return "subject" }
 
-type StoragenodeBandwidthRollup_IntervalSeconds_Field struct {
+type SsoIdentity_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value []byte
 }
 
-func StoragenodeBandwidthRollup_IntervalSeconds(v uint) StoragenodeBandwidthRollup_IntervalSeconds_Field {
-	return StoragenodeBandwidthRollup_IntervalSeconds_Field{_set: true, _value: v}
+func SsoIdentity_UserId(v []byte) SsoIdentity_UserId_Field {
+	return SsoIdentity_UserId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollup_IntervalSeconds_Field) value() interface{} {
+func (f SsoIdentity_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
+func (SsoIdentity_UserId_Field) _Column() string { return "user_id" }
 
-type StoragenodeBandwidthRollup_Action_Field struct {
+type SsoIdentity_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value time.Time
 }
 
-func StoragenodeBandwidthRollup_Action(v uint) StoragenodeBandwidthRollup_Action_Field {
-	return StoragenodeBandwidthRollup_Action_Field{_set: true, _value: v}
+func SsoIdentity_CreatedAt(v time.Time) SsoIdentity_CreatedAt_Field {
+	return SsoIdentity_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollup_Action_Field) value() interface{} {
+func (f SsoIdentity_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_Action_Field) _Column() string { return "action" }
+func (SsoIdentity_CreatedAt_Field) _Column() string { return "created_at" }
 
-type StoragenodeBandwidthRollup_Allocated_Field struct {
-	_set   bool
-	_null  bool
+type StoragenodeBandwidthRollup struct {
+	StoragenodeId   []byte
+	IntervalStart   time.Time
+	IntervalSeconds uint
+	Action          uint
+	Allocated       *uint64
+	Settled         uint64
+}
+
+func (StoragenodeBandwidthRollup) _Table() string { return "storagenode_bandwidth_rollups" }
+
+type StoragenodeBandwidthRollup_Create_Fields struct {
+	Allocated StoragenodeBandwidthRollup_Allocated_Field
+}
+
+type StoragenodeBandwidthRollup_Update_Fields struct {
+	Allocated StoragenodeBandwidthRollup_Allocated_Field
+	Settled   StoragenodeBandwidthRollup_Settled_Field
+}
+
+type StoragenodeBandwidthRollup_StoragenodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func StoragenodeBandwidthRollup_StoragenodeId(v []byte) StoragenodeBandwidthRollup_StoragenodeId_Field {
+	return StoragenodeBandwidthRollup_StoragenodeId_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeBandwidthRollup_StoragenodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeBandwidthRollup_StoragenodeId_Field) _Column() string { return "storagenode_id" }
+
+type StoragenodeBandwidthRollup_IntervalStart_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func StoragenodeBandwidthRollup_IntervalStart(v time.Time) StoragenodeBandwidthRollup_IntervalStart_Field {
+	return StoragenodeBandwidthRollup_IntervalStart_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeBandwidthRollup_IntervalStart_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeBandwidthRollup_IntervalStart_Field) _Column() string { return "interval_start" }
+
+type StoragenodeBandwidthRollup_IntervalSeconds_Field struct {
+	_set   bool
+	_null  bool
+	_value uint
+}
+
+func StoragenodeBandwidthRollup_IntervalSeconds(v uint) StoragenodeBandwidthRollup_IntervalSeconds_Field {
+	return StoragenodeBandwidthRollup_IntervalSeconds_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeBandwidthRollup_IntervalSeconds_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeBandwidthRollup_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
+
+type StoragenodeBandwidthRollup_Action_Field struct {
+	_set   bool
+	_null  bool
+	_value uint
+}
+
+func StoragenodeBandwidthRollup_Action(v uint) StoragenodeBandwidthRollup_Action_Field {
+	return StoragenodeBandwidthRollup_Action_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeBandwidthRollup_Action_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeBandwidthRollup_Action_Field) _Column() string { return "action" }
+
+type StoragenodeBandwidthRollup_Allocated_Field struct {
+	_set   bool
+	_null  bool
 	_value *uint64
 }
 
@@ -10822,734 +11294,1509 @@ func (f WebappSession_ExpiresAt_Field) value() interface{} {
 
 func (WebappSession_ExpiresAt_Field) _Column() string { return "expires_at" }
 
-type ApiKey struct {
-	Id        []byte
-	ProjectId []byte
-	Head      []byte
-	Name      string
-	Secret    []byte
-	UserAgent []byte
-	CreatedAt time.Time
+type WebauthnChallenge struct {
+	Nonce     []byte
+	ExpiresAt time.Time
 }
 
-func (ApiKey) _Table() string { return "api_keys" }
-
-type ApiKey_Create_Fields struct {
-	UserAgent ApiKey_UserAgent_Field
-}
+func (WebauthnChallenge) _Table() string { return "webauthn_challenges" }
 
-type ApiKey_Update_Fields struct {
-	Name ApiKey_Name_Field
+type WebauthnChallenge_Update_Fields struct {
 }
 
-type ApiKey_Id_Field struct {
+type WebauthnChallenge_Nonce_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func ApiKey_Id(v []byte) ApiKey_Id_Field {
-	return ApiKey_Id_Field{_set: true, _value: v}
+func WebauthnChallenge_Nonce(v []byte) WebauthnChallenge_Nonce_Field {
+	return WebauthnChallenge_Nonce_Field{_set: true, _value: v}
 }
 
-func (f ApiKey_Id_Field) value() interface{} {
+func (f WebauthnChallenge_Nonce_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_Id_Field) _Column() string { return "id" }
+func (WebauthnChallenge_Nonce_Field) _Column() string { return "nonce" }
 
-type ApiKey_ProjectId_Field struct {
+type WebauthnChallenge_ExpiresAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func ApiKey_ProjectId(v []byte) ApiKey_ProjectId_Field {
-	return ApiKey_ProjectId_Field{_set: true, _value: v}
+func WebauthnChallenge_ExpiresAt(v time.Time) WebauthnChallenge_ExpiresAt_Field {
+	return WebauthnChallenge_ExpiresAt_Field{_set: true, _value: v}
 }
 
-func (f ApiKey_ProjectId_Field) value() interface{} {
+func (f WebauthnChallenge_ExpiresAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_ProjectId_Field) _Column() string { return "project_id" }
+func (WebauthnChallenge_ExpiresAt_Field) _Column() string { return "expires_at" }
 
-type ApiKey_Head_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+type WebauthnCredential struct {
+	Id           []byte
+	UserId       []byte
+	Name         string
+	CredentialId []byte
+	PublicKey    []byte
+	SignCount    int64
+	CreatedAt    time.Time
+	LastUsedAt   *time.Time
 }
 
-func ApiKey_Head(v []byte) ApiKey_Head_Field {
-	return ApiKey_Head_Field{_set: true, _value: v}
-}
+func (WebauthnCredential) _Table() string { return "webauthn_credentials" }
 
-func (f ApiKey_Head_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type WebauthnCredential_Create_Fields struct {
+	CreatedAt  WebauthnCredential_CreatedAt_Field
+	LastUsedAt WebauthnCredential_LastUsedAt_Field
 }
 
-func (ApiKey_Head_Field) _Column() string { return "head" }
+type WebauthnCredential_Update_Fields struct {
+	SignCount  WebauthnCredential_SignCount_Field
+	LastUsedAt WebauthnCredential_LastUsedAt_Field
+}
 
-type ApiKey_Name_Field struct {
+type WebauthnCredential_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func ApiKey_Name(v string) ApiKey_Name_Field {
-	return ApiKey_Name_Field{_set: true, _value: v}
+func WebauthnCredential_Id(v []byte) WebauthnCredential_Id_Field {
+	return WebauthnCredential_Id_Field{_set: true, _value: v}
 }
 
-func (f ApiKey_Name_Field) value() interface{} {
+func (f WebauthnCredential_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_Name_Field) _Column() string { return "name" }
+func (WebauthnCredential_Id_Field) _Column() string { return "id" }
 
-type ApiKey_Secret_Field struct {
+type WebauthnCredential_UserId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func ApiKey_Secret(v []byte) ApiKey_Secret_Field {
-	return ApiKey_Secret_Field{_set: true, _value: v}
+func WebauthnCredential_UserId(v []byte) WebauthnCredential_UserId_Field {
+	return WebauthnCredential_UserId_Field{_set: true, _value: v}
 }
 
-func (f ApiKey_Secret_Field) value() interface{} {
+func (f WebauthnCredential_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_Secret_Field) _Column() string { return "secret" }
+func (WebauthnCredential_UserId_Field) _Column() string { return "user_id" }
 
-type ApiKey_UserAgent_Field struct {
+type WebauthnCredential_Name_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
-}
-
-func ApiKey_UserAgent(v []byte) ApiKey_UserAgent_Field {
-	return ApiKey_UserAgent_Field{_set: true, _value: v}
-}
-
-func ApiKey_UserAgent_Raw(v []byte) ApiKey_UserAgent_Field {
-	if v == nil {
-		return ApiKey_UserAgent_Null()
-	}
-	return ApiKey_UserAgent(v)
+	_value string
 }
 
-func ApiKey_UserAgent_Null() ApiKey_UserAgent_Field {
-	return ApiKey_UserAgent_Field{_set: true, _null: true}
+func WebauthnCredential_Name(v string) WebauthnCredential_Name_Field {
+	return WebauthnCredential_Name_Field{_set: true, _value: v}
 }
 
-func (f ApiKey_UserAgent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f ApiKey_UserAgent_Field) value() interface{} {
+func (f WebauthnCredential_Name_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_UserAgent_Field) _Column() string { return "user_agent" }
+func (WebauthnCredential_Name_Field) _Column() string { return "name" }
 
-type ApiKey_CreatedAt_Field struct {
+type WebauthnCredential_CredentialId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func ApiKey_CreatedAt(v time.Time) ApiKey_CreatedAt_Field {
-	return ApiKey_CreatedAt_Field{_set: true, _value: v}
+func WebauthnCredential_CredentialId(v []byte) WebauthnCredential_CredentialId_Field {
+	return WebauthnCredential_CredentialId_Field{_set: true, _value: v}
 }
 
-func (f ApiKey_CreatedAt_Field) value() interface{} {
+func (f WebauthnCredential_CredentialId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_CreatedAt_Field) _Column() string { return "created_at" }
-
-type BucketMetainfo struct {
-	Id                              []byte
-	ProjectId                       []byte
-	Name                            []byte
-	UserAgent                       []byte
-	PathCipher                      int
-	CreatedAt                       time.Time
-	DefaultSegmentSize              int
-	DefaultEncryptionCipherSuite    int
-	DefaultEncryptionBlockSize      int
-	DefaultRedundancyAlgorithm      int
-	DefaultRedundancyShareSize      int
-	DefaultRedundancyRequiredShares int
-	DefaultRedundancyRepairShares   int
-	DefaultRedundancyOptimalShares  int
-	DefaultRedundancyTotalShares    int
-	Placement                       *int
-}
-
-func (BucketMetainfo) _Table() string { return "bucket_metainfos" }
-
-type BucketMetainfo_Create_Fields struct {
-	UserAgent BucketMetainfo_UserAgent_Field
-	Placement BucketMetainfo_Placement_Field
-}
-
-type BucketMetainfo_Update_Fields struct {
-	UserAgent                       BucketMetainfo_UserAgent_Field
-	DefaultSegmentSize              BucketMetainfo_DefaultSegmentSize_Field
-	DefaultEncryptionCipherSuite    BucketMetainfo_DefaultEncryptionCipherSuite_Field
-	DefaultEncryptionBlockSize      BucketMetainfo_DefaultEncryptionBlockSize_Field
-	DefaultRedundancyAlgorithm      BucketMetainfo_DefaultRedundancyAlgorithm_Field
-	DefaultRedundancyShareSize      BucketMetainfo_DefaultRedundancyShareSize_Field
-	DefaultRedundancyRequiredShares BucketMetainfo_DefaultRedundancyRequiredShares_Field
-	DefaultRedundancyRepairShares   BucketMetainfo_DefaultRedundancyRepairShares_Field
-	DefaultRedundancyOptimalShares  BucketMetainfo_DefaultRedundancyOptimalShares_Field
-	DefaultRedundancyTotalShares    BucketMetainfo_DefaultRedundancyTotalShares_Field
-	Placement                       BucketMetainfo_Placement_Field
-}
+func (WebauthnCredential_CredentialId_Field) _Column() string { return "credential_id" }
 
-type BucketMetainfo_Id_Field struct {
+type WebauthnCredential_PublicKey_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BucketMetainfo_Id(v []byte) BucketMetainfo_Id_Field {
-	return BucketMetainfo_Id_Field{_set: true, _value: v}
+func WebauthnCredential_PublicKey(v []byte) WebauthnCredential_PublicKey_Field {
+	return WebauthnCredential_PublicKey_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_Id_Field) value() interface{} {
+func (f WebauthnCredential_PublicKey_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_Id_Field) _Column() string { return "id" }
+func (WebauthnCredential_PublicKey_Field) _Column() string { return "public_key" }
 
-type BucketMetainfo_ProjectId_Field struct {
+type WebauthnCredential_SignCount_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func BucketMetainfo_ProjectId(v []byte) BucketMetainfo_ProjectId_Field {
-	return BucketMetainfo_ProjectId_Field{_set: true, _value: v}
+func WebauthnCredential_SignCount(v int64) WebauthnCredential_SignCount_Field {
+	return WebauthnCredential_SignCount_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_ProjectId_Field) value() interface{} {
+func (f WebauthnCredential_SignCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_ProjectId_Field) _Column() string { return "project_id" }
+func (WebauthnCredential_SignCount_Field) _Column() string { return "sign_count" }
 
-type BucketMetainfo_Name_Field struct {
+type WebauthnCredential_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func BucketMetainfo_Name(v []byte) BucketMetainfo_Name_Field {
-	return BucketMetainfo_Name_Field{_set: true, _value: v}
+func WebauthnCredential_CreatedAt(v time.Time) WebauthnCredential_CreatedAt_Field {
+	return WebauthnCredential_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_Name_Field) value() interface{} {
+func (f WebauthnCredential_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_Name_Field) _Column() string { return "name" }
+func (WebauthnCredential_CreatedAt_Field) _Column() string { return "created_at" }
 
-type BucketMetainfo_UserAgent_Field struct {
+type WebauthnCredential_LastUsedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *time.Time
 }
 
-func BucketMetainfo_UserAgent(v []byte) BucketMetainfo_UserAgent_Field {
-	return BucketMetainfo_UserAgent_Field{_set: true, _value: v}
+func WebauthnCredential_LastUsedAt(v time.Time) WebauthnCredential_LastUsedAt_Field {
+	return WebauthnCredential_LastUsedAt_Field{_set: true, _value: &v}
 }
 
-func BucketMetainfo_UserAgent_Raw(v []byte) BucketMetainfo_UserAgent_Field {
+func WebauthnCredential_LastUsedAt_Raw(v *time.Time) WebauthnCredential_LastUsedAt_Field {
 	if v == nil {
-		return BucketMetainfo_UserAgent_Null()
+		return WebauthnCredential_LastUsedAt_Null()
 	}
-	return BucketMetainfo_UserAgent(v)
+	return WebauthnCredential_LastUsedAt(*v)
 }
 
-func BucketMetainfo_UserAgent_Null() BucketMetainfo_UserAgent_Field {
-	return BucketMetainfo_UserAgent_Field{_set: true, _null: true}
+func WebauthnCredential_LastUsedAt_Null() WebauthnCredential_LastUsedAt_Field {
+	return WebauthnCredential_LastUsedAt_Field{_set: true, _null: true}
 }
 
-func (f BucketMetainfo_UserAgent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f WebauthnCredential_LastUsedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f BucketMetainfo_UserAgent_Field) value() interface{} {
+func (f WebauthnCredential_LastUsedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_UserAgent_Field) _Column() string { return "user_agent" }
+func (WebauthnCredential_LastUsedAt_Field) _Column() string { return "last_used_at" }
 
-type BucketMetainfo_PathCipher_Field struct {
-	_set   bool
-	_null  bool
+type ApiKey struct {
+	Id        []byte
+	ProjectId []byte
+	Head      []byte
+	Name      string
+	Secret    []byte
+	UserAgent []byte
+	CreatedAt time.Time
+}
+
+func (ApiKey) _Table() string { return "api_keys" }
+
+type ApiKey_Create_Fields struct {
+	UserAgent ApiKey_UserAgent_Field
+}
+
+type ApiKey_Update_Fields struct {
+	Name ApiKey_Name_Field
+}
+
+type ApiKey_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ApiKey_Id(v []byte) ApiKey_Id_Field {
+	return ApiKey_Id_Field{_set: true, _value: v}
+}
+
+func (f ApiKey_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKey_Id_Field) _Column() string { return "id" }
+
+type ApiKey_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ApiKey_ProjectId(v []byte) ApiKey_ProjectId_Field {
+	return ApiKey_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ApiKey_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKey_ProjectId_Field) _Column() string { return "project_id" }
+
+type ApiKey_Head_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ApiKey_Head(v []byte) ApiKey_Head_Field {
+	return ApiKey_Head_Field{_set: true, _value: v}
+}
+
+func (f ApiKey_Head_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKey_Head_Field) _Column() string { return "head" }
+
+type ApiKey_Name_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ApiKey_Name(v string) ApiKey_Name_Field {
+	return ApiKey_Name_Field{_set: true, _value: v}
+}
+
+func (f ApiKey_Name_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKey_Name_Field) _Column() string { return "name" }
+
+type ApiKey_Secret_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ApiKey_Secret(v []byte) ApiKey_Secret_Field {
+	return ApiKey_Secret_Field{_set: true, _value: v}
+}
+
+func (f ApiKey_Secret_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKey_Secret_Field) _Column() string { return "secret" }
+
+type ApiKey_UserAgent_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ApiKey_UserAgent(v []byte) ApiKey_UserAgent_Field {
+	return ApiKey_UserAgent_Field{_set: true, _value: v}
+}
+
+func ApiKey_UserAgent_Raw(v []byte) ApiKey_UserAgent_Field {
+	if v == nil {
+		return ApiKey_UserAgent_Null()
+	}
+	return ApiKey_UserAgent(v)
+}
+
+func ApiKey_UserAgent_Null() ApiKey_UserAgent_Field {
+	return ApiKey_UserAgent_Field{_set: true, _null: true}
+}
+
+func (f ApiKey_UserAgent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f ApiKey_UserAgent_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKey_UserAgent_Field) _Column() string { return "user_agent" }
+
+type ApiKey_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ApiKey_CreatedAt(v time.Time) ApiKey_CreatedAt_Field {
+	return ApiKey_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ApiKey_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKey_CreatedAt_Field) _Column() string { return "created_at" }
+
+type BucketMetainfo struct {
+	Id                              []byte
+	ProjectId                       []byte
+	Name                            []byte
+	UserAgent                       []byte
+	PathCipher                      int
+	CreatedAt                       time.Time
+	DefaultSegmentSize              int
+	DefaultEncryptionCipherSuite    int
+	DefaultEncryptionBlockSize      int
+	DefaultRedundancyAlgorithm      int
+	DefaultRedundancyShareSize      int
+	DefaultRedundancyRequiredShares int
+	DefaultRedundancyRepairShares   int
+	DefaultRedundancyOptimalShares  int
+	DefaultRedundancyTotalShares    int
+	Placement                       *int
+	Versioning                      *int
+	DefaultRetentionMode            *int
+	DefaultRetentionDays            *int
+	LifecycleConfiguration          []byte
+	NotificationConfiguration       []byte
+	RateLimits                      []byte
+}
+
+func (BucketMetainfo) _Table() string { return "bucket_metainfos" }
+
+type BucketMetainfo_Create_Fields struct {
+	UserAgent                 BucketMetainfo_UserAgent_Field
+	Placement                 BucketMetainfo_Placement_Field
+	Versioning                BucketMetainfo_Versioning_Field
+	DefaultRetentionMode      BucketMetainfo_DefaultRetentionMode_Field
+	DefaultRetentionDays      BucketMetainfo_DefaultRetentionDays_Field
+	LifecycleConfiguration    BucketMetainfo_LifecycleConfiguration_Field
+	NotificationConfiguration BucketMetainfo_NotificationConfiguration_Field
+	RateLimits                BucketMetainfo_RateLimits_Field
+}
+
+type BucketMetainfo_Update_Fields struct {
+	UserAgent                       BucketMetainfo_UserAgent_Field
+	DefaultSegmentSize              BucketMetainfo_DefaultSegmentSize_Field
+	DefaultEncryptionCipherSuite    BucketMetainfo_DefaultEncryptionCipherSuite_Field
+	DefaultEncryptionBlockSize      BucketMetainfo_DefaultEncryptionBlockSize_Field
+	DefaultRedundancyAlgorithm      BucketMetainfo_DefaultRedundancyAlgorithm_Field
+	DefaultRedundancyShareSize      BucketMetainfo_DefaultRedundancyShareSize_Field
+	DefaultRedundancyRequiredShares BucketMetainfo_DefaultRedundancyRequiredShares_Field
+	DefaultRedundancyRepairShares   BucketMetainfo_DefaultRedundancyRepairShares_Field
+	DefaultRedundancyOptimalShares  BucketMetainfo_DefaultRedundancyOptimalShares_Field
+	DefaultRedundancyTotalShares    BucketMetainfo_DefaultRedundancyTotalShares_Field
+	Placement                       BucketMetainfo_Placement_Field
+	Versioning                      BucketMetainfo_Versioning_Field
+	DefaultRetentionMode            BucketMetainfo_DefaultRetentionMode_Field
+	DefaultRetentionDays            BucketMetainfo_DefaultRetentionDays_Field
+	LifecycleConfiguration          BucketMetainfo_LifecycleConfiguration_Field
+	NotificationConfiguration       BucketMetainfo_NotificationConfiguration_Field
+	RateLimits                      BucketMetainfo_RateLimits_Field
+}
+
+type BucketMetainfo_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketMetainfo_Id(v []byte) BucketMetainfo_Id_Field {
+	return BucketMetainfo_Id_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_Id_Field) _Column() string { return "id" }
+
+type BucketMetainfo_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketMetainfo_ProjectId(v []byte) BucketMetainfo_ProjectId_Field {
+	return BucketMetainfo_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_ProjectId_Field) _Column() string { return "project_id" }
+
+type BucketMetainfo_Name_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketMetainfo_Name(v []byte) BucketMetainfo_Name_Field {
+	return BucketMetainfo_Name_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_Name_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_Name_Field) _Column() string { return "name" }
+
+type BucketMetainfo_UserAgent_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketMetainfo_UserAgent(v []byte) BucketMetainfo_UserAgent_Field {
+	return BucketMetainfo_UserAgent_Field{_set: true, _value: v}
+}
+
+func BucketMetainfo_UserAgent_Raw(v []byte) BucketMetainfo_UserAgent_Field {
+	if v == nil {
+		return BucketMetainfo_UserAgent_Null()
+	}
+	return BucketMetainfo_UserAgent(v)
+}
+
+func BucketMetainfo_UserAgent_Null() BucketMetainfo_UserAgent_Field {
+	return BucketMetainfo_UserAgent_Field{_set: true, _null: true}
+}
+
+func (f BucketMetainfo_UserAgent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f BucketMetainfo_UserAgent_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_UserAgent_Field) _Column() string { return "user_agent" }
+
+type BucketMetainfo_PathCipher_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketMetainfo_PathCipher(v int) BucketMetainfo_PathCipher_Field {
+	return BucketMetainfo_PathCipher_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_PathCipher_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_PathCipher_Field) _Column() string { return "path_cipher" }
+
+type BucketMetainfo_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func BucketMetainfo_CreatedAt(v time.Time) BucketMetainfo_CreatedAt_Field {
+	return BucketMetainfo_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_CreatedAt_Field) _Column() string { return "created_at" }
+
+type BucketMetainfo_DefaultSegmentSize_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketMetainfo_DefaultSegmentSize(v int) BucketMetainfo_DefaultSegmentSize_Field {
+	return BucketMetainfo_DefaultSegmentSize_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultSegmentSize_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultSegmentSize_Field) _Column() string { return "default_segment_size" }
+
+type BucketMetainfo_DefaultEncryptionCipherSuite_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketMetainfo_DefaultEncryptionCipherSuite(v int) BucketMetainfo_DefaultEncryptionCipherSuite_Field {
+	return BucketMetainfo_DefaultEncryptionCipherSuite_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultEncryptionCipherSuite_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultEncryptionCipherSuite_Field) _Column() string {
+	return "default_encryption_cipher_suite"
+}
+
+type BucketMetainfo_DefaultEncryptionBlockSize_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketMetainfo_DefaultEncryptionBlockSize(v int) BucketMetainfo_DefaultEncryptionBlockSize_Field {
+	return BucketMetainfo_DefaultEncryptionBlockSize_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultEncryptionBlockSize_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultEncryptionBlockSize_Field) _Column() string {
+	return "default_encryption_block_size"
+}
+
+type BucketMetainfo_DefaultRedundancyAlgorithm_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketMetainfo_DefaultRedundancyAlgorithm(v int) BucketMetainfo_DefaultRedundancyAlgorithm_Field {
+	return BucketMetainfo_DefaultRedundancyAlgorithm_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultRedundancyAlgorithm_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultRedundancyAlgorithm_Field) _Column() string {
+	return "default_redundancy_algorithm"
+}
+
+type BucketMetainfo_DefaultRedundancyShareSize_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketMetainfo_DefaultRedundancyShareSize(v int) BucketMetainfo_DefaultRedundancyShareSize_Field {
+	return BucketMetainfo_DefaultRedundancyShareSize_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultRedundancyShareSize_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultRedundancyShareSize_Field) _Column() string {
+	return "default_redundancy_share_size"
+}
+
+type BucketMetainfo_DefaultRedundancyRequiredShares_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketMetainfo_DefaultRedundancyRequiredShares(v int) BucketMetainfo_DefaultRedundancyRequiredShares_Field {
+	return BucketMetainfo_DefaultRedundancyRequiredShares_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultRedundancyRequiredShares_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultRedundancyRequiredShares_Field) _Column() string {
+	return "default_redundancy_required_shares"
+}
+
+type BucketMetainfo_DefaultRedundancyRepairShares_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketMetainfo_DefaultRedundancyRepairShares(v int) BucketMetainfo_DefaultRedundancyRepairShares_Field {
+	return BucketMetainfo_DefaultRedundancyRepairShares_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultRedundancyRepairShares_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultRedundancyRepairShares_Field) _Column() string {
+	return "default_redundancy_repair_shares"
+}
+
+type BucketMetainfo_DefaultRedundancyOptimalShares_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func BucketMetainfo_DefaultRedundancyOptimalShares(v int) BucketMetainfo_DefaultRedundancyOptimalShares_Field {
+	return BucketMetainfo_DefaultRedundancyOptimalShares_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultRedundancyOptimalShares_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultRedundancyOptimalShares_Field) _Column() string {
+	return "default_redundancy_optimal_shares"
+}
+
+type BucketMetainfo_DefaultRedundancyTotalShares_Field struct {
+	_set   bool
+	_null  bool
 	_value int
 }
 
-func BucketMetainfo_PathCipher(v int) BucketMetainfo_PathCipher_Field {
-	return BucketMetainfo_PathCipher_Field{_set: true, _value: v}
+func BucketMetainfo_DefaultRedundancyTotalShares(v int) BucketMetainfo_DefaultRedundancyTotalShares_Field {
+	return BucketMetainfo_DefaultRedundancyTotalShares_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultRedundancyTotalShares_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_DefaultRedundancyTotalShares_Field) _Column() string {
+	return "default_redundancy_total_shares"
+}
+
+type BucketMetainfo_Placement_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func BucketMetainfo_Placement(v int) BucketMetainfo_Placement_Field {
+	return BucketMetainfo_Placement_Field{_set: true, _value: &v}
+}
+
+func BucketMetainfo_Placement_Raw(v *int) BucketMetainfo_Placement_Field {
+	if v == nil {
+		return BucketMetainfo_Placement_Null()
+	}
+	return BucketMetainfo_Placement(*v)
+}
+
+func BucketMetainfo_Placement_Null() BucketMetainfo_Placement_Field {
+	return BucketMetainfo_Placement_Field{_set: true, _null: true}
+}
+
+func (f BucketMetainfo_Placement_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f BucketMetainfo_Placement_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketMetainfo_Placement_Field) _Column() string { return "placement" }
+
+type BucketMetainfo_Versioning_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
 }
 
-func (f BucketMetainfo_PathCipher_Field) value() interface{} {
+func BucketMetainfo_Versioning(v int) BucketMetainfo_Versioning_Field {
+	return BucketMetainfo_Versioning_Field{_set: true, _value: &v}
+}
+
+func BucketMetainfo_Versioning_Raw(v *int) BucketMetainfo_Versioning_Field {
+	if v == nil {
+		return BucketMetainfo_Versioning_Null()
+	}
+	return BucketMetainfo_Versioning(*v)
+}
+
+func BucketMetainfo_Versioning_Null() BucketMetainfo_Versioning_Field {
+	return BucketMetainfo_Versioning_Field{_set: true, _null: true}
+}
+
+func (f BucketMetainfo_Versioning_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f BucketMetainfo_Versioning_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_PathCipher_Field) _Column() string { return "path_cipher" }
+func (BucketMetainfo_Versioning_Field) _Column() string { return "versioning" }
 
-type BucketMetainfo_CreatedAt_Field struct {
+type BucketMetainfo_DefaultRetentionMode_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *int
 }
 
-func BucketMetainfo_CreatedAt(v time.Time) BucketMetainfo_CreatedAt_Field {
-	return BucketMetainfo_CreatedAt_Field{_set: true, _value: v}
+func BucketMetainfo_DefaultRetentionMode(v int) BucketMetainfo_DefaultRetentionMode_Field {
+	return BucketMetainfo_DefaultRetentionMode_Field{_set: true, _value: &v}
 }
 
-func (f BucketMetainfo_CreatedAt_Field) value() interface{} {
+func BucketMetainfo_DefaultRetentionMode_Raw(v *int) BucketMetainfo_DefaultRetentionMode_Field {
+	if v == nil {
+		return BucketMetainfo_DefaultRetentionMode_Null()
+	}
+	return BucketMetainfo_DefaultRetentionMode(*v)
+}
+
+func BucketMetainfo_DefaultRetentionMode_Null() BucketMetainfo_DefaultRetentionMode_Field {
+	return BucketMetainfo_DefaultRetentionMode_Field{_set: true, _null: true}
+}
+
+func (f BucketMetainfo_DefaultRetentionMode_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f BucketMetainfo_DefaultRetentionMode_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_CreatedAt_Field) _Column() string { return "created_at" }
+func (BucketMetainfo_DefaultRetentionMode_Field) _Column() string { return "default_retention_mode" }
 
-type BucketMetainfo_DefaultSegmentSize_Field struct {
+type BucketMetainfo_DefaultRetentionDays_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value *int
 }
 
-func BucketMetainfo_DefaultSegmentSize(v int) BucketMetainfo_DefaultSegmentSize_Field {
-	return BucketMetainfo_DefaultSegmentSize_Field{_set: true, _value: v}
+func BucketMetainfo_DefaultRetentionDays(v int) BucketMetainfo_DefaultRetentionDays_Field {
+	return BucketMetainfo_DefaultRetentionDays_Field{_set: true, _value: &v}
 }
 
-func (f BucketMetainfo_DefaultSegmentSize_Field) value() interface{} {
+func BucketMetainfo_DefaultRetentionDays_Raw(v *int) BucketMetainfo_DefaultRetentionDays_Field {
+	if v == nil {
+		return BucketMetainfo_DefaultRetentionDays_Null()
+	}
+	return BucketMetainfo_DefaultRetentionDays(*v)
+}
+
+func BucketMetainfo_DefaultRetentionDays_Null() BucketMetainfo_DefaultRetentionDays_Field {
+	return BucketMetainfo_DefaultRetentionDays_Field{_set: true, _null: true}
+}
+
+func (f BucketMetainfo_DefaultRetentionDays_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f BucketMetainfo_DefaultRetentionDays_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultSegmentSize_Field) _Column() string { return "default_segment_size" }
+func (BucketMetainfo_DefaultRetentionDays_Field) _Column() string { return "default_retention_days" }
 
-type BucketMetainfo_DefaultEncryptionCipherSuite_Field struct {
+type BucketMetainfo_LifecycleConfiguration_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func BucketMetainfo_DefaultEncryptionCipherSuite(v int) BucketMetainfo_DefaultEncryptionCipherSuite_Field {
-	return BucketMetainfo_DefaultEncryptionCipherSuite_Field{_set: true, _value: v}
+func BucketMetainfo_LifecycleConfiguration(v []byte) BucketMetainfo_LifecycleConfiguration_Field {
+	return BucketMetainfo_LifecycleConfiguration_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_DefaultEncryptionCipherSuite_Field) value() interface{} {
+func BucketMetainfo_LifecycleConfiguration_Raw(v []byte) BucketMetainfo_LifecycleConfiguration_Field {
+	if v == nil {
+		return BucketMetainfo_LifecycleConfiguration_Null()
+	}
+	return BucketMetainfo_LifecycleConfiguration(v)
+}
+
+func BucketMetainfo_LifecycleConfiguration_Null() BucketMetainfo_LifecycleConfiguration_Field {
+	return BucketMetainfo_LifecycleConfiguration_Field{_set: true, _null: true}
+}
+
+func (f BucketMetainfo_LifecycleConfiguration_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f BucketMetainfo_LifecycleConfiguration_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultEncryptionCipherSuite_Field) _Column() string {
-	return "default_encryption_cipher_suite"
-}
+func (BucketMetainfo_LifecycleConfiguration_Field) _Column() string { return "lifecycle_configuration" }
 
-type BucketMetainfo_DefaultEncryptionBlockSize_Field struct {
+type BucketMetainfo_NotificationConfiguration_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func BucketMetainfo_DefaultEncryptionBlockSize(v int) BucketMetainfo_DefaultEncryptionBlockSize_Field {
-	return BucketMetainfo_DefaultEncryptionBlockSize_Field{_set: true, _value: v}
+func BucketMetainfo_NotificationConfiguration(v []byte) BucketMetainfo_NotificationConfiguration_Field {
+	return BucketMetainfo_NotificationConfiguration_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_DefaultEncryptionBlockSize_Field) value() interface{} {
+func BucketMetainfo_NotificationConfiguration_Raw(v []byte) BucketMetainfo_NotificationConfiguration_Field {
+	if v == nil {
+		return BucketMetainfo_NotificationConfiguration_Null()
+	}
+	return BucketMetainfo_NotificationConfiguration(v)
+}
+
+func BucketMetainfo_NotificationConfiguration_Null() BucketMetainfo_NotificationConfiguration_Field {
+	return BucketMetainfo_NotificationConfiguration_Field{_set: true, _null: true}
+}
+
+func (f BucketMetainfo_NotificationConfiguration_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f BucketMetainfo_NotificationConfiguration_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultEncryptionBlockSize_Field) _Column() string {
-	return "default_encryption_block_size"
+func (BucketMetainfo_NotificationConfiguration_Field) _Column() string {
+	return "notification_configuration"
 }
 
-type BucketMetainfo_DefaultRedundancyAlgorithm_Field struct {
+type BucketMetainfo_RateLimits_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func BucketMetainfo_DefaultRedundancyAlgorithm(v int) BucketMetainfo_DefaultRedundancyAlgorithm_Field {
-	return BucketMetainfo_DefaultRedundancyAlgorithm_Field{_set: true, _value: v}
+func BucketMetainfo_RateLimits(v []byte) BucketMetainfo_RateLimits_Field {
+	return BucketMetainfo_RateLimits_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_DefaultRedundancyAlgorithm_Field) value() interface{} {
+func BucketMetainfo_RateLimits_Raw(v []byte) BucketMetainfo_RateLimits_Field {
+	if v == nil {
+		return BucketMetainfo_RateLimits_Null()
+	}
+	return BucketMetainfo_RateLimits(v)
+}
+
+func BucketMetainfo_RateLimits_Null() BucketMetainfo_RateLimits_Field {
+	return BucketMetainfo_RateLimits_Field{_set: true, _null: true}
+}
+
+func (f BucketMetainfo_RateLimits_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f BucketMetainfo_RateLimits_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultRedundancyAlgorithm_Field) _Column() string {
-	return "default_redundancy_algorithm"
+func (BucketMetainfo_RateLimits_Field) _Column() string { return "rate_limits" }
+
+type ProjectInvitation struct {
+	ProjectId []byte
+	Email     string
+	InviterId []byte
+	CreatedAt time.Time
+	Role      *int
 }
 
-type BucketMetainfo_DefaultRedundancyShareSize_Field struct {
+func (ProjectInvitation) _Table() string { return "project_invitations" }
+
+type ProjectInvitation_Create_Fields struct {
+	InviterId ProjectInvitation_InviterId_Field
+	Role      ProjectInvitation_Role_Field
+}
+
+type ProjectInvitation_Update_Fields struct {
+	InviterId ProjectInvitation_InviterId_Field
+	CreatedAt ProjectInvitation_CreatedAt_Field
+	Role      ProjectInvitation_Role_Field
+}
+
+type ProjectInvitation_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func BucketMetainfo_DefaultRedundancyShareSize(v int) BucketMetainfo_DefaultRedundancyShareSize_Field {
-	return BucketMetainfo_DefaultRedundancyShareSize_Field{_set: true, _value: v}
+func ProjectInvitation_ProjectId(v []byte) ProjectInvitation_ProjectId_Field {
+	return ProjectInvitation_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_DefaultRedundancyShareSize_Field) value() interface{} {
+func (f ProjectInvitation_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultRedundancyShareSize_Field) _Column() string {
-	return "default_redundancy_share_size"
+func (ProjectInvitation_ProjectId_Field) _Column() string { return "project_id" }
+
+type ProjectInvitation_Email_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-type BucketMetainfo_DefaultRedundancyRequiredShares_Field struct {
+func ProjectInvitation_Email(v string) ProjectInvitation_Email_Field {
+	return ProjectInvitation_Email_Field{_set: true, _value: v}
+}
+
+func (f ProjectInvitation_Email_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectInvitation_Email_Field) _Column() string { return "email" }
+
+type ProjectInvitation_InviterId_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func BucketMetainfo_DefaultRedundancyRequiredShares(v int) BucketMetainfo_DefaultRedundancyRequiredShares_Field {
-	return BucketMetainfo_DefaultRedundancyRequiredShares_Field{_set: true, _value: v}
+func ProjectInvitation_InviterId(v []byte) ProjectInvitation_InviterId_Field {
+	return ProjectInvitation_InviterId_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_DefaultRedundancyRequiredShares_Field) value() interface{} {
+func ProjectInvitation_InviterId_Raw(v []byte) ProjectInvitation_InviterId_Field {
+	if v == nil {
+		return ProjectInvitation_InviterId_Null()
+	}
+	return ProjectInvitation_InviterId(v)
+}
+
+func ProjectInvitation_InviterId_Null() ProjectInvitation_InviterId_Field {
+	return ProjectInvitation_InviterId_Field{_set: true, _null: true}
+}
+
+func (f ProjectInvitation_InviterId_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f ProjectInvitation_InviterId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultRedundancyRequiredShares_Field) _Column() string {
-	return "default_redundancy_required_shares"
+func (ProjectInvitation_InviterId_Field) _Column() string { return "inviter_id" }
+
+type ProjectInvitation_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-type BucketMetainfo_DefaultRedundancyRepairShares_Field struct {
+func ProjectInvitation_CreatedAt(v time.Time) ProjectInvitation_CreatedAt_Field {
+	return ProjectInvitation_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ProjectInvitation_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectInvitation_CreatedAt_Field) _Column() string { return "created_at" }
+
+type ProjectInvitation_Role_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value *int
 }
 
-func BucketMetainfo_DefaultRedundancyRepairShares(v int) BucketMetainfo_DefaultRedundancyRepairShares_Field {
-	return BucketMetainfo_DefaultRedundancyRepairShares_Field{_set: true, _value: v}
+func ProjectInvitation_Role(v int) ProjectInvitation_Role_Field {
+	return ProjectInvitation_Role_Field{_set: true, _value: &v}
 }
 
-func (f BucketMetainfo_DefaultRedundancyRepairShares_Field) value() interface{} {
+func ProjectInvitation_Role_Raw(v *int) ProjectInvitation_Role_Field {
+	if v == nil {
+		return ProjectInvitation_Role_Null()
+	}
+	return ProjectInvitation_Role(*v)
+}
+
+func ProjectInvitation_Role_Null() ProjectInvitation_Role_Field {
+	return ProjectInvitation_Role_Field{_set: true, _null: true}
+}
+
+func (f ProjectInvitation_Role_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f ProjectInvitation_Role_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultRedundancyRepairShares_Field) _Column() string {
-	return "default_redundancy_repair_shares"
+func (ProjectInvitation_Role_Field) _Column() string { return "role" }
+
+type ProjectMember struct {
+	MemberId  []byte
+	ProjectId []byte
+	CreatedAt time.Time
+	Role      *int
 }
 
-type BucketMetainfo_DefaultRedundancyOptimalShares_Field struct {
+func (ProjectMember) _Table() string { return "project_members" }
+
+type ProjectMember_Create_Fields struct {
+	Role ProjectMember_Role_Field
+}
+
+type ProjectMember_Update_Fields struct {
+	Role ProjectMember_Role_Field
+}
+
+type ProjectMember_MemberId_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func BucketMetainfo_DefaultRedundancyOptimalShares(v int) BucketMetainfo_DefaultRedundancyOptimalShares_Field {
-	return BucketMetainfo_DefaultRedundancyOptimalShares_Field{_set: true, _value: v}
+func ProjectMember_MemberId(v []byte) ProjectMember_MemberId_Field {
+	return ProjectMember_MemberId_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_DefaultRedundancyOptimalShares_Field) value() interface{} {
+func (f ProjectMember_MemberId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultRedundancyOptimalShares_Field) _Column() string {
-	return "default_redundancy_optimal_shares"
+func (ProjectMember_MemberId_Field) _Column() string { return "member_id" }
+
+type ProjectMember_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectMember_ProjectId(v []byte) ProjectMember_ProjectId_Field {
+	return ProjectMember_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ProjectMember_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type BucketMetainfo_DefaultRedundancyTotalShares_Field struct {
+func (ProjectMember_ProjectId_Field) _Column() string { return "project_id" }
+
+type ProjectMember_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func BucketMetainfo_DefaultRedundancyTotalShares(v int) BucketMetainfo_DefaultRedundancyTotalShares_Field {
-	return BucketMetainfo_DefaultRedundancyTotalShares_Field{_set: true, _value: v}
+func ProjectMember_CreatedAt(v time.Time) ProjectMember_CreatedAt_Field {
+	return ProjectMember_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_DefaultRedundancyTotalShares_Field) value() interface{} {
+func (f ProjectMember_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultRedundancyTotalShares_Field) _Column() string {
-	return "default_redundancy_total_shares"
-}
+func (ProjectMember_CreatedAt_Field) _Column() string { return "created_at" }
 
-type BucketMetainfo_Placement_Field struct {
+type ProjectMember_Role_Field struct {
 	_set   bool
 	_null  bool
 	_value *int
 }
 
-func BucketMetainfo_Placement(v int) BucketMetainfo_Placement_Field {
-	return BucketMetainfo_Placement_Field{_set: true, _value: &v}
+func ProjectMember_Role(v int) ProjectMember_Role_Field {
+	return ProjectMember_Role_Field{_set: true, _value: &v}
 }
 
-func BucketMetainfo_Placement_Raw(v *int) BucketMetainfo_Placement_Field {
+func ProjectMember_Role_Raw(v *int) ProjectMember_Role_Field {
 	if v == nil {
-		return BucketMetainfo_Placement_Null()
+		return ProjectMember_Role_Null()
 	}
-	return BucketMetainfo_Placement(*v)
+	return ProjectMember_Role(*v)
 }
 
-func BucketMetainfo_Placement_Null() BucketMetainfo_Placement_Field {
-	return BucketMetainfo_Placement_Field{_set: true, _null: true}
+func ProjectMember_Role_Null() ProjectMember_Role_Field {
+	return ProjectMember_Role_Field{_set: true, _null: true}
 }
 
-func (f BucketMetainfo_Placement_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f ProjectMember_Role_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f BucketMetainfo_Placement_Field) value() interface{} {
+func (f ProjectMember_Role_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_Placement_Field) _Column() string { return "placement" }
+func (ProjectMember_Role_Field) _Column() string { return "role" }
 
-type ProjectInvitation struct {
-	ProjectId []byte
-	Email     string
-	InviterId []byte
-	CreatedAt time.Time
+type ProjectUsageAlert struct {
+	Id                 []byte
+	ProjectId          []byte
+	Kind               string
+	Threshold          int64
+	WebhookUrl         *string
+	NotifiedAt         *time.Time
+	WebhookDeliveredAt *time.Time
+	WebhookAttempts    int
+	CreatedAt          time.Time
 }
 
-func (ProjectInvitation) _Table() string { return "project_invitations" }
+func (ProjectUsageAlert) _Table() string { return "project_usage_alerts" }
 
-type ProjectInvitation_Create_Fields struct {
-	InviterId ProjectInvitation_InviterId_Field
+type ProjectUsageAlert_Create_Fields struct {
+	WebhookUrl         ProjectUsageAlert_WebhookUrl_Field
+	NotifiedAt         ProjectUsageAlert_NotifiedAt_Field
+	WebhookDeliveredAt ProjectUsageAlert_WebhookDeliveredAt_Field
+	WebhookAttempts    ProjectUsageAlert_WebhookAttempts_Field
+	CreatedAt          ProjectUsageAlert_CreatedAt_Field
 }
 
-type ProjectInvitation_Update_Fields struct {
-	InviterId ProjectInvitation_InviterId_Field
-	CreatedAt ProjectInvitation_CreatedAt_Field
+type ProjectUsageAlert_Update_Fields struct {
+	NotifiedAt         ProjectUsageAlert_NotifiedAt_Field
+	WebhookDeliveredAt ProjectUsageAlert_WebhookDeliveredAt_Field
+	WebhookAttempts    ProjectUsageAlert_WebhookAttempts_Field
 }
 
-type ProjectInvitation_ProjectId_Field struct {
+type ProjectUsageAlert_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func ProjectInvitation_ProjectId(v []byte) ProjectInvitation_ProjectId_Field {
-	return ProjectInvitation_ProjectId_Field{_set: true, _value: v}
+func ProjectUsageAlert_Id(v []byte) ProjectUsageAlert_Id_Field {
+	return ProjectUsageAlert_Id_Field{_set: true, _value: v}
 }
 
-func (f ProjectInvitation_ProjectId_Field) value() interface{} {
+func (f ProjectUsageAlert_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectInvitation_ProjectId_Field) _Column() string { return "project_id" }
+func (ProjectUsageAlert_Id_Field) _Column() string { return "id" }
 
-type ProjectInvitation_Email_Field struct {
+type ProjectUsageAlert_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectUsageAlert_ProjectId(v []byte) ProjectUsageAlert_ProjectId_Field {
+	return ProjectUsageAlert_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ProjectUsageAlert_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectUsageAlert_ProjectId_Field) _Column() string { return "project_id" }
+
+type ProjectUsageAlert_Kind_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func ProjectInvitation_Email(v string) ProjectInvitation_Email_Field {
-	return ProjectInvitation_Email_Field{_set: true, _value: v}
+func ProjectUsageAlert_Kind(v string) ProjectUsageAlert_Kind_Field {
+	return ProjectUsageAlert_Kind_Field{_set: true, _value: v}
 }
 
-func (f ProjectInvitation_Email_Field) value() interface{} {
+func (f ProjectUsageAlert_Kind_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectInvitation_Email_Field) _Column() string { return "email" }
+func (ProjectUsageAlert_Kind_Field) _Column() string { return "kind" }
 
-type ProjectInvitation_InviterId_Field struct {
+type ProjectUsageAlert_Threshold_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func ProjectInvitation_InviterId(v []byte) ProjectInvitation_InviterId_Field {
-	return ProjectInvitation_InviterId_Field{_set: true, _value: v}
+func ProjectUsageAlert_Threshold(v int64) ProjectUsageAlert_Threshold_Field {
+	return ProjectUsageAlert_Threshold_Field{_set: true, _value: v}
 }
 
-func ProjectInvitation_InviterId_Raw(v []byte) ProjectInvitation_InviterId_Field {
+func (f ProjectUsageAlert_Threshold_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectUsageAlert_Threshold_Field) _Column() string { return "threshold" }
+
+type ProjectUsageAlert_WebhookUrl_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func ProjectUsageAlert_WebhookUrl(v string) ProjectUsageAlert_WebhookUrl_Field {
+	return ProjectUsageAlert_WebhookUrl_Field{_set: true, _value: &v}
+}
+
+func ProjectUsageAlert_WebhookUrl_Raw(v *string) ProjectUsageAlert_WebhookUrl_Field {
 	if v == nil {
-		return ProjectInvitation_InviterId_Null()
+		return ProjectUsageAlert_WebhookUrl_Null()
 	}
-	return ProjectInvitation_InviterId(v)
+	return ProjectUsageAlert_WebhookUrl(*v)
 }
 
-func ProjectInvitation_InviterId_Null() ProjectInvitation_InviterId_Field {
-	return ProjectInvitation_InviterId_Field{_set: true, _null: true}
+func ProjectUsageAlert_WebhookUrl_Null() ProjectUsageAlert_WebhookUrl_Field {
+	return ProjectUsageAlert_WebhookUrl_Field{_set: true, _null: true}
 }
 
-func (f ProjectInvitation_InviterId_Field) isnull() bool {
+func (f ProjectUsageAlert_WebhookUrl_Field) isnull() bool {
 	return !f._set || f._null || f._value == nil
 }
 
-func (f ProjectInvitation_InviterId_Field) value() interface{} {
+func (f ProjectUsageAlert_WebhookUrl_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectInvitation_InviterId_Field) _Column() string { return "inviter_id" }
+func (ProjectUsageAlert_WebhookUrl_Field) _Column() string { return "webhook_url" }
 
-type ProjectInvitation_CreatedAt_Field struct {
+type ProjectUsageAlert_NotifiedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *time.Time
 }
 
-func ProjectInvitation_CreatedAt(v time.Time) ProjectInvitation_CreatedAt_Field {
-	return ProjectInvitation_CreatedAt_Field{_set: true, _value: v}
+func ProjectUsageAlert_NotifiedAt(v time.Time) ProjectUsageAlert_NotifiedAt_Field {
+	return ProjectUsageAlert_NotifiedAt_Field{_set: true, _value: &v}
 }
 
-func (f ProjectInvitation_CreatedAt_Field) value() interface{} {
+func ProjectUsageAlert_NotifiedAt_Raw(v *time.Time) ProjectUsageAlert_NotifiedAt_Field {
+	if v == nil {
+		return ProjectUsageAlert_NotifiedAt_Null()
+	}
+	return ProjectUsageAlert_NotifiedAt(*v)
+}
+
+func ProjectUsageAlert_NotifiedAt_Null() ProjectUsageAlert_NotifiedAt_Field {
+	return ProjectUsageAlert_NotifiedAt_Field{_set: true, _null: true}
+}
+
+func (f ProjectUsageAlert_NotifiedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f ProjectUsageAlert_NotifiedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectInvitation_CreatedAt_Field) _Column() string { return "created_at" }
+func (ProjectUsageAlert_NotifiedAt_Field) _Column() string { return "notified_at" }
 
-type ProjectMember struct {
-	MemberId  []byte
-	ProjectId []byte
-	CreatedAt time.Time
+type ProjectUsageAlert_WebhookDeliveredAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
 }
 
-func (ProjectMember) _Table() string { return "project_members" }
+func ProjectUsageAlert_WebhookDeliveredAt(v time.Time) ProjectUsageAlert_WebhookDeliveredAt_Field {
+	return ProjectUsageAlert_WebhookDeliveredAt_Field{_set: true, _value: &v}
+}
 
-type ProjectMember_Update_Fields struct {
+func ProjectUsageAlert_WebhookDeliveredAt_Raw(v *time.Time) ProjectUsageAlert_WebhookDeliveredAt_Field {
+	if v == nil {
+		return ProjectUsageAlert_WebhookDeliveredAt_Null()
+	}
+	return ProjectUsageAlert_WebhookDeliveredAt(*v)
 }
 
-type ProjectMember_MemberId_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func ProjectUsageAlert_WebhookDeliveredAt_Null() ProjectUsageAlert_WebhookDeliveredAt_Field {
+	return ProjectUsageAlert_WebhookDeliveredAt_Field{_set: true, _null: true}
 }
 
-func ProjectMember_MemberId(v []byte) ProjectMember_MemberId_Field {
-	return ProjectMember_MemberId_Field{_set: true, _value: v}
+func (f ProjectUsageAlert_WebhookDeliveredAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f ProjectMember_MemberId_Field) value() interface{} {
+func (f ProjectUsageAlert_WebhookDeliveredAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectMember_MemberId_Field) _Column() string { return "member_id" }
+func (ProjectUsageAlert_WebhookDeliveredAt_Field) _Column() string { return "webhook_delivered_at" }
 
-type ProjectMember_ProjectId_Field struct {
+type ProjectUsageAlert_WebhookAttempts_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int
 }
 
-func ProjectMember_ProjectId(v []byte) ProjectMember_ProjectId_Field {
-	return ProjectMember_ProjectId_Field{_set: true, _value: v}
+func ProjectUsageAlert_WebhookAttempts(v int) ProjectUsageAlert_WebhookAttempts_Field {
+	return ProjectUsageAlert_WebhookAttempts_Field{_set: true, _value: v}
 }
 
-func (f ProjectMember_ProjectId_Field) value() interface{} {
+func (f ProjectUsageAlert_WebhookAttempts_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectMember_ProjectId_Field) _Column() string { return "project_id" }
+func (ProjectUsageAlert_WebhookAttempts_Field) _Column() string { return "webhook_attempts" }
 
-type ProjectMember_CreatedAt_Field struct {
+type ProjectUsageAlert_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func ProjectMember_CreatedAt(v time.Time) ProjectMember_CreatedAt_Field {
-	return ProjectMember_CreatedAt_Field{_set: true, _value: v}
+func ProjectUsageAlert_CreatedAt(v time.Time) ProjectUsageAlert_CreatedAt_Field {
+	return ProjectUsageAlert_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f ProjectMember_CreatedAt_Field) value() interface{} {
+func (f ProjectUsageAlert_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectMember_CreatedAt_Field) _Column() string { return "created_at" }
+func (ProjectUsageAlert_CreatedAt_Field) _Column() string { return "created_at" }
 
 type StripecoinpaymentsApplyBalanceIntent struct {
 	TxId      string
@@ -12015,6 +13262,22 @@ type Placement_Row struct {
 	Placement *int
 }
 
+type Versioning_Row struct {
+	Versioning *int
+}
+
+type LifecycleConfiguration_Row struct {
+	LifecycleConfiguration []byte
+}
+
+type NotificationConfiguration_Row struct {
+	NotificationConfiguration []byte
+}
+
+type RateLimits_Row struct {
+	RateLimits []byte
+}
+
 type ProjectLimit_Row struct {
 	ProjectLimit int
 }
@@ -12998,7 +14261,8 @@ func (obj *pgxImpl) Create_Project(ctx context.Context,
 
 func (obj *pgxImpl) Create_ProjectMember(ctx context.Context,
 	project_member_member_id ProjectMember_MemberId_Field,
-	project_member_project_id ProjectMember_ProjectId_Field) (
+	project_member_project_id ProjectMember_ProjectId_Field,
+	optional ProjectMember_Create_Fields) (
 	project_member *ProjectMember, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -13006,17 +14270,18 @@ func (obj *pgxImpl) Create_ProjectMember(ctx context.Context,
 	__member_id_val := project_member_member_id.value()
 	__project_id_val := project_member_project_id.value()
 	__created_at_val := __now
+	__role_val := optional.Role.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_members ( member_id, project_id, created_at ) VALUES ( ?, ?, ? ) RETURNING project_members.member_id, project_members.project_id, project_members.created_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_members ( member_id, project_id, created_at, role ) VALUES ( ?, ?, ?, ? ) RETURNING project_members.member_id, project_members.project_id, project_members.created_at, project_members.role")
 
 	var __values []interface{}
-	__values = append(__values, __member_id_val, __project_id_val, __created_at_val)
+	__values = append(__values, __member_id_val, __project_id_val, __created_at_val, __role_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	project_member = &ProjectMember{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt, &project_member.Role)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -13036,17 +14301,18 @@ func (obj *pgxImpl) Replace_ProjectInvitation(ctx context.Context,
 	__email_val := project_invitation_email.value()
 	__inviter_id_val := optional.InviterId.value()
 	__created_at_val := __now
+	__role_val := optional.Role.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_invitations ( project_id, email, inviter_id, created_at ) VALUES ( ?, ?, ?, ? ) ON CONFLICT ( project_id, email ) DO UPDATE SET project_id = EXCLUDED.project_id, email = EXCLUDED.email, inviter_id = EXCLUDED.inviter_id, created_at = EXCLUDED.created_at RETURNING project_invitations.project_id, project_invitations.email, project_invitations.inviter_id, project_invitations.created_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_invitations ( project_id, email, inviter_id, created_at, role ) VALUES ( ?, ?, ?, ?, ? ) ON CONFLICT ( project_id, email ) DO UPDATE SET project_id = EXCLUDED.project_id, email = EXCLUDED.email, inviter_id = EXCLUDED.inviter_id, created_at = EXCLUDED.created_at, role = EXCLUDED.role RETURNING project_invitations.project_id, project_invitations.email, project_invitations.inviter_id, project_invitations.created_at, project_invitations.role")
 
 	var __values []interface{}
-	__values = append(__values, __project_id_val, __email_val, __inviter_id_val, __created_at_val)
+	__values = append(__values, __project_id_val, __email_val, __inviter_id_val, __created_at_val, __role_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	project_invitation = &ProjectInvitation{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_invitation.ProjectId, &project_invitation.Email, &project_invitation.InviterId, &project_invitation.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_invitation.ProjectId, &project_invitation.Email, &project_invitation.InviterId, &project_invitation.CreatedAt, &project_invitation.Role)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -13125,17 +14391,23 @@ func (obj *pgxImpl) Create_BucketMetainfo(ctx context.Context,
 	__default_redundancy_optimal_shares_val := bucket_metainfo_default_redundancy_optimal_shares.value()
 	__default_redundancy_total_shares_val := bucket_metainfo_default_redundancy_total_shares.value()
 	__placement_val := optional.Placement.value()
+	__versioning_val := optional.Versioning.value()
+	__default_retention_mode_val := optional.DefaultRetentionMode.value()
+	__default_retention_days_val := optional.DefaultRetentionDays.value()
+	__lifecycle_configuration_val := optional.LifecycleConfiguration.value()
+	__notification_configuration_val := optional.NotificationConfiguration.value()
+	__rate_limits_val := optional.RateLimits.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_metainfos ( id, project_id, name, user_agent, path_cipher, created_at, default_segment_size, default_encryption_cipher_suite, default_encryption_block_size, default_redundancy_algorithm, default_redundancy_share_size, default_redundancy_required_shares, default_redundancy_repair_shares, default_redundancy_optimal_shares, default_redundancy_total_shares, placement ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_metainfos ( id, project_id, name, user_agent, path_cipher, created_at, default_segment_size, default_encryption_cipher_suite, default_encryption_block_size, default_redundancy_algorithm, default_redundancy_share_size, default_redundancy_required_shares, default_redundancy_repair_shares, default_redundancy_optimal_shares, default_redundancy_total_shares, placement, versioning, default_retention_mode, default_retention_days, lifecycle_configuration, notification_configuration, rate_limits ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.versioning, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.lifecycle_configuration, bucket_metainfos.notification_configuration, bucket_metainfos.rate_limits")
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __project_id_val, __name_val, __user_agent_val, __path_cipher_val, __created_at_val, __default_segment_size_val, __default_encryption_cipher_suite_val, __default_encryption_block_size_val, __default_redundancy_algorithm_val, __default_redundancy_share_size_val, __default_redundancy_required_shares_val, __default_redundancy_repair_shares_val, __default_redundancy_optimal_shares_val, __default_redundancy_total_shares_val, __placement_val)
+	__values = append(__values, __id_val, __project_id_val, __name_val, __user_agent_val, __path_cipher_val, __created_at_val, __default_segment_size_val, __default_encryption_cipher_suite_val, __default_encryption_block_size_val, __default_redundancy_algorithm_val, __default_redundancy_share_size_val, __default_redundancy_required_shares_val, __default_redundancy_repair_shares_val, __default_redundancy_optimal_shares_val, __default_redundancy_total_shares_val, __placement_val, __versioning_val, __default_retention_mode_val, __default_retention_days_val, __lifecycle_configuration_val, __notification_configuration_val, __rate_limits_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.Versioning, &bucket_metainfo.DefaultRetentionMode, &bucket_metainfo.DefaultRetentionDays, &bucket_metainfo.LifecycleConfiguration, &bucket_metainfo.NotificationConfiguration, &bucket_metainfo.RateLimits)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -16036,7 +17308,7 @@ func (obj *pgxImpl) All_ProjectMember_By_MemberId(ctx context.Context,
 	rows []*ProjectMember, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE project_members.member_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at, project_members.role FROM project_members WHERE project_members.member_id = ?")
 
 	var __values []interface{}
 	__values = append(__values, project_member_member_id.value())
@@ -16054,7 +17326,7 @@ func (obj *pgxImpl) All_ProjectMember_By_MemberId(ctx context.Context,
 
 			for __rows.Next() {
 				project_member := &ProjectMember{}
-				err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+				err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt, &project_member.Role)
 				if err != nil {
 					return nil, err
 				}
@@ -16082,7 +17354,7 @@ func (obj *pgxImpl) Get_ProjectInvitation_By_ProjectId_And_Email(ctx context.Con
 	project_invitation *ProjectInvitation, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_invitations.project_id, project_invitations.email, project_invitations.inviter_id, project_invitations.created_at FROM project_invitations WHERE project_invitations.project_id = ? AND project_invitations.email = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_invitations.project_id, project_invitations.email, project_invitations.inviter_id, project_invitations.created_at, project_invitations.role FROM project_invitations WHERE project_invitations.project_id = ? AND project_invitations.email = ?")
 
 	var __values []interface{}
 	__values = append(__values, project_invitation_project_id.value(), project_invitation_email.value())
@@ -16091,7 +17363,7 @@ func (obj *pgxImpl) Get_ProjectInvitation_By_ProjectId_And_Email(ctx context.Con
 	obj.logStmt(__stmt, __values...)
 
 	project_invitation = &ProjectInvitation{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_invitation.ProjectId, &project_invitation.Email, &project_invitation.InviterId, &project_invitation.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_invitation.ProjectId, &project_invitation.Email, &project_invitation.InviterId, &project_invitation.CreatedAt, &project_invitation.Role)
 	if err != nil {
 		return (*ProjectInvitation)(nil), obj.makeErr(err)
 	}
@@ -16104,7 +17376,7 @@ func (obj *pgxImpl) All_ProjectInvitation_By_Email(ctx context.Context,
 	rows []*ProjectInvitation, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_invitations.project_id, project_invitations.email, project_invitations.inviter_id, project_invitations.created_at FROM project_invitations WHERE project_invitations.email = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_invitations.project_id, project_invitations.email, project_invitations.inviter_id, project_invitations.created_at, project_invitations.role FROM project_invitations WHERE project_invitations.email = ?")
 
 	var __values []interface{}
 	__values = append(__values, project_invitation_email.value())
@@ -16122,7 +17394,7 @@ func (obj *pgxImpl) All_ProjectInvitation_By_Email(ctx context.Context,
 
 			for __rows.Next() {
 				project_invitation := &ProjectInvitation{}
-				err = __rows.Scan(&project_invitation.ProjectId, &project_invitation.Email, &project_invitation.InviterId, &project_invitation.CreatedAt)
+				err = __rows.Scan(&project_invitation.ProjectId, &project_invitation.Email, &project_invitation.InviterId, &project_invitation.CreatedAt, &project_invitation.Role)
 				if err != nil {
 					return nil, err
 				}
@@ -16149,7 +17421,7 @@ func (obj *pgxImpl) All_ProjectInvitation_By_ProjectId(ctx context.Context,
 	rows []*ProjectInvitation, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_invitations.project_id, project_invitations.email, project_invitations.inviter_id, project_invitations.created_at FROM project_invitations WHERE project_invitations.project_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_invitations.project_id, project_invitations.email, project_invitations.inviter_id, project_invitations.created_at, project_invitations.role FROM project_invitations WHERE project_invitations.project_id = ?")
 
 	var __values []interface{}
 	__values = append(__values, project_invitation_project_id.value())
@@ -16167,7 +17439,7 @@ func (obj *pgxImpl) All_ProjectInvitation_By_ProjectId(ctx context.Context,
 
 			for __rows.Next() {
 				project_invitation := &ProjectInvitation{}
-				err = __rows.Scan(&project_invitation.ProjectId, &project_invitation.Email, &project_invitation.InviterId, &project_invitation.CreatedAt)
+				err = __rows.Scan(&project_invitation.ProjectId, &project_invitation.Email, &project_invitation.InviterId, &project_invitation.CreatedAt, &project_invitation.Role)
 				if err != nil {
 					return nil, err
 				}
@@ -16262,7 +17534,7 @@ func (obj *pgxImpl) Get_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context
 	bucket_metainfo *BucketMetainfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.versioning, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.lifecycle_configuration, bucket_metainfos.notification_configuration, bucket_metainfos.rate_limits FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
@@ -16271,7 +17543,7 @@ func (obj *pgxImpl) Get_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.Versioning, &bucket_metainfo.DefaultRetentionMode, &bucket_metainfo.DefaultRetentionDays, &bucket_metainfo.LifecycleConfiguration, &bucket_metainfo.NotificationConfiguration, &bucket_metainfo.RateLimits)
 	if err != nil {
 		return (*BucketMetainfo)(nil), obj.makeErr(err)
 	}
@@ -16348,6 +17620,98 @@ func (obj *pgxImpl) Get_BucketMetainfo_Placement_By_ProjectId_And_Name(ctx conte
 
 }
 
+func (obj *pgxImpl) Get_BucketMetainfo_Versioning_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *Versioning_Row, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.versioning FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &Versioning_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.Versioning)
+	if err != nil {
+		return (*Versioning_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *pgxImpl) Get_BucketMetainfo_LifecycleConfiguration_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *LifecycleConfiguration_Row, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.lifecycle_configuration FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &LifecycleConfiguration_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.LifecycleConfiguration)
+	if err != nil {
+		return (*LifecycleConfiguration_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *pgxImpl) Get_BucketMetainfo_NotificationConfiguration_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *NotificationConfiguration_Row, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.notification_configuration FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &NotificationConfiguration_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.NotificationConfiguration)
+	if err != nil {
+		return (*NotificationConfiguration_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *pgxImpl) Get_BucketMetainfo_RateLimits_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *RateLimits_Row, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.rate_limits FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &RateLimits_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.RateLimits)
+	if err != nil {
+		return (*RateLimits_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
 func (obj *pgxImpl) Get_BucketMetainfo_UserAgent_By_ProjectId_And_Name(ctx context.Context,
 	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 	bucket_metainfo_name BucketMetainfo_Name_Field) (
@@ -16400,7 +17764,7 @@ func (obj *pgxImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_GreaterOrEqual_
 	rows []*BucketMetainfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name >= ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.versioning, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.lifecycle_configuration, bucket_metainfos.notification_configuration, bucket_metainfos.rate_limits FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name >= ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name_greater_or_equal.value())
@@ -16420,7 +17784,7 @@ func (obj *pgxImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_GreaterOrEqual_
 
 			for __rows.Next() {
 				bucket_metainfo := &BucketMetainfo{}
-				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.Versioning, &bucket_metainfo.DefaultRetentionMode, &bucket_metainfo.DefaultRetentionDays, &bucket_metainfo.LifecycleConfiguration, &bucket_metainfo.NotificationConfiguration, &bucket_metainfo.RateLimits)
 				if err != nil {
 					return nil, err
 				}
@@ -16450,7 +17814,7 @@ func (obj *pgxImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greater_OrderBy
 	rows []*BucketMetainfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name > ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.versioning, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.lifecycle_configuration, bucket_metainfos.notification_configuration, bucket_metainfos.rate_limits FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name > ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name_greater.value())
@@ -16470,7 +17834,7 @@ func (obj *pgxImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greater_OrderBy
 
 			for __rows.Next() {
 				bucket_metainfo := &BucketMetainfo{}
-				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.Versioning, &bucket_metainfo.DefaultRetentionMode, &bucket_metainfo.DefaultRetentionDays, &bucket_metainfo.LifecycleConfiguration, &bucket_metainfo.NotificationConfiguration, &bucket_metainfo.RateLimits)
 				if err != nil {
 					return nil, err
 				}
@@ -18585,6 +19949,48 @@ func (obj *pgxImpl) Update_Project_By_Id(ctx context.Context,
 	return project, nil
 }
 
+func (obj *pgxImpl) Update_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field,
+	project_member_project_id ProjectMember_ProjectId_Field,
+	update ProjectMember_Update_Fields) (
+	project_member *ProjectMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_members SET "), __sets, __sqlbundle_Literal(" WHERE project_members.member_id = ? AND project_members.project_id = ? RETURNING project_members.member_id, project_members.project_id, project_members.created_at, project_members.role")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Role._set {
+		__values = append(__values, update.Role.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("role = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, project_member_member_id.value(), project_member_project_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_member = &ProjectMember{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt, &project_member.Role)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_member, nil
+}
+
 func (obj *pgxImpl) Update_ProjectInvitation_By_ProjectId_And_Email(ctx context.Context,
 	project_invitation_project_id ProjectInvitation_ProjectId_Field,
 	project_invitation_email ProjectInvitation_Email_Field,
@@ -18593,7 +19999,7 @@ func (obj *pgxImpl) Update_ProjectInvitation_By_ProjectId_And_Email(ctx context.
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_invitations SET "), __sets, __sqlbundle_Literal(" WHERE project_invitations.project_id = ? AND project_invitations.email = ? RETURNING project_invitations.project_id, project_invitations.email, project_invitations.inviter_id, project_invitations.created_at")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_invitations SET "), __sets, __sqlbundle_Literal(" WHERE project_invitations.project_id = ? AND project_invitations.email = ? RETURNING project_invitations.project_id, project_invitations.email, project_invitations.inviter_id, project_invitations.created_at, project_invitations.role")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -18609,6 +20015,11 @@ func (obj *pgxImpl) Update_ProjectInvitation_By_ProjectId_And_Email(ctx context.
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("created_at = ?"))
 	}
 
+	if update.Role._set {
+		__values = append(__values, update.Role.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("role = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -18622,7 +20033,7 @@ func (obj *pgxImpl) Update_ProjectInvitation_By_ProjectId_And_Email(ctx context.
 	obj.logStmt(__stmt, __values...)
 
 	project_invitation = &ProjectInvitation{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_invitation.ProjectId, &project_invitation.Email, &project_invitation.InviterId, &project_invitation.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_invitation.ProjectId, &project_invitation.Email, &project_invitation.InviterId, &project_invitation.CreatedAt, &project_invitation.Role)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -18677,7 +20088,7 @@ func (obj *pgxImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx context.Cont
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_metainfos SET "), __sets, __sqlbundle_Literal(" WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ? RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_metainfos SET "), __sets, __sqlbundle_Literal(" WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ? RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.versioning, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.lifecycle_configuration, bucket_metainfos.notification_configuration, bucket_metainfos.rate_limits")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -18737,6 +20148,30 @@ func (obj *pgxImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx context.Cont
 		__values = append(__values, update.Placement.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("placement = ?"))
 	}
+	if update.Versioning._set {
+		__values = append(__values, update.Versioning.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("versioning = ?"))
+	}
+	if update.DefaultRetentionMode._set {
+		__values = append(__values, update.DefaultRetentionMode.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_retention_mode = ?"))
+	}
+	if update.DefaultRetentionDays._set {
+		__values = append(__values, update.DefaultRetentionDays.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_retention_days = ?"))
+	}
+	if update.LifecycleConfiguration._set {
+		__values = append(__values, update.LifecycleConfiguration.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("lifecycle_configuration = ?"))
+	}
+	if update.NotificationConfiguration._set {
+		__values = append(__values, update.NotificationConfiguration.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("notification_configuration = ?"))
+	}
+	if update.RateLimits._set {
+		__values = append(__values, update.RateLimits.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("rate_limits = ?"))
+	}
 
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
@@ -18751,7 +20186,7 @@ func (obj *pgxImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx context.Cont
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.Versioning, &bucket_metainfo.DefaultRetentionMode, &bucket_metainfo.DefaultRetentionDays, &bucket_metainfo.LifecycleConfiguration, &bucket_metainfo.NotificationConfiguration, &bucket_metainfo.RateLimits)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -19706,6 +21141,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_usage_alerts;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -19746,6 +21191,26 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM webauthn_credentials;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM webauthn_challenges;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -19906,6 +21371,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM sso_identities;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -20096,6 +21571,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM console_audit_events;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -21133,7 +22618,8 @@ func (obj *pgxcockroachImpl) Create_Project(ctx context.Context,
 
 func (obj *pgxcockroachImpl) Create_ProjectMember(ctx context.Context,
 	project_member_member_id ProjectMember_MemberId_Field,
-	project_member_project_id ProjectMember_ProjectId_Field) (
+	project_member_project_id ProjectMember_ProjectId_Field,
+	optional ProjectMember_Create_Fields) (
 	project_member *ProjectMember, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -21141,17 +22627,18 @@ func (obj *pgxcockroachImpl) Create_ProjectMember(ctx context.Context,
 	__member_id_val := project_member_member_id.value()
 	__project_id_val := project_member_project_id.value()
 	__created_at_val := __now
+	__role_val := optional.Role.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_members ( member_id, project_id, created_at ) VALUES ( ?, ?, ? ) RETURNING project_members.member_id, project_members.project_id, project_members.created_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_members ( member_id, project_id, created_at, role ) VALUES ( ?, ?, ?, ? ) RETURNING project_members.member_id, project_members.project_id, project_members.created_at, project_members.role")
 
 	var __values []interface{}
-	__values = append(__values, __member_id_val, __project_id_val, __created_at_val)
+	__values = append(__values, __member_id_val, __project_id_val, __created_at_val, __role_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	project_member = &ProjectMember{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt, &project_member.Role)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -21171,17 +22658,18 @@ func (obj *pgxcockroachImpl) Replace_ProjectInvitation(ctx context.Context,
 	__email_val := project_invitation_email.value()
 	__inviter_id_val := optional.InviterId.value()
 	__created_at_val := __now
+	__role_val := optional.Role.value()
 
-	var __embed_stmt = __sqlbundle_Literal("UPSERT INTO project_invitations ( project_id, email, inviter_id, created_at ) VALUES ( ?, ?, ?, ? ) RETURNING project_invitations.project_id, project_invitations.email, project_invitations.inviter_id, project_invitations.created_at")
+	var __embed_stmt = __sqlbundle_Literal("UPSERT INTO project_invitations ( project_id, email, inviter_id, created_at, role ) VALUES ( ?, ?, ?, ?, ? ) RETURNING project_invitations.project_id, project_invitations.email, project_invitations.inviter_id, project_invitations.created_at, project_invitations.role")
 
 	var __values []interface{}
-	__values = append(__values, __project_id_val, __email_val, __inviter_id_val, __created_at_val)
+	__values = append(__values, __project_id_val, __email_val, __inviter_id_val, __created_at_val, __role_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	project_invitation = &ProjectInvitation{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_invitation.ProjectId, &project_invitation.Email, &project_invitation.InviterId, &project_invitation.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_invitation.ProjectId, &project_invitation.Email, &project_invitation.InviterId, &project_invitation.CreatedAt, &project_invitation.Role)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -21260,17 +22748,23 @@ func (obj *pgxcockroachImpl) Create_BucketMetainfo(ctx context.Context,
 	__default_redundancy_optimal_shares_val := bucket_metainfo_default_redundancy_optimal_shares.value()
 	__default_redundancy_total_shares_val := bucket_metainfo_default_redundancy_total_shares.value()
 	__placement_val := optional.Placement.value()
+	__versioning_val := optional.Versioning.value()
+	__default_retention_mode_val := optional.DefaultRetentionMode.value()
+	__default_retention_days_val := optional.DefaultRetentionDays.value()
+	__lifecycle_configuration_val := optional.LifecycleConfiguration.value()
+	__notification_configuration_val := optional.NotificationConfiguration.value()
+	__rate_limits_val := optional.RateLimits.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_metainfos ( id, project_id, name, user_agent, path_cipher, created_at, default_segment_size, default_encryption_cipher_suite, default_encryption_block_size, default_redundancy_algorithm, default_redundancy_share_size, default_redundancy_required_shares, default_redundancy_repair_shares, default_redundancy_optimal_shares, default_redundancy_total_shares, placement ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_metainfos ( id, project_id, name, user_agent, path_cipher, created_at, default_segment_size, default_encryption_cipher_suite, default_encryption_block_size, default_redundancy_algorithm, default_redundancy_share_size, default_redundancy_required_shares, default_redundancy_repair_shares, default_redundancy_optimal_shares, default_redundancy_total_shares, placement, versioning, default_retention_mode, default_retention_days, lifecycle_configuration, notification_configuration, rate_limits ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.versioning, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.lifecycle_configuration, bucket_metainfos.notification_configuration, bucket_metainfos.rate_limits")
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __project_id_val, __name_val, __user_agent_val, __path_cipher_val, __created_at_val, __default_segment_size_val, __default_encryption_cipher_suite_val, __default_encryption_block_size_val, __default_redundancy_algorithm_val, __default_redundancy_share_size_val, __default_redundancy_required_shares_val, __default_redundancy_repair_shares_val, __default_redundancy_optimal_shares_val, __default_redundancy_total_shares_val, __placement_val)
+	__values = append(__values, __id_val, __project_id_val, __name_val, __user_agent_val, __path_cipher_val, __created_at_val, __default_segment_size_val, __default_encryption_cipher_suite_val, __default_encryption_block_size_val, __default_redundancy_algorithm_val, __default_redundancy_share_size_val, __default_redundancy_required_shares_val, __default_redundancy_repair_shares_val, __default_redundancy_optimal_shares_val, __default_redundancy_total_shares_val, __placement_val, __versioning_val, __default_retention_mode_val, __default_retention_days_val, __lifecycle_configuration_val, __notification_configuration_val, __rate_limits_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.Versioning, &bucket_metainfo.DefaultRetentionMode, &bucket_metainfo.DefaultRetentionDays, &bucket_metainfo.LifecycleConfiguration, &bucket_metainfo.NotificationConfiguration, &bucket_metainfo.RateLimits)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -24171,7 +25665,7 @@ func (obj *pgxcockroachImpl) All_ProjectMember_By_MemberId(ctx context.Context,
 	rows []*ProjectMember, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE project_members.member_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at, project_members.role FROM project_members WHERE project_members.member_id = ?")
 
 	var __values []interface{}
 	__values = append(__values, project_member_member_id.value())
@@ -24189,7 +25683,7 @@ func (obj *pgxcockroachImpl) All_ProjectMember_By_MemberId(ctx context.Context,
 
 			for __rows.Next() {
 				project_member := &ProjectMember{}
-				err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+				err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt, &project_member.Role)
 				if err != nil {
 					return nil, err
 				}
@@ -24217,7 +25711,7 @@ func (obj *pgxcockroachImpl) Get_ProjectInvitation_By_ProjectId_And_Email(ctx co
 	project_invitation *ProjectInvitation, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_invitations.project_id, project_invitations.email, project_invitations.inviter_id, project_invitations.created_at FROM project_invitations WHERE project_invitations.project_id = ? AND project_invitations.email = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_invitations.project_id, project_invitations.email, project_invitations.inviter_id, project_invitations.created_at, project_invitations.role FROM project_invitations WHERE project_invitations.project_id = ? AND project_invitations.email = ?")
 
 	var __values []interface{}
 	__values = append(__values, project_invitation_project_id.value(), project_invitation_email.value())
@@ -24226,7 +25720,7 @@ func (obj *pgxcockroachImpl) Get_ProjectInvitation_By_ProjectId_And_Email(ctx co
 	obj.logStmt(__stmt, __values...)
 
 	project_invitation = &ProjectInvitation{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_invitation.ProjectId, &project_invitation.Email, &project_invitation.InviterId, &project_invitation.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_invitation.ProjectId, &project_invitation.Email, &project_invitation.InviterId, &project_invitation.CreatedAt, &project_invitation.Role)
 	if err != nil {
 		return (*ProjectInvitation)(nil), obj.makeErr(err)
 	}
@@ -24239,7 +25733,7 @@ func (obj *pgxcockroachImpl) All_ProjectInvitation_By_Email(ctx context.Context,
 	rows []*ProjectInvitation, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_invitations.project_id, project_invitations.email, project_invitations.inviter_id, project_invitations.created_at FROM project_invitations WHERE project_invitations.email = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_invitations.project_id, project_invitations.email, project_invitations.inviter_id, project_invitations.created_at, project_invitations.role FROM project_invitations WHERE project_invitations.email = ?")
 
 	var __values []interface{}
 	__values = append(__values, project_invitation_email.value())
@@ -24257,7 +25751,7 @@ func (obj *pgxcockroachImpl) All_ProjectInvitation_By_Email(ctx context.Context,
 
 			for __rows.Next() {
 				project_invitation := &ProjectInvitation{}
-				err = __rows.Scan(&project_invitation.ProjectId, &project_invitation.Email, &project_invitation.InviterId, &project_invitation.CreatedAt)
+				err = __rows.Scan(&project_invitation.ProjectId, &project_invitation.Email, &project_invitation.InviterId, &project_invitation.CreatedAt, &project_invitation.Role)
 				if err != nil {
 					return nil, err
 				}
@@ -24284,7 +25778,7 @@ func (obj *pgxcockroachImpl) All_ProjectInvitation_By_ProjectId(ctx context.Cont
 	rows []*ProjectInvitation, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_invitations.project_id, project_invitations.email, project_invitations.inviter_id, project_invitations.created_at FROM project_invitations WHERE project_invitations.project_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_invitations.project_id, project_invitations.email, project_invitations.inviter_id, project_invitations.created_at, project_invitations.role FROM project_invitations WHERE project_invitations.project_id = ?")
 
 	var __values []interface{}
 	__values = append(__values, project_invitation_project_id.value())
@@ -24302,7 +25796,7 @@ func (obj *pgxcockroachImpl) All_ProjectInvitation_By_ProjectId(ctx context.Cont
 
 			for __rows.Next() {
 				project_invitation := &ProjectInvitation{}
-				err = __rows.Scan(&project_invitation.ProjectId, &project_invitation.Email, &project_invitation.InviterId, &project_invitation.CreatedAt)
+				err = __rows.Scan(&project_invitation.ProjectId, &project_invitation.Email, &project_invitation.InviterId, &project_invitation.CreatedAt, &project_invitation.Role)
 				if err != nil {
 					return nil, err
 				}
@@ -24397,7 +25891,7 @@ func (obj *pgxcockroachImpl) Get_BucketMetainfo_By_ProjectId_And_Name(ctx contex
 	bucket_metainfo *BucketMetainfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.versioning, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.lifecycle_configuration, bucket_metainfos.notification_configuration, bucket_metainfos.rate_limits FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
@@ -24406,7 +25900,7 @@ func (obj *pgxcockroachImpl) Get_BucketMetainfo_By_ProjectId_And_Name(ctx contex
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.Versioning, &bucket_metainfo.DefaultRetentionMode, &bucket_metainfo.DefaultRetentionDays, &bucket_metainfo.LifecycleConfiguration, &bucket_metainfo.NotificationConfiguration, &bucket_metainfo.RateLimits)
 	if err != nil {
 		return (*BucketMetainfo)(nil), obj.makeErr(err)
 	}
@@ -24483,6 +25977,98 @@ func (obj *pgxcockroachImpl) Get_BucketMetainfo_Placement_By_ProjectId_And_Name(
 
 }
 
+func (obj *pgxcockroachImpl) Get_BucketMetainfo_Versioning_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *Versioning_Row, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.versioning FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &Versioning_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.Versioning)
+	if err != nil {
+		return (*Versioning_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *pgxcockroachImpl) Get_BucketMetainfo_LifecycleConfiguration_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *LifecycleConfiguration_Row, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.lifecycle_configuration FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &LifecycleConfiguration_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.LifecycleConfiguration)
+	if err != nil {
+		return (*LifecycleConfiguration_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *pgxcockroachImpl) Get_BucketMetainfo_NotificationConfiguration_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *NotificationConfiguration_Row, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.notification_configuration FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &NotificationConfiguration_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.NotificationConfiguration)
+	if err != nil {
+		return (*NotificationConfiguration_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *pgxcockroachImpl) Get_BucketMetainfo_RateLimits_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *RateLimits_Row, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.rate_limits FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &RateLimits_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.RateLimits)
+	if err != nil {
+		return (*RateLimits_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
 func (obj *pgxcockroachImpl) Get_BucketMetainfo_UserAgent_By_ProjectId_And_Name(ctx context.Context,
 	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 	bucket_metainfo_name BucketMetainfo_Name_Field) (
@@ -24535,7 +26121,7 @@ func (obj *pgxcockroachImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greate
 	rows []*BucketMetainfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name >= ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.versioning, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.lifecycle_configuration, bucket_metainfos.notification_configuration, bucket_metainfos.rate_limits FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name >= ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name_greater_or_equal.value())
@@ -24555,7 +26141,7 @@ func (obj *pgxcockroachImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greate
 
 			for __rows.Next() {
 				bucket_metainfo := &BucketMetainfo{}
-				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.Versioning, &bucket_metainfo.DefaultRetentionMode, &bucket_metainfo.DefaultRetentionDays, &bucket_metainfo.LifecycleConfiguration, &bucket_metainfo.NotificationConfiguration, &bucket_metainfo.RateLimits)
 				if err != nil {
 					return nil, err
 				}
@@ -24585,7 +26171,7 @@ func (obj *pgxcockroachImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greate
 	rows []*BucketMetainfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name > ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.versioning, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.lifecycle_configuration, bucket_metainfos.notification_configuration, bucket_metainfos.rate_limits FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name > ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name_greater.value())
@@ -24605,7 +26191,7 @@ func (obj *pgxcockroachImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greate
 
 			for __rows.Next() {
 				bucket_metainfo := &BucketMetainfo{}
-				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.Versioning, &bucket_metainfo.DefaultRetentionMode, &bucket_metainfo.DefaultRetentionDays, &bucket_metainfo.LifecycleConfiguration, &bucket_metainfo.NotificationConfiguration, &bucket_metainfo.RateLimits)
 				if err != nil {
 					return nil, err
 				}
@@ -26720,6 +28306,48 @@ func (obj *pgxcockroachImpl) Update_Project_By_Id(ctx context.Context,
 	return project, nil
 }
 
+func (obj *pgxcockroachImpl) Update_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field,
+	project_member_project_id ProjectMember_ProjectId_Field,
+	update ProjectMember_Update_Fields) (
+	project_member *ProjectMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_members SET "), __sets, __sqlbundle_Literal(" WHERE project_members.member_id = ? AND project_members.project_id = ? RETURNING project_members.member_id, project_members.project_id, project_members.created_at, project_members.role")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Role._set {
+		__values = append(__values, update.Role.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("role = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, project_member_member_id.value(), project_member_project_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_member = &ProjectMember{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt, &project_member.Role)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_member, nil
+}
+
 func (obj *pgxcockroachImpl) Update_ProjectInvitation_By_ProjectId_And_Email(ctx context.Context,
 	project_invitation_project_id ProjectInvitation_ProjectId_Field,
 	project_invitation_email ProjectInvitation_Email_Field,
@@ -26728,7 +28356,7 @@ func (obj *pgxcockroachImpl) Update_ProjectInvitation_By_ProjectId_And_Email(ctx
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_invitations SET "), __sets, __sqlbundle_Literal(" WHERE project_invitations.project_id = ? AND project_invitations.email = ? RETURNING project_invitations.project_id, project_invitations.email, project_invitations.inviter_id, project_invitations.created_at")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_invitations SET "), __sets, __sqlbundle_Literal(" WHERE project_invitations.project_id = ? AND project_invitations.email = ? RETURNING project_invitations.project_id, project_invitations.email, project_invitations.inviter_id, project_invitations.created_at, project_invitations.role")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -26744,6 +28372,11 @@ func (obj *pgxcockroachImpl) Update_ProjectInvitation_By_ProjectId_And_Email(ctx
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("created_at = ?"))
 	}
 
+	if update.Role._set {
+		__values = append(__values, update.Role.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("role = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -26757,7 +28390,7 @@ func (obj *pgxcockroachImpl) Update_ProjectInvitation_By_ProjectId_And_Email(ctx
 	obj.logStmt(__stmt, __values...)
 
 	project_invitation = &ProjectInvitation{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_invitation.ProjectId, &project_invitation.Email, &project_invitation.InviterId, &project_invitation.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_invitation.ProjectId, &project_invitation.Email, &project_invitation.InviterId, &project_invitation.CreatedAt, &project_invitation.Role)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -26812,7 +28445,7 @@ func (obj *pgxcockroachImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx con
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_metainfos SET "), __sets, __sqlbundle_Literal(" WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ? RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_metainfos SET "), __sets, __sqlbundle_Literal(" WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ? RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.versioning, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.lifecycle_configuration, bucket_metainfos.notification_configuration, bucket_metainfos.rate_limits")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -26872,6 +28505,30 @@ func (obj *pgxcockroachImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx con
 		__values = append(__values, update.Placement.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("placement = ?"))
 	}
+	if update.Versioning._set {
+		__values = append(__values, update.Versioning.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("versioning = ?"))
+	}
+	if update.DefaultRetentionMode._set {
+		__values = append(__values, update.DefaultRetentionMode.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_retention_mode = ?"))
+	}
+	if update.DefaultRetentionDays._set {
+		__values = append(__values, update.DefaultRetentionDays.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_retention_days = ?"))
+	}
+	if update.LifecycleConfiguration._set {
+		__values = append(__values, update.LifecycleConfiguration.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("lifecycle_configuration = ?"))
+	}
+	if update.NotificationConfiguration._set {
+		__values = append(__values, update.NotificationConfiguration.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("notification_configuration = ?"))
+	}
+	if update.RateLimits._set {
+		__values = append(__values, update.RateLimits.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("rate_limits = ?"))
+	}
 
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
@@ -26886,7 +28543,7 @@ func (obj *pgxcockroachImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx con
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.Versioning, &bucket_metainfo.DefaultRetentionMode, &bucket_metainfo.DefaultRetentionDays, &bucket_metainfo.LifecycleConfiguration, &bucket_metainfo.NotificationConfiguration, &bucket_metainfo.RateLimits)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -27841,6 +29498,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_usage_alerts;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -27881,6 +29548,26 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM webauthn_credentials;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM webauthn_challenges;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -28041,6 +29728,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM sso_identities;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -28231,6 +29928,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM console_audit_events;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -28908,13 +30615,14 @@ func (rx *Rx) Create_Project(ctx context.Context,
 
 func (rx *Rx) Create_ProjectMember(ctx context.Context,
 	project_member_member_id ProjectMember_MemberId_Field,
-	project_member_project_id ProjectMember_ProjectId_Field) (
+	project_member_project_id ProjectMember_ProjectId_Field,
+	optional ProjectMember_Create_Fields) (
 	project_member *ProjectMember, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_ProjectMember(ctx, project_member_member_id, project_member_project_id)
+	return tx.Create_ProjectMember(ctx, project_member_member_id, project_member_project_id, optional)
 
 }
 
@@ -29438,6 +31146,50 @@ func (rx *Rx) Get_BucketMetainfo_Placement_By_ProjectId_And_Name(ctx context.Con
 	return tx.Get_BucketMetainfo_Placement_By_ProjectId_And_Name(ctx, bucket_metainfo_project_id, bucket_metainfo_name)
 }
 
+func (rx *Rx) Get_BucketMetainfo_Versioning_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *Versioning_Row, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_BucketMetainfo_Versioning_By_ProjectId_And_Name(ctx, bucket_metainfo_project_id, bucket_metainfo_name)
+}
+
+func (rx *Rx) Get_BucketMetainfo_LifecycleConfiguration_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *LifecycleConfiguration_Row, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_BucketMetainfo_LifecycleConfiguration_By_ProjectId_And_Name(ctx, bucket_metainfo_project_id, bucket_metainfo_name)
+}
+
+func (rx *Rx) Get_BucketMetainfo_NotificationConfiguration_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *NotificationConfiguration_Row, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_BucketMetainfo_NotificationConfiguration_By_ProjectId_And_Name(ctx, bucket_metainfo_project_id, bucket_metainfo_name)
+}
+
+func (rx *Rx) Get_BucketMetainfo_RateLimits_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *RateLimits_Row, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_BucketMetainfo_RateLimits_By_ProjectId_And_Name(ctx, bucket_metainfo_project_id, bucket_metainfo_name)
+}
+
 func (rx *Rx) Get_BucketMetainfo_UserAgent_By_ProjectId_And_Name(ctx context.Context,
 	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 	bucket_metainfo_name BucketMetainfo_Name_Field) (
@@ -30329,6 +32081,18 @@ func (rx *Rx) Update_ProjectInvitation_By_ProjectId_And_Email(ctx context.Contex
 	return tx.Update_ProjectInvitation_By_ProjectId_And_Email(ctx, project_invitation_project_id, project_invitation_email, update)
 }
 
+func (rx *Rx) Update_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field,
+	project_member_project_id ProjectMember_ProjectId_Field,
+	update ProjectMember_Update_Fields) (
+	project_member *ProjectMember, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_ProjectMember_By_MemberId_And_ProjectId(ctx, project_member_member_id, project_member_project_id, update)
+}
+
 func (rx *Rx) Update_Project_By_Id(ctx context.Context,
 	project_id Project_Id_Field,
 	update Project_Update_Fields) (
@@ -30697,7 +32461,8 @@ type Methods interface {
 
 	Create_ProjectMember(ctx context.Context,
 		project_member_member_id ProjectMember_MemberId_Field,
-		project_member_project_id ProjectMember_ProjectId_Field) (
+		project_member_project_id ProjectMember_ProjectId_Field,
+		optional ProjectMember_Create_Fields) (
 		project_member *ProjectMember, err error)
 
 	Create_RegistrationToken(ctx context.Context,
@@ -30932,6 +32697,26 @@ type Methods interface {
 		bucket_metainfo_name BucketMetainfo_Name_Field) (
 		row *Placement_Row, err error)
 
+	Get_BucketMetainfo_Versioning_By_ProjectId_And_Name(ctx context.Context,
+		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+		bucket_metainfo_name BucketMetainfo_Name_Field) (
+		row *Versioning_Row, err error)
+
+	Get_BucketMetainfo_LifecycleConfiguration_By_ProjectId_And_Name(ctx context.Context,
+		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+		bucket_metainfo_name BucketMetainfo_Name_Field) (
+		row *LifecycleConfiguration_Row, err error)
+
+	Get_BucketMetainfo_NotificationConfiguration_By_ProjectId_And_Name(ctx context.Context,
+		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+		bucket_metainfo_name BucketMetainfo_Name_Field) (
+		row *NotificationConfiguration_Row, err error)
+
+	Get_BucketMetainfo_RateLimits_By_ProjectId_And_Name(ctx context.Context,
+		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+		bucket_metainfo_name BucketMetainfo_Name_Field) (
+		row *RateLimits_Row, err error)
+
 	Get_BucketMetainfo_UserAgent_By_ProjectId_And_Name(ctx context.Context,
 		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 		bucket_metainfo_name BucketMetainfo_Name_Field) (
@@ -31337,6 +33122,12 @@ type Methods interface {
 		update ProjectInvitation_Update_Fields) (
 		project_invitation *ProjectInvitation, err error)
 
+	Update_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
+		project_member_member_id ProjectMember_MemberId_Field,
+		project_member_project_id ProjectMember_ProjectId_Field,
+		update ProjectMember_Update_Fields) (
+		project_member *ProjectMember, err error)
+
 	Update_Project_By_Id(ctx context.Context,
 		project_id Project_Id_Field,
 		update Project_Update_Fields) (
@@ -2422,6 +2422,138 @@ func (db *satelliteDB) ProductionMigration() *migrate.Migration {
 						PRIMARY KEY ( node_id, name, signer ));`,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add versioning to bucket_metainfos",
+				Version:     242,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN versioning integer;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add default retention to bucket_metainfos",
+				Version:     243,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN default_retention_mode integer;`,
+					`ALTER TABLE bucket_metainfos ADD COLUMN default_retention_days integer;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add lifecycle configuration to bucket_metainfos",
+				Version:     244,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN lifecycle_configuration bytea;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add notification configuration to bucket_metainfos",
+				Version:     245,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN notification_configuration bytea;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add rate_limits to bucket_metainfos",
+				Version:     246,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN rate_limits bytea;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add role to project_members and project_invitations",
+				Version:     247,
+				Action: migrate.SQL{
+					`ALTER TABLE project_members ADD COLUMN role integer;`,
+					`ALTER TABLE project_invitations ADD COLUMN role integer;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add console_audit_events table",
+				Version:     248,
+				Action: migrate.SQL{
+					`CREATE TABLE console_audit_events (
+						id bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						operation text NOT NULL,
+						user_id bytea,
+						email text NOT NULL,
+						source_ip text NOT NULL,
+						forwarded_for_ip text NOT NULL,
+						request_id text NOT NULL,
+						project_id bytea,
+						api_key_id bytea,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX console_audit_events_project_id_created_at_index ON console_audit_events ( project_id, created_at );`,
+					`CREATE INDEX console_audit_events_user_id_created_at_index ON console_audit_events ( user_id, created_at );`,
+					`CREATE INDEX console_audit_events_created_at_index ON console_audit_events ( created_at );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add sso_identities table",
+				Version:     249,
+				Action: migrate.SQL{
+					`CREATE TABLE sso_identities (
+						provider text NOT NULL,
+						subject text NOT NULL,
+						user_id bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						PRIMARY KEY ( provider, subject )
+					);`,
+					`CREATE INDEX sso_identities_user_id_index ON sso_identities ( user_id );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add webauthn_credentials and webauthn_challenges tables",
+				Version:     250,
+				Action: migrate.SQL{
+					`CREATE TABLE webauthn_credentials (
+						id bytea NOT NULL,
+						user_id bytea NOT NULL,
+						name text NOT NULL,
+						credential_id bytea NOT NULL,
+						public_key bytea NOT NULL,
+						sign_count bigint NOT NULL,
+						created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						last_used_at timestamp with time zone,
+						PRIMARY KEY ( id ),
+						UNIQUE ( user_id, credential_id )
+					);`,
+					`CREATE TABLE webauthn_challenges (
+						nonce bytea NOT NULL,
+						expires_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( nonce )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add project_usage_alerts table",
+				Version:     251,
+				Action: migrate.SQL{
+					`CREATE TABLE project_usage_alerts (
+						id bytea NOT NULL,
+						project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+						kind text NOT NULL,
+						threshold bigint NOT NULL,
+						webhook_url text,
+						notified_at timestamp with time zone,
+						webhook_delivered_at timestamp with time zone,
+						webhook_attempts integer NOT NULL DEFAULT 0,
+						created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX project_usage_alerts_project_id_index ON project_usage_alerts ( project_id );`,
+				},
+			},
 			// NB: after updating testdata in `testdata`, run
 			//     `go generate` to update `migratez.go`.
 		},
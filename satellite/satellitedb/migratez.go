@@ -13,7 +13,7 @@ func (db *satelliteDB) testMigration() *migrate.Migration {
 			{
 				DB:          &db.migrationDB,
 				Description: "Testing setup",
-				Version:     241,
+				Version:     251,
 				Action: migrate.SQL{`-- AUTOGENERATED BY storj.io/dbx
 -- DO NOT EDIT
 CREATE TABLE account_freeze_events (
@@ -108,6 +108,19 @@ CREATE TABLE coinpayments_transactions (
                                            created_at timestamp with time zone NOT NULL,
                                            PRIMARY KEY ( id )
 );
+CREATE TABLE console_audit_events (
+	id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	operation text NOT NULL,
+	user_id bytea,
+	email text NOT NULL,
+	source_ip text NOT NULL,
+	forwarded_for_ip text NOT NULL,
+	request_id text NOT NULL,
+	project_id bytea,
+	api_key_id bytea,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE graceful_exit_progress (
                                         node_id bytea NOT NULL,
                                         bytes_transferred bigint NOT NULL,
@@ -337,6 +350,13 @@ CREATE TABLE segment_pending_audits (
                                         reverify_count bigint NOT NULL,
                                         PRIMARY KEY ( node_id )
 );
+CREATE TABLE sso_identities (
+	provider text NOT NULL,
+	subject text NOT NULL,
+	user_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( provider, subject )
+);
 CREATE TABLE storagenode_bandwidth_rollups (
                                                storagenode_id bytea NOT NULL,
                                                interval_start timestamp with time zone NOT NULL,
@@ -521,6 +541,23 @@ CREATE TABLE webapp_sessions (
                                  expires_at timestamp with time zone NOT NULL,
                                  PRIMARY KEY ( id )
 );
+CREATE TABLE webauthn_challenges (
+	nonce bytea NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( nonce )
+);
+CREATE TABLE webauthn_credentials (
+	id bytea NOT NULL,
+	user_id bytea NOT NULL,
+	name text NOT NULL,
+	credential_id bytea NOT NULL,
+	public_key bytea NOT NULL,
+	sign_count bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	last_used_at timestamp with time zone,
+	PRIMARY KEY ( id ),
+	UNIQUE ( user_id, credential_id )
+);
 CREATE TABLE api_keys (
                           id bytea NOT NULL,
                           project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
@@ -550,6 +587,12 @@ CREATE TABLE bucket_metainfos (
                                   default_redundancy_optimal_shares integer NOT NULL,
                                   default_redundancy_total_shares integer NOT NULL,
                                   placement integer,
+                                  versioning integer,
+                                  default_retention_mode integer,
+                                  default_retention_days integer,
+                                  lifecycle_configuration bytea,
+                                  notification_configuration bytea,
+                                  rate_limits bytea,
                                   PRIMARY KEY ( id ),
                                   UNIQUE ( project_id, name )
 );
@@ -558,14 +601,28 @@ CREATE TABLE project_invitations (
                                      email text NOT NULL,
                                      inviter_id bytea REFERENCES users( id ) ON DELETE SET NULL,
                                      created_at timestamp with time zone NOT NULL,
+                                     role integer,
                                      PRIMARY KEY ( project_id, email )
 );
 CREATE TABLE project_members (
                                  member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
                                  project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
                                  created_at timestamp with time zone NOT NULL,
+                                 role integer,
                                  PRIMARY KEY ( member_id, project_id )
 );
+CREATE TABLE project_usage_alerts (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	kind text NOT NULL,
+	threshold bigint NOT NULL,
+	webhook_url text,
+	notified_at timestamp with time zone,
+	webhook_delivered_at timestamp with time zone,
+	webhook_attempts integer NOT NULL DEFAULT 0,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE stripecoinpayments_apply_balance_intents (
                                                           tx_id text NOT NULL REFERENCES coinpayments_transactions( id ) ON DELETE CASCADE,
                                                           state integer NOT NULL,
@@ -579,6 +636,9 @@ CREATE INDEX bucket_bandwidth_rollups_action_interval_project_id_index ON bucket
 CREATE INDEX bucket_bandwidth_rollups_archive_project_id_action_interval_index ON bucket_bandwidth_rollup_archives ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_archive_action_interval_project_id_index ON bucket_bandwidth_rollup_archives ( action, interval_start, project_id ) ;
 CREATE INDEX bucket_storage_tallies_project_id_interval_start_index ON bucket_storage_tallies ( project_id, interval_start ) ;
+CREATE INDEX console_audit_events_project_id_created_at_index ON console_audit_events ( project_id, created_at ) ;
+CREATE INDEX console_audit_events_user_id_created_at_index ON console_audit_events ( user_id, created_at ) ;
+CREATE INDEX console_audit_events_created_at_index ON console_audit_events ( created_at ) ;
 CREATE INDEX graceful_exit_segment_transfer_nid_dr_qa_fa_lfa_index ON graceful_exit_segment_transfer_queue ( node_id, durability_ratio, queued_at, finished_at, last_failed_at ) ;
 CREATE INDEX node_last_ip ON nodes ( last_net ) ;
 CREATE INDEX nodes_dis_unk_off_exit_fin_last_success_index ON nodes ( disqualified, unknown_audit_suspended, offline_suspended, exit_finished_at, last_contact_success ) ;
@@ -596,6 +656,7 @@ CREATE INDEX project_bandwidth_daily_rollup_interval_day_index ON project_bandwi
 CREATE INDEX repair_queue_updated_at_index ON repair_queue ( updated_at ) ;
 CREATE INDEX repair_queue_num_healthy_pieces_attempted_at_index ON repair_queue ( segment_health, attempted_at ) ;
 CREATE INDEX reverification_audits_inserted_at_index ON reverification_audits ( inserted_at ) ;
+CREATE INDEX sso_identities_user_id_index ON sso_identities ( user_id ) ;
 CREATE INDEX storagenode_bandwidth_rollups_interval_start_index ON storagenode_bandwidth_rollups ( interval_start ) ;
 CREATE INDEX storagenode_bandwidth_rollup_archives_interval_start_index ON storagenode_bandwidth_rollup_archives ( interval_start ) ;
 CREATE INDEX storagenode_payments_node_id_period_index ON storagenode_payments ( node_id, period ) ;
@@ -608,6 +669,7 @@ CREATE INDEX webapp_sessions_user_id_index ON webapp_sessions ( user_id ) ;
 CREATE INDEX project_invitations_project_id_index ON project_invitations ( project_id ) ;
 CREATE INDEX project_invitations_email_index ON project_invitations ( email ) ;
 CREATE INDEX project_members_project_id_index ON project_members ( project_id ) ;
+CREATE INDEX project_usage_alerts_project_id_index ON project_usage_alerts ( project_id ) ;
 
 `},
 			},
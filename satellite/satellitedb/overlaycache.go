@@ -64,7 +64,7 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 	defer mon.Task()(&ctx)(&err)
 
 	query := `
-		SELECT id, address, last_net, last_ip_port, vetted_at, country_code, noise_proto, noise_public_key, debounce_limit, features, country_code
+		SELECT id, address, last_net, last_ip_port, vetted_at, country_code, noise_proto, noise_public_key, debounce_limit, features, country_code, free_disk
 			FROM nodes
 			` + cache.db.impl.AsOfSystemInterval(selectionCfg.AsOfSystemTime.Interval()) + `
 			WHERE disqualified IS NULL
@@ -110,7 +110,7 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 		var vettedAt *time.Time
 		var noise noiseScanner
 		err = rows.Scan(&node.ID, &node.Address.Address, &node.LastNet, &lastIPPort, &vettedAt, &node.CountryCode, &noise.Proto,
-			&noise.PublicKey, &node.Address.DebounceLimit, &node.Address.Features, &node.CountryCode)
+			&noise.PublicKey, &node.Address.DebounceLimit, &node.Address.Features, &node.CountryCode, &node.FreeDisk)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -122,6 +122,7 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 		// By similar logic, all nodes selected here are "online" in terms of the specified selectionCfg
 		// (specifically, OnlineWindow).
 		node.Online = true
+		node.Vetted = vettedAt != nil
 
 		if vettedAt == nil {
 			newNodes = append(newNodes, &node)
@@ -160,7 +161,8 @@ func (cache *overlaycache) selectAllStorageNodesDownload(ctx context.Context, on
 
 	query := `
 		SELECT id, address, last_net, last_ip_port, noise_proto, noise_public_key, debounce_limit, features, country_code,
-               exit_initiated_at IS NOT NULL AS exiting, (unknown_audit_suspended IS NOT NULL OR offline_suspended IS NOT NULL) AS suspended
+               exit_initiated_at IS NOT NULL AS exiting, (unknown_audit_suspended IS NOT NULL OR offline_suspended IS NOT NULL) AS suspended,
+               vetted_at IS NOT NULL AS vetted, free_disk
 			FROM nodes
 			` + cache.db.impl.AsOfSystemInterval(asOfConfig.Interval()) + `
 			WHERE disqualified IS NULL
@@ -186,7 +188,7 @@ func (cache *overlaycache) selectAllStorageNodesDownload(ctx context.Context, on
 		var noise noiseScanner
 		err = rows.Scan(&node.ID, &node.Address.Address, &node.LastNet, &lastIPPort, &noise.Proto,
 			&noise.PublicKey, &node.Address.DebounceLimit, &node.Address.Features, &node.CountryCode,
-			&node.Exiting, &node.Suspended)
+			&node.Exiting, &node.Suspended, &node.Vetted, &node.FreeDisk)
 		if err != nil {
 			return nil, err
 		}
@@ -418,7 +420,8 @@ func (cache *overlaycache) knownReliable(ctx context.Context, nodeIDs storj.Node
 	}
 
 	err = withRows(cache.db.Query(ctx, `
-		SELECT id, address, last_net, last_ip_port, country_code, last_contact_success > $2 as online, exit_initiated_at IS NOT NULL as exiting
+		SELECT id, address, last_net, last_ip_port, country_code, last_contact_success > $2 as online, exit_initiated_at IS NOT NULL as exiting,
+			vetted_at IS NOT NULL as vetted, free_disk
 		FROM nodes
 			`+cache.db.impl.AsOfSystemInterval(asOfSystemInterval)+`
 		WHERE id = any($1::bytea[])
@@ -466,7 +469,8 @@ func (cache *overlaycache) reliable(ctx context.Context, onlineWindow, asOfSyste
 	defer mon.Task()(&ctx)(&err)
 
 	err = withRows(cache.db.Query(ctx, `
-		SELECT id, address, last_net, last_ip_port, country_code, last_contact_success > $1 as online, exit_initiated_at IS NOT NULL as exiting
+		SELECT id, address, last_net, last_ip_port, country_code, last_contact_success > $1 as online, exit_initiated_at IS NOT NULL as exiting,
+			vetted_at IS NOT NULL as vetted, free_disk
 		FROM nodes
 			`+cache.db.impl.AsOfSystemInterval(asOfSystemInterval)+`
 		WHERE disqualified IS NULL
@@ -497,7 +501,7 @@ func scanSelectedNode(rows tagsql.Rows) (nodeselection.SelectedNode, error) {
 	var node nodeselection.SelectedNode
 	node.Address = &pb.NodeAddress{}
 	var lastIPPort sql.NullString
-	err := rows.Scan(&node.ID, &node.Address.Address, &node.LastNet, &lastIPPort, &node.CountryCode, &node.Online, &node.Exiting)
+	err := rows.Scan(&node.ID, &node.Address.Address, &node.LastNet, &lastIPPort, &node.CountryCode, &node.Online, &node.Exiting, &node.Vetted, &node.FreeDisk)
 	if err != nil {
 		return nodeselection.SelectedNode{}, err
 	}
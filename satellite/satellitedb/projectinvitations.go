@@ -27,7 +27,9 @@ func (invites *projectInvitations) Upsert(ctx context.Context, invite *console.P
 		return nil, Error.New("invitation is nil")
 	}
 
-	createFields := dbx.ProjectInvitation_Create_Fields{}
+	createFields := dbx.ProjectInvitation_Create_Fields{
+		Role: dbx.ProjectInvitation_Role(int(invite.Role)),
+	}
 	if invite.InviterID != nil {
 		id := invite.InviterID[:]
 		createFields.InviterId = dbx.ProjectInvitation_InviterId(id)
@@ -105,6 +107,9 @@ func projectInvitationFromDBX(dbxInvite *dbx.ProjectInvitation) (_ *console.Proj
 		Email:     dbxInvite.Email,
 		CreatedAt: dbxInvite.CreatedAt,
 	}
+	if dbxInvite.Role != nil {
+		invite.Role = console.ProjectMemberRole(*dbxInvite.Role)
+	}
 
 	projectID, err := uuid.FromBytes(dbxInvite.ProjectId)
 	if err != nil {
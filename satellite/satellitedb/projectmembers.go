@@ -5,6 +5,7 @@ package satellitedb
 
 import (
 	"context"
+	"database/sql"
 	"strings"
 	"time"
 
@@ -96,9 +97,9 @@ func (pm *projectMembers) GetPagedWithInvitationsByProjectID(ctx context.Context
 	}
 
 	membersQuery := `
-		SELECT member_id, project_id, created_at, email, inviter_id FROM (
+		SELECT member_id, project_id, created_at, email, inviter_id, role FROM (
 			(
-				SELECT pm.member_id, pm.project_id, pm.created_at, u.email, u.full_name, NULL as inviter_id
+				SELECT pm.member_id, pm.project_id, pm.created_at, u.email, u.full_name, NULL as inviter_id, pm.role
 				FROM project_members pm
 				INNER JOIN users u ON pm.member_id = u.id
 				WHERE pm.project_id = $1
@@ -108,7 +109,7 @@ func (pm *projectMembers) GetPagedWithInvitationsByProjectID(ctx context.Context
 					u.short_name ILIKE $2
 				)
 			) UNION ALL (
-				SELECT NULL as member_id, project_id, created_at, LOWER(email) as email, LOWER(SPLIT_PART(email, '@', 1)) as full_name, inviter_id
+				SELECT NULL as member_id, project_id, created_at, LOWER(email) as email, LOWER(SPLIT_PART(email, '@', 1)) as full_name, inviter_id, role
 				FROM project_invitations pi
 				WHERE project_id = $1
 				AND email ILIKE $2
@@ -138,6 +139,7 @@ func (pm *projectMembers) GetPagedWithInvitationsByProjectID(ctx context.Context
 			createdAt time.Time
 			email     string
 			inviterID uuid.NullUUID
+			role      *int
 		)
 
 		err = rows.Scan(
@@ -146,21 +148,29 @@ func (pm *projectMembers) GetPagedWithInvitationsByProjectID(ctx context.Context
 			&createdAt,
 			&email,
 			&inviterID,
+			&role,
 		)
 		if err != nil {
 			return nil, err
 		}
 
+		var memberRole console.ProjectMemberRole
+		if role != nil {
+			memberRole = console.ProjectMemberRole(*role)
+		}
+
 		if memberID.Valid {
 			page.ProjectMembers = append(page.ProjectMembers, console.ProjectMember{
 				MemberID:  memberID.UUID,
 				ProjectID: projectID,
+				Role:      memberRole,
 				CreatedAt: createdAt,
 			})
 		} else {
 			invite := console.ProjectInvitation{
 				ProjectID: projectID,
 				Email:     email,
+				Role:      memberRole,
 				CreatedAt: createdAt,
 			}
 			if inviterID.Valid {
@@ -184,12 +194,21 @@ func (pm *projectMembers) GetPagedWithInvitationsByProjectID(ctx context.Context
 	return page, err
 }
 
-// Insert is a method for inserting project member into the database.
+// Insert is a method for inserting project member with the admin role into the database.
 func (pm *projectMembers) Insert(ctx context.Context, memberID, projectID uuid.UUID) (_ *console.ProjectMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return pm.InsertWithRole(ctx, memberID, projectID, console.RoleAdmin)
+}
+
+// InsertWithRole is a method for inserting project member with the given role into the database.
+func (pm *projectMembers) InsertWithRole(ctx context.Context, memberID, projectID uuid.UUID, role console.ProjectMemberRole) (_ *console.ProjectMember, err error) {
 	defer mon.Task()(&ctx)(&err)
 	createdProjectMember, err := pm.methods.Create_ProjectMember(ctx,
 		dbx.ProjectMember_MemberId(memberID[:]),
-		dbx.ProjectMember_ProjectId(projectID[:]))
+		dbx.ProjectMember_ProjectId(projectID[:]),
+		dbx.ProjectMember_Create_Fields{
+			Role: dbx.ProjectMember_Role(int(role)),
+		})
 	if err != nil {
 		return nil, err
 	}
@@ -197,6 +216,25 @@ func (pm *projectMembers) Insert(ctx context.Context, memberID, projectID uuid.U
 	return projectMemberFromDBX(ctx, createdProjectMember)
 }
 
+// UpdateRole is a method for updating the role of a project member.
+func (pm *projectMembers) UpdateRole(ctx context.Context, memberID, projectID uuid.UUID, role console.ProjectMemberRole) (_ *console.ProjectMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+	updatedProjectMember, err := pm.methods.Update_ProjectMember_By_MemberId_And_ProjectId(ctx,
+		dbx.ProjectMember_MemberId(memberID[:]),
+		dbx.ProjectMember_ProjectId(projectID[:]),
+		dbx.ProjectMember_Update_Fields{
+			Role: dbx.ProjectMember_Role(int(role)),
+		})
+	if err != nil {
+		return nil, err
+	}
+	if updatedProjectMember == nil {
+		return nil, sql.ErrNoRows
+	}
+
+	return projectMemberFromDBX(ctx, updatedProjectMember)
+}
+
 // Delete is a method for deleting project member by memberID and projectID from the database.
 func (pm *projectMembers) Delete(ctx context.Context, memberID, projectID uuid.UUID) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -226,11 +264,16 @@ func projectMemberFromDBX(ctx context.Context, projectMember *dbx.ProjectMember)
 		return nil, err
 	}
 
-	return &console.ProjectMember{
+	member := &console.ProjectMember{
 		MemberID:  memberID,
 		ProjectID: projectID,
 		CreatedAt: projectMember.CreatedAt,
-	}, nil
+	}
+	if projectMember.Role != nil {
+		member.Role = console.ProjectMemberRole(*projectMember.Role)
+	}
+
+	return member, nil
 }
 
 // projectMembersSortClause returns what ORDER BY clause should be used when sorting project member results.
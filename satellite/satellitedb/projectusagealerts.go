@@ -0,0 +1,157 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/private/tagsql"
+	"storj.io/storj/satellite/console"
+)
+
+// ensures that projectUsageAlerts implements console.ProjectUsageAlerts.
+var _ console.ProjectUsageAlerts = (*projectUsageAlerts)(nil)
+
+// projectUsageAlerts is an implementation of console.ProjectUsageAlerts.
+type projectUsageAlerts struct {
+	db *satelliteDB
+}
+
+// Insert is a method for inserting a usage alert into the database.
+func (alerts *projectUsageAlerts) Insert(ctx context.Context, alert *console.ProjectUsageAlert) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var webhookURL *string
+	if alert.WebhookURL != "" {
+		webhookURL = &alert.WebhookURL
+	}
+
+	err = alerts.db.QueryRowContext(ctx, `
+		INSERT INTO project_usage_alerts (id, project_id, kind, threshold, webhook_url)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING created_at
+	`, alert.ID, alert.ProjectID, string(alert.Kind), alert.Threshold, webhookURL,
+	).Scan(&alert.CreatedAt)
+	return Error.Wrap(err)
+}
+
+// GetByProjectID is a method for querying the usage alerts of the project in the order of their creation.
+func (alerts *projectUsageAlerts) GetByProjectID(ctx context.Context, projectID uuid.UUID) (_ []console.ProjectUsageAlert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := alerts.db.QueryContext(ctx, `
+		SELECT id, project_id, kind, threshold, webhook_url, notified_at, created_at,
+			webhook_delivered_at, webhook_attempts
+		FROM project_usage_alerts
+		WHERE project_id = $1
+		ORDER BY created_at, id
+	`, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return scanProjectUsageAlerts(rows)
+}
+
+// List is a method for querying at most limit usage alerts, whose ID is greater than the cursor, ordered by ID.
+func (alerts *projectUsageAlerts) List(ctx context.Context, cursor uuid.UUID, limit int) (_ []console.ProjectUsageAlert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := alerts.db.QueryContext(ctx, `
+		SELECT id, project_id, kind, threshold, webhook_url, notified_at, created_at,
+			webhook_delivered_at, webhook_attempts
+		FROM project_usage_alerts
+		WHERE id > $1
+		ORDER BY id
+		LIMIT $2
+	`, cursor, limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return scanProjectUsageAlerts(rows)
+}
+
+func scanProjectUsageAlerts(rows tagsql.Rows) (_ []console.ProjectUsageAlert, err error) {
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var result []console.ProjectUsageAlert
+	for rows.Next() {
+		var alert console.ProjectUsageAlert
+		var kind string
+		var webhookURL sql.NullString
+		var notifiedAt, webhookDeliveredAt sql.NullTime
+		err = rows.Scan(&alert.ID, &alert.ProjectID, &kind, &alert.Threshold, &webhookURL, &notifiedAt, &alert.CreatedAt,
+			&webhookDeliveredAt, &alert.WebhookAttempts)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		alert.Kind = console.UsageAlertKind(kind)
+		alert.WebhookURL = webhookURL.String
+		if notifiedAt.Valid {
+			alert.NotifiedAt = &notifiedAt.Time
+		}
+		if webhookDeliveredAt.Valid {
+			alert.WebhookDeliveredAt = &webhookDeliveredAt.Time
+		}
+		result = append(result, alert)
+	}
+
+	return result, Error.Wrap(rows.Err())
+}
+
+// UpdateNotifiedAt is a method for updating when the users were notified about the usage crossing the threshold.
+// It resets the failed webhook deliveries.
+func (alerts *projectUsageAlerts) UpdateNotifiedAt(ctx context.Context, id uuid.UUID, notifiedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = alerts.db.ExecContext(ctx, `
+		UPDATE project_usage_alerts SET notified_at = $2, webhook_attempts = 0 WHERE id = $1
+	`, id, notifiedAt)
+	return Error.Wrap(err)
+}
+
+// UpdateWebhookDeliveredAt is a method for updating when the webhook was notified about the usage crossing the threshold.
+func (alerts *projectUsageAlerts) UpdateWebhookDeliveredAt(ctx context.Context, id uuid.UUID, deliveredAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = alerts.db.ExecContext(ctx, `
+		UPDATE project_usage_alerts SET webhook_delivered_at = $2 WHERE id = $1
+	`, id, deliveredAt)
+	return Error.Wrap(err)
+}
+
+// IncrementWebhookAttempts is a method for counting a failed webhook delivery.
+func (alerts *projectUsageAlerts) IncrementWebhookAttempts(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = alerts.db.ExecContext(ctx, `
+		UPDATE project_usage_alerts SET webhook_attempts = webhook_attempts + 1 WHERE id = $1
+	`, id)
+	return Error.Wrap(err)
+}
+
+// Delete is a method for deleting a usage alert of the project.
+func (alerts *projectUsageAlerts) Delete(ctx context.Context, projectID, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := alerts.db.ExecContext(ctx, `
+		DELETE FROM project_usage_alerts WHERE project_id = $1 AND id = $2
+	`, projectID, id)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+// ensures that ssoIdentities implements console.SSOIdentities.
+var _ console.SSOIdentities = (*ssoIdentities)(nil)
+
+// ssoIdentities is an implementation of console.SSOIdentities.
+type ssoIdentities struct {
+	db *satelliteDB
+}
+
+// Insert is a method for linking an identity provider account to a user.
+func (identities *ssoIdentities) Insert(ctx context.Context, identity console.SSOIdentity) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = identities.db.ExecContext(ctx, `
+		INSERT INTO sso_identities (provider, subject, user_id) VALUES ($1, $2, $3)
+	`, identity.Provider, identity.Subject, identity.UserID)
+	return Error.Wrap(err)
+}
+
+// GetUserID is a method for querying the user linked to an identity provider account.
+func (identities *ssoIdentities) GetUserID(ctx context.Context, provider, subject string) (userID uuid.UUID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = identities.db.QueryRowContext(ctx, `
+		SELECT user_id FROM sso_identities WHERE provider = $1 AND subject = $2
+	`, provider, subject).Scan(&userID)
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+	return userID, nil
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"storj.io/storj/satellite/console"
+)
+
+// ensures that webAuthnChallenges implements console.WebAuthnChallenges.
+var _ console.WebAuthnChallenges = (*webAuthnChallenges)(nil)
+
+// webAuthnChallenges is an implementation of console.WebAuthnChallenges.
+type webAuthnChallenges struct {
+	db *satelliteDB
+}
+
+// Insert is a method for storing the nonce of a new challenge.
+func (challenges *webAuthnChallenges) Insert(ctx context.Context, nonce []byte, expiresAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = challenges.db.ExecContext(ctx, `
+		INSERT INTO webauthn_challenges (nonce, expires_at) VALUES ($1, $2)
+	`, nonce, expiresAt)
+	return Error.Wrap(err)
+}
+
+// Consume is a method for deleting the nonce of a challenge.
+func (challenges *webAuthnChallenges) Consume(ctx context.Context, nonce []byte, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := challenges.db.ExecContext(ctx, `
+		DELETE FROM webauthn_challenges WHERE nonce = $1 AND expires_at > $2
+	`, nonce, now)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// DeleteExpired is a method for deleting the nonces of the challenges, which expired before now.
+func (challenges *webAuthnChallenges) DeleteExpired(ctx context.Context, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = challenges.db.ExecContext(ctx, `
+		DELETE FROM webauthn_challenges WHERE expires_at <= $1
+	`, now)
+	return Error.Wrap(err)
+}
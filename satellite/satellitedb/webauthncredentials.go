@@ -0,0 +1,101 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+// ensures that webAuthnCredentials implements console.WebAuthnCredentials.
+var _ console.WebAuthnCredentials = (*webAuthnCredentials)(nil)
+
+// webAuthnCredentials is an implementation of console.WebAuthnCredentials.
+type webAuthnCredentials struct {
+	db *satelliteDB
+}
+
+// Insert is a method for inserting a credential into the database.
+func (credentials *webAuthnCredentials) Insert(ctx context.Context, credential *console.WebAuthnCredential) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = credentials.db.QueryRowContext(ctx, `
+		INSERT INTO webauthn_credentials (id, user_id, name, credential_id, public_key, sign_count)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING created_at
+	`, credential.ID, credential.UserID, credential.Name, credential.CredentialID, credential.PublicKey, int64(credential.SignCount),
+	).Scan(&credential.CreatedAt)
+	return Error.Wrap(err)
+}
+
+// GetByUserID is a method for querying the credentials of the user in the order of their registration.
+func (credentials *webAuthnCredentials) GetByUserID(ctx context.Context, userID uuid.UUID) (_ []console.WebAuthnCredential, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := credentials.db.QueryContext(ctx, `
+		SELECT id, name, credential_id, public_key, sign_count, created_at, last_used_at
+		FROM webauthn_credentials
+		WHERE user_id = $1
+		ORDER BY created_at, id
+	`, userID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var result []console.WebAuthnCredential
+	for rows.Next() {
+		credential := console.WebAuthnCredential{UserID: userID}
+		var signCount int64
+		var lastUsedAt sql.NullTime
+		err = rows.Scan(&credential.ID, &credential.Name, &credential.CredentialID, &credential.PublicKey, &signCount, &credential.CreatedAt, &lastUsedAt)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		credential.SignCount = uint32(signCount)
+		if lastUsedAt.Valid {
+			credential.LastUsedAt = &lastUsedAt.Time
+		}
+		result = append(result, credential)
+	}
+
+	return result, Error.Wrap(rows.Err())
+}
+
+// UpdateUsage is a method for updating the signature counter of a credential, which was used to sign in.
+func (credentials *webAuthnCredentials) UpdateUsage(ctx context.Context, id uuid.UUID, signCount uint32, usedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = credentials.db.ExecContext(ctx, `
+		UPDATE webauthn_credentials SET sign_count = $2, last_used_at = $3 WHERE id = $1
+	`, id, int64(signCount), usedAt)
+	return Error.Wrap(err)
+}
+
+// Delete is a method for deleting a credential of the user.
+func (credentials *webAuthnCredentials) Delete(ctx context.Context, userID, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := credentials.db.ExecContext(ctx, `
+		DELETE FROM webauthn_credentials WHERE user_id = $1 AND id = $2
+	`, userID, id)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package segmentanalysis contains generic ranged loop observers which
+// operators can enable from configuration to analyze segments.
+package segmentanalysis
+
+import (
+	"github.com/zeebo/errs"
+)
+
+// Error is the error class for this package.
+var Error = errs.Class("segment analysis")
+
+// Config contains the configuration of the segment analysis observers.
+type Config struct {
+	ReportDir    string `help:"directory where segment analysis reports are written, reports are only logged when empty" default:""`
+	ReportFormat string `help:"format of segment analysis reports (csv, json)" default:"csv"`
+}
@@ -0,0 +1,136 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package segmentanalysis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"storj.io/storj/satellite/metabase/rangedloop"
+)
+
+// RedundancyHistogram counts segments and their encrypted bytes for every
+// redundancy scheme in use.
+type RedundancyHistogram struct {
+	writer *ReportWriter
+	counts map[string]*segmentCount
+}
+
+var _ rangedloop.CheckpointObserver = (*RedundancyHistogram)(nil)
+
+type segmentCount struct {
+	Segments int64 `json:"segments"`
+	Bytes    int64 `json:"bytes"`
+}
+
+// NewRedundancyHistogram creates a redundancy histogram observer.
+func NewRedundancyHistogram(writer *ReportWriter) *RedundancyHistogram {
+	return &RedundancyHistogram{writer: writer}
+}
+
+// Start resets the histogram.
+func (obs *RedundancyHistogram) Start(ctx context.Context, startTime time.Time) error {
+	obs.counts = map[string]*segmentCount{}
+	return nil
+}
+
+// Fork returns a histogram for a single range.
+func (obs *RedundancyHistogram) Fork(ctx context.Context) (rangedloop.Partial, error) {
+	return &redundancyHistogramFork{counts: map[string]*segmentCount{}}, nil
+}
+
+// Join adds the counts of the range to the histogram.
+func (obs *RedundancyHistogram) Join(ctx context.Context, partial rangedloop.Partial) error {
+	fork, ok := partial.(*redundancyHistogramFork)
+	if !ok {
+		return Error.New("expected %T but got %T", fork, partial)
+	}
+
+	for scheme, count := range fork.counts {
+		total, ok := obs.counts[scheme]
+		if !ok {
+			total = &segmentCount{}
+			obs.counts[scheme] = total
+		}
+		total.Segments += count.Segments
+		total.Bytes += count.Bytes
+	}
+	return nil
+}
+
+// CheckpointPartial returns the counts of the range.
+func (obs *RedundancyHistogram) CheckpointPartial(ctx context.Context, partial rangedloop.Partial) ([]byte, error) {
+	fork, ok := partial.(*redundancyHistogramFork)
+	if !ok {
+		return nil, Error.New("expected %T but got %T", fork, partial)
+	}
+
+	data, err := json.Marshal(fork.counts)
+	return data, Error.Wrap(err)
+}
+
+// RestorePartial returns a histogram which continues from the checkpointed counts.
+func (obs *RedundancyHistogram) RestorePartial(ctx context.Context, data []byte) (rangedloop.Partial, error) {
+	fork := &redundancyHistogramFork{counts: map[string]*segmentCount{}}
+	if err := json.Unmarshal(data, &fork.counts); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return fork, nil
+}
+
+// Finish writes the histogram report.
+func (obs *RedundancyHistogram) Finish(ctx context.Context) error {
+	return obs.writer.Write(obs.Report())
+}
+
+// Report returns the histogram sorted by scheme.
+func (obs *RedundancyHistogram) Report() *Report {
+	schemes := make([]string, 0, len(obs.counts))
+	for scheme := range obs.counts {
+		schemes = append(schemes, scheme)
+	}
+	sort.Strings(schemes)
+
+	report := &Report{
+		Name:   "redundancy-histogram",
+		Header: []string{"scheme", "segments", "bytes"},
+	}
+	for _, scheme := range schemes {
+		count := obs.counts[scheme]
+		report.Rows = append(report.Rows, []string{
+			scheme,
+			strconv.FormatInt(count.Segments, 10),
+			strconv.FormatInt(count.Bytes, 10),
+		})
+	}
+	return report
+}
+
+type redundancyHistogramFork struct {
+	counts map[string]*segmentCount
+}
+
+// Process counts the segments by redundancy scheme.
+func (fork *redundancyHistogramFork) Process(ctx context.Context, segments []rangedloop.Segment) error {
+	for _, segment := range segments {
+		scheme := "inline"
+		if !segment.Inline() {
+			rs := segment.Redundancy
+			scheme = fmt.Sprintf("%d/%d/%d/%d-%d", rs.RequiredShares, rs.RepairShares, rs.OptimalShares, rs.TotalShares, rs.ShareSize)
+		}
+
+		count, ok := fork.counts[scheme]
+		if !ok {
+			count = &segmentCount{}
+			fork.counts[scheme] = count
+		}
+		count.Segments++
+		count.Bytes += int64(segment.EncryptedSize)
+	}
+	return nil
+}
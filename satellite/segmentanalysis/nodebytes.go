@@ -0,0 +1,137 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package segmentanalysis
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/metabase/rangedloop"
+)
+
+// NodeBytes aggregates the number of pieces and the bytes they use on every
+// node.
+type NodeBytes struct {
+	writer *ReportWriter
+	nodes  map[storj.NodeID]*pieceCount
+}
+
+var _ rangedloop.CheckpointObserver = (*NodeBytes)(nil)
+
+type pieceCount struct {
+	Pieces int64 `json:"pieces"`
+	Bytes  int64 `json:"bytes"`
+}
+
+// NewNodeBytes creates a bytes per node observer.
+func NewNodeBytes(writer *ReportWriter) *NodeBytes {
+	return &NodeBytes{writer: writer}
+}
+
+// Start resets the aggregated usage.
+func (obs *NodeBytes) Start(ctx context.Context, startTime time.Time) error {
+	obs.nodes = map[storj.NodeID]*pieceCount{}
+	return nil
+}
+
+// Fork returns an aggregator for a single range.
+func (obs *NodeBytes) Fork(ctx context.Context) (rangedloop.Partial, error) {
+	return &nodeBytesFork{nodes: map[storj.NodeID]*pieceCount{}}, nil
+}
+
+// Join adds the usage of the range to the total.
+func (obs *NodeBytes) Join(ctx context.Context, partial rangedloop.Partial) error {
+	fork, ok := partial.(*nodeBytesFork)
+	if !ok {
+		return Error.New("expected %T but got %T", fork, partial)
+	}
+
+	for nodeID, count := range fork.nodes {
+		total, ok := obs.nodes[nodeID]
+		if !ok {
+			total = &pieceCount{}
+			obs.nodes[nodeID] = total
+		}
+		total.Pieces += count.Pieces
+		total.Bytes += count.Bytes
+	}
+	return nil
+}
+
+// CheckpointPartial returns the usage of the range.
+func (obs *NodeBytes) CheckpointPartial(ctx context.Context, partial rangedloop.Partial) ([]byte, error) {
+	fork, ok := partial.(*nodeBytesFork)
+	if !ok {
+		return nil, Error.New("expected %T but got %T", fork, partial)
+	}
+
+	data, err := json.Marshal(fork.nodes)
+	return data, Error.Wrap(err)
+}
+
+// RestorePartial returns an aggregator which continues from the checkpointed usage.
+func (obs *NodeBytes) RestorePartial(ctx context.Context, data []byte) (rangedloop.Partial, error) {
+	fork := &nodeBytesFork{nodes: map[storj.NodeID]*pieceCount{}}
+	if err := json.Unmarshal(data, &fork.nodes); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return fork, nil
+}
+
+// Finish writes the usage report.
+func (obs *NodeBytes) Finish(ctx context.Context) error {
+	return obs.writer.Write(obs.Report())
+}
+
+// Report returns the usage of every node sorted by node ID.
+func (obs *NodeBytes) Report() *Report {
+	nodeIDs := make(storj.NodeIDList, 0, len(obs.nodes))
+	for nodeID := range obs.nodes {
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	sort.Slice(nodeIDs, func(i, j int) bool { return nodeIDs[i].Less(nodeIDs[j]) })
+
+	report := &Report{
+		Name:   "node-bytes",
+		Header: []string{"node_id", "pieces", "bytes"},
+	}
+	for _, nodeID := range nodeIDs {
+		count := obs.nodes[nodeID]
+		report.Rows = append(report.Rows, []string{
+			nodeID.String(),
+			strconv.FormatInt(count.Pieces, 10),
+			strconv.FormatInt(count.Bytes, 10),
+		})
+	}
+	return report
+}
+
+type nodeBytesFork struct {
+	nodes map[storj.NodeID]*pieceCount
+}
+
+// Process adds the piece size of every piece to the node holding it.
+func (fork *nodeBytesFork) Process(ctx context.Context, segments []rangedloop.Segment) error {
+	for _, segment := range segments {
+		if segment.Inline() {
+			continue
+		}
+
+		pieceSize := segment.PieceSize()
+		for _, piece := range segment.Pieces {
+			count, ok := fork.nodes[piece.StorageNode]
+			if !ok {
+				count = &pieceCount{}
+				fork.nodes[piece.StorageNode] = count
+			}
+			count.Pieces++
+			count.Bytes += pieceSize
+		}
+	}
+	return nil
+}
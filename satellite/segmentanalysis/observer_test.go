@@ -0,0 +1,175 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package segmentanalysis_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/metabase/rangedloop/rangedlooptest"
+	"storj.io/storj/satellite/segmentanalysis"
+)
+
+// badNodeChecker reports every piece on the bad node as out of placement.
+type badNodeChecker struct {
+	bad storj.NodeID
+}
+
+func (checker badNodeChecker) OutOfPlacementPieces(ctx context.Context, created time.Time, pieces metabase.Pieces, placement storj.PlacementConstraint) (result metabase.Pieces, _ error) {
+	for _, piece := range pieces {
+		if piece.StorageNode == checker.bad {
+			result = append(result, piece)
+		}
+	}
+	return result, nil
+}
+
+func TestObservers(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := zaptest.NewLogger(t)
+
+	nodeA, nodeB := storj.NodeID{1}, storj.NodeID{2}
+	rs := storj.RedundancyScheme{
+		Algorithm:      storj.ReedSolomon,
+		ShareSize:      256,
+		RequiredShares: 1,
+		RepairShares:   1,
+		OptimalShares:  2,
+		TotalShares:    2,
+	}
+
+	segments := []rangedloop.Segment{
+		{
+			StreamID:      testrand.UUID(),
+			EncryptedSize: 1024,
+			Redundancy:    rs,
+			Pieces:        metabase.Pieces{{Number: 0, StorageNode: nodeA}, {Number: 1, StorageNode: nodeB}},
+			Placement:     storj.EU,
+		},
+		{
+			StreamID:      testrand.UUID(),
+			EncryptedSize: 512,
+			Redundancy:    rs,
+			Pieces:        metabase.Pieces{{Number: 0, StorageNode: nodeA}},
+			Placement:     storj.EU,
+		},
+		{
+			StreamID:      testrand.UUID(),
+			EncryptedSize: 100,
+		},
+	}
+
+	dir := ctx.Dir("reports")
+	config := segmentanalysis.Config{ReportDir: dir, ReportFormat: "csv"}
+
+	registry := rangedloop.NewRegistry()
+	require.NoError(t, segmentanalysis.Register(registry, log, config, badNodeChecker{bad: nodeB}))
+
+	observers, err := registry.Create(registry.Names())
+	require.NoError(t, err)
+	require.Len(t, observers, 3)
+
+	service := rangedloop.NewService(log, rangedloop.Config{
+		Parallelism: 2,
+		BatchSize:   1,
+	}, &rangedlooptest.RangeSplitter{Segments: segments}, observers)
+
+	_, err = service.RunOnce(ctx)
+	require.NoError(t, err)
+
+	for _, observer := range observers {
+		switch observer := observer.(type) {
+		case *segmentanalysis.RedundancyHistogram:
+			require.Equal(t, [][]string{
+				{"1/1/2/2-256", "2", "1536"},
+				{"inline", "1", "100"},
+			}, observer.Report().Rows)
+		case *segmentanalysis.NodeBytes:
+			require.Equal(t, [][]string{
+				{nodeA.String(), "2", "2048"},
+				{nodeB.String(), "1", "1280"},
+			}, observer.Report().Rows)
+		case *segmentanalysis.PlacementViolations:
+			require.Equal(t, [][]string{
+				{"1", "2", "1", "1"},
+			}, observer.Report().Rows)
+		default:
+			t.Fatalf("unexpected observer %T", observer)
+		}
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "placement-violations-*.csv"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	// the partials continue from their checkpoints with the same results.
+	for _, observer := range observers {
+		checkpointer, ok := observer.(rangedloop.CheckpointObserver)
+		require.True(t, ok, "%T", observer)
+
+		reporter := observer.(interface {
+			Report() *segmentanalysis.Report
+		})
+		expected := reporter.Report().Rows
+
+		require.NoError(t, observer.Start(ctx, time.Now()))
+		partial, err := observer.Fork(ctx)
+		require.NoError(t, err)
+		require.NoError(t, partial.Process(ctx, segments[:1]))
+
+		data, err := checkpointer.CheckpointPartial(ctx, partial)
+		require.NoError(t, err)
+		partial, err = checkpointer.RestorePartial(ctx, data)
+		require.NoError(t, err)
+		require.NoError(t, partial.Process(ctx, segments[1:]))
+		require.NoError(t, observer.Join(ctx, partial))
+
+		require.Equal(t, expected, reporter.Report().Rows, "%T", observer)
+	}
+
+	data, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	require.Equal(t, "placement,segments,violating_segments,violating_pieces\n1,2,1,1\n", string(data))
+}
+
+func TestReportJSON(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	report := &segmentanalysis.Report{
+		Name:   "example",
+		Header: []string{"a", "b"},
+		Rows:   [][]string{{"1", "2"}},
+	}
+
+	dir := ctx.Dir("reports")
+	writer, err := segmentanalysis.NewReportWriter(zaptest.NewLogger(t), segmentanalysis.Config{ReportDir: dir, ReportFormat: "json"})
+	require.NoError(t, err)
+	require.NoError(t, writer.Write(report))
+
+	files, err := filepath.Glob(filepath.Join(dir, "example-*.json"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	data, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	require.JSONEq(t, `[{"a":"1","b":"2"}]`, string(data))
+
+	_, err = segmentanalysis.NewReportWriter(zaptest.NewLogger(t), segmentanalysis.Config{ReportDir: dir, ReportFormat: "xml"})
+	require.Error(t, err)
+
+	// an unknown format is rejected when the observers are registered.
+	err = segmentanalysis.Register(rangedloop.NewRegistry(), zaptest.NewLogger(t), segmentanalysis.Config{ReportFormat: "xml"}, nil)
+	require.Error(t, err)
+}
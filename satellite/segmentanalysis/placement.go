@@ -0,0 +1,162 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package segmentanalysis
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/rangedloop"
+)
+
+// PlacementChecker finds the pieces of a segment which are stored on nodes
+// outside of the segment placement.
+//
+// It is implemented by checker.ReliabilityCache.
+type PlacementChecker interface {
+	OutOfPlacementPieces(ctx context.Context, created time.Time, pieces metabase.Pieces, placement storj.PlacementConstraint) (metabase.Pieces, error)
+}
+
+// PlacementViolations counts, for every placement, the segments that have
+// pieces on nodes which do not match the placement.
+type PlacementViolations struct {
+	writer     *ReportWriter
+	checker    PlacementChecker
+	placements map[storj.PlacementConstraint]*violationCount
+}
+
+var _ rangedloop.CheckpointObserver = (*PlacementViolations)(nil)
+
+type violationCount struct {
+	Segments          int64 `json:"segments"`
+	ViolatingSegments int64 `json:"violating_segments"`
+	ViolatingPieces   int64 `json:"violating_pieces"`
+}
+
+// NewPlacementViolations creates a placement violation observer.
+func NewPlacementViolations(writer *ReportWriter, checker PlacementChecker) *PlacementViolations {
+	return &PlacementViolations{writer: writer, checker: checker}
+}
+
+// Start resets the counts.
+func (obs *PlacementViolations) Start(ctx context.Context, startTime time.Time) error {
+	obs.placements = map[storj.PlacementConstraint]*violationCount{}
+	return nil
+}
+
+// Fork returns a counter for a single range.
+func (obs *PlacementViolations) Fork(ctx context.Context) (rangedloop.Partial, error) {
+	return &placementViolationsFork{
+		checker:    obs.checker,
+		placements: map[storj.PlacementConstraint]*violationCount{},
+	}, nil
+}
+
+// Join adds the counts of the range to the total.
+func (obs *PlacementViolations) Join(ctx context.Context, partial rangedloop.Partial) error {
+	fork, ok := partial.(*placementViolationsFork)
+	if !ok {
+		return Error.New("expected %T but got %T", fork, partial)
+	}
+
+	for placement, count := range fork.placements {
+		total, ok := obs.placements[placement]
+		if !ok {
+			total = &violationCount{}
+			obs.placements[placement] = total
+		}
+		total.Segments += count.Segments
+		total.ViolatingSegments += count.ViolatingSegments
+		total.ViolatingPieces += count.ViolatingPieces
+	}
+	return nil
+}
+
+// CheckpointPartial returns the counts of the range.
+func (obs *PlacementViolations) CheckpointPartial(ctx context.Context, partial rangedloop.Partial) ([]byte, error) {
+	fork, ok := partial.(*placementViolationsFork)
+	if !ok {
+		return nil, Error.New("expected %T but got %T", fork, partial)
+	}
+
+	data, err := json.Marshal(fork.placements)
+	return data, Error.Wrap(err)
+}
+
+// RestorePartial returns a counter which continues from the checkpointed counts.
+func (obs *PlacementViolations) RestorePartial(ctx context.Context, data []byte) (rangedloop.Partial, error) {
+	fork := &placementViolationsFork{
+		checker:    obs.checker,
+		placements: map[storj.PlacementConstraint]*violationCount{},
+	}
+	if err := json.Unmarshal(data, &fork.placements); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return fork, nil
+}
+
+// Finish writes the violation report.
+func (obs *PlacementViolations) Finish(ctx context.Context) error {
+	return obs.writer.Write(obs.Report())
+}
+
+// Report returns the counts for every placement sorted by placement.
+func (obs *PlacementViolations) Report() *Report {
+	placements := make([]storj.PlacementConstraint, 0, len(obs.placements))
+	for placement := range obs.placements {
+		placements = append(placements, placement)
+	}
+	sort.Slice(placements, func(i, j int) bool { return placements[i] < placements[j] })
+
+	report := &Report{
+		Name:   "placement-violations",
+		Header: []string{"placement", "segments", "violating_segments", "violating_pieces"},
+	}
+	for _, placement := range placements {
+		count := obs.placements[placement]
+		report.Rows = append(report.Rows, []string{
+			strconv.FormatUint(uint64(placement), 10),
+			strconv.FormatInt(count.Segments, 10),
+			strconv.FormatInt(count.ViolatingSegments, 10),
+			strconv.FormatInt(count.ViolatingPieces, 10),
+		})
+	}
+	return report
+}
+
+type placementViolationsFork struct {
+	checker    PlacementChecker
+	placements map[storj.PlacementConstraint]*violationCount
+}
+
+// Process checks the pieces of every remote segment with a placement.
+func (fork *placementViolationsFork) Process(ctx context.Context, segments []rangedloop.Segment) error {
+	for _, segment := range segments {
+		if segment.Inline() || segment.Placement == storj.EveryCountry {
+			continue
+		}
+
+		count, ok := fork.placements[segment.Placement]
+		if !ok {
+			count = &violationCount{}
+			fork.placements[segment.Placement] = count
+		}
+		count.Segments++
+
+		outOfPlacement, err := fork.checker.OutOfPlacementPieces(ctx, segment.CreatedAt, segment.Pieces, segment.Placement)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if len(outOfPlacement) > 0 {
+			count.ViolatingSegments++
+			count.ViolatingPieces += int64(len(outOfPlacement))
+		}
+	}
+	return nil
+}
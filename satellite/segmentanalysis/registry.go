@@ -0,0 +1,31 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package segmentanalysis
+
+import (
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/satellite/metabase/rangedloop"
+)
+
+// Register adds the segment analysis observers to the registry.
+func Register(registry *rangedloop.Registry, log *zap.Logger, config Config, checker PlacementChecker) error {
+	writer, err := NewReportWriter(log, config)
+	if err != nil {
+		return err
+	}
+
+	return errs.Combine(
+		registry.Register("redundancy-histogram", func() (rangedloop.Observer, error) {
+			return NewRedundancyHistogram(writer), nil
+		}),
+		registry.Register("node-bytes", func() (rangedloop.Observer, error) {
+			return NewNodeBytes(writer), nil
+		}),
+		registry.Register("placement-violations", func() (rangedloop.Observer, error) {
+			return NewPlacementViolations(writer, checker), nil
+		}),
+	)
+}
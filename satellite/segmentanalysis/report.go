@@ -0,0 +1,129 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package segmentanalysis
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+)
+
+// Report is the tabular result of a single observer run.
+type Report struct {
+	Name   string
+	Header []string
+	Rows   [][]string
+}
+
+// WriteCSV writes the report as CSV with the header as the first record.
+func (report *Report) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(report.Header); err != nil {
+		return Error.Wrap(err)
+	}
+	if err := cw.WriteAll(report.Rows); err != nil {
+		return Error.Wrap(err)
+	}
+	return nil
+}
+
+// WriteJSON writes the report as a JSON array with one object per row that
+// is keyed by the header.
+func (report *Report) WriteJSON(w io.Writer) error {
+	rows := make([]map[string]string, 0, len(report.Rows))
+	for _, row := range report.Rows {
+		entry := make(map[string]string, len(report.Header))
+		for i, column := range report.Header {
+			if i < len(row) {
+				entry[column] = row[i]
+			}
+		}
+		rows = append(rows, entry)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return Error.Wrap(enc.Encode(rows))
+}
+
+// maxLoggedRows is the number of rows included in the log summary of a
+// report when no report directory is configured.
+const maxLoggedRows = 10
+
+// ReportWriter stores the reports of the observers.
+type ReportWriter struct {
+	log    *zap.Logger
+	config Config
+	encode func(*Report, io.Writer) error
+	now    func() time.Time
+}
+
+// NewReportWriter creates a writer that stores reports according to config.
+func NewReportWriter(log *zap.Logger, config Config) (*ReportWriter, error) {
+	var encode func(*Report, io.Writer) error
+	switch config.ReportFormat {
+	case "csv":
+		encode = (*Report).WriteCSV
+	case "json":
+		encode = (*Report).WriteJSON
+	default:
+		return nil, Error.New("unknown report format %q", config.ReportFormat)
+	}
+
+	return &ReportWriter{
+		log:    log,
+		config: config,
+		encode: encode,
+		now:    time.Now,
+	}, nil
+}
+
+// Write stores the report in the report directory. When no directory is
+// configured a summary of the report is only logged.
+func (writer *ReportWriter) Write(report *Report) (err error) {
+	if writer.config.ReportDir == "" {
+		rows := report.Rows
+		if len(rows) > maxLoggedRows {
+			rows = rows[:maxLoggedRows]
+		}
+		sample := make([]string, 0, len(rows))
+		for _, row := range rows {
+			sample = append(sample, strings.Join(row, ","))
+		}
+
+		writer.log.Info("segment analysis report",
+			zap.String("name", report.Name),
+			zap.String("header", strings.Join(report.Header, ",")),
+			zap.Int("rows", len(report.Rows)),
+			zap.Strings("sample", sample))
+		return nil
+	}
+
+	if err := os.MkdirAll(writer.config.ReportDir, 0755); err != nil {
+		return Error.Wrap(err)
+	}
+
+	name := report.Name + "-" + writer.now().UTC().Format("20060102T150405Z") + "." + writer.config.ReportFormat
+	path := filepath.Join(writer.config.ReportDir, name)
+
+	file, err := os.Create(path)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(file.Close())) }()
+
+	if err := writer.encode(report, file); err != nil {
+		return err
+	}
+
+	writer.log.Info("segment analysis report written", zap.String("name", report.Name), zap.String("path", path))
+	return nil
+}
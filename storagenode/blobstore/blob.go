@@ -17,6 +17,10 @@ import (
 // ErrInvalidBlobRef is returned when an blob reference is invalid.
 var ErrInvalidBlobRef = errs.Class("invalid blob ref")
 
+// ErrUnavailable is returned when a blob can't be found, because the storage,
+// which may contain it, is temporarily unavailable.
+var ErrUnavailable = errs.Class("blob unavailable")
+
 // FormatVersion represents differing storage format version values. Different Blobs implementors
 // might interpret different FormatVersion values differently, but they share a type so that there
 // can be a common StorageFormatVersion() call on the interface.
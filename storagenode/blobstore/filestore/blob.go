@@ -8,6 +8,7 @@ import (
 	"context"
 	"io"
 	"os"
+	"time"
 
 	"github.com/zeebo/errs"
 
@@ -129,7 +130,15 @@ func (blob *blobWriter) Commit(ctx context.Context) (err error) {
 		return err
 	}
 
+	size, err := blob.fh.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return Error.Wrap(errs.Combine(err, blob.store.dir.DeleteTemporary(ctx, blob.fh), blob.track.Close()))
+	}
+
 	err = blob.store.dir.Commit(ctx, blob.fh, blob.ref, blob.formatVersion)
+	if err == nil && blob.store.index != nil {
+		blob.store.logIndexError(blob.ref.Namespace, blob.store.index.put(blob.ref, blob.formatVersion, size, time.Now()))
+	}
 	return Error.Wrap(errs.Combine(err, blob.track.Close()))
 }
 
@@ -99,6 +99,12 @@ func (dir *Dir) garbagedir() string { return filepath.Join(dir.path, "garbage")
 // trashdir contains files staged for deletion for a period of time.
 func (dir *Dir) trashdir() string { return filepath.Join(dir.path, "trash") }
 
+// indexdir contains the journals of the piece index.
+func (dir *Dir) indexdir() string { return filepath.Join(dir.path, "index") }
+
+// corrupteddir contains the blobs, which have been found to be corrupted.
+func (dir *Dir) corrupteddir() string { return filepath.Join(dir.path, "corrupted") }
+
 // CreateVerificationFile creates a file to be used for storage directory verification.
 func (dir *Dir) CreateVerificationFile(ctx context.Context, id storj.NodeID) error {
 	f, err := os.Create(filepath.Join(dir.path, verificationFileName))
@@ -417,6 +423,30 @@ func (dir *Dir) TrashWithStorageFormat(ctx context.Context, ref blobstore.BlobRe
 	return err
 }
 
+// Quarantine moves the blob specified by ref and the format version to the
+// corrupted dir, where it's kept for inspection, but isn't served anymore.
+func (dir *Dir) Quarantine(ctx context.Context, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	blobsBasePath, err := dir.blobToBasePath(ref)
+	if err != nil {
+		return err
+	}
+
+	corruptedBasePath, err := dir.refToDirPath(ref, dir.corrupteddir())
+	if err != nil {
+		return err
+	}
+	corruptedVerPath := blobPathForFormatVersion(corruptedBasePath, formatVer)
+
+	err = os.MkdirAll(filepath.Dir(corruptedVerPath), dirPermission)
+	if err != nil && !os.IsExist(err) {
+		return err
+	}
+
+	return rename(blobPathForFormatVersion(blobsBasePath, formatVer), corruptedVerPath)
+}
+
 // ReplaceTrashnow is a helper for tests to replace the trashnow function used
 // when moving files to the trash.
 func (dir *Dir) ReplaceTrashnow(trashnow func() time.Time) {
@@ -0,0 +1,748 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package filestore
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/storagenode/blobstore"
+)
+
+// operations recorded in the index journals.
+const (
+	indexOpPut     = 1
+	indexOpDelete  = 2
+	indexOpTrash   = 3
+	indexOpRestore = 4
+)
+
+const (
+	// indexRecordHeaderSize is the size of the length and the checksum preceding every record.
+	indexRecordHeaderSize = 8
+	// indexCleanName is the file, which lists the trustworthy namespace journals
+	// after the index has been closed cleanly.
+	indexCleanName = "clean"
+)
+
+// IndexStats contains the differences found when reconciling the index with
+// the directory tree.
+type IndexStats struct {
+	Added   int64
+	Updated int64
+	Removed int64
+}
+
+// indexKey identifies a blob in a namespace.
+type indexKey struct {
+	key           string
+	formatVersion blobstore.FormatVersion
+}
+
+// indexEntry is the state of a blob, which is kept in the index.
+type indexEntry struct {
+	size    int64
+	modTime time.Time
+	// trashedAt is when the blob was moved to the trash, zero when it's not trashed.
+	trashedAt time.Time
+}
+
+func (e *indexEntry) trashed() bool { return !e.trashedAt.IsZero() }
+
+// indexRecord is a change of the index, which is appended to the journal of the namespace.
+type indexRecord struct {
+	op    byte
+	key   indexKey
+	entry indexEntry
+}
+
+// namespaceIndex is the index of a single namespace.
+type namespaceIndex struct {
+	name    string
+	file    *os.File
+	entries map[indexKey]*indexEntry
+	records int
+
+	// trusted is whether the entries match the directory tree. The directory
+	// tree is walked instead of using the entries, until they are reconciled.
+	trusted bool
+	// changed contains the keys, which have been changed since the
+	// reconciliation started. It's nil when there's no reconciliation.
+	changed map[indexKey]struct{}
+}
+
+// pieceIndex keeps the size, the modification time and the trash state of
+// every blob, so that the directory tree doesn't need to be walked to find
+// them. Every namespace has a journal of the changes, which is replayed when
+// the namespace is used the first time.
+//
+// The journals aren't synced on every change, hence they are only trusted
+// after the index has been closed cleanly; otherwise the namespace is
+// reconciled with the directory tree by the next walk.
+type pieceIndex struct {
+	log  *zap.Logger
+	path string
+
+	initOnce sync.Once
+	initErr  error
+
+	mu         sync.Mutex
+	clean      map[string]bool
+	namespaces map[string]*namespaceIndex
+}
+
+func newPieceIndex(log *zap.Logger, path string) *pieceIndex {
+	return &pieceIndex{
+		log:  log,
+		path: path,
+	}
+}
+
+// init reads and removes the clean marker. It must be called with the mutex held.
+func (index *pieceIndex) init() error {
+	index.initOnce.Do(func() {
+		index.clean = map[string]bool{}
+		index.namespaces = map[string]*namespaceIndex{}
+
+		if err := os.MkdirAll(index.path, dirPermission); err != nil {
+			index.initErr = Error.Wrap(err)
+			return
+		}
+
+		markerPath := filepath.Join(index.path, indexCleanName)
+		data, err := os.ReadFile(markerPath)
+		if err != nil && !os.IsNotExist(err) {
+			index.initErr = Error.Wrap(err)
+			return
+		}
+		for _, name := range bytes.Fields(data) {
+			index.clean[string(name)] = true
+		}
+		if err == nil {
+			index.initErr = Error.Wrap(os.Remove(markerPath))
+		}
+	})
+	return index.initErr
+}
+
+// namespace returns the index of the namespace, loading it when needed. It
+// must be called with the mutex held.
+func (index *pieceIndex) namespace(namespace []byte) (*namespaceIndex, error) {
+	if err := index.init(); err != nil {
+		return nil, err
+	}
+
+	name := pathEncoding.EncodeToString(namespace)
+	if ns, ok := index.namespaces[name]; ok {
+		return ns, nil
+	}
+
+	ns, err := index.load(name)
+	if err != nil {
+		return nil, err
+	}
+	index.namespaces[name] = ns
+	return ns, nil
+}
+
+// load replays the journal of the namespace.
+func (index *pieceIndex) load(name string) (_ *namespaceIndex, err error) {
+	path := filepath.Join(index.path, name)
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, blobPermission)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, file.Close())
+		}
+	}()
+
+	ns := &namespaceIndex{
+		name:    name,
+		file:    file,
+		entries: map[indexKey]*indexEntry{},
+		trusted: index.clean[name],
+	}
+
+	var valid int64
+	reader := bufio.NewReaderSize(file, 256<<10)
+	header := make([]byte, indexRecordHeaderSize)
+	var payload []byte
+	for {
+		if _, err := io.ReadFull(reader, header); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				break
+			}
+			return nil, Error.Wrap(err)
+		}
+
+		size := binary.BigEndian.Uint32(header)
+		if size > 1<<10 {
+			break
+		}
+		if cap(payload) < int(size) {
+			payload = make([]byte, size)
+		}
+		payload = payload[:size]
+		if _, err := io.ReadFull(reader, payload); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				break
+			}
+			return nil, Error.Wrap(err)
+		}
+		if crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(header[4:]) {
+			break
+		}
+		rec, ok := decodeIndexRecord(payload)
+		if !ok {
+			break
+		}
+
+		ns.apply(rec)
+		valid += int64(indexRecordHeaderSize + len(payload))
+		ns.records++
+	}
+
+	stat, err := file.Stat()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if stat.Size() != valid {
+		// the journal is damaged, so it can't be trusted either.
+		ns.trusted = false
+		if err := file.Truncate(valid); err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+	if _, err := file.Seek(valid, io.SeekStart); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if ns.records > 2*len(ns.entries)+1000 {
+		if err := index.rewrite(ns); err != nil {
+			return nil, err
+		}
+	}
+	return ns, nil
+}
+
+// apply applies the record to the entries.
+func (ns *namespaceIndex) apply(rec indexRecord) {
+	switch rec.op {
+	case indexOpPut:
+		e := rec.entry
+		ns.entries[rec.key] = &e
+	case indexOpDelete:
+		delete(ns.entries, rec.key)
+	case indexOpTrash:
+		if e, ok := ns.entries[rec.key]; ok {
+			e.trashedAt = rec.entry.trashedAt
+		}
+	case indexOpRestore:
+		if e, ok := ns.entries[rec.key]; ok {
+			e.trashedAt = time.Time{}
+		}
+	}
+	if ns.changed != nil {
+		ns.changed[rec.key] = struct{}{}
+	}
+}
+
+// update appends the records to the journal of the namespace and applies
+// them. Failures make the namespace untrusted, so that it's reconciled by
+// the next walk.
+func (index *pieceIndex) update(namespace []byte, records ...indexRecord) error {
+	index.mu.Lock()
+	defer index.mu.Unlock()
+
+	ns, err := index.loadForUpdate(namespace)
+	if err != nil {
+		return err
+	}
+	return index.updateNamespace(ns, records...)
+}
+
+// loadForUpdate returns the index of the namespace, which is about to be
+// changed. When it can't be loaded, the namespace isn't trusted anymore,
+// because the change would be missing from it. It must be called with the
+// mutex held.
+func (index *pieceIndex) loadForUpdate(namespace []byte) (*namespaceIndex, error) {
+	ns, err := index.namespace(namespace)
+	if err != nil {
+		if index.clean != nil {
+			delete(index.clean, pathEncoding.EncodeToString(namespace))
+		}
+		return nil, err
+	}
+	return ns, nil
+}
+
+// invalidate makes the namespace untrusted, when a blob operation failed
+// part way and it's unknown which changes should be recorded.
+func (index *pieceIndex) invalidate(namespace []byte) error {
+	index.mu.Lock()
+	defer index.mu.Unlock()
+
+	ns, err := index.loadForUpdate(namespace)
+	if err != nil {
+		return err
+	}
+	ns.trusted = false
+	return nil
+}
+
+// updateNamespace is update with the mutex held.
+func (index *pieceIndex) updateNamespace(ns *namespaceIndex, records ...indexRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	var buf []byte
+	for _, rec := range records {
+		buf = appendIndexRecord(buf, rec)
+		ns.apply(rec)
+	}
+	ns.records += len(records)
+
+	if _, err := ns.file.Write(buf); err != nil {
+		ns.trusted = false
+		return Error.Wrap(err)
+	}
+	return nil
+}
+
+// put records a committed blob.
+func (index *pieceIndex) put(ref blobstore.BlobRef, formatVer blobstore.FormatVersion, size int64, modTime time.Time) error {
+	return index.update(ref.Namespace, indexRecord{
+		op:    indexOpPut,
+		key:   indexKey{string(ref.Key), formatVer},
+		entry: indexEntry{size: size, modTime: modTime},
+	})
+}
+
+// delete records a deleted blob.
+func (index *pieceIndex) delete(ref blobstore.BlobRef, formatVer blobstore.FormatVersion) error {
+	return index.update(ref.Namespace, indexRecord{op: indexOpDelete, key: indexKey{string(ref.Key), formatVer}})
+}
+
+// trash records a blob moved to the trash.
+func (index *pieceIndex) trash(ref blobstore.BlobRef, now time.Time) error {
+	index.mu.Lock()
+	defer index.mu.Unlock()
+
+	ns, err := index.loadForUpdate(ref.Namespace)
+	if err != nil {
+		return err
+	}
+
+	var records []indexRecord
+	for formatVer := MinFormatVersionSupported; formatVer <= MaxFormatVersionSupported; formatVer++ {
+		key := indexKey{string(ref.Key), formatVer}
+		if e, ok := ns.entries[key]; ok && !e.trashed() {
+			records = append(records, indexRecord{op: indexOpTrash, key: key, entry: indexEntry{trashedAt: now}})
+		}
+	}
+	return index.updateNamespace(ns, records...)
+}
+
+// restore records the blobs restored from the trash.
+func (index *pieceIndex) restore(namespace []byte, keys [][]byte) error {
+	index.mu.Lock()
+	defer index.mu.Unlock()
+
+	ns, err := index.loadForUpdate(namespace)
+	if err != nil {
+		return err
+	}
+
+	var records []indexRecord
+	for _, k := range keys {
+		for formatVer := MinFormatVersionSupported; formatVer <= MaxFormatVersionSupported; formatVer++ {
+			key := indexKey{string(k), formatVer}
+			if e, ok := ns.entries[key]; ok && e.trashed() {
+				records = append(records, indexRecord{op: indexOpRestore, key: key})
+			}
+		}
+	}
+	return index.updateNamespace(ns, records...)
+}
+
+// deleteTrashed records the blobs deleted from the trash.
+func (index *pieceIndex) deleteTrashed(namespace []byte, keys [][]byte) error {
+	index.mu.Lock()
+	defer index.mu.Unlock()
+
+	ns, err := index.loadForUpdate(namespace)
+	if err != nil {
+		return err
+	}
+
+	var records []indexRecord
+	for _, k := range keys {
+		for formatVer := MinFormatVersionSupported; formatVer <= MaxFormatVersionSupported; formatVer++ {
+			key := indexKey{string(k), formatVer}
+			if e, ok := ns.entries[key]; ok && e.trashed() {
+				records = append(records, indexRecord{op: indexOpDelete, key: key})
+			}
+		}
+	}
+	return index.updateNamespace(ns, records...)
+}
+
+// deleteNamespace removes the index of the namespace.
+func (index *pieceIndex) deleteNamespace(namespace []byte) error {
+	index.mu.Lock()
+	defer index.mu.Unlock()
+
+	if err := index.init(); err != nil {
+		return err
+	}
+
+	name := pathEncoding.EncodeToString(namespace)
+	var closeErr error
+	if ns, ok := index.namespaces[name]; ok {
+		closeErr = ns.file.Close()
+		delete(index.namespaces, name)
+	}
+	delete(index.clean, name)
+
+	removeErr := os.Remove(filepath.Join(index.path, name))
+	if os.IsNotExist(removeErr) {
+		removeErr = nil
+	}
+	return Error.Wrap(errs.Combine(closeErr, removeErr))
+}
+
+// indexedBlob is a blob with its entry in the index.
+type indexedBlob struct {
+	indexKey
+	indexEntry
+}
+
+// blobs returns the blobs of the namespace, which match the filter. ok is
+// false, when the index of the namespace isn't trusted.
+func (index *pieceIndex) blobs(namespace []byte, filter func(*indexEntry) bool) (blobs []indexedBlob, ok bool) {
+	index.mu.Lock()
+	defer index.mu.Unlock()
+
+	ns, err := index.namespace(namespace)
+	if err != nil {
+		index.log.Warn("unable to load piece index", zap.Binary("namespace", namespace), zap.Error(err))
+		return nil, false
+	}
+	if !ns.trusted {
+		return nil, false
+	}
+
+	for key, e := range ns.entries {
+		if filter(e) {
+			blobs = append(blobs, indexedBlob{key, *e})
+		}
+	}
+	return blobs, true
+}
+
+// beginReconcile starts tracking the changes of the namespace, so that the
+// changes made during the walk of the directory tree aren't overwritten. It
+// returns false, when there's already a reconciliation in progress.
+func (index *pieceIndex) beginReconcile(namespace []byte) (bool, error) {
+	index.mu.Lock()
+	defer index.mu.Unlock()
+
+	ns, err := index.namespace(namespace)
+	if err != nil {
+		return false, err
+	}
+	if ns.changed != nil {
+		return false, nil
+	}
+	ns.changed = map[indexKey]struct{}{}
+	return true, nil
+}
+
+// abortReconcile stops tracking the changes of the namespace.
+func (index *pieceIndex) abortReconcile(namespace []byte) {
+	index.mu.Lock()
+	defer index.mu.Unlock()
+
+	if ns, err := index.namespace(namespace); err == nil {
+		ns.changed = nil
+	}
+}
+
+// finishReconcile replaces the entries of the namespace with the blobs found
+// in the directory tree, except for the ones changed during the walk, and
+// rewrites the journal.
+func (index *pieceIndex) finishReconcile(namespace []byte, found map[indexKey]indexEntry) (stats IndexStats, err error) {
+	index.mu.Lock()
+	defer index.mu.Unlock()
+
+	ns, err := index.namespace(namespace)
+	if err != nil {
+		return stats, err
+	}
+	changed := ns.changed
+	ns.changed = nil
+
+	for key, e := range found {
+		if _, ok := changed[key]; ok {
+			continue
+		}
+		existing, ok := ns.entries[key]
+		switch {
+		case !ok:
+			stats.Added++
+		case existing.size != e.size || existing.trashed() != e.trashed():
+			stats.Updated++
+		default:
+			continue
+		}
+		e := e
+		ns.entries[key] = &e
+	}
+	for key := range ns.entries {
+		if _, ok := changed[key]; ok {
+			continue
+		}
+		if _, ok := found[key]; !ok {
+			delete(ns.entries, key)
+			stats.Removed++
+		}
+	}
+
+	if err := index.rewrite(ns); err != nil {
+		return stats, err
+	}
+	ns.trusted = true
+	return stats, nil
+}
+
+// rewrite replaces the journal of the namespace with the current entries.
+func (index *pieceIndex) rewrite(ns *namespaceIndex) (err error) {
+	path := filepath.Join(index.path, ns.name)
+	tmpPath := path + ".tmp"
+
+	err = func() (err error) {
+		file, err := os.Create(tmpPath)
+		if err != nil {
+			return err
+		}
+		defer func() { err = errs.Combine(err, file.Close()) }()
+
+		writer := bufio.NewWriterSize(file, 256<<10)
+		var buf []byte
+		for key, e := range ns.entries {
+			buf = appendIndexRecord(buf[:0], indexRecord{op: indexOpPut, key: key, entry: *e})
+			if _, err := writer.Write(buf); err != nil {
+				return err
+			}
+		}
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+		return file.Sync()
+	}()
+	if err != nil {
+		removeErr := os.Remove(tmpPath)
+		if os.IsNotExist(removeErr) {
+			removeErr = nil
+		}
+		return Error.Wrap(errs.Combine(err, removeErr))
+	}
+
+	if err := ns.file.Close(); err != nil {
+		return Error.Wrap(err)
+	}
+	renameErr := rename(tmpPath, path)
+
+	// the journal must be reopened even when the rename failed.
+	ns.file, err = os.OpenFile(path, os.O_RDWR|os.O_APPEND, blobPermission)
+	if err != nil || renameErr != nil {
+		ns.trusted = false
+		return Error.Wrap(errs.Combine(renameErr, err))
+	}
+	ns.records = len(ns.entries)
+	return nil
+}
+
+// Close syncs the journals and marks the trusted ones as clean.
+func (index *pieceIndex) Close() error {
+	index.mu.Lock()
+	defer index.mu.Unlock()
+
+	if index.namespaces == nil {
+		// the index hasn't been used.
+		return nil
+	}
+
+	var group errs.Group
+	clean := index.clean
+	for name, ns := range index.namespaces {
+		err := errs.Combine(ns.file.Sync(), ns.file.Close())
+		group.Add(err)
+		clean[name] = ns.trusted && err == nil
+	}
+	index.namespaces = map[string]*namespaceIndex{}
+
+	var marker bytes.Buffer
+	for name, trusted := range clean {
+		if trusted {
+			marker.WriteString(name)
+			marker.WriteByte('\n')
+		}
+	}
+	group.Add(writeFileSync(filepath.Join(index.path, indexCleanName), marker.Bytes()))
+
+	return Error.Wrap(group.Err())
+}
+
+// writeFileSync writes the file durably.
+func writeFileSync(path string, data []byte) (err error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, file.Close()) }()
+
+	if _, err := file.Write(data); err != nil {
+		return err
+	}
+	return file.Sync()
+}
+
+// appendIndexRecord appends the encoded record with its header to buf.
+func appendIndexRecord(buf []byte, rec indexRecord) []byte {
+	start := len(buf)
+	buf = append(buf, make([]byte, indexRecordHeaderSize)...)
+
+	buf = append(buf, rec.op, byte(rec.key.formatVersion), byte(len(rec.key.key)))
+	buf = append(buf, rec.key.key...)
+
+	switch rec.op {
+	case indexOpPut:
+		buf = appendIndexUint64(buf, uint64(rec.entry.size))
+		buf = appendIndexUint64(buf, encodeIndexTime(rec.entry.modTime))
+		buf = appendIndexUint64(buf, encodeIndexTime(rec.entry.trashedAt))
+	case indexOpTrash:
+		buf = appendIndexUint64(buf, encodeIndexTime(rec.entry.trashedAt))
+	}
+
+	payload := buf[start+indexRecordHeaderSize:]
+	binary.BigEndian.PutUint32(buf[start:], uint32(len(payload)))
+	binary.BigEndian.PutUint32(buf[start+4:], crc32.ChecksumIEEE(payload))
+	return buf
+}
+
+// decodeIndexRecord decodes the payload of a record.
+func decodeIndexRecord(payload []byte) (rec indexRecord, ok bool) {
+	if len(payload) < 3 {
+		return rec, false
+	}
+	rec.op = payload[0]
+	rec.key.formatVersion = blobstore.FormatVersion(payload[1])
+	keyLen := int(payload[2])
+	payload = payload[3:]
+	if len(payload) < keyLen {
+		return rec, false
+	}
+	rec.key.key = string(payload[:keyLen])
+	payload = payload[keyLen:]
+
+	next := func() time.Time {
+		v := binary.BigEndian.Uint64(payload)
+		payload = payload[8:]
+		return decodeIndexTime(v)
+	}
+
+	switch rec.op {
+	case indexOpPut:
+		if len(payload) != 24 {
+			return rec, false
+		}
+		rec.entry.size = int64(binary.BigEndian.Uint64(payload))
+		payload = payload[8:]
+		rec.entry.modTime = next()
+		rec.entry.trashedAt = next()
+	case indexOpTrash:
+		if len(payload) != 8 {
+			return rec, false
+		}
+		rec.entry.trashedAt = next()
+	case indexOpDelete, indexOpRestore:
+		if len(payload) != 0 {
+			return rec, false
+		}
+	default:
+		return rec, false
+	}
+	return rec, true
+}
+
+func appendIndexUint64(buf []byte, v uint64) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], v)
+	return append(buf, b[:]...)
+}
+
+// encodeIndexTime encodes t as unix nanoseconds, where the zero time is encoded as zero.
+func encodeIndexTime(t time.Time) uint64 {
+	if t.IsZero() {
+		return 0
+	}
+	return uint64(t.UnixNano())
+}
+
+// decodeIndexTime is the inverse of encodeIndexTime.
+func decodeIndexTime(v uint64) time.Time {
+	if v == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, int64(v))
+}
+
+// indexBlobInfo implements blobstore.BlobInfo using the metadata in the index.
+type indexBlobInfo struct {
+	ref           blobstore.BlobRef
+	path          string
+	formatVersion blobstore.FormatVersion
+	entry         indexEntry
+}
+
+func (info *indexBlobInfo) BlobRef() blobstore.BlobRef { return info.ref }
+
+func (info *indexBlobInfo) StorageFormatVersion() blobstore.FormatVersion {
+	return info.formatVersion
+}
+
+func (info *indexBlobInfo) FullPath(ctx context.Context) (string, error) { return info.path, nil }
+
+func (info *indexBlobInfo) Stat(ctx context.Context) (os.FileInfo, error) {
+	return &indexFileInfo{name: filepath.Base(info.path), entry: info.entry}, nil
+}
+
+// indexFileInfo implements os.FileInfo using the metadata in the index.
+type indexFileInfo struct {
+	name  string
+	entry indexEntry
+}
+
+func (info *indexFileInfo) Name() string       { return info.name }
+func (info *indexFileInfo) Size() int64        { return info.entry.size }
+func (info *indexFileInfo) Mode() os.FileMode  { return blobPermission }
+func (info *indexFileInfo) ModTime() time.Time { return info.entry.modTime }
+func (info *indexFileInfo) IsDir() bool        { return false }
+func (info *indexFileInfo) Sys() interface{}   { return nil }
@@ -42,12 +42,15 @@ func monFileInTrash(namespace []byte) *monkit.Meter {
 
 // Config is configuration for the blob store.
 type Config struct {
-	WriteBufferSize memory.Size `help:"in-memory buffer for uploads" default:"128KiB"`
+	WriteBufferSize    memory.Size   `help:"in-memory buffer for uploads" default:"128KiB"`
+	Index              bool          `help:"maintain an on-disk index of the pieces, which is used instead of walking the directories for space usage, garbage collection and trash emptying" default:"false"`
+	IndexCheckInterval time.Duration `help:"how often the piece index is reconciled with the directories" default:"168h0m0s"`
 }
 
 // DefaultConfig is the default value for Config.
 var DefaultConfig = Config{
-	WriteBufferSize: 128 * memory.KiB,
+	WriteBufferSize:    128 * memory.KiB,
+	IndexCheckInterval: 168 * time.Hour,
 }
 
 // blobStore implements a blob store.
@@ -56,12 +59,23 @@ type blobStore struct {
 	dir    *Dir
 	config Config
 
+	// index is nil, when the piece index isn't enabled.
+	index *pieceIndex
+
 	track leak.Ref
 }
 
 // New creates a new disk blob store in the specified directory.
 func New(log *zap.Logger, dir *Dir, config Config) blobstore.Blobs {
-	return &blobStore{dir: dir, log: log, config: config, track: leak.Root(1)}
+	return newBlobStore(log, dir, config)
+}
+
+func newBlobStore(log *zap.Logger, dir *Dir, config Config) *blobStore {
+	store := &blobStore{dir: dir, log: log, config: config, track: leak.Root(1)}
+	if config.Index {
+		store.index = newPieceIndex(log.Named("index"), dir.indexdir())
+	}
+	return store
 }
 
 // NewAt creates a new disk blob store in the specified directory.
@@ -70,11 +84,17 @@ func NewAt(log *zap.Logger, path string, config Config) (blobstore.Blobs, error)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
-	return &blobStore{dir: dir, log: log, config: config, track: leak.Root(1)}, nil
+	return newBlobStore(log, dir, config), nil
 }
 
 // Close closes the store.
-func (store *blobStore) Close() error { return store.track.Close() }
+func (store *blobStore) Close() error {
+	var indexErr error
+	if store.index != nil {
+		indexErr = store.index.Close()
+	}
+	return errs.Combine(indexErr, store.track.Close())
+}
 
 // Open loads blob with the specified hash.
 func (store *blobStore) Open(ctx context.Context, ref blobstore.BlobRef) (_ blobstore.BlobReader, err error) {
@@ -124,6 +144,15 @@ func (store *blobStore) StatWithStorageFormat(ctx context.Context, ref blobstore
 func (store *blobStore) Delete(ctx context.Context, ref blobstore.BlobRef) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	err = store.dir.Delete(ctx, ref)
+	if store.index != nil {
+		if err != nil {
+			store.invalidateIndex(ref.Namespace)
+		} else {
+			for formatVer := MinFormatVersionSupported; formatVer <= MaxFormatVersionSupported; formatVer++ {
+				store.logIndexError(ref.Namespace, store.index.delete(ref, formatVer))
+			}
+		}
+	}
 	return Error.Wrap(err)
 }
 
@@ -131,6 +160,23 @@ func (store *blobStore) Delete(ctx context.Context, ref blobstore.BlobRef) (err
 func (store *blobStore) DeleteWithStorageFormat(ctx context.Context, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	err = store.dir.DeleteWithStorageFormat(ctx, ref, formatVer)
+	if store.index != nil {
+		if err != nil {
+			store.invalidateIndex(ref.Namespace)
+		} else {
+			store.logIndexError(ref.Namespace, store.index.delete(ref, formatVer))
+		}
+	}
+	return Error.Wrap(err)
+}
+
+// Quarantine moves the blob aside, when it has been found to be corrupted.
+func (store *blobStore) Quarantine(ctx context.Context, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.dir.Quarantine(ctx, ref, formatVer)
+	if err == nil && store.index != nil {
+		store.logIndexError(ref.Namespace, store.index.delete(ref, formatVer))
+	}
 	return Error.Wrap(err)
 }
 
@@ -138,29 +184,102 @@ func (store *blobStore) DeleteWithStorageFormat(ctx context.Context, ref blobsto
 func (store *blobStore) DeleteNamespace(ctx context.Context, ref []byte) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	err = store.dir.DeleteNamespace(ctx, ref)
+	if store.index != nil {
+		if err != nil {
+			store.invalidateIndex(ref)
+		} else {
+			err = store.index.deleteNamespace(ref)
+		}
+	}
 	return Error.Wrap(err)
 }
 
 // Trash moves the ref to a trash directory.
 func (store *blobStore) Trash(ctx context.Context, ref blobstore.BlobRef) (err error) {
 	defer mon.Task()(&ctx)(&err)
-	return Error.Wrap(store.dir.Trash(ctx, ref))
+	err = store.dir.Trash(ctx, ref)
+	if store.index != nil {
+		if err != nil {
+			store.invalidateIndex(ref.Namespace)
+		} else {
+			store.logIndexError(ref.Namespace, store.index.trash(ref, store.dir.trashnow()))
+		}
+	}
+	return Error.Wrap(err)
 }
 
 // RestoreTrash moves every piece in the trash back into the regular location.
 func (store *blobStore) RestoreTrash(ctx context.Context, namespace []byte) (keysRestored [][]byte, err error) {
 	defer mon.Task()(&ctx)(&err)
 	keysRestored, err = store.dir.RestoreTrash(ctx, namespace)
+	if store.index != nil {
+		store.logIndexError(namespace, store.index.restore(namespace, keysRestored))
+		if err != nil {
+			store.invalidateIndex(namespace)
+		}
+	}
 	return keysRestored, Error.Wrap(err)
 }
 
 // // EmptyTrash removes all files in trash that have been there longer than trashExpiryDur.
 func (store *blobStore) EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (bytesEmptied int64, keys [][]byte, err error) {
 	defer mon.Task()(&ctx)(&err)
+	if store.index != nil {
+		if blobs, ok := store.index.blobs(namespace, func(e *indexEntry) bool {
+			return e.trashed() && e.trashedAt.Before(trashedBefore)
+		}); ok {
+			return store.emptyTrashFromIndex(ctx, namespace, blobs)
+		}
+	}
+
 	bytesEmptied, keys, err = store.dir.EmptyTrash(ctx, namespace, trashedBefore)
+	if store.index != nil {
+		store.logIndexError(namespace, store.index.deleteTrashed(namespace, keys))
+		if err != nil {
+			store.invalidateIndex(namespace)
+		}
+	}
 	return bytesEmptied, keys, Error.Wrap(err)
 }
 
+// logIndexError logs the failure to update the piece index. It doesn't fail
+// the blob operation, because the namespace is reconciled by the next walk.
+func (store *blobStore) logIndexError(namespace []byte, err error) {
+	if err != nil {
+		store.log.Warn("unable to update piece index", zap.Binary("namespace", namespace), zap.Error(err))
+	}
+}
+
+// invalidateIndex makes the piece index of the namespace untrusted, after a
+// blob operation failed, because it's unknown which of its changes happened.
+func (store *blobStore) invalidateIndex(namespace []byte) {
+	store.logIndexError(namespace, store.index.invalidate(namespace))
+}
+
+// emptyTrashFromIndex deletes the trashed blobs found in the index.
+func (store *blobStore) emptyTrashFromIndex(ctx context.Context, namespace []byte, blobs []indexedBlob) (bytesEmptied int64, keys [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var errorsEncountered errs.Group
+	for _, blob := range blobs {
+		if err := ctx.Err(); err != nil {
+			errorsEncountered.Add(err)
+			break
+		}
+
+		ref := blobstore.BlobRef{Namespace: namespace, Key: []byte(blob.key)}
+		err := store.dir.deleteWithStorageFormatInPath(ctx, store.dir.trashdir(), ref, blob.formatVersion)
+		if err != nil {
+			errorsEncountered.Add(err)
+			continue
+		}
+		store.logIndexError(namespace, store.index.delete(ref, blob.formatVersion))
+		keys = append(keys, ref.Key)
+		bytesEmptied += blob.size
+	}
+	return bytesEmptied, keys, Error.Wrap(errorsEncountered.Err())
+}
+
 // GarbageCollect tries to delete any files that haven't yet been deleted.
 func (store *blobStore) GarbageCollect(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -238,6 +357,12 @@ func (store *blobStore) TrashIsEmpty() (_ bool, err error) {
 func (store *blobStore) SpaceUsedForTrash(ctx context.Context) (total int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	if store.index != nil {
+		if total, ok := store.spaceUsedForTrashFromIndex(ctx); ok {
+			return total, nil
+		}
+	}
+
 	empty, err := store.TrashIsEmpty()
 	if err != nil {
 		return total, err
@@ -257,6 +382,25 @@ func (store *blobStore) SpaceUsedForTrash(ctx context.Context) (total int64, err
 	return total, err
 }
 
+// spaceUsedForTrashFromIndex adds up the trashed blobs in the index. ok is
+// false, when the index of some namespace in the trash isn't trusted.
+func (store *blobStore) spaceUsedForTrashFromIndex(ctx context.Context) (total int64, ok bool) {
+	namespaces, err := store.dir.listNamespacesInPath(ctx, store.dir.trashdir())
+	if err != nil {
+		return 0, false
+	}
+	for _, namespace := range namespaces {
+		blobs, ok := store.index.blobs(namespace, func(e *indexEntry) bool { return e.trashed() })
+		if !ok {
+			return 0, false
+		}
+		for _, blob := range blobs {
+			total += blob.size
+		}
+	}
+	return total, true
+}
+
 // FreeSpace returns how much space left in underlying directory.
 func (store *blobStore) FreeSpace(ctx context.Context) (int64, error) {
 	info, err := store.dir.Info(ctx)
@@ -288,7 +432,108 @@ func (store *blobStore) ListNamespaces(ctx context.Context) (ids [][]byte, err e
 // returns a non-nil error, WalkNamespace will stop iterating and return the error immediately. The
 // ctx parameter is intended specifically to allow canceling iteration early.
 func (store *blobStore) WalkNamespace(ctx context.Context, namespace []byte, walkFunc func(blobstore.BlobInfo) error) (err error) {
-	return store.dir.WalkNamespace(ctx, namespace, walkFunc)
+	if store.index == nil {
+		return store.dir.WalkNamespace(ctx, namespace, walkFunc)
+	}
+
+	blobs, ok := store.index.blobs(namespace, func(e *indexEntry) bool { return !e.trashed() })
+	if !ok {
+		// the index can't be used, but it can be fixed while walking the directories.
+		_, err := store.reconcileIndex(ctx, namespace, walkFunc)
+		return err
+	}
+
+	for _, blob := range blobs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		ref := blobstore.BlobRef{Namespace: namespace, Key: []byte(blob.key)}
+		path, err := store.dir.blobToBasePath(ref)
+		if err != nil {
+			return err
+		}
+		err = walkFunc(&indexBlobInfo{
+			ref:           ref,
+			path:          blobPathForFormatVersion(path, blob.formatVersion),
+			formatVersion: blob.formatVersion,
+			entry:         blob.indexEntry,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReconcileIndex walks the directories of the namespace and fixes the piece
+// index, when it doesn't match them.
+func (store *blobStore) ReconcileIndex(ctx context.Context, namespace []byte) (stats IndexStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if store.index == nil {
+		return stats, Error.New("piece index is not enabled")
+	}
+	return store.reconcileIndex(ctx, namespace, func(blobstore.BlobInfo) error { return nil })
+}
+
+// reconcileIndex walks the directories of the namespace, calling walkFunc for
+// the blobs, and reconciles the piece index with the blobs found. The index
+// isn't changed, when the walk doesn't complete.
+func (store *blobStore) reconcileIndex(ctx context.Context, namespace []byte, walkFunc func(blobstore.BlobInfo) error) (stats IndexStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	started, err := store.index.beginReconcile(namespace)
+	if err != nil {
+		store.log.Warn("unable to load piece index", zap.Binary("namespace", namespace), zap.Error(err))
+	}
+	if !started {
+		// there's a reconciliation in progress already.
+		return stats, store.dir.WalkNamespace(ctx, namespace, walkFunc)
+	}
+	defer func() {
+		if err != nil {
+			store.index.abortReconcile(namespace)
+		}
+	}()
+
+	found := map[indexKey]indexEntry{}
+	collect := func(info blobstore.BlobInfo, trashed bool) {
+		stat, err := info.Stat(ctx)
+		if err != nil {
+			return
+		}
+		e := indexEntry{size: stat.Size(), modTime: stat.ModTime()}
+		if trashed {
+			// the modification time is changed when the blob is moved to the trash.
+			e.trashedAt = stat.ModTime()
+		}
+		found[indexKey{string(info.BlobRef().Key), info.StorageFormatVersion()}] = e
+	}
+
+	err = store.dir.WalkNamespace(ctx, namespace, func(info blobstore.BlobInfo) error {
+		collect(info, false)
+		return walkFunc(info)
+	})
+	if err != nil {
+		return stats, err
+	}
+	err = store.dir.walkNamespaceInPath(ctx, namespace, store.dir.trashdir(), func(info blobstore.BlobInfo) error {
+		collect(info, true)
+		return nil
+	})
+	if err != nil {
+		return stats, err
+	}
+
+	stats, err = store.index.finishReconcile(namespace, found)
+	if err != nil {
+		return stats, err
+	}
+	if stats != (IndexStats{}) {
+		store.log.Info("piece index reconciled", zap.Binary("namespace", namespace),
+			zap.Int64("added", stats.Added), zap.Int64("updated", stats.Updated), zap.Int64("removed", stats.Removed))
+	}
+	return stats, nil
 }
 
 // TestCreateV0 creates a new V0 blob that can be written. This is ONLY appropriate in test situations.
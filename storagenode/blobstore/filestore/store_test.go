@@ -923,3 +923,143 @@ func TestStorageDirVerification(t *testing.T) {
 	require.NoError(t, dir.CreateVerificationFile(ctx, ident0.ID))
 	require.NoError(t, store.VerifyStorageDir(ctx, ident0.ID))
 }
+
+func TestStoreIndex(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := zaptest.NewLogger(t)
+
+	dir, err := filestore.NewDir(log, ctx.Dir("store"))
+	require.NoError(t, err)
+
+	config := filestore.DefaultConfig
+	config.Index = true
+
+	type indexReconciler interface {
+		ReconcileIndex(ctx context.Context, namespace []byte) (filestore.IndexStats, error)
+	}
+
+	store := filestore.New(log, dir, config)
+
+	namespace := testrand.Bytes(namespaceSize)
+	var refs []blobstore.BlobRef
+	for i := 0; i < 10; i++ {
+		ref := blobstore.BlobRef{Namespace: namespace, Key: testrand.Bytes(keySize)}
+		writer, err := store.Create(ctx, ref, 4*memory.KiB.Int64())
+		require.NoError(t, err)
+		_, err = writer.Write(testrand.BytesInt(memory.KiB.Int() * (i + 1)))
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx))
+		refs = append(refs, ref)
+	}
+
+	// the first walk reconciles the index, the following ones use it.
+	for i := 0; i < 2; i++ {
+		used, err := store.SpaceUsedForBlobsInNamespace(ctx, namespace)
+		require.NoError(t, err)
+		require.Equal(t, 55*memory.KiB.Int64(), used)
+	}
+
+	stats, err := store.(indexReconciler).ReconcileIndex(ctx, namespace)
+	require.NoError(t, err)
+	require.Equal(t, filestore.IndexStats{}, stats)
+
+	// trash and empty using the index.
+	now := time.Now()
+	dir.ReplaceTrashnow(func() time.Time { return now.Add(-time.Hour) })
+	require.NoError(t, store.Trash(ctx, refs[0]))
+	require.NoError(t, store.Trash(ctx, refs[1]))
+	dir.ReplaceTrashnow(time.Now)
+
+	trash, err := store.SpaceUsedForTrash(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3*memory.KiB.Int64(), trash)
+
+	restored, err := store.RestoreTrash(ctx, namespace)
+	require.NoError(t, err)
+	require.Len(t, restored, 2)
+
+	dir.ReplaceTrashnow(func() time.Time { return now.Add(-time.Hour) })
+	require.NoError(t, store.Trash(ctx, refs[0]))
+	dir.ReplaceTrashnow(time.Now)
+
+	emptied, keys, err := store.EmptyTrash(ctx, namespace, now)
+	require.NoError(t, err)
+	require.Equal(t, memory.KiB.Int64(), emptied)
+	require.Equal(t, [][]byte{refs[0].Key}, keys)
+
+	require.NoError(t, store.Delete(ctx, refs[1]))
+	require.NoError(t, store.Close())
+
+	// the index is trusted after a clean shutdown, even when the directories
+	// have been changed behind its back.
+	info, err := dir.Stat(ctx, refs[2])
+	require.NoError(t, err)
+	path, err := info.FullPath(ctx)
+	require.NoError(t, err)
+	require.NoError(t, os.Remove(path))
+
+	store = filestore.New(log, dir, config)
+	used, err := store.SpaceUsedForBlobsInNamespace(ctx, namespace)
+	require.NoError(t, err)
+	require.Equal(t, 52*memory.KiB.Int64(), used)
+
+	stats, err = store.(indexReconciler).ReconcileIndex(ctx, namespace)
+	require.NoError(t, err)
+	require.Equal(t, filestore.IndexStats{Removed: 1}, stats)
+
+	used, err = store.SpaceUsedForBlobsInNamespace(ctx, namespace)
+	require.NoError(t, err)
+	require.Equal(t, 49*memory.KiB.Int64(), used)
+	require.NoError(t, store.Close())
+
+	// the index isn't trusted without a clean shutdown.
+	require.NoError(t, os.Remove(filepath.Join(dir.Path(), "index", "clean")))
+	info, err = dir.Stat(ctx, refs[3])
+	require.NoError(t, err)
+	path, err = info.FullPath(ctx)
+	require.NoError(t, err)
+	require.NoError(t, os.Remove(path))
+
+	store = filestore.New(log, dir, config)
+	defer ctx.Check(store.Close)
+
+	for i := 0; i < 2; i++ {
+		used, err = store.SpaceUsedForBlobsInNamespace(ctx, namespace)
+		require.NoError(t, err)
+		require.Equal(t, 45*memory.KiB.Int64(), used)
+	}
+}
+
+func TestStoreIndexFailedTrash(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := zaptest.NewLogger(t)
+
+	dir, err := filestore.NewDir(log, ctx.Dir("store"))
+	require.NoError(t, err)
+
+	config := filestore.DefaultConfig
+	config.Index = true
+
+	store := filestore.New(log, dir, config)
+	defer ctx.Check(store.Close)
+
+	namespace := testrand.Bytes(namespaceSize)
+	ref := blobstore.BlobRef{Namespace: namespace, Key: testrand.Bytes(keySize)}
+	writer, err := store.Create(ctx, ref, memory.KiB.Int64())
+	require.NoError(t, err)
+	_, err = writer.Write(testrand.BytesInt(memory.KiB.Int()))
+	require.NoError(t, err)
+	require.NoError(t, writer.Commit(ctx))
+
+	used, err := store.SpaceUsedForBlobsInNamespace(ctx, namespace)
+	require.NoError(t, err)
+	require.Equal(t, memory.KiB.Int64(), used)
+
+	// the blob isn't recorded as trashed, when it couldn't be moved to the trash.
+	require.NoError(t, os.RemoveAll(filepath.Join(dir.Path(), "trash")))
+	require.Error(t, store.Trash(ctx, ref))
+
+	used, err = store.SpaceUsedForBlobsInNamespace(ctx, namespace)
+	require.NoError(t, err)
+	require.Equal(t, memory.KiB.Int64(), used)
+}
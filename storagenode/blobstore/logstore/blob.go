@@ -0,0 +1,178 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package logstore
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"os"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/leak"
+	"storj.io/storj/storagenode/blobstore"
+)
+
+// blobReader implements reading a blob from a section of a log.
+type blobReader struct {
+	*io.SectionReader
+	file          *os.File
+	formatVersion blobstore.FormatVersion
+	// release releases the log, once the reader is closed.
+	release func()
+
+	track leak.Ref
+}
+
+func newBlobReader(track leak.Ref, file *os.File, e entry, release func()) *blobReader {
+	return &blobReader{
+		SectionReader: io.NewSectionReader(file, e.offset, e.size),
+		file:          file,
+		formatVersion: e.formatVersion,
+		release:       release,
+		track:         track,
+	}
+}
+
+// Size returns how large is the blob.
+func (blob *blobReader) Size() (int64, error) {
+	return blob.SectionReader.Size(), nil
+}
+
+// StorageFormatVersion gets the storage format version being used by the blob.
+func (blob *blobReader) StorageFormatVersion() blobstore.FormatVersion {
+	return blob.formatVersion
+}
+
+// Close closes the reader.
+func (blob *blobReader) Close() error {
+	err := blob.file.Close()
+	blob.release()
+	return errs.Combine(err, blob.track.Close())
+}
+
+// blobWriter implements writing blobs. The blob is written to a temporary
+// file, which is appended to the active log on commit.
+type blobWriter struct {
+	ref           blobstore.BlobRef
+	store         *Store
+	closed        bool
+	formatVersion blobstore.FormatVersion
+	buffer        *bufio.Writer
+	fh            *os.File
+
+	track leak.Ref
+}
+
+func newBlobWriter(track leak.Ref, ref blobstore.BlobRef, store *Store, formatVersion blobstore.FormatVersion, file *os.File, bufferSize int) *blobWriter {
+	return &blobWriter{
+		ref:           ref,
+		store:         store,
+		closed:        false,
+		formatVersion: formatVersion,
+		buffer:        bufio.NewWriterSize(file, bufferSize),
+		fh:            file,
+
+		track: track,
+	}
+}
+
+// Write adds data to the blob.
+func (blob *blobWriter) Write(p []byte) (int, error) {
+	return blob.buffer.Write(p)
+}
+
+// Cancel discards the blob.
+func (blob *blobWriter) Cancel(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if blob.closed {
+		return nil
+	}
+	blob.closed = true
+
+	err = blob.store.dir.DeleteTemporary(ctx, blob.fh)
+	return Error.Wrap(errs.Combine(err, blob.track.Close()))
+}
+
+// Commit appends the blob to the active log.
+func (blob *blobWriter) Commit(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if blob.closed {
+		return Error.New("already closed")
+	}
+	blob.closed = true
+
+	if err := blob.buffer.Flush(); err != nil {
+		return Error.Wrap(errs.Combine(err, blob.store.dir.DeleteTemporary(ctx, blob.fh), blob.track.Close()))
+	}
+
+	err = blob.store.commit(ctx, blob.fh, blob.ref, blob.formatVersion)
+	return errs.Combine(err, blob.track.Close())
+}
+
+// Seek flushes any buffer and seeks the underlying file.
+func (blob *blobWriter) Seek(offset int64, whence int) (int64, error) {
+	if err := blob.buffer.Flush(); err != nil {
+		return 0, err
+	}
+
+	return blob.fh.Seek(offset, whence)
+}
+
+// Size returns how much has been written so far.
+func (blob *blobWriter) Size() (int64, error) {
+	return blob.Seek(0, io.SeekCurrent)
+}
+
+// StorageFormatVersion indicates what storage format version the blob is using.
+func (blob *blobWriter) StorageFormatVersion() blobstore.FormatVersion {
+	return blob.formatVersion
+}
+
+// blobInfo implements blobstore.BlobInfo for a blob in a log.
+type blobInfo struct {
+	ref  blobstore.BlobRef
+	path string
+	e    entry
+}
+
+func newBlobInfo(ref blobstore.BlobRef, path string, e entry) *blobInfo {
+	return &blobInfo{ref: ref, path: path, e: e}
+}
+
+// BlobRef returns the relevant BlobRef for the blob.
+func (info *blobInfo) BlobRef() blobstore.BlobRef { return info.ref }
+
+// StorageFormatVersion indicates the storage format version used to store the piece.
+func (info *blobInfo) StorageFormatVersion() blobstore.FormatVersion { return info.e.formatVersion }
+
+// FullPath returns the path of the log containing the blob.
+func (info *blobInfo) FullPath(ctx context.Context) (string, error) { return info.path, nil }
+
+// Stat returns the metadata of the blob, which is kept in the index.
+func (info *blobInfo) Stat(ctx context.Context) (os.FileInfo, error) {
+	return &fileInfo{
+		name:    string(info.ref.Key),
+		size:    info.e.size,
+		modTime: info.e.modTime,
+	}, nil
+}
+
+// fileInfo implements os.FileInfo for a blob in a log.
+type fileInfo struct {
+	name    string
+	size    int64
+	modTime time.Time
+}
+
+func (info *fileInfo) Name() string       { return info.name }
+func (info *fileInfo) Size() int64        { return info.size }
+func (info *fileInfo) Mode() os.FileMode  { return 0644 }
+func (info *fileInfo) ModTime() time.Time { return info.modTime }
+func (info *fileInfo) IsDir() bool        { return false }
+func (info *fileInfo) Sys() interface{}   { return nil }
@@ -0,0 +1,157 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package logstore
+
+import (
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/blobstore"
+)
+
+// pieceKey is the key of a blob, which is a piece ID.
+type pieceKey [len(storj.PieceID{})]byte
+
+// indexEntry is the compact form of an entry, which is kept in the index.
+type indexEntry struct {
+	log           uint32
+	formatVersion uint8
+	offset        int64
+	size          int64
+	// modTime and trashedAt are unix nanoseconds, see encodeTime.
+	modTime   uint64
+	trashedAt uint64
+}
+
+func newIndexEntry(e entry) indexEntry {
+	return indexEntry{
+		log:           e.log,
+		formatVersion: uint8(e.formatVersion),
+		offset:        e.offset,
+		size:          e.size,
+		modTime:       encodeTime(e.modTime),
+		trashedAt:     encodeTime(e.trashedAt),
+	}
+}
+
+func (e indexEntry) entry() entry {
+	return entry{
+		formatVersion: blobstore.FormatVersion(e.formatVersion),
+		log:           e.log,
+		offset:        e.offset,
+		size:          e.size,
+		modTime:       decodeTime(e.modTime),
+		trashedAt:     decodeTime(e.trashedAt),
+	}
+}
+
+// namespaceIndex contains the blobs of a namespace.
+type namespaceIndex struct {
+	pieces map[pieceKey]indexEntry
+	// other contains the blobs, whose keys aren't piece IDs.
+	other map[string]indexEntry
+}
+
+func (ns *namespaceIndex) len() int { return len(ns.pieces) + len(ns.other) }
+
+// index is the in-memory index of the blobs. It contains every blob stored
+// by the node, hence it's kept compact: the entries are stored by value and
+// the piece IDs are stored as arrays, so there are no allocations per blob.
+type index struct {
+	namespaces map[string]*namespaceIndex
+}
+
+func newIndex() *index {
+	return &index{namespaces: map[string]*namespaceIndex{}}
+}
+
+// get returns the entry of the blob.
+func (idx *index) get(namespace, key []byte) (entry, bool) {
+	ns, ok := idx.namespaces[string(namespace)]
+	if !ok {
+		return entry{}, false
+	}
+	var e indexEntry
+	if len(key) == len(pieceKey{}) {
+		e, ok = ns.pieces[*(*pieceKey)(key)]
+	} else {
+		e, ok = ns.other[string(key)]
+	}
+	if !ok {
+		return entry{}, false
+	}
+	return e.entry(), true
+}
+
+// put adds or replaces the entry of the blob.
+func (idx *index) put(namespace, key []byte, e entry) {
+	ns, ok := idx.namespaces[string(namespace)]
+	if !ok {
+		ns = &namespaceIndex{pieces: map[pieceKey]indexEntry{}, other: map[string]indexEntry{}}
+		idx.namespaces[string(namespace)] = ns
+	}
+	if len(key) == len(pieceKey{}) {
+		ns.pieces[*(*pieceKey)(key)] = newIndexEntry(e)
+	} else {
+		ns.other[string(key)] = newIndexEntry(e)
+	}
+}
+
+// delete removes the blob.
+func (idx *index) delete(namespace, key []byte) {
+	ns, ok := idx.namespaces[string(namespace)]
+	if !ok {
+		return
+	}
+	if len(key) == len(pieceKey{}) {
+		delete(ns.pieces, *(*pieceKey)(key))
+	} else {
+		delete(ns.other, string(key))
+	}
+}
+
+// deleteNamespace removes all the blobs of the namespace.
+func (idx *index) deleteNamespace(namespace []byte) {
+	delete(idx.namespaces, string(namespace))
+}
+
+// hasNamespace returns whether the namespace contains any blobs.
+func (idx *index) hasNamespace(namespace []byte) bool {
+	ns, ok := idx.namespaces[string(namespace)]
+	return ok && ns.len() > 0
+}
+
+// rangeNamespace calls fn for every blob of the namespace, until fn returns
+// false. The blobs may be changed by fn.
+func (idx *index) rangeNamespace(namespace []byte, fn func(key []byte, e entry) bool) {
+	ns, ok := idx.namespaces[string(namespace)]
+	if !ok {
+		return
+	}
+	for key, e := range ns.pieces {
+		key := key
+		if !fn(key[:], e.entry()) {
+			return
+		}
+	}
+	for key, e := range ns.other {
+		if !fn([]byte(key), e.entry()) {
+			return
+		}
+	}
+}
+
+// rangeAll calls fn for every blob, until fn returns false. The blobs may be
+// changed by fn.
+func (idx *index) rangeAll(fn func(namespace, key []byte, e entry) bool) {
+	for namespace := range idx.namespaces {
+		namespace := []byte(namespace)
+		more := true
+		idx.rangeNamespace(namespace, func(key []byte, e entry) bool {
+			more = fn(namespace, key, e)
+			return more
+		})
+		if !more {
+			return
+		}
+	}
+}
@@ -0,0 +1,315 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package logstore
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"os"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/storagenode/blobstore"
+)
+
+// operations recorded in the journal.
+const (
+	opPut             = 1
+	opTrash           = 2
+	opRestore         = 3
+	opDelete          = 4
+	opDeleteNamespace = 5
+)
+
+// recordHeaderSize is the size of the length and the checksum preceding every record.
+const recordHeaderSize = 8
+
+// entry is the location and the state of a blob.
+type entry struct {
+	formatVersion blobstore.FormatVersion
+	log           uint32
+	offset        int64
+	size          int64
+	modTime       time.Time
+	// trashedAt is when the blob was moved to the trash, zero when it's not trashed.
+	trashedAt time.Time
+}
+
+// trashed returns whether the blob is in the trash.
+func (e *entry) trashed() bool { return !e.trashedAt.IsZero() }
+
+// record is a change of the index, which is appended to the journal.
+type record struct {
+	op        byte
+	namespace []byte
+	key       []byte
+	// entry is set for opPut.
+	entry entry
+	// time is set for opTrash.
+	time time.Time
+}
+
+// encodeTime encodes t as unix nanoseconds, where the zero time is encoded as zero.
+func encodeTime(t time.Time) uint64 {
+	if t.IsZero() {
+		return 0
+	}
+	return uint64(t.UnixNano())
+}
+
+// decodeTime is the inverse of encodeTime.
+func decodeTime(v uint64) time.Time {
+	if v == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, int64(v))
+}
+
+// appendRecord appends the encoded record with its header to buf.
+func appendRecord(buf []byte, rec record) ([]byte, error) {
+	if len(rec.namespace) > 255 || len(rec.key) > 255 {
+		return nil, blobstore.ErrInvalidBlobRef.New("namespace or key is too long")
+	}
+
+	start := len(buf)
+	buf = append(buf, make([]byte, recordHeaderSize)...)
+
+	buf = append(buf, rec.op, byte(len(rec.namespace)))
+	buf = append(buf, rec.namespace...)
+	buf = append(buf, byte(len(rec.key)))
+	buf = append(buf, rec.key...)
+
+	switch rec.op {
+	case opPut:
+		buf = append(buf, byte(rec.entry.formatVersion))
+		buf = appendUint32(buf, rec.entry.log)
+		buf = appendUint64(buf, uint64(rec.entry.offset))
+		buf = appendUint64(buf, uint64(rec.entry.size))
+		buf = appendUint64(buf, encodeTime(rec.entry.modTime))
+		buf = appendUint64(buf, encodeTime(rec.entry.trashedAt))
+	case opTrash:
+		buf = appendUint64(buf, encodeTime(rec.time))
+	}
+
+	payload := buf[start+recordHeaderSize:]
+	binary.BigEndian.PutUint32(buf[start:], uint32(len(payload)))
+	binary.BigEndian.PutUint32(buf[start+4:], crc32.ChecksumIEEE(payload))
+	return buf, nil
+}
+
+func appendUint32(buf []byte, v uint32) []byte {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], v)
+	return append(buf, b[:]...)
+}
+
+func appendUint64(buf []byte, v uint64) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], v)
+	return append(buf, b[:]...)
+}
+
+// decodeRecord decodes the payload of a record.
+func decodeRecord(payload []byte) (rec record, err error) {
+	invalid := Error.New("invalid journal record")
+
+	next := func(n int) []byte {
+		if err != nil || len(payload) < n {
+			err = invalid
+			return make([]byte, n)
+		}
+		v := payload[:n]
+		payload = payload[n:]
+		return v
+	}
+
+	rec.op = next(1)[0]
+	rec.namespace = append([]byte(nil), next(int(next(1)[0]))...)
+	rec.key = append([]byte(nil), next(int(next(1)[0]))...)
+
+	switch rec.op {
+	case opPut:
+		rec.entry.formatVersion = blobstore.FormatVersion(next(1)[0])
+		rec.entry.log = binary.BigEndian.Uint32(next(4))
+		rec.entry.offset = int64(binary.BigEndian.Uint64(next(8)))
+		rec.entry.size = int64(binary.BigEndian.Uint64(next(8)))
+		rec.entry.modTime = decodeTime(binary.BigEndian.Uint64(next(8)))
+		rec.entry.trashedAt = decodeTime(binary.BigEndian.Uint64(next(8)))
+	case opTrash:
+		rec.time = decodeTime(binary.BigEndian.Uint64(next(8)))
+	case opRestore, opDelete, opDeleteNamespace:
+	default:
+		return record{}, invalid
+	}
+	if err != nil {
+		return record{}, err
+	}
+	if len(payload) != 0 {
+		return record{}, invalid
+	}
+	return rec, nil
+}
+
+// journal is the append-only file of the index changes.
+type journal struct {
+	path    string
+	file    *os.File
+	records int
+	// truncated is the number of bytes dropped from the end when opening.
+	truncated int64
+}
+
+// openJournal opens the journal and calls fn for every record in it. A
+// partially written record at the end, which is left behind by a crash, is
+// truncated.
+func openJournal(path string, fn func(record)) (_ *journal, err error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, file.Close())
+		}
+	}()
+
+	j := &journal{path: path, file: file}
+
+	var valid int64
+	reader := bufio.NewReaderSize(file, 256<<10)
+	header := make([]byte, recordHeaderSize)
+	var payload []byte
+	for {
+		if _, err := io.ReadFull(reader, header); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				break
+			}
+			return nil, Error.Wrap(err)
+		}
+
+		size := binary.BigEndian.Uint32(header)
+		if size > 1<<10 {
+			break
+		}
+		if cap(payload) < int(size) {
+			payload = make([]byte, size)
+		}
+		payload = payload[:size]
+		if _, err := io.ReadFull(reader, payload); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				break
+			}
+			return nil, Error.Wrap(err)
+		}
+		if crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(header[4:]) {
+			break
+		}
+
+		rec, err := decodeRecord(payload)
+		if err != nil {
+			break
+		}
+		fn(rec)
+
+		valid += int64(recordHeaderSize + len(payload))
+		j.records++
+	}
+
+	stat, err := file.Stat()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	j.truncated = stat.Size() - valid
+
+	if err := file.Truncate(valid); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if _, err := file.Seek(valid, io.SeekStart); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return j, nil
+}
+
+// Append writes the records durably to the journal.
+func (j *journal) Append(records ...record) error {
+	var buf []byte
+	for _, rec := range records {
+		var err error
+		buf, err = appendRecord(buf, rec)
+		if err != nil {
+			return err
+		}
+	}
+	if _, err := j.file.Write(buf); err != nil {
+		return Error.Wrap(err)
+	}
+	if err := j.file.Sync(); err != nil {
+		return Error.Wrap(err)
+	}
+	j.records += len(records)
+	return nil
+}
+
+// Rewrite atomically replaces the contents of the journal with the records.
+func (j *journal) Rewrite(records []record) (err error) {
+	tmpPath := j.path + ".tmp"
+	if err := writeJournalFile(tmpPath, records); err != nil {
+		return errs.Combine(err, ignoreNotExist(os.Remove(tmpPath)))
+	}
+
+	if err := j.file.Close(); err != nil {
+		return Error.Wrap(err)
+	}
+	renameErr := os.Rename(tmpPath, j.path)
+
+	// the journal must be reopened even when the rename failed.
+	j.file, err = os.OpenFile(j.path, os.O_RDWR|os.O_APPEND, 0644)
+	if err != nil || renameErr != nil {
+		return Error.Wrap(errs.Combine(renameErr, err))
+	}
+	j.records = len(records)
+	return nil
+}
+
+// writeJournalFile writes the records durably to a new file.
+func writeJournalFile(path string, records []record) (err error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(file.Close())) }()
+
+	writer := bufio.NewWriterSize(file, 256<<10)
+	var buf []byte
+	for _, rec := range records {
+		buf, err = appendRecord(buf[:0], rec)
+		if err != nil {
+			return err
+		}
+		if _, err := writer.Write(buf); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(file.Sync())
+}
+
+// ignoreNotExist returns nil when err is about a missing file.
+func ignoreNotExist(err error) error {
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// Close closes the journal.
+func (j *journal) Close() error {
+	return Error.Wrap(j.file.Close())
+}
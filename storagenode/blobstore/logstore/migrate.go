@@ -0,0 +1,89 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package logstore
+
+import (
+	"context"
+	"io"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/storagenode/blobstore"
+)
+
+// MigrateStats contains the outcome of a migration.
+type MigrateStats struct {
+	Blobs   int64
+	Bytes   int64
+	Skipped int64
+}
+
+// Migrate copies all the blobs from the source blob store to the log store,
+// keeping their storage format versions. The source blobs are deleted after
+// they have been copied, unless keepSource is set. Blobs, which are already
+// in the log store, are skipped, hence an interrupted migration can be
+// restarted.
+//
+// The blobs in the trash of the source are not migrated; the trash should be
+// emptied or restored before the migration.
+func Migrate(ctx context.Context, log *zap.Logger, from blobstore.Blobs, to *Store, keepSource bool) (stats MigrateStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	namespaces, err := from.ListNamespaces(ctx)
+	if err != nil {
+		return stats, Error.Wrap(err)
+	}
+
+	for _, namespace := range namespaces {
+		err := from.WalkNamespace(ctx, namespace, func(info blobstore.BlobInfo) error {
+			ref := info.BlobRef()
+			if _, err := to.StatWithStorageFormat(ctx, ref, info.StorageFormatVersion()); err == nil {
+				stats.Skipped++
+			} else {
+				n, err := migrateBlob(ctx, from, to, ref, info.StorageFormatVersion())
+				if err != nil {
+					return err
+				}
+				stats.Blobs++
+				stats.Bytes += n
+			}
+
+			if keepSource {
+				return nil
+			}
+			return Error.Wrap(from.DeleteWithStorageFormat(ctx, ref, info.StorageFormatVersion()))
+		})
+		if err != nil {
+			return stats, err
+		}
+		log.Info("namespace migrated", zap.Binary("namespace", namespace), zap.Int64("migrated", stats.Blobs))
+	}
+	return stats, nil
+}
+
+// migrateBlob copies a single blob.
+func migrateBlob(ctx context.Context, from blobstore.Blobs, to *Store, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) (_ int64, err error) {
+	reader, err := from.OpenWithStorageFormat(ctx, ref, formatVer)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(reader.Close())) }()
+
+	size, err := reader.Size()
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	writer, err := to.CreateWithStorageFormat(ctx, ref, size, formatVer)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := io.Copy(writer, reader)
+	if err != nil {
+		return 0, Error.Wrap(errs.Combine(err, writer.Cancel(ctx)))
+	}
+	return n, writer.Commit(ctx)
+}
@@ -0,0 +1,968 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package logstore
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/leak"
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/blobstore/filestore"
+)
+
+var (
+	// Error is the default logstore error class.
+	Error = errs.Class("logstore")
+
+	mon = monkit.Package()
+
+	_ blobstore.Blobs = (*Store)(nil)
+)
+
+const (
+	// logsDir is the sub-directory of the storage directory containing the logs and the journal.
+	logsDir = "logs"
+	// journalName is the file name of the journal.
+	journalName = "index"
+	// logSuffix is the file name suffix of the logs.
+	logSuffix = ".log"
+
+	// compactionRatio is the ratio of live bytes below which a log is compacted.
+	compactionRatio = 0.5
+)
+
+// Config is configuration for the log blob store.
+type Config struct {
+	Enabled         bool        `help:"store the pieces in append-only log files instead of a file per piece; existing pieces must be migrated with the migrate-logstore command" default:"false"`
+	MaxLogSize      memory.Size `help:"size after which a new log file is started" default:"1GiB"`
+	WriteBufferSize memory.Size `help:"in-memory buffer for uploads" default:"128KiB"`
+}
+
+// DefaultConfig is the default value for Config.
+var DefaultConfig = Config{
+	MaxLogSize:      memory.GiB,
+	WriteBufferSize: 128 * memory.KiB,
+}
+
+// logFile contains the space accounting of a log.
+type logFile struct {
+	// size is the number of bytes in the log.
+	size int64
+	// live is the number of bytes used by blobs, which are not deleted.
+	live int64
+	// readers is the number of open readers, which keep the log from being removed.
+	readers int
+}
+
+// Store implements a blob store, which appends the blobs to large log files
+// and keeps their location in an in-memory index. The changes of the index
+// are appended to a journal, which is replayed when the store is opened.
+//
+// Deleted blobs leave garbage in the logs, which is reclaimed by Compact.
+//
+// The blobs are appended to the active log holding appendMu only, so that
+// the other operations aren't blocked while the data is written and synced.
+// When both mutexes are needed, appendMu must be locked first.
+type Store struct {
+	log    *zap.Logger
+	dir    *filestore.Dir
+	config Config
+
+	trashnow func() time.Time
+
+	initOnce sync.Once
+	initErr  error
+
+	appendMu sync.Mutex
+	active   *os.File
+
+	mu       sync.Mutex
+	journal  *journal
+	blobs    *index
+	logs     map[uint32]*logFile
+	activeID uint32
+
+	track leak.Ref
+}
+
+// New creates a new log blob store in the specified directory. The index is
+// loaded lazily, when the store is used for the first time.
+func New(log *zap.Logger, dir *filestore.Dir, config Config) *Store {
+	return &Store{
+		log:      log,
+		dir:      dir,
+		config:   config,
+		trashnow: time.Now,
+		track:    leak.Root(1),
+	}
+}
+
+// NewAt creates a new log blob store in the specified directory.
+func NewAt(log *zap.Logger, path string, config Config) (*Store, error) {
+	dir, err := filestore.NewDir(log, path)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return New(log, dir, config), nil
+}
+
+// logsPath returns the directory of the logs and the journal.
+func (store *Store) logsPath() string { return filepath.Join(store.dir.Path(), logsDir) }
+
+// logPath returns the path of the log.
+func (store *Store) logPath(id uint32) string {
+	return filepath.Join(store.logsPath(), fmt.Sprintf("%08d%s", id, logSuffix))
+}
+
+// init loads the index and opens the active log.
+func (store *Store) init(ctx context.Context) error {
+	store.initOnce.Do(func() {
+		store.initErr = store.load(ctx)
+	})
+	return store.initErr
+}
+
+func (store *Store) load(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := os.MkdirAll(store.logsPath(), 0700); err != nil {
+		return Error.Wrap(err)
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.blobs = newIndex()
+	store.logs = map[uint32]*logFile{}
+
+	// journaled is the end of the last blob of every log, which was recorded
+	// in the journal.
+	journaled := map[uint32]int64{}
+	store.journal, err = openJournal(filepath.Join(store.logsPath(), journalName), func(rec record) {
+		if rec.op == opPut {
+			if end := rec.entry.offset + rec.entry.size; end > journaled[rec.entry.log] {
+				journaled[rec.entry.log] = end
+			}
+		}
+		store.apply(rec)
+	})
+	if err != nil {
+		return err
+	}
+	if store.journal.truncated > 0 {
+		store.log.Warn("dropped invalid records from the end of the journal", zap.Int64("bytes", store.journal.truncated))
+	}
+
+	names, err := os.ReadDir(store.logsPath())
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	for _, name := range names {
+		if !strings.HasSuffix(name.Name(), logSuffix) {
+			continue
+		}
+		id, err := strconv.ParseUint(strings.TrimSuffix(name.Name(), logSuffix), 10, 32)
+		if err != nil {
+			continue
+		}
+		info, err := name.Info()
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		store.logs[uint32(id)] = &logFile{size: info.Size()}
+		if uint32(id) > store.activeID {
+			store.activeID = uint32(id)
+		}
+	}
+
+	live := 0
+	store.blobs.rangeAll(func(namespace, key []byte, e entry) bool {
+		logFile, ok := store.logs[e.log]
+		if !ok || e.offset+e.size > logFile.size {
+			store.log.Warn("dropping blob missing from the logs",
+				zap.Binary("namespace", namespace),
+				zap.Binary("key", key))
+			store.blobs.delete(namespace, key)
+			return true
+		}
+		logFile.live += e.size
+		live++
+		return true
+	})
+
+	if store.activeID == 0 {
+		store.activeID = 1
+		store.logs[store.activeID] = &logFile{}
+	}
+	for id := range store.logs {
+		store.removeIfUnused(id)
+	}
+
+	store.active, err = os.OpenFile(store.logPath(store.activeID), os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	// anything after the last journaled blob of the active log was left
+	// behind by an interrupted write, hence it's discarded instead of being
+	// kept as garbage and appended after.
+	active := store.logs[store.activeID]
+	if size := journaled[store.activeID]; size < active.size {
+		store.log.Warn("dropped unjournaled data from the end of the active log",
+			zap.Uint32("log", store.activeID),
+			zap.Int64("bytes", active.size-size))
+		if err := store.active.Truncate(size); err != nil {
+			return Error.Wrap(err)
+		}
+		active.size = size
+	}
+	if _, err := store.active.Seek(active.size, io.SeekStart); err != nil {
+		return Error.Wrap(err)
+	}
+
+	// keep the journal from growing without bounds, when the same blobs are
+	// changed over and over again.
+	if store.journal.records > 2*live+1000 {
+		if err := store.journal.Rewrite(store.snapshot()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// apply applies a record of the journal to the index.
+func (store *Store) apply(rec record) {
+	switch rec.op {
+	case opPut:
+		store.blobs.put(rec.namespace, rec.key, rec.entry)
+	case opTrash:
+		if e, ok := store.blobs.get(rec.namespace, rec.key); ok {
+			e.trashedAt = rec.time
+			store.blobs.put(rec.namespace, rec.key, e)
+		}
+	case opRestore:
+		if e, ok := store.blobs.get(rec.namespace, rec.key); ok {
+			e.trashedAt = time.Time{}
+			store.blobs.put(rec.namespace, rec.key, e)
+		}
+	case opDelete:
+		store.blobs.delete(rec.namespace, rec.key)
+	case opDeleteNamespace:
+		store.blobs.deleteNamespace(rec.namespace)
+	}
+}
+
+// snapshot returns the records, which recreate the current index.
+func (store *Store) snapshot() []record {
+	var records []record
+	store.blobs.rangeAll(func(namespace, key []byte, e entry) bool {
+		records = append(records, record{
+			op:        opPut,
+			namespace: namespace,
+			key:       key,
+			entry:     e,
+		})
+		return true
+	})
+	return records
+}
+
+// update appends the record to the journal and applies it to the index,
+// keeping the space accounting of the logs up to date.
+func (store *Store) update(rec record) error {
+	if err := store.journal.Append(rec); err != nil {
+		return err
+	}
+
+	var removed []entry
+	switch rec.op {
+	case opPut, opDelete:
+		if e, ok := store.blobs.get(rec.namespace, rec.key); ok {
+			removed = append(removed, e)
+		}
+	case opDeleteNamespace:
+		store.blobs.rangeNamespace(rec.namespace, func(_ []byte, e entry) bool {
+			removed = append(removed, e)
+			return true
+		})
+	}
+
+	store.apply(rec)
+
+	if rec.op == opPut {
+		store.logs[rec.entry.log].live += rec.entry.size
+	}
+	for _, e := range removed {
+		store.logs[e.log].live -= e.size
+		store.removeIfUnused(e.log)
+	}
+	return nil
+}
+
+// removeIfUnused removes the log, when it's not active, all the blobs in it
+// have been deleted and it's not being read.
+func (store *Store) removeIfUnused(id uint32) {
+	logFile, ok := store.logs[id]
+	if !ok || id == store.activeID || logFile.live > 0 || logFile.readers > 0 {
+		return
+	}
+	// the removal fails on some platforms, while the log is being read; it's
+	// retried when the store is opened the next time.
+	if err := os.Remove(store.logPath(id)); err != nil && !os.IsNotExist(err) {
+		store.log.Debug("unable to remove unused log", zap.Uint32("log", id), zap.Error(err))
+		return
+	}
+	delete(store.logs, id)
+}
+
+// lookup returns the entry of the blob, which is not in the trash.
+func (store *Store) lookup(ref blobstore.BlobRef) (entry, bool) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	e, ok := store.blobs.get(ref.Namespace, ref.Key)
+	if !ok || e.trashed() {
+		return entry{}, false
+	}
+	return e, true
+}
+
+// pin keeps the log from being removed, until it's released.
+func (store *Store) pin(id uint32) {
+	store.logs[id].readers++
+}
+
+// release releases the log pinned before, and removes it when it's unused.
+func (store *Store) release(id uint32) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.logs[id].readers--
+	store.removeIfUnused(id)
+}
+
+// Close closes the store.
+func (store *Store) Close() error {
+	store.appendMu.Lock()
+	defer store.appendMu.Unlock()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	var group errs.Group
+	if store.active != nil {
+		group.Add(Error.Wrap(store.active.Close()))
+		store.active = nil
+	}
+	if store.journal != nil {
+		group.Add(store.journal.Close())
+		store.journal = nil
+	}
+	group.Add(store.track.Close())
+	return group.Err()
+}
+
+// Create creates a new blob that can be written.
+// Optionally takes a size argument for performance improvements, -1 is unknown size.
+func (store *Store) Create(ctx context.Context, ref blobstore.BlobRef, size int64) (_ blobstore.BlobWriter, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.create(ctx, ref, size, filestore.MaxFormatVersionSupported)
+}
+
+// CreateWithStorageFormat creates a new blob with the given storage format
+// version, which is used when migrating blobs from another blob store.
+func (store *Store) CreateWithStorageFormat(ctx context.Context, ref blobstore.BlobRef, size int64, formatVer blobstore.FormatVersion) (_ blobstore.BlobWriter, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.create(ctx, ref, size, formatVer)
+}
+
+// TestCreateV0 creates a new V0 blob that can be written. This is ONLY appropriate in test situations.
+func (store *Store) TestCreateV0(ctx context.Context, ref blobstore.BlobRef) (_ blobstore.BlobWriter, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.create(ctx, ref, -1, filestore.FormatV0)
+}
+
+func (store *Store) create(ctx context.Context, ref blobstore.BlobRef, size int64, formatVer blobstore.FormatVersion) (_ blobstore.BlobWriter, err error) {
+	if !ref.IsValid() {
+		return nil, blobstore.ErrInvalidBlobRef.New("")
+	}
+	if err := store.init(ctx); err != nil {
+		return nil, err
+	}
+
+	// the blob is written to a temporary file first, because the writer must
+	// be seekable and the logs are append-only.
+	file, err := store.dir.CreateTemporaryFile(ctx, size)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return newBlobWriter(store.track.Child("blobWriter", 1), ref, store, formatVer, file, store.config.WriteBufferSize.Int()), nil
+}
+
+// commit appends the contents of the temporary file to the active log and
+// adds the blob to the index.
+func (store *Store) commit(ctx context.Context, file *os.File, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	defer func() { err = errs.Combine(err, store.dir.DeleteTemporary(ctx, file)) }()
+
+	// the temporary file may be preallocated, hence the current position is
+	// the size of the blob.
+	size, err := file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return Error.Wrap(err)
+	}
+
+	store.appendMu.Lock()
+	defer store.appendMu.Unlock()
+
+	e, err := store.append(io.LimitReader(file, size))
+	if err != nil {
+		return err
+	}
+	e.formatVersion = formatVer
+	e.modTime = store.trashnow()
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	return store.update(record{op: opPut, namespace: ref.Namespace, key: ref.Key, entry: e})
+}
+
+// append appends the data to the active log, starting a new log when the
+// active one is full. It must be called with appendMu held, but not mu.
+func (store *Store) append(data io.Reader) (e entry, err error) {
+	if store.active == nil {
+		return entry{}, Error.New("store is closed")
+	}
+
+	// the size of the active log is only changed while holding appendMu.
+	store.mu.Lock()
+	activeID, offset := store.activeID, store.logs[store.activeID].size
+	store.mu.Unlock()
+
+	if offset >= store.config.MaxLogSize.Int64() {
+		if err := store.rotate(); err != nil {
+			return entry{}, err
+		}
+		activeID, offset = activeID+1, 0
+	}
+
+	n, err := io.Copy(store.active, data)
+	if err == nil {
+		err = store.active.Sync()
+	}
+	if err != nil {
+		// drop the partially written data.
+		_, seekErr := store.active.Seek(offset, io.SeekStart)
+		return entry{}, Error.Wrap(errs.Combine(err, store.active.Truncate(offset), seekErr))
+	}
+
+	store.mu.Lock()
+	store.logs[activeID].size += n
+	store.mu.Unlock()
+
+	return entry{log: activeID, offset: offset, size: n}, nil
+}
+
+// rotate closes the active log and starts a new one. It must be called with
+// appendMu held, but not mu.
+func (store *Store) rotate() error {
+	store.mu.Lock()
+	next := store.activeID + 1
+	store.mu.Unlock()
+
+	file, err := os.OpenFile(store.logPath(next), os.O_RDWR|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if err := store.active.Close(); err != nil {
+		return Error.Wrap(errs.Combine(err, file.Close()))
+	}
+	store.active = file
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	previous := store.activeID
+	store.activeID = next
+	store.logs[next] = &logFile{}
+	store.removeIfUnused(previous)
+	return nil
+}
+
+// Open loads blob with the specified hash.
+func (store *Store) Open(ctx context.Context, ref blobstore.BlobRef) (_ blobstore.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if err := store.init(ctx); err != nil {
+		return nil, err
+	}
+
+	return store.open(ref, func(e entry) bool { return true })
+}
+
+// OpenWithStorageFormat loads the already-located blob, avoiding the potential need to check multiple
+// storage formats to find the blob.
+func (store *Store) OpenWithStorageFormat(ctx context.Context, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) (_ blobstore.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if err := store.init(ctx); err != nil {
+		return nil, err
+	}
+
+	return store.open(ref, func(e entry) bool { return e.formatVersion == formatVer })
+}
+
+// open opens the blob, which is not in the trash. The log containing the
+// blob is pinned until the reader is closed, hence it's not removed by a
+// compaction in the meantime.
+func (store *Store) open(ref blobstore.BlobRef, match func(entry) bool) (blobstore.BlobReader, error) {
+	store.mu.Lock()
+	e, ok := store.blobs.get(ref.Namespace, ref.Key)
+	if !ok || e.trashed() || !match(e) {
+		store.mu.Unlock()
+		return nil, os.ErrNotExist
+	}
+	store.pin(e.log)
+	store.mu.Unlock()
+
+	file, err := os.Open(store.logPath(e.log))
+	if err != nil {
+		store.release(e.log)
+		return nil, Error.Wrap(err)
+	}
+	return newBlobReader(store.track.Child("blobReader", 1), file, e, func() { store.release(e.log) }), nil
+}
+
+// Stat looks up the metadata of the blob.
+func (store *Store) Stat(ctx context.Context, ref blobstore.BlobRef) (_ blobstore.BlobInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if err := store.init(ctx); err != nil {
+		return nil, err
+	}
+
+	e, ok := store.lookup(ref)
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return newBlobInfo(ref, store.logPath(e.log), e), nil
+}
+
+// StatWithStorageFormat looks up the metadata of the blob with the given storage format version.
+func (store *Store) StatWithStorageFormat(ctx context.Context, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) (_ blobstore.BlobInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if err := store.init(ctx); err != nil {
+		return nil, err
+	}
+
+	e, ok := store.lookup(ref)
+	if !ok || e.formatVersion != formatVer {
+		return nil, os.ErrNotExist
+	}
+	return newBlobInfo(ref, store.logPath(e.log), e), nil
+}
+
+// Delete deletes blobs with the specified ref.
+//
+// It doesn't return an error if the blob isn't found.
+func (store *Store) Delete(ctx context.Context, ref blobstore.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.delete(ctx, ref, func(e entry) bool { return true })
+}
+
+// DeleteWithStorageFormat deletes blobs with the specified ref and storage format version.
+func (store *Store) DeleteWithStorageFormat(ctx context.Context, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.delete(ctx, ref, func(e entry) bool { return e.formatVersion == formatVer })
+}
+
+func (store *Store) delete(ctx context.Context, ref blobstore.BlobRef, match func(entry) bool) error {
+	if err := store.init(ctx); err != nil {
+		return err
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	e, ok := store.blobs.get(ref.Namespace, ref.Key)
+	if !ok || e.trashed() || !match(e) {
+		return nil
+	}
+	return store.update(record{op: opDelete, namespace: ref.Namespace, key: ref.Key})
+}
+
+// DeleteNamespace deletes all the blobs of a specific satellite, used after successful GE only.
+func (store *Store) DeleteNamespace(ctx context.Context, namespace []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if err := store.init(ctx); err != nil {
+		return err
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	if !store.blobs.hasNamespace(namespace) {
+		return nil
+	}
+	return store.update(record{op: opDeleteNamespace, namespace: namespace})
+}
+
+// Trash marks the blob for pending deletion.
+func (store *Store) Trash(ctx context.Context, ref blobstore.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if err := store.init(ctx); err != nil {
+		return err
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	e, ok := store.blobs.get(ref.Namespace, ref.Key)
+	if !ok || e.trashed() {
+		return nil
+	}
+	return store.update(record{op: opTrash, namespace: ref.Namespace, key: ref.Key, time: store.trashnow()})
+}
+
+// ReplaceTrashnow is a helper for tests to replace the trashnow function used
+// when moving blobs to the trash.
+func (store *Store) ReplaceTrashnow(trashnow func() time.Time) {
+	store.trashnow = trashnow
+}
+
+// RestoreTrash restores all the blobs in the trash of the namespace.
+func (store *Store) RestoreTrash(ctx context.Context, namespace []byte) (keysRestored [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if err := store.init(ctx); err != nil {
+		return nil, err
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.blobs.rangeNamespace(namespace, func(key []byte, e entry) bool {
+		if !e.trashed() {
+			return true
+		}
+		if err = store.update(record{op: opRestore, namespace: namespace, key: key}); err != nil {
+			return false
+		}
+		keysRestored = append(keysRestored, key)
+		return true
+	})
+	return keysRestored, err
+}
+
+// EmptyTrash removes all the blobs, which were moved to the trash of the
+// namespace before trashedBefore, and compacts the logs afterwards.
+func (store *Store) EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (bytesEmptied int64, keys [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if err := store.init(ctx); err != nil {
+		return 0, nil, err
+	}
+
+	err = func() (err error) {
+		store.mu.Lock()
+		defer store.mu.Unlock()
+
+		store.blobs.rangeNamespace(namespace, func(key []byte, e entry) bool {
+			if !e.trashed() || !e.trashedAt.Before(trashedBefore) {
+				return true
+			}
+			if err = store.update(record{op: opDelete, namespace: namespace, key: key}); err != nil {
+				return false
+			}
+			bytesEmptied += e.size
+			keys = append(keys, key)
+			return true
+		})
+		return err
+	}()
+	if err != nil {
+		return bytesEmptied, keys, err
+	}
+
+	return bytesEmptied, keys, store.Compact(ctx)
+}
+
+// Compact moves the blobs out of the logs, which mostly contain garbage, and
+// removes those logs.
+func (store *Store) Compact(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if err := store.init(ctx); err != nil {
+		return err
+	}
+
+	store.mu.Lock()
+	var ids []uint32
+	for id, logFile := range store.logs {
+		if id != store.activeID && float64(logFile.live) < compactionRatio*float64(logFile.size) {
+			ids = append(ids, id)
+		}
+	}
+	store.mu.Unlock()
+
+	sort.Slice(ids, func(i, k int) bool { return ids[i] < ids[k] })
+	for _, id := range ids {
+		if err := store.compactLog(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// compactLog moves the blobs out of the log one by one, so that the other
+// operations aren't blocked for long. The log is removed after the last
+// blob has been moved.
+func (store *Store) compactLog(ctx context.Context, id uint32) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	type location struct{ namespace, key []byte }
+
+	store.mu.Lock()
+	if _, ok := store.logs[id]; !ok {
+		store.mu.Unlock()
+		return nil
+	}
+	store.pin(id)
+	var locations []location
+	store.blobs.rangeAll(func(namespace, key []byte, e entry) bool {
+		if e.log == id {
+			locations = append(locations, location{namespace, key})
+		}
+		return true
+	})
+	store.mu.Unlock()
+	defer store.release(id)
+
+	source, err := os.Open(store.logPath(id))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(source.Close())) }()
+
+	move := func(loc location) error {
+		store.appendMu.Lock()
+		defer store.appendMu.Unlock()
+
+		store.mu.Lock()
+		e, ok := store.blobs.get(loc.namespace, loc.key)
+		store.mu.Unlock()
+		if !ok || e.log != id {
+			return nil
+		}
+
+		moved, err := store.append(io.NewSectionReader(source, e.offset, e.size))
+		if err != nil {
+			return err
+		}
+
+		store.mu.Lock()
+		defer store.mu.Unlock()
+
+		// the blob may have been changed, while it was being moved.
+		current, ok := store.blobs.get(loc.namespace, loc.key)
+		if !ok || current.log != id || current.offset != e.offset {
+			return nil
+		}
+		moved.formatVersion, moved.modTime, moved.trashedAt = current.formatVersion, current.modTime, current.trashedAt
+
+		return store.update(record{op: opPut, namespace: loc.namespace, key: loc.key, entry: moved})
+	}
+
+	for _, loc := range locations {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := move(loc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GarbageCollect tries to remove the unused logs, which couldn't be removed before.
+func (store *Store) GarbageCollect(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if err := store.init(ctx); err != nil {
+		return err
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	for id := range store.logs {
+		store.removeIfUnused(id)
+	}
+	return nil
+}
+
+// SpaceUsedForBlobs adds up the space used in all namespaces for blob storage.
+func (store *Store) SpaceUsedForBlobs(ctx context.Context) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return total, store.sum(ctx, nil, func(e entry) {
+		if !e.trashed() {
+			total += e.size
+		}
+	})
+}
+
+// SpaceUsedForBlobsInNamespace adds up how much is used in the given namespace for blob storage.
+func (store *Store) SpaceUsedForBlobsInNamespace(ctx context.Context, namespace []byte) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return total, store.sum(ctx, namespace, func(e entry) {
+		if !e.trashed() {
+			total += e.size
+		}
+	})
+}
+
+// SpaceUsedForTrash returns the total space used by the trash.
+func (store *Store) SpaceUsedForTrash(ctx context.Context) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return total, store.sum(ctx, nil, func(e entry) {
+		if e.trashed() {
+			total += e.size
+		}
+	})
+}
+
+// SpaceUsedForGarbage returns the space used by the deleted blobs, which
+// haven't been compacted yet.
+func (store *Store) SpaceUsedForGarbage(ctx context.Context) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if err := store.init(ctx); err != nil {
+		return 0, err
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	for _, logFile := range store.logs {
+		total += logFile.size - logFile.live
+	}
+	return total, nil
+}
+
+// sum calls fn for every blob in the namespace, or in every namespace when it's nil.
+func (store *Store) sum(ctx context.Context, namespace []byte, fn func(entry)) error {
+	if err := store.init(ctx); err != nil {
+		return err
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	collect := func(_ []byte, e entry) bool {
+		fn(e)
+		return true
+	}
+	if namespace != nil {
+		store.blobs.rangeNamespace(namespace, collect)
+		return nil
+	}
+	store.blobs.rangeAll(func(_, key []byte, e entry) bool { return collect(key, e) })
+	return nil
+}
+
+// FreeSpace returns how much space left in underlying directory.
+func (store *Store) FreeSpace(ctx context.Context) (int64, error) {
+	info, err := store.dir.Info(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return info.AvailableSpace, nil
+}
+
+// CheckWritability tests writability of the storage directory by creating and deleting a file.
+func (store *Store) CheckWritability(ctx context.Context) error {
+	if err := store.init(ctx); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(store.logsPath(), "write-test")
+	if err != nil {
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Remove(f.Name())
+}
+
+// ListNamespaces finds all the namespaces, which contain blobs.
+func (store *Store) ListNamespaces(ctx context.Context) (ids [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if err := store.init(ctx); err != nil {
+		return nil, err
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	for namespace, ns := range store.blobs.namespaces {
+		if ns.len() > 0 {
+			ids = append(ids, []byte(namespace))
+		}
+	}
+	return ids, nil
+}
+
+// WalkNamespace executes walkFunc for each blob in the given namespace, which
+// is not in the trash. If walkFunc returns a non-nil error, WalkNamespace will
+// stop iterating and return the error immediately. The ctx parameter is
+// intended specifically to allow canceling iteration early.
+func (store *Store) WalkNamespace(ctx context.Context, namespace []byte, walkFunc func(blobstore.BlobInfo) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if err := store.init(ctx); err != nil {
+		return err
+	}
+
+	// walkFunc is called without holding the mutex, because it may use the store.
+	var infos []blobstore.BlobInfo
+	store.mu.Lock()
+	store.blobs.rangeNamespace(namespace, func(key []byte, e entry) bool {
+		if !e.trashed() {
+			ref := blobstore.BlobRef{Namespace: namespace, Key: key}
+			infos = append(infos, newBlobInfo(ref, store.logPath(e.log), e))
+		}
+		return true
+	})
+	store.mu.Unlock()
+
+	for _, info := range infos {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := walkFunc(info); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateVerificationFile creates a file to be used for storage directory verification.
+func (store *Store) CreateVerificationFile(ctx context.Context, id storj.NodeID) error {
+	return store.dir.CreateVerificationFile(ctx, id)
+}
+
+// VerifyStorageDir verifies that the storage directory is correct by checking for the existence and validity
+// of the verification file.
+func (store *Store) VerifyStorageDir(ctx context.Context, id storj.NodeID) error {
+	return store.dir.Verify(ctx, id)
+}
@@ -0,0 +1,299 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package logstore_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/blobstore/filestore"
+	"storj.io/storj/storagenode/blobstore/logstore"
+)
+
+func writeBlob(ctx context.Context, t *testing.T, store blobstore.Blobs, ref blobstore.BlobRef, data []byte) {
+	writer, err := store.Create(ctx, ref, int64(len(data)))
+	require.NoError(t, err)
+	_, err = writer.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, writer.Commit(ctx))
+	// two commits should fail
+	require.Error(t, writer.Commit(ctx))
+}
+
+func readBlob(ctx context.Context, t *testing.T, store blobstore.Blobs, ref blobstore.BlobRef) []byte {
+	reader, err := store.Open(ctx, ref)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, reader.Close()) }()
+
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+
+	size, err := reader.Size()
+	require.NoError(t, err)
+	require.Equal(t, int64(len(data)), size)
+	return data
+}
+
+func TestStore(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	config := logstore.DefaultConfig
+	config.MaxLogSize = 64 * memory.KiB
+
+	store, err := logstore.NewAt(zaptest.NewLogger(t), ctx.Dir("store"), config)
+	require.NoError(t, err)
+
+	namespace := testrand.Bytes(32)
+	blobs := map[string][]byte{}
+	var refs []blobstore.BlobRef
+	for i := 0; i < 20; i++ {
+		ref := blobstore.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+		data := testrand.BytesInt(10 * memory.KiB.Int())
+		writeBlob(ctx, t, store, ref, data)
+		blobs[string(ref.Key)] = data
+		refs = append(refs, ref)
+	}
+
+	// a cancelled blob isn't stored.
+	canceled := blobstore.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+	writer, err := store.Create(ctx, canceled, -1)
+	require.NoError(t, err)
+	_, err = writer.Write(testrand.Bytes(memory.KiB))
+	require.NoError(t, err)
+	require.NoError(t, writer.Cancel(ctx))
+	_, err = store.Open(ctx, canceled)
+	require.True(t, os.IsNotExist(err))
+
+	for _, ref := range refs {
+		require.Equal(t, blobs[string(ref.Key)], readBlob(ctx, t, store, ref))
+	}
+
+	used, err := store.SpaceUsedForBlobs(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(20*10*memory.KiB), used)
+
+	walked := 0
+	require.NoError(t, store.WalkNamespace(ctx, namespace, func(info blobstore.BlobInfo) error {
+		stat, err := info.Stat(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(len(blobs[string(info.BlobRef().Key)])), stat.Size())
+		require.Equal(t, filestore.MaxFormatVersionSupported, info.StorageFormatVersion())
+		walked++
+		return nil
+	}))
+	require.Equal(t, len(refs), walked)
+
+	// trash and restore.
+	require.NoError(t, store.Trash(ctx, refs[0]))
+	_, err = store.Open(ctx, refs[0])
+	require.True(t, os.IsNotExist(err))
+	trash, err := store.SpaceUsedForTrash(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(10*memory.KiB), trash)
+
+	restored, err := store.RestoreTrash(ctx, namespace)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{refs[0].Key}, restored)
+	require.Equal(t, blobs[string(refs[0].Key)], readBlob(ctx, t, store, refs[0]))
+
+	// trash and empty most of the blobs, which compacts the logs.
+	now := time.Now()
+	store.ReplaceTrashnow(func() time.Time { return now.Add(-time.Hour) })
+	for _, ref := range refs[:15] {
+		require.NoError(t, store.Trash(ctx, ref))
+	}
+	emptied, keys, err := store.EmptyTrash(ctx, namespace, now)
+	require.NoError(t, err)
+	require.Equal(t, int64(15*10*memory.KiB), emptied)
+	require.Len(t, keys, 15)
+
+	garbage, err := store.SpaceUsedForGarbage(ctx)
+	require.NoError(t, err)
+	require.Less(t, garbage, int64(config.MaxLogSize))
+
+	for _, ref := range refs[15:] {
+		require.Equal(t, blobs[string(ref.Key)], readBlob(ctx, t, store, ref))
+	}
+
+	// deleting doesn't fail for missing blobs.
+	require.NoError(t, store.Delete(ctx, refs[19]))
+	require.NoError(t, store.Delete(ctx, refs[19]))
+
+	require.NoError(t, store.Close())
+
+	// the index is recovered from the journal.
+	store, err = logstore.NewAt(zaptest.NewLogger(t), ctx.Dir("store"), config)
+	require.NoError(t, err)
+	defer ctx.Check(store.Close)
+
+	for _, ref := range refs[:15] {
+		_, err := store.Stat(ctx, ref)
+		require.True(t, os.IsNotExist(err))
+	}
+	for _, ref := range refs[15:19] {
+		require.Equal(t, blobs[string(ref.Key)], readBlob(ctx, t, store, ref))
+	}
+
+	used, err = store.SpaceUsedForBlobsInNamespace(ctx, namespace)
+	require.NoError(t, err)
+	require.Equal(t, int64(4*10*memory.KiB), used)
+
+	require.NoError(t, store.DeleteNamespace(ctx, namespace))
+	namespaces, err := store.ListNamespaces(ctx)
+	require.NoError(t, err)
+	require.Empty(t, namespaces)
+}
+
+func TestMigrate(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := zaptest.NewLogger(t)
+
+	dir, err := filestore.NewDir(log, ctx.Dir("store"))
+	require.NoError(t, err)
+
+	from := filestore.New(log, dir, filestore.DefaultConfig)
+	defer ctx.Check(from.Close)
+
+	blobs := map[string][]byte{}
+	var refs []blobstore.BlobRef
+	for _, namespace := range [][]byte{testrand.Bytes(32), testrand.Bytes(32)} {
+		for i := 0; i < 5; i++ {
+			ref := blobstore.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+			data := testrand.BytesInt(memory.KiB.Int())
+			writeBlob(ctx, t, from, ref, data)
+			blobs[string(ref.Key)] = data
+			refs = append(refs, ref)
+		}
+	}
+
+	to := logstore.New(log, dir, logstore.DefaultConfig)
+	defer ctx.Check(to.Close)
+
+	stats, err := logstore.Migrate(ctx, log, from, to, true)
+	require.NoError(t, err)
+	require.Equal(t, logstore.MigrateStats{Blobs: 10, Bytes: 10 * memory.KiB.Int64()}, stats)
+
+	// migrating again skips the blobs, which were copied already.
+	stats, err = logstore.Migrate(ctx, log, from, to, false)
+	require.NoError(t, err)
+	require.Equal(t, logstore.MigrateStats{Skipped: 10}, stats)
+
+	for _, ref := range refs {
+		require.Equal(t, blobs[string(ref.Key)], readBlob(ctx, t, to, ref))
+
+		_, err := from.Stat(ctx, ref)
+		require.True(t, errs.Is(err, os.ErrNotExist))
+	}
+}
+
+func TestCompactOpenBlob(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	config := logstore.DefaultConfig
+	config.MaxLogSize = 25 * memory.KiB
+
+	store, err := logstore.NewAt(zaptest.NewLogger(t), ctx.Dir("store"), config)
+	require.NoError(t, err)
+	defer ctx.Check(store.Close)
+
+	// the keys, which aren't piece IDs, are supported too.
+	namespace := testrand.Bytes(32)
+	blobs := map[string][]byte{}
+	var refs []blobstore.BlobRef
+	for _, key := range [][]byte{testrand.Bytes(32), []byte("short"), testrand.Bytes(32), testrand.Bytes(32)} {
+		ref := blobstore.BlobRef{Namespace: namespace, Key: key}
+		data := testrand.BytesInt(10 * memory.KiB.Int())
+		writeBlob(ctx, t, store, ref, data)
+		blobs[string(ref.Key)] = data
+		refs = append(refs, ref)
+	}
+
+	info, err := store.Stat(ctx, refs[0])
+	require.NoError(t, err)
+	path, err := info.FullPath(ctx)
+	require.NoError(t, err)
+
+	// the log isn't removed, while a blob in it is being read.
+	reader, err := store.Open(ctx, refs[0])
+	require.NoError(t, err)
+
+	require.NoError(t, store.Delete(ctx, refs[1]))
+	require.NoError(t, store.Delete(ctx, refs[2]))
+	require.NoError(t, store.Compact(ctx))
+
+	moved, err := store.Stat(ctx, refs[0])
+	require.NoError(t, err)
+	movedPath, err := moved.FullPath(ctx)
+	require.NoError(t, err)
+	require.NotEqual(t, path, movedPath)
+
+	_, err = os.Stat(path)
+	require.NoError(t, err)
+
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, blobs[string(refs[0].Key)], data)
+	require.NoError(t, reader.Close())
+
+	_, err = os.Stat(path)
+	require.True(t, os.IsNotExist(err))
+
+	for _, ref := range []blobstore.BlobRef{refs[0], refs[3]} {
+		require.Equal(t, blobs[string(ref.Key)], readBlob(ctx, t, store, ref))
+	}
+}
+
+func TestStoreTornLogTail(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	store, err := logstore.NewAt(zaptest.NewLogger(t), ctx.Dir("store"), logstore.DefaultConfig)
+	require.NoError(t, err)
+
+	first := blobstore.BlobRef{Namespace: testrand.Bytes(32), Key: testrand.Bytes(32)}
+	firstData := testrand.BytesInt(memory.KiB.Int())
+	writeBlob(ctx, t, store, first, firstData)
+
+	info, err := store.Stat(ctx, first)
+	require.NoError(t, err)
+	path, err := info.FullPath(ctx)
+	require.NoError(t, err)
+	require.NoError(t, store.Close())
+
+	// a write interrupted by a crash leaves data, which isn't in the journal.
+	log, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0644)
+	require.NoError(t, err)
+	_, err = log.Write(testrand.BytesInt(100))
+	require.NoError(t, err)
+	require.NoError(t, log.Close())
+
+	store, err = logstore.NewAt(zaptest.NewLogger(t), ctx.Dir("store"), logstore.DefaultConfig)
+	require.NoError(t, err)
+	defer ctx.Check(store.Close)
+
+	garbage, err := store.SpaceUsedForGarbage(ctx)
+	require.NoError(t, err)
+	require.Zero(t, garbage)
+
+	second := blobstore.BlobRef{Namespace: first.Namespace, Key: testrand.Bytes(32)}
+	secondData := testrand.BytesInt(memory.KiB.Int())
+	writeBlob(ctx, t, store, second, secondData)
+
+	require.Equal(t, firstData, readBlob(ctx, t, store, first))
+	require.Equal(t, secondData, readBlob(ctx, t, store, second))
+
+	stat, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, int64(len(firstData)+len(secondData)), stat.Size())
+}
@@ -0,0 +1,673 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package multistore implements a blob store, which spreads the blobs over
+// several storage directories, usually on different disks.
+package multistore
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/lrucache"
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/blobstore"
+)
+
+var (
+	// Error is the default multistore error class.
+	Error = errs.Class("multistore")
+
+	mon = monkit.Package()
+
+	_ blobstore.Blobs = (*Store)(nil)
+)
+
+// Config is configuration for the additional storage directories.
+type Config struct {
+	Dirs []string `help:"additional storage directories with their allocated disk space, e.g. /mnt/disk2/storage:2TB" default:""`
+}
+
+// DirConfig is the configuration of a single additional storage directory.
+type DirConfig struct {
+	Path      string
+	Allocated memory.Size
+}
+
+// ParseDirs parses the additional storage directories.
+func (config Config) ParseDirs() ([]DirConfig, error) {
+	var dirs []DirConfig
+	for _, dir := range config.Dirs {
+		dir = strings.TrimSpace(dir)
+		if dir == "" {
+			continue
+		}
+
+		// the path may contain colons on Windows, hence the last one separates the allocation.
+		i := strings.LastIndexByte(dir, ':')
+		if i <= 0 {
+			return nil, Error.New("missing allocated disk space for %q", dir)
+		}
+		// memory.Size doesn't handle values without any digits.
+		value := dir[i+1:]
+		if !strings.ContainsAny(value, "0123456789") {
+			return nil, Error.New("invalid allocated disk space for %q", dir)
+		}
+		var allocated memory.Size
+		if err := allocated.Set(value); err != nil {
+			return nil, Error.New("invalid allocated disk space for %q: %v", dir, err)
+		}
+		dirs = append(dirs, DirConfig{Path: dir[:i], Allocated: allocated})
+	}
+	return dirs, nil
+}
+
+// Disk is a storage directory managed by the store.
+type Disk struct {
+	// Path identifies the disk in the logs and reports.
+	Path string
+	// Allocated is the disk space allocated for the blobs; zero means the whole disk.
+	Allocated int64
+	Blobs     blobstore.Blobs
+}
+
+// DiskStatus is the state of a disk.
+type DiskStatus struct {
+	Path      string
+	Allocated int64
+	// Used is the disk space used by the blobs and the trash, as of the last refresh.
+	Used int64
+	// Free is the free space on the whole disk.
+	Free int64
+	// Available is the space available for new blobs.
+	Available int64
+	Online    bool
+	// Err is the reason why the disk is offline.
+	Err error
+}
+
+// health checks of the disks.
+const (
+	checkWritable = iota
+	checkVerified
+	checkCount
+)
+
+// disk is a storage directory with its state.
+type disk struct {
+	Disk
+
+	mu     sync.Mutex
+	used   int64
+	online bool
+	err    error
+	// failures contains the last error of every health check.
+	failures [checkCount]error
+}
+
+func (d *disk) isOnline() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.online
+}
+
+// setHealth records the result of the check, and takes the disk offline or
+// brings it back online, depending on the results of all the checks.
+func (d *disk) setHealth(log *zap.Logger, check int, checkErr error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.failures[check] = checkErr
+	var err error
+	for _, failure := range d.failures {
+		if failure != nil {
+			err = failure
+			break
+		}
+	}
+
+	switch {
+	case err != nil && d.online:
+		log.Error("taking disk offline", zap.String("path", d.Path), zap.Error(err))
+		mon.Event("multistore_disk_offline")
+	case err == nil && !d.online:
+		log.Info("disk is back online", zap.String("path", d.Path))
+	}
+	d.online, d.err = err == nil, err
+}
+
+// Store implements a blob store over several disks. New blobs are placed on
+// the disk with the most available space, and the blobs are found by looking
+// them up on every disk, with the recent locations cached.
+//
+// A disk, which fails the writability or the verification checks, is taken
+// offline: the blobs on it can't be read and no new blobs are placed on it,
+// until it passes the checks again.
+type Store struct {
+	log   *zap.Logger
+	disks []*disk
+
+	// locations caches the index of the disk containing a blob.
+	locations *lrucache.ExpiringLRUOf[int]
+}
+
+// New creates a new store over the disks.
+func New(log *zap.Logger, disks []Disk) *Store {
+	store := &Store{
+		log: log,
+		locations: lrucache.NewOf[int](lrucache.Options{
+			Capacity:   100000,
+			Expiration: time.Hour,
+			Name:       "multistore-locations",
+		}),
+	}
+	for _, d := range disks {
+		store.disks = append(store.disks, &disk{Disk: d, online: true})
+	}
+	return store
+}
+
+// online returns the disks, which are online.
+func (store *Store) online() []*disk {
+	disks := make([]*disk, 0, len(store.disks))
+	for _, d := range store.disks {
+		if d.isOnline() {
+			disks = append(disks, d)
+		}
+	}
+	return disks
+}
+
+func locationKey(ref blobstore.BlobRef) string {
+	return string(ref.Namespace) + string(ref.Key)
+}
+
+// locate calls fn with the disks, which may contain the blob, starting with
+// the cached location, until fn returns something else than os.ErrNotExist.
+// When the blob isn't found while a disk is offline, it fails with
+// blobstore.ErrUnavailable, since the blob may be on the offline disk.
+func (store *Store) locate(ctx context.Context, ref blobstore.BlobRef, fn func(*disk) error) error {
+	key := locationKey(ref)
+
+	tried := -1
+	if i, ok := store.locations.GetCached(ctx, key); ok {
+		if d := store.disks[i]; d.isOnline() {
+			err := fn(d)
+			if !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+			store.locations.Delete(ctx, key)
+			tried = i
+		}
+	}
+
+	offline := 0
+	for i, d := range store.disks {
+		if !d.isOnline() {
+			offline++
+			continue
+		}
+		if i == tried {
+			continue
+		}
+		err := fn(d)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err == nil {
+			store.locations.Add(ctx, key, i)
+		}
+		return err
+	}
+	if offline > 0 {
+		return blobstore.ErrUnavailable.New("%d disk(s) offline", offline)
+	}
+	return os.ErrNotExist
+}
+
+// remove calls fn for every online disk containing the blob to remove it.
+// When the blob isn't found on the online disks while a disk is offline, it
+// fails with blobstore.ErrUnavailable, so that the removal is retried later
+// instead of the blob reappearing once the disk is back online.
+func (store *Store) remove(ctx context.Context, ref blobstore.BlobRef, fn func(*disk) error) error {
+	store.locations.Delete(ctx, locationKey(ref))
+
+	var group errs.Group
+	found, offline := false, 0
+	for _, d := range store.disks {
+		if !d.isOnline() {
+			offline++
+			continue
+		}
+		if _, err := d.Blobs.Stat(ctx, ref); errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		found = true
+		group.Add(fn(d))
+	}
+	if !found && offline > 0 {
+		group.Add(blobstore.ErrUnavailable.New("%d disk(s) offline", offline))
+	}
+	return group.Err()
+}
+
+// each calls fn for every online disk, and combines the errors.
+func (store *Store) each(fn func(*disk) error) error {
+	var group errs.Group
+	for _, d := range store.online() {
+		group.Add(fn(d))
+	}
+	return group.Err()
+}
+
+// available returns the space available for new blobs on the disk.
+func (store *Store) available(ctx context.Context, d *disk) (int64, error) {
+	free, err := d.Blobs.FreeSpace(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if d.Allocated <= 0 {
+		return free, nil
+	}
+
+	d.mu.Lock()
+	remaining := d.Allocated - d.used
+	d.mu.Unlock()
+
+	if remaining < free {
+		return remaining, nil
+	}
+	return free, nil
+}
+
+// place returns the disk with the most available space.
+func (store *Store) place(ctx context.Context) (_ *disk, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var best *disk
+	var bestAvailable int64
+	for _, d := range store.online() {
+		available, err := store.available(ctx, d)
+		if err != nil {
+			store.log.Warn("unable to determine free space", zap.String("path", d.Path), zap.Error(err))
+			continue
+		}
+		if best == nil || available > bestAvailable {
+			best, bestAvailable = d, available
+		}
+	}
+	if best == nil {
+		return nil, Error.New("no disk is available")
+	}
+	return best, nil
+}
+
+// Refresh recalculates the space used on every disk, which is used to
+// enforce the allocations when placing new blobs.
+func (store *Store) Refresh(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, d := range store.online() {
+		blobs, err := d.Blobs.SpaceUsedForBlobs(ctx)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		trash, err := d.Blobs.SpaceUsedForTrash(ctx)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		d.mu.Lock()
+		d.used = blobs + trash
+		d.mu.Unlock()
+	}
+	return nil
+}
+
+// Disks returns the state of every disk.
+func (store *Store) Disks(ctx context.Context) (_ []DiskStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	statuses := make([]DiskStatus, 0, len(store.disks))
+	for _, d := range store.disks {
+		d.mu.Lock()
+		status := DiskStatus{
+			Path:      d.Path,
+			Allocated: d.Allocated,
+			Used:      d.used,
+			Online:    d.online,
+			Err:       d.err,
+		}
+		d.mu.Unlock()
+
+		if status.Online {
+			if status.Free, err = d.Blobs.FreeSpace(ctx); err != nil {
+				return nil, Error.Wrap(err)
+			}
+			if status.Available, err = store.available(ctx, d); err != nil {
+				return nil, Error.Wrap(err)
+			}
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// Create creates a new blob on the disk with the most available space.
+func (store *Store) Create(ctx context.Context, ref blobstore.BlobRef, size int64) (_ blobstore.BlobWriter, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	d, err := store.place(ctx)
+	if err != nil {
+		return nil, err
+	}
+	writer, err := d.Blobs.Create(ctx, ref, size)
+	if err != nil {
+		return nil, err
+	}
+	return &blobWriter{BlobWriter: writer, store: store, disk: d, ref: ref}, nil
+}
+
+// TestCreateV0 creates a new V0 blob that can be written. This is ONLY appropriate in test situations.
+func (store *Store) TestCreateV0(ctx context.Context, ref blobstore.BlobRef) (_ blobstore.BlobWriter, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	d, err := store.place(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blobs, ok := d.Blobs.(interface {
+		TestCreateV0(ctx context.Context, ref blobstore.BlobRef) (_ blobstore.BlobWriter, err error)
+	})
+	if !ok {
+		return nil, Error.New("can't create V0 blobs with this blob store (%T)", d.Blobs)
+	}
+	writer, err := blobs.TestCreateV0(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	return &blobWriter{BlobWriter: writer, store: store, disk: d, ref: ref}, nil
+}
+
+// blobWriter accounts for the committed blob on the disk.
+type blobWriter struct {
+	blobstore.BlobWriter
+	store *Store
+	disk  *disk
+	ref   blobstore.BlobRef
+}
+
+// Commit commits the blob and remembers its location.
+func (blob *blobWriter) Commit(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	size, sizeErr := blob.BlobWriter.Size()
+	if err := blob.BlobWriter.Commit(ctx); err != nil {
+		return err
+	}
+	if sizeErr == nil {
+		blob.disk.mu.Lock()
+		blob.disk.used += size
+		blob.disk.mu.Unlock()
+	}
+	for i, d := range blob.store.disks {
+		if d == blob.disk {
+			blob.store.locations.Add(ctx, locationKey(blob.ref), i)
+		}
+	}
+	return nil
+}
+
+// Open opens the blob from the disk containing it.
+func (store *Store) Open(ctx context.Context, ref blobstore.BlobRef) (reader blobstore.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.locate(ctx, ref, func(d *disk) (err error) {
+		reader, err = d.Blobs.Open(ctx, ref)
+		return err
+	})
+	return reader, err
+}
+
+// OpenWithStorageFormat opens the blob with the given storage format from the disk containing it.
+func (store *Store) OpenWithStorageFormat(ctx context.Context, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) (reader blobstore.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.locate(ctx, ref, func(d *disk) (err error) {
+		reader, err = d.Blobs.OpenWithStorageFormat(ctx, ref, formatVer)
+		return err
+	})
+	return reader, err
+}
+
+// Stat looks up the metadata of the blob on the disk containing it.
+func (store *Store) Stat(ctx context.Context, ref blobstore.BlobRef) (info blobstore.BlobInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.locate(ctx, ref, func(d *disk) (err error) {
+		info, err = d.Blobs.Stat(ctx, ref)
+		return err
+	})
+	return info, err
+}
+
+// StatWithStorageFormat looks up the metadata of the blob with the given storage format on the disk containing it.
+func (store *Store) StatWithStorageFormat(ctx context.Context, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) (info blobstore.BlobInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.locate(ctx, ref, func(d *disk) (err error) {
+		info, err = d.Blobs.StatWithStorageFormat(ctx, ref, formatVer)
+		return err
+	})
+	return info, err
+}
+
+// Delete deletes the blob from every online disk.
+func (store *Store) Delete(ctx context.Context, ref blobstore.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.remove(ctx, ref, func(d *disk) error { return d.Blobs.Delete(ctx, ref) })
+}
+
+// DeleteWithStorageFormat deletes the blob with the given storage format from every online disk.
+func (store *Store) DeleteWithStorageFormat(ctx context.Context, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.remove(ctx, ref, func(d *disk) error { return d.Blobs.DeleteWithStorageFormat(ctx, ref, formatVer) })
+}
+
+// Quarantine moves the blob aside on the disk containing it. The blob is
+// deleted, when the store of the disk can't move blobs aside.
+func (store *Store) Quarantine(ctx context.Context, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.locate(ctx, ref, func(d *disk) error {
+		if _, err := d.Blobs.StatWithStorageFormat(ctx, ref, formatVer); err != nil {
+			return err
+		}
+		if blobs, ok := d.Blobs.(interface {
+			Quarantine(ctx context.Context, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) error
+		}); ok {
+			return blobs.Quarantine(ctx, ref, formatVer)
+		}
+		return d.Blobs.DeleteWithStorageFormat(ctx, ref, formatVer)
+	})
+	store.locations.Delete(ctx, locationKey(ref))
+	return err
+}
+
+// DeleteNamespace deletes the blobs of the namespace from every disk.
+func (store *Store) DeleteNamespace(ctx context.Context, namespace []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.each(func(d *disk) error { return d.Blobs.DeleteNamespace(ctx, namespace) })
+}
+
+// Trash moves the blob to the trash on every online disk.
+func (store *Store) Trash(ctx context.Context, ref blobstore.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.remove(ctx, ref, func(d *disk) error { return d.Blobs.Trash(ctx, ref) })
+}
+
+// RestoreTrash restores the trash of the namespace on every disk.
+func (store *Store) RestoreTrash(ctx context.Context, namespace []byte) (keysRestored [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.each(func(d *disk) error {
+		keys, err := d.Blobs.RestoreTrash(ctx, namespace)
+		keysRestored = append(keysRestored, keys...)
+		return err
+	})
+	return keysRestored, err
+}
+
+// EmptyTrash empties the trash of the namespace on every disk.
+func (store *Store) EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (bytesEmptied int64, keys [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.each(func(d *disk) error {
+		emptied, deleted, err := d.Blobs.EmptyTrash(ctx, namespace, trashedBefore)
+		bytesEmptied += emptied
+		keys = append(keys, deleted...)
+		return err
+	})
+	return bytesEmptied, keys, err
+}
+
+// FreeSpace returns the space available for new blobs on all the online disks.
+func (store *Store) FreeSpace(ctx context.Context) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.each(func(d *disk) error {
+		available, err := store.available(ctx, d)
+		total += available
+		return err
+	})
+	return total, err
+}
+
+// SpaceUsedForTrash returns the space used by the trash on all the online disks.
+func (store *Store) SpaceUsedForTrash(ctx context.Context) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.each(func(d *disk) error {
+		used, err := d.Blobs.SpaceUsedForTrash(ctx)
+		total += used
+		return err
+	})
+	return total, err
+}
+
+// SpaceUsedForBlobs returns the space used by the blobs on all the online disks.
+func (store *Store) SpaceUsedForBlobs(ctx context.Context) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.each(func(d *disk) error {
+		used, err := d.Blobs.SpaceUsedForBlobs(ctx)
+		total += used
+		return err
+	})
+	return total, err
+}
+
+// SpaceUsedForBlobsInNamespace returns the space used by the blobs of the namespace on all the online disks.
+func (store *Store) SpaceUsedForBlobsInNamespace(ctx context.Context, namespace []byte) (total int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.each(func(d *disk) error {
+		used, err := d.Blobs.SpaceUsedForBlobsInNamespace(ctx, namespace)
+		total += used
+		return err
+	})
+	return total, err
+}
+
+// ListNamespaces returns the namespaces found on any online disk.
+func (store *Store) ListNamespaces(ctx context.Context) (ids [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	seen := map[string]bool{}
+	err = store.each(func(d *disk) error {
+		namespaces, err := d.Blobs.ListNamespaces(ctx)
+		for _, namespace := range namespaces {
+			if !seen[string(namespace)] {
+				seen[string(namespace)] = true
+				ids = append(ids, namespace)
+			}
+		}
+		return err
+	})
+	return ids, err
+}
+
+// WalkNamespace walks the blobs of the namespace on every online disk.
+func (store *Store) WalkNamespace(ctx context.Context, namespace []byte, walkFunc func(blobstore.BlobInfo) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	for _, d := range store.online() {
+		if err := d.Blobs.WalkNamespace(ctx, namespace, walkFunc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CheckWritability checks the writability of every disk and takes the
+// failing ones offline. It fails only when no disk is writable.
+func (store *Store) CheckWritability(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.check(ctx, checkWritable, func(d *disk) error { return d.Blobs.CheckWritability(ctx) })
+}
+
+// CreateVerificationFile creates the verification file on every disk.
+func (store *Store) CreateVerificationFile(ctx context.Context, id storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	var group errs.Group
+	for _, d := range store.disks {
+		group.Add(d.Blobs.CreateVerificationFile(ctx, id))
+	}
+	return group.Err()
+}
+
+// VerifyStorageDir verifies every disk and takes the failing ones offline.
+// A disk without any blobs and a verification file is considered to be newly
+// added, and the verification file is created for it. It fails only when no
+// disk can be verified.
+func (store *Store) VerifyStorageDir(ctx context.Context, id storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.check(ctx, checkVerified, func(d *disk) error {
+		err := d.Blobs.VerifyStorageDir(ctx, id)
+		if err == nil || !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		namespaces, listErr := d.Blobs.ListNamespaces(ctx)
+		if listErr != nil || len(namespaces) > 0 {
+			return err
+		}
+		store.log.Info("initializing new disk", zap.String("path", d.Path))
+		return d.Blobs.CreateVerificationFile(ctx, id)
+	})
+}
+
+// check runs the check on every disk, including the offline ones, and
+// updates their health.
+func (store *Store) check(ctx context.Context, check int, fn func(*disk) error) error {
+	var failures errs.Group
+	for _, d := range store.disks {
+		err := fn(d)
+		if ctx.Err() != nil {
+			// a timeout isn't a failure of the disk.
+			return ctx.Err()
+		}
+		d.setHealth(store.log, check, err)
+		if err != nil {
+			failures.Add(Error.New("%s: %v", d.Path, err))
+		}
+	}
+	if len(store.online()) == 0 {
+		return failures.Err()
+	}
+	return nil
+}
+
+// Close closes the stores of every disk.
+func (store *Store) Close() error {
+	var group errs.Group
+	for _, d := range store.disks {
+		group.Add(d.Blobs.Close())
+	}
+	return group.Err()
+}
@@ -0,0 +1,189 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package multistore_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/blobstore/filestore"
+	"storj.io/storj/storagenode/blobstore/multistore"
+)
+
+func TestParseDirs(t *testing.T) {
+	dirs, err := multistore.Config{Dirs: []string{"/mnt/disk2/storage:2TB", " ", `C:\storage:500GB`}}.ParseDirs()
+	require.NoError(t, err)
+	require.Equal(t, []multistore.DirConfig{
+		{Path: "/mnt/disk2/storage", Allocated: 2 * memory.TB},
+		{Path: `C:\storage`, Allocated: 500 * memory.GB},
+	}, dirs)
+
+	_, err = multistore.Config{Dirs: []string{"/mnt/disk2/storage"}}.ParseDirs()
+	require.Error(t, err)
+
+	_, err = multistore.Config{Dirs: []string{"/mnt/disk2/storage:lots"}}.ParseDirs()
+	require.Error(t, err)
+}
+
+func TestStore(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := zaptest.NewLogger(t)
+
+	var disks []multistore.Disk
+	for _, name := range []string{"disk1", "disk2"} {
+		dir, err := filestore.NewDir(log, ctx.Dir(name))
+		require.NoError(t, err)
+		disks = append(disks, multistore.Disk{
+			Path:      name,
+			Allocated: 10 * memory.KiB.Int64(),
+			Blobs:     filestore.New(log, dir, filestore.DefaultConfig),
+		})
+	}
+
+	store := multistore.New(log, disks)
+	defer ctx.Check(store.Close)
+
+	nodeID := testrand.NodeID()
+	require.NoError(t, store.CreateVerificationFile(ctx, nodeID))
+	require.NoError(t, store.VerifyStorageDir(ctx, nodeID))
+
+	// the blobs are spread over the disks by the available space.
+	namespace := testrand.Bytes(32)
+	blobs := map[string][]byte{}
+	var refs []blobstore.BlobRef
+	for i := 0; i < 8; i++ {
+		ref := blobstore.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+		data := testrand.BytesInt(memory.KiB.Int())
+
+		writer, err := store.Create(ctx, ref, int64(len(data)))
+		require.NoError(t, err)
+		_, err = writer.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx))
+
+		blobs[string(ref.Key)] = data
+		refs = append(refs, ref)
+	}
+
+	statuses, err := store.Disks(ctx)
+	require.NoError(t, err)
+	require.Len(t, statuses, 2)
+	for _, status := range statuses {
+		require.True(t, status.Online)
+		require.Equal(t, 4*memory.KiB.Int64(), status.Used)
+		require.Equal(t, 6*memory.KiB.Int64(), status.Available)
+	}
+
+	for _, d := range disks {
+		used, err := d.Blobs.SpaceUsedForBlobs(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 4*memory.KiB.Int64(), used)
+	}
+
+	// the blobs are found on any disk, also without the cached locations.
+	for _, s := range []*multistore.Store{store, multistore.New(log, disks)} {
+		for _, ref := range refs {
+			reader, err := s.Open(ctx, ref)
+			require.NoError(t, err)
+			data, err := io.ReadAll(reader)
+			require.NoError(t, err)
+			require.NoError(t, reader.Close())
+			require.Equal(t, blobs[string(ref.Key)], data)
+		}
+	}
+
+	walked := 0
+	require.NoError(t, store.WalkNamespace(ctx, namespace, func(info blobstore.BlobInfo) error {
+		walked++
+		return nil
+	}))
+	require.Equal(t, len(refs), walked)
+
+	namespaces, err := store.ListNamespaces(ctx)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{namespace}, namespaces)
+
+	// a disk failing the verification is taken offline, and the node keeps
+	// running with the others.
+	require.NoError(t, disks[1].Blobs.CreateVerificationFile(ctx, testrand.NodeID()))
+	require.NoError(t, store.VerifyStorageDir(ctx, nodeID))
+
+	statuses, err = store.Disks(ctx)
+	require.NoError(t, err)
+	require.True(t, statuses[0].Online)
+	require.False(t, statuses[1].Online)
+	require.Error(t, statuses[1].Err)
+
+	var offline []blobstore.BlobRef
+	for _, ref := range refs {
+		if _, err := store.Stat(ctx, ref); err != nil {
+			require.True(t, blobstore.ErrUnavailable.Has(err), err)
+			offline = append(offline, ref)
+		}
+	}
+	require.Len(t, offline, 4)
+
+	// the blobs on the offline disk can't be removed.
+	err = store.Delete(ctx, offline[0])
+	require.True(t, blobstore.ErrUnavailable.Has(err), err)
+	err = store.Trash(ctx, offline[1])
+	require.True(t, blobstore.ErrUnavailable.Has(err), err)
+
+	// the disk is back online, once it passes the check.
+	require.NoError(t, disks[1].Blobs.CreateVerificationFile(ctx, nodeID))
+	require.NoError(t, store.VerifyStorageDir(ctx, nodeID))
+	for _, ref := range refs {
+		_, err := store.Stat(ctx, ref)
+		require.NoError(t, err)
+	}
+
+	// the allocations are enforced.
+	require.NoError(t, store.Refresh(ctx))
+	writer, err := store.Create(ctx, blobstore.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}, 0)
+	require.NoError(t, err)
+	_, err = writer.Write(testrand.BytesInt(6 * memory.KiB.Int()))
+	require.NoError(t, err)
+	require.NoError(t, writer.Commit(ctx))
+
+	free, err := store.FreeSpace(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 6*memory.KiB.Int64(), free)
+
+	// when every disk fails, the store fails.
+	require.Error(t, store.VerifyStorageDir(ctx, testrand.NodeID()))
+}
+
+func TestNewDisk(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := zaptest.NewLogger(t)
+
+	newDisk := func(name string) multistore.Disk {
+		dir, err := filestore.NewDir(log, ctx.Dir(name))
+		require.NoError(t, err)
+		return multistore.Disk{Path: name, Blobs: filestore.New(log, dir, filestore.DefaultConfig)}
+	}
+
+	nodeID := testrand.NodeID()
+
+	first := newDisk("disk1")
+	require.NoError(t, first.Blobs.CreateVerificationFile(ctx, nodeID))
+
+	// the verification file is created for a newly added empty disk.
+	store := multistore.New(log, []multistore.Disk{first, newDisk("disk2")})
+	defer ctx.Check(store.Close)
+
+	require.NoError(t, store.VerifyStorageDir(ctx, nodeID))
+	statuses, err := store.Disks(ctx)
+	require.NoError(t, err)
+	for _, status := range statuses {
+		require.True(t, status.Online)
+	}
+}
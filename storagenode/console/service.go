@@ -6,6 +6,7 @@ package console
 import (
 	"context"
 	"math"
+	"sort"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -64,6 +65,8 @@ type Service struct {
 
 	quicStats      *contact.QUICStats
 	configuredPort string
+
+	scrubber *pieces.Scrubber
 }
 
 // NewService returns new instance of Service.
@@ -71,7 +74,7 @@ func NewService(log *zap.Logger, bandwidth bandwidth.DB, pieceStore *pieces.Stor
 	allocatedDiskSpace memory.Size, walletAddress string, versionInfo version.Info, trust *trust.Pool,
 	reputationDB reputation.DB, storageUsageDB storageusage.DB, pricingDB pricing.DB, satelliteDB satellites.DB,
 	pingStats *contact.PingStats, contact *contact.Service, estimation *estimatedpayouts.Service, usageCache *pieces.BlobsUsageCache,
-	walletFeatures operator.WalletFeatures, port string, quicStats *contact.QUICStats) (*Service, error) {
+	walletFeatures operator.WalletFeatures, port string, quicStats *contact.QUICStats, scrubber *pieces.Scrubber) (*Service, error) {
 	if log == nil {
 		return nil, errs.New("log can't be nil")
 	}
@@ -125,6 +128,7 @@ func NewService(log *zap.Logger, bandwidth bandwidth.DB, pieceStore *pieces.Stor
 		walletFeatures:     walletFeatures,
 		quicStats:          quicStats,
 		configuredPort:     port,
+		scrubber:           scrubber,
 	}, nil
 }
 
@@ -135,6 +139,7 @@ type SatelliteInfo struct {
 	Disqualified       *time.Time   `json:"disqualified"`
 	Suspended          *time.Time   `json:"suspended"`
 	CurrentStorageUsed int64        `json:"currentStorageUsed"`
+	CorruptedPieces    int64        `json:"corruptedPieces"`
 }
 
 // Dashboard encapsulates dashboard stale data.
@@ -159,6 +164,15 @@ type Dashboard struct {
 	ConfiguredPort   string    `json:"configuredPort"`
 	QUICStatus       string    `json:"quicStatus"`
 	LastQUICPingedAt time.Time `json:"lastQuicPingedAt"`
+
+	TrustRejections []TrustRejection `json:"trustRejections"`
+}
+
+// TrustRejection describes a trust source, whose list has been rejected.
+type TrustRejection struct {
+	Source     string    `json:"source"`
+	Reason     string    `json:"reason"`
+	RejectedAt time.Time `json:"rejectedAt"`
 }
 
 // GetDashboardData returns stale dashboard data.
@@ -179,6 +193,17 @@ func (s *Service) GetDashboardData(ctx context.Context) (_ *Dashboard, err error
 	data.LastQUICPingedAt = s.quicStats.WhenLastPinged()
 	data.ConfiguredPort = s.configuredPort
 
+	for source, rejection := range s.trust.Rejections() {
+		data.TrustRejections = append(data.TrustRejections, TrustRejection{
+			Source:     source,
+			Reason:     rejection.Reason,
+			RejectedAt: rejection.RejectedAt,
+		})
+	}
+	sort.Slice(data.TrustRejections, func(i, j int) bool {
+		return data.TrustRejections[i].Source < data.TrustRejections[j].Source
+	})
+
 	stats, err := s.reputationDB.All(ctx)
 	if err != nil {
 		return nil, SNOServiceErr.Wrap(err)
@@ -205,6 +230,7 @@ func (s *Service) GetDashboardData(ctx context.Context) (_ *Dashboard, err error
 				Suspended:          rep.SuspendedAt,
 				URL:                url.Address,
 				CurrentStorageUsed: currentStorageUsed,
+				CorruptedPieces:    s.scrubStats(rep.SatelliteID).Corrupted,
 			},
 		)
 	}
@@ -265,6 +291,15 @@ type Satellite struct {
 	AuditHistory       reputation.AuditHistory `json:"auditHistory"`
 	PriceModel         PriceModel              `json:"priceModel"`
 	NodeJoinedAt       time.Time               `json:"nodeJoinedAt"`
+	Scrub              ScrubInfo               `json:"scrub"`
+}
+
+// ScrubInfo contains the results of the verification of the stored pieces.
+type ScrubInfo struct {
+	Enabled         bool      `json:"enabled"`
+	VerifiedPieces  int64     `json:"verifiedPieces"`
+	CorruptedPieces int64     `json:"corruptedPieces"`
+	LastScrubbed    time.Time `json:"lastScrubbed"`
 }
 
 // GetSatelliteData returns satellite related data.
@@ -349,9 +384,30 @@ func (s *Service) GetSatelliteData(ctx context.Context, satelliteID storj.NodeID
 		AuditHistory: reputation.GetAuditHistoryFromPB(rep.AuditHistory),
 		PriceModel:   satellitePricing,
 		NodeJoinedAt: rep.JoinedAt,
+		Scrub:        s.scrubInfo(satelliteID),
 	}, nil
 }
 
+// scrubStats returns the scrub statistics of the satellite, when the scrubber
+// is enabled.
+func (s *Service) scrubStats(satelliteID storj.NodeID) pieces.ScrubStats {
+	if s.scrubber == nil {
+		return pieces.ScrubStats{}
+	}
+	return s.scrubber.SatelliteStats(satelliteID)
+}
+
+// scrubInfo returns the results of the verification of the satellite's pieces.
+func (s *Service) scrubInfo(satelliteID storj.NodeID) ScrubInfo {
+	stats := s.scrubStats(satelliteID)
+	return ScrubInfo{
+		Enabled:         s.scrubber != nil,
+		VerifiedPieces:  stats.Verified,
+		CorruptedPieces: stats.Corrupted,
+		LastScrubbed:    stats.LastScrubbed,
+	}
+}
+
 // Satellites represents consolidated data across all satellites.
 type Satellites struct {
 	StorageDaily      []storageusage.StampGroup `json:"storageDaily"`
@@ -36,3 +36,13 @@ func SetLowIOPriority() error {
 func ioprioPrioClassValue(class, data uint32) uint32 {
 	return (((class) & ioprioClassMask) << ioprioClassShift) | ((data) & ioprioPrioMask)
 }
+
+// SetLowThreadIOPriority lowers the I/O priority of the calling thread only,
+// hence the calling goroutine must be locked to its thread with
+// runtime.LockOSThread.
+//
+// On linux, the I/O priority is a property of the thread, which is what
+// SetLowIOPriority changes.
+func SetLowThreadIOPriority() error {
+	return SetLowIOPriority()
+}
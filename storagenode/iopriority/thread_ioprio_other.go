@@ -0,0 +1,17 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+//go:build !linux
+// +build !linux
+
+package iopriority
+
+import "errors"
+
+// SetLowThreadIOPriority lowers the I/O priority of the calling thread only.
+//
+// It's only supported on linux, the other platforms change the priority of
+// the whole process.
+func SetLowThreadIOPriority() error {
+	return errors.New("lowering the thread I/O priority is not supported on this platform")
+}
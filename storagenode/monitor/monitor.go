@@ -18,6 +18,7 @@ import (
 	"storj.io/common/pb"
 	"storj.io/common/sync2"
 	"storj.io/storj/storagenode/bandwidth"
+	"storj.io/storj/storagenode/blobstore/multistore"
 	"storj.io/storj/storagenode/contact"
 	"storj.io/storj/storagenode/pieces"
 )
@@ -43,6 +44,17 @@ type DiskSpace struct {
 	Available int64
 	// Overused is the amount of disk space overused by the storage node, in bytes.
 	Overused int64
+	// Disks is the state of every storage directory, when there are several of them.
+	Disks []multistore.DiskStatus
+}
+
+// DiskReporter reports the state of the disks, when the storage node uses
+// several storage directories.
+type DiskReporter interface {
+	// Refresh recalculates the space used on every disk.
+	Refresh(ctx context.Context) error
+	// Disks returns the state of every disk.
+	Disks(ctx context.Context) ([]multistore.DiskStatus, error)
 }
 
 // Config defines parameters for storage node disk and bandwidth usage monitoring.
@@ -66,6 +78,7 @@ type Service struct {
 	store                 *pieces.Store
 	contact               *contact.Service
 	usageDB               bandwidth.DB
+	disks                 DiskReporter
 	allocatedDiskSpace    int64
 	cooldown              *sync2.Cooldown
 	Loop                  *sync2.Cycle
@@ -74,13 +87,15 @@ type Service struct {
 	Config                Config
 }
 
-// NewService creates a new storage node monitoring service.
-func NewService(log *zap.Logger, store *pieces.Store, contact *contact.Service, usageDB bandwidth.DB, allocatedDiskSpace int64, interval time.Duration, reportCapacity func(context.Context), config Config) *Service {
+// NewService creates a new storage node monitoring service. disks is nil,
+// when the storage node uses a single storage directory.
+func NewService(log *zap.Logger, store *pieces.Store, contact *contact.Service, usageDB bandwidth.DB, disks DiskReporter, allocatedDiskSpace int64, interval time.Duration, reportCapacity func(context.Context), config Config) *Service {
 	return &Service{
 		log:                   log,
 		store:                 store,
 		contact:               contact,
 		usageDB:               usageDB,
+		disks:                 disks,
 		allocatedDiskSpace:    allocatedDiskSpace,
 		cooldown:              sync2.NewCooldown(config.NotifyLowDiskCooldown),
 		Loop:                  sync2.NewCycle(interval),
@@ -218,6 +233,10 @@ func (service *Service) Close() (err error) {
 func (service *Service) updateNodeInformation(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	if service.disks != nil {
+		service.reportDisks(ctx)
+	}
+
 	freeSpace, err := service.AvailableSpace(ctx)
 	if err != nil {
 		return err
@@ -229,6 +248,29 @@ func (service *Service) updateNodeInformation(ctx context.Context) (err error) {
 	return nil
 }
 
+// reportDisks refreshes the space used on every disk and reports it.
+func (service *Service) reportDisks(ctx context.Context) {
+	if err := service.disks.Refresh(ctx); err != nil {
+		service.log.Error("error refreshing disk usage", zap.Error(err))
+	}
+
+	disks, err := service.disks.Disks(ctx)
+	if err != nil {
+		service.log.Error("error getting disk usage", zap.Error(err))
+		return
+	}
+	for _, disk := range disks {
+		tag := monkit.NewSeriesTag("disk", disk.Path)
+		mon.IntVal("disk_allocated_space", tag).Observe(disk.Allocated)
+		mon.IntVal("disk_used_space", tag).Observe(disk.Used)
+		mon.IntVal("disk_available_space", tag).Observe(disk.Available)
+		mon.BoolVal("disk_online", tag).Observe(disk.Online)
+		if !disk.Online {
+			service.log.Warn("disk is offline", zap.String("path", disk.Path), zap.Error(disk.Err))
+		}
+	}
+}
+
 // AvailableSpace returns available disk space for upload.
 func (service *Service) AvailableSpace(ctx context.Context) (_ int64, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -283,6 +325,14 @@ func (service *Service) DiskSpace(ctx context.Context) (_ DiskSpace, err error)
 		available = storageStatus.DiskFree
 	}
 
+	var disks []multistore.DiskStatus
+	if service.disks != nil {
+		disks, err = service.disks.Disks(ctx)
+		if err != nil {
+			return DiskSpace{}, Error.Wrap(err)
+		}
+	}
+
 	return DiskSpace{
 		Allocated:     service.allocatedDiskSpace,
 		UsedForPieces: usedForPieces,
@@ -290,5 +340,6 @@ func (service *Service) DiskSpace(ctx context.Context) (_ DiskSpace, err error)
 		Free:          storageStatus.DiskFree,
 		Available:     available,
 		Overused:      overused,
+		Disks:         disks,
 	}, nil
 }
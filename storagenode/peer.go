@@ -19,6 +19,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"storj.io/common/identity"
+	"storj.io/common/memory"
 	"storj.io/common/pb"
 	"storj.io/common/peertls/extensions"
 	"storj.io/common/peertls/tlsopts"
@@ -34,6 +35,8 @@ import (
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/blobstore"
 	"storj.io/storj/storagenode/blobstore/filestore"
+	"storj.io/storj/storagenode/blobstore/logstore"
+	"storj.io/storj/storagenode/blobstore/multistore"
 	"storj.io/storj/storagenode/collector"
 	"storj.io/storj/storagenode/console"
 	"storj.io/storj/storagenode/console/consoleserver"
@@ -119,8 +122,11 @@ type Config struct {
 	Collector collector.Config
 
 	Filestore filestore.Config
+	Logstore  logstore.Config
+	Disks     multistore.Config
 
-	Pieces pieces.Config
+	Pieces   pieces.Config
+	Scrubber pieces.ScrubberConfig
 
 	Retain retain.Config
 
@@ -143,15 +149,30 @@ func (config *Config) DatabaseConfig() storagenodedb.Config {
 	if dbdir == "" {
 		dbdir = config.Storage.Path
 	}
+	// the directories are checked by Verify.
+	extraPieces, _ := config.Disks.ParseDirs()
 	return storagenodedb.Config{
-		Storage:   config.Storage.Path,
-		Info:      filepath.Join(dbdir, "piecestore.db"),
-		Info2:     filepath.Join(dbdir, "info.db"),
-		Pieces:    config.Storage.Path,
-		Filestore: config.Filestore,
+		Storage:     config.Storage.Path,
+		Info:        filepath.Join(dbdir, "piecestore.db"),
+		Info2:       filepath.Join(dbdir, "info.db"),
+		Pieces:      config.Storage.Path,
+		Filestore:   config.Filestore,
+		Logstore:    config.Logstore,
+		Allocated:   config.Storage.AllocatedDiskSpace.Int64(),
+		ExtraPieces: extraPieces,
 	}
 }
 
+// AllocatedDiskSpace returns the disk space allocated in all the storage directories.
+func (config *Config) AllocatedDiskSpace() memory.Size {
+	total := config.Storage.AllocatedDiskSpace
+	extraPieces, _ := config.Disks.ParseDirs()
+	for _, extra := range extraPieces {
+		total += extra.Allocated
+	}
+	return total
+}
+
 // Verify verifies whether configuration is consistent and acceptable.
 func (config *Config) Verify(log *zap.Logger) error {
 	err := config.Operator.Verify(log)
@@ -173,6 +194,10 @@ func (config *Config) Verify(log *zap.Logger) error {
 		}
 	}
 
+	if _, err := config.Disks.ParseDirs(); err != nil {
+		return errs.New("invalid disks.dirs: %v", err)
+	}
+
 	return nil
 }
 
@@ -249,6 +274,8 @@ type Peer struct {
 		Trust          *trust.Pool
 		Store          *pieces.Store
 		TrashChore     *pieces.TrashChore
+		IndexChore     *pieces.IndexChore
+		Scrubber       *pieces.Scrubber
 		BlobsCache     *pieces.BlobsUsageCache
 		CacheService   *pieces.CacheService
 		RetainService  *retain.Service
@@ -466,7 +493,12 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		peer.Storage2.BlobsCache = pieces.NewBlobsUsageCache(peer.Log.Named("blobscache"), peer.DB.Pieces())
 		peer.Storage2.FileWalker = pieces.NewFileWalker(peer.Log.Named("filewalker"), peer.Storage2.BlobsCache, peer.DB.V0PieceInfo())
 
-		if config.Pieces.EnableLazyFilewalker {
+		// the log store and the piece index can't be opened by the lazy
+		// filewalker process concurrently, and walking them is cheap anyway.
+		// The lazy filewalker only walks the main storage directory, hence
+		// it's disabled with additional disks too.
+		extraPieces, _ := config.Disks.ParseDirs()
+		if config.Pieces.EnableLazyFilewalker && !config.Logstore.Enabled && !config.Filestore.Index && len(extraPieces) == 0 {
 			executable, err := os.Executable()
 			if err != nil {
 				return nil, errs.Combine(err, peer.Close())
@@ -505,6 +537,38 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			Close: peer.Storage2.TrashChore.Close,
 		})
 
+		if config.Filestore.Index && !config.Logstore.Enabled {
+			peer.Storage2.IndexChore = pieces.NewIndexChore(
+				log.Named("pieces:index"),
+				config.Filestore.IndexCheckInterval,
+				peer.DB.Pieces(),
+			)
+			if peer.Storage2.IndexChore != nil {
+				peer.Services.Add(lifecycle.Item{
+					Name:  "pieces:index",
+					Run:   peer.Storage2.IndexChore.Run,
+					Close: peer.Storage2.IndexChore.Close,
+				})
+				peer.Debug.Server.Panel.Add(
+					debug.Cycle("Pieces Index Chore", peer.Storage2.IndexChore.Cycle))
+			}
+		}
+
+		if config.Scrubber.Enabled {
+			peer.Storage2.Scrubber = pieces.NewScrubber(
+				log.Named("pieces:scrubber"),
+				config.Scrubber,
+				peer.Storage2.Store,
+			)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "pieces:scrubber",
+				Run:   peer.Storage2.Scrubber.Run,
+				Close: peer.Storage2.Scrubber.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Pieces Scrubber", peer.Storage2.Scrubber.Cycle))
+		}
+
 		peer.Storage2.CacheService = pieces.NewService(
 			log.Named("piecestore:cache"),
 			peer.Storage2.BlobsCache,
@@ -520,12 +584,16 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		peer.Debug.Server.Panel.Add(
 			debug.Cycle("Piecestore Cache", peer.Storage2.CacheService.Loop))
 
+		// the disks are reported, when there are several storage directories.
+		disks, _ := peer.DB.Pieces().(monitor.DiskReporter)
+
 		peer.Storage2.Monitor = monitor.NewService(
 			log.Named("piecestore:monitor"),
 			peer.Storage2.Store,
 			peer.Contact.Service,
 			peer.DB.Bandwidth(),
-			config.Storage.AllocatedDiskSpace.Int64(),
+			disks,
+			config.AllocatedDiskSpace().Int64(),
 			// TODO: use config.Storage.Monitor.Interval, but for some reason is not set
 			config.Storage.KBucketRefreshInterval,
 			peer.Contact.Chore.Trigger,
@@ -695,7 +763,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.DB.Bandwidth(),
 			peer.Storage2.Store,
 			peer.Version.Service,
-			config.Storage.AllocatedDiskSpace,
+			config.AllocatedDiskSpace(),
 			config.Operator.Wallet,
 			versionInfo,
 			peer.Storage2.Trust,
@@ -710,6 +778,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			config.Operator.WalletFeatures,
 			port,
 			peer.Contact.QUICStats,
+			peer.Storage2.Scrubber,
 		)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
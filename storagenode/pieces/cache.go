@@ -306,6 +306,32 @@ func (blobs *BlobsUsageCache) Trash(ctx context.Context, blobRef blobstore.BlobR
 	return nil
 }
 
+// Quarantine moves the corrupted blob aside and updates the cache. The blob is
+// deleted, when the underlying blob store can't move blobs aside.
+func (blobs *BlobsUsageCache) Quarantine(ctx context.Context, blobRef blobstore.BlobRef, formatVer blobstore.FormatVersion) error {
+	pieceTotal, pieceContentSize, err := blobs.pieceSizes(ctx, blobRef)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if q, ok := blobs.Blobs.(quarantiner); ok {
+		err = q.Quarantine(ctx, blobRef, formatVer)
+	} else {
+		err = blobs.Blobs.DeleteWithStorageFormat(ctx, blobRef, formatVer)
+	}
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	satelliteID, err := storj.NodeIDFromBytes(blobRef.Namespace)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	blobs.Update(ctx, satelliteID, -pieceTotal, -pieceContentSize, 0)
+	return nil
+}
+
 // EmptyTrash empties the trash and updates the cache.
 func (blobs *BlobsUsageCache) EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (int64, [][]byte, error) {
 	satelliteID, err := storj.NodeIDFromBytes(namespace)
@@ -0,0 +1,86 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/blobstore/filestore"
+)
+
+// IndexReconciler is implemented by the blob stores, which keep an index of
+// the pieces.
+type IndexReconciler interface {
+	// ReconcileIndex walks the directories of the namespace and fixes the
+	// index, when it doesn't match them.
+	ReconcileIndex(ctx context.Context, namespace []byte) (filestore.IndexStats, error)
+}
+
+// IndexChore is the chore that periodically checks the consistency of the
+// piece index.
+type IndexChore struct {
+	log   *zap.Logger
+	blobs blobstore.Blobs
+	index IndexReconciler
+
+	Cycle *sync2.Cycle
+}
+
+// NewIndexChore instantiates a new IndexChore. It returns nil, when the blob
+// store doesn't keep an index.
+func NewIndexChore(log *zap.Logger, interval time.Duration, blobs blobstore.Blobs) *IndexChore {
+	index, ok := blobs.(IndexReconciler)
+	if !ok {
+		return nil
+	}
+	return &IndexChore{
+		log:   log,
+		blobs: blobs,
+		index: index,
+
+		Cycle: sync2.NewCycle(interval),
+	}
+}
+
+// Run starts the cycle.
+func (chore *IndexChore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the index is reconciled by the first walk of the namespace anyway,
+	// when it can't be trusted after a restart.
+	chore.Cycle.SetDelayStart()
+
+	return chore.Cycle.Run(ctx, func(ctx context.Context) error {
+		namespaces, err := chore.blobs.ListNamespaces(ctx)
+		if err != nil {
+			chore.log.Error("listing namespaces failed", zap.Error(err))
+			return nil
+		}
+		for _, namespace := range namespaces {
+			stats, err := chore.index.ReconcileIndex(ctx, namespace)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				chore.log.Error("checking piece index failed", zap.Binary("namespace", namespace), zap.Error(err))
+				continue
+			}
+			if stats != (filestore.IndexStats{}) {
+				mon.Counter("piece_index_mismatches").Inc(stats.Added + stats.Updated + stats.Removed)
+			}
+		}
+		return nil
+	})
+}
+
+// Close closes the chore.
+func (chore *IndexChore) Close() error {
+	chore.Cycle.Close()
+	return nil
+}
@@ -0,0 +1,324 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/blobstore/filestore"
+	"storj.io/storj/storagenode/iopriority"
+)
+
+// ScrubberConfig is the configuration for the piece scrubber.
+type ScrubberConfig struct {
+	Enabled  bool          `help:"whether to verify the stored pieces in the background" default:"false"`
+	Interval time.Duration `help:"how long to wait between two scrubs of all the pieces" default:"168h0m0s"`
+	ReadRate memory.Size   `help:"how many bytes per second the scrubber reads at most" default:"4MB"`
+}
+
+// ScrubStats contains the scrub statistics of a satellite.
+type ScrubStats struct {
+	// Verified is the number of pieces verified by the last scrub.
+	Verified int64
+	// Corrupted is the number of corrupted pieces found since the node started.
+	Corrupted int64
+	// LastScrubbed is when the last scrub of the pieces of the satellite finished.
+	LastScrubbed time.Time
+}
+
+// scrubBufferSize is the size of the reads of the scrubber.
+const scrubBufferSize = 256 * memory.KiB
+
+// errCorrupted is returned when the content of a piece doesn't match its header.
+var errCorrupted = errs.Class("corrupted piece")
+
+// Scrubber is the chore that periodically reads all the pieces and verifies
+// them against the hashes stored in their headers. The corrupted pieces are
+// moved aside, hence they aren't served anymore.
+//
+// architecture: Chore
+type Scrubber struct {
+	log    *zap.Logger
+	config ScrubberConfig
+	store  *Store
+
+	Cycle *sync2.Cycle
+
+	mu    sync.Mutex
+	stats map[storj.NodeID]ScrubStats
+}
+
+// NewScrubber instantiates a new Scrubber.
+func NewScrubber(log *zap.Logger, config ScrubberConfig, store *Store) *Scrubber {
+	return &Scrubber{
+		log:    log,
+		config: config,
+		store:  store,
+
+		Cycle: sync2.NewCycle(config.Interval),
+		stats: map[storj.NodeID]ScrubStats{},
+	}
+}
+
+// Run starts the cycle.
+func (scrubber *Scrubber) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the I/O priority is lowered for the thread of the scrubber only. The
+	// thread isn't unlocked, hence it's discarded when the scrubber stops.
+	runtime.LockOSThread()
+	if err := iopriority.SetLowThreadIOPriority(); err != nil {
+		scrubber.log.Info("unable to lower the I/O priority of the scrubber", zap.Error(err))
+	}
+
+	// scrubbing right after a restart would repeat the previous scrub.
+	scrubber.Cycle.SetDelayStart()
+
+	return scrubber.Cycle.Run(ctx, func(ctx context.Context) error {
+		if err := scrubber.ScrubOnce(ctx); err != nil && ctx.Err() == nil {
+			scrubber.log.Error("scrubbing pieces failed", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// ScrubOnce verifies all the pieces once.
+func (scrubber *Scrubber) ScrubOnce(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	satellites, err := scrubber.store.getAllStoringSatellites(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	throttle := newThrottle(scrubber.config.ReadRate.Int64())
+	for _, satellite := range satellites {
+		if err := scrubber.scrubSatellite(ctx, satellite, throttle); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			scrubber.log.Error("scrubbing satellite pieces failed", zap.Stringer("Satellite ID", satellite), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// scrubSatellite verifies all the pieces of the satellite.
+func (scrubber *Scrubber) scrubSatellite(ctx context.Context, satellite storj.NodeID, throttle *throttle) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	type walkedPiece struct {
+		pieceID       storj.PieceID
+		formatVersion blobstore.FormatVersion
+	}
+
+	var verified int64
+	var corrupted, failed []walkedPiece
+	buffer := make([]byte, scrubBufferSize)
+
+	// check verifies the piece, and returns whether it has been verified.
+	check := func(piece walkedPiece, verifyErr error) bool {
+		switch {
+		case verifyErr == nil:
+			return true
+		case errCorrupted.Has(verifyErr):
+			scrubber.log.Warn("corrupted piece found",
+				zap.Stringer("Satellite ID", satellite),
+				zap.Stringer("Piece ID", piece.pieceID),
+				zap.Error(verifyErr))
+			corrupted = append(corrupted, piece)
+			return true
+		default:
+			return false
+		}
+	}
+
+	err = scrubber.store.WalkSatellitePieces(ctx, satellite, func(access StoredPieceAccess) error {
+		// the hashes of the V0 pieces are kept in the database.
+		if access.StorageFormatVersion() < filestore.FormatV1 {
+			return nil
+		}
+
+		verifyErr := scrubber.verify(ctx, satellite, access.PieceID(), access.StorageFormatVersion(), buffer, throttle)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if errors.Is(verifyErr, os.ErrNotExist) {
+			// the piece has been deleted in the meantime.
+			return nil
+		}
+
+		piece := walkedPiece{pieceID: access.PieceID(), formatVersion: access.StorageFormatVersion()}
+		if check(piece, verifyErr) {
+			verified++
+		} else {
+			// an error reading the piece doesn't mean that the piece is
+			// corrupted, hence it's verified again after the walk.
+			scrubber.log.Debug("unable to verify piece, retrying later",
+				zap.Stringer("Satellite ID", satellite),
+				zap.Stringer("Piece ID", piece.pieceID),
+				zap.Error(verifyErr))
+			failed = append(failed, piece)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, piece := range failed {
+		verifyErr := scrubber.verify(ctx, satellite, piece.pieceID, piece.formatVersion, buffer, throttle)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if errors.Is(verifyErr, os.ErrNotExist) {
+			continue
+		}
+		if check(piece, verifyErr) {
+			verified++
+			continue
+		}
+		// the piece is verified again by the next scrub.
+		scrubber.log.Warn("unable to verify piece",
+			zap.Stringer("Satellite ID", satellite),
+			zap.Stringer("Piece ID", piece.pieceID),
+			zap.Error(verifyErr))
+		mon.Counter("scrubber_unverified_pieces").Inc(1)
+	}
+
+	// the pieces are moved aside after the walk, which isn't meant to see
+	// the changes it has caused.
+	for _, piece := range corrupted {
+		if err := scrubber.store.Quarantine(ctx, satellite, piece.pieceID, piece.formatVersion); err != nil {
+			scrubber.log.Error("unable to move the corrupted piece aside",
+				zap.Stringer("Satellite ID", satellite),
+				zap.Stringer("Piece ID", piece.pieceID),
+				zap.Error(err))
+		}
+	}
+
+	mon.Counter("scrubber_corrupted_pieces").Inc(int64(len(corrupted)))
+
+	scrubber.mu.Lock()
+	defer scrubber.mu.Unlock()
+	stats := scrubber.stats[satellite]
+	stats.Verified = verified
+	stats.Corrupted += int64(len(corrupted))
+	stats.LastScrubbed = time.Now()
+	scrubber.stats[satellite] = stats
+
+	return nil
+}
+
+// verify reads the piece and checks it against its header. Only a mismatch
+// between the content and the header fails with errCorrupted, other errors,
+// e.g. a failing disk, don't say anything about the piece.
+func (scrubber *Scrubber) verify(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID, formatVersion blobstore.FormatVersion, buffer []byte, throttle *throttle) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ref := blobstore.BlobRef{Namespace: satellite.Bytes(), Key: pieceID.Bytes()}
+	blob, err := scrubber.store.blobs.OpenWithStorageFormat(ctx, ref, formatVersion)
+	if err != nil {
+		return err
+	}
+	reader, err := NewReader(blob)
+	if err != nil {
+		return errs.Combine(err, blob.Close())
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	header, err := reader.GetPieceHeader()
+	if err != nil {
+		var pathErr *os.PathError
+		if errors.As(err, &pathErr) {
+			return err
+		}
+		return errCorrupted.New("invalid header: %v", err)
+	}
+	if header.OrderLimit.PieceId != pieceID {
+		return errCorrupted.New("piece ID in the header (%s) doesn't match", header.OrderLimit.PieceId)
+	}
+
+	hasher := pb.NewHashFromAlgorithm(header.HashAlgorithm)
+	for {
+		n, err := reader.Read(buffer)
+		_, _ = hasher.Write(buffer[:n])
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if !throttle.wait(ctx, n) {
+			return ctx.Err()
+		}
+	}
+
+	if !bytes.Equal(hasher.Sum(nil), header.Hash) {
+		return errCorrupted.New("hash doesn't match the header")
+	}
+	return nil
+}
+
+// Stats returns the scrub statistics of every satellite.
+func (scrubber *Scrubber) Stats() map[storj.NodeID]ScrubStats {
+	scrubber.mu.Lock()
+	defer scrubber.mu.Unlock()
+
+	stats := make(map[storj.NodeID]ScrubStats, len(scrubber.stats))
+	for satellite, s := range scrubber.stats {
+		stats[satellite] = s
+	}
+	return stats
+}
+
+// SatelliteStats returns the scrub statistics of the satellite.
+func (scrubber *Scrubber) SatelliteStats(satellite storj.NodeID) ScrubStats {
+	scrubber.mu.Lock()
+	defer scrubber.mu.Unlock()
+	return scrubber.stats[satellite]
+}
+
+// Close closes the chore.
+func (scrubber *Scrubber) Close() error {
+	scrubber.Cycle.Close()
+	return nil
+}
+
+// throttle limits the read rate.
+type throttle struct {
+	rate  int64
+	start time.Time
+	read  int64
+}
+
+func newThrottle(rate int64) *throttle {
+	return &throttle{rate: rate, start: time.Now()}
+}
+
+// wait accounts for n bytes read, and waits until reading them is within
+// the rate. It returns false, when the context is canceled.
+func (throttle *throttle) wait(ctx context.Context, n int) bool {
+	throttle.read += int64(n)
+	if throttle.rate <= 0 {
+		return ctx.Err() == nil
+	}
+	due := throttle.start.Add(time.Duration(float64(throttle.read) / float64(throttle.rate) * float64(time.Second)))
+	return sync2.Sleep(ctx, time.Until(due))
+}
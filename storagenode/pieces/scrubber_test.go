@@ -0,0 +1,143 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode/blobstore"
+	"storj.io/storj/storagenode/blobstore/filestore"
+	"storj.io/storj/storagenode/pieces"
+)
+
+func TestScrubber(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := zaptest.NewLogger(t)
+
+	dir, err := filestore.NewDir(log, ctx.Dir("pieces"))
+	require.NoError(t, err)
+
+	blobs := &failingBlobs{
+		Blobs:    filestore.New(log, dir, filestore.DefaultConfig),
+		failures: map[string]int{},
+	}
+	defer ctx.Check(blobs.Close)
+
+	fw := pieces.NewFileWalker(log, blobs, nil)
+	store := pieces.NewStore(log, fw, nil, blobs, nil, nil, nil, pieces.DefaultConfig)
+
+	satelliteID := testrand.NodeID()
+
+	writePiece := func(pieceID storj.PieceID, corruptHeader bool) {
+		writer, err := store.Writer(ctx, satelliteID, pieceID, pb.PieceHashAlgorithm_SHA256)
+		require.NoError(t, err)
+		_, err = writer.Write(testrand.BytesInt(10 * memory.KiB.Int()))
+		require.NoError(t, err)
+
+		hash := writer.Hash()
+		if corruptHeader {
+			hash = testrand.Bytes(32)
+		}
+		require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{
+			Hash:          hash,
+			HashAlgorithm: pb.PieceHashAlgorithm_SHA256,
+			OrderLimit:    pb.OrderLimit{PieceId: pieceID},
+		}))
+	}
+
+	intact, rotten, mismatched := testrand.PieceID(), testrand.PieceID(), testrand.PieceID()
+	writePiece(intact, false)
+	writePiece(rotten, false)
+	writePiece(mismatched, true)
+
+	// flip a byte of the piece content.
+	info, err := store.Stat(ctx, satelliteID, rotten)
+	require.NoError(t, err)
+	path, err := info.FullPath(ctx)
+	require.NoError(t, err)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	data[len(data)-1] ^= 0xFF
+	require.NoError(t, os.WriteFile(path, data, 0644))
+
+	// the pieces, which can't be read, aren't corrupted.
+	flaky, unreadable := testrand.PieceID(), testrand.PieceID()
+	writePiece(flaky, false)
+	writePiece(unreadable, false)
+	blobs.fail(flaky, 1)
+	blobs.fail(unreadable, 2)
+
+	scrubber := pieces.NewScrubber(log, pieces.ScrubberConfig{ReadRate: 0}, store)
+	require.NoError(t, scrubber.ScrubOnce(ctx))
+
+	stats := scrubber.SatelliteStats(satelliteID)
+	require.Equal(t, int64(4), stats.Verified)
+	require.Equal(t, int64(2), stats.Corrupted)
+	require.False(t, stats.LastScrubbed.IsZero())
+
+	// the corrupted pieces are moved aside.
+	for _, pieceID := range []storj.PieceID{intact, flaky, unreadable} {
+		reader, err := store.Reader(ctx, satelliteID, pieceID)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+	}
+
+	for _, pieceID := range []storj.PieceID{rotten, mismatched} {
+		_, err := store.Reader(ctx, satelliteID, pieceID)
+		require.True(t, os.IsNotExist(err))
+	}
+
+	corrupted, err := filepath.Glob(filepath.Join(ctx.Dir("pieces"), "corrupted", "*", "*", "*"))
+	require.NoError(t, err)
+	require.Len(t, corrupted, 2)
+
+	// a second scrub only sees the intact pieces.
+	require.NoError(t, scrubber.ScrubOnce(ctx))
+	stats = scrubber.SatelliteStats(satelliteID)
+	require.Equal(t, int64(3), stats.Verified)
+	require.Equal(t, int64(2), stats.Corrupted)
+}
+
+// failingBlobs fails to open the blobs a given number of times.
+type failingBlobs struct {
+	blobstore.Blobs
+
+	mu       sync.Mutex
+	failures map[string]int
+}
+
+func (blobs *failingBlobs) fail(pieceID storj.PieceID, times int) {
+	blobs.mu.Lock()
+	defer blobs.mu.Unlock()
+	blobs.failures[string(pieceID.Bytes())] = times
+}
+
+func (blobs *failingBlobs) OpenWithStorageFormat(ctx context.Context, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) (blobstore.BlobReader, error) {
+	blobs.mu.Lock()
+	defer blobs.mu.Unlock()
+	if blobs.failures[string(ref.Key)] > 0 {
+		blobs.failures[string(ref.Key)]--
+		return nil, errs.New("input/output error")
+	}
+	return blobs.Blobs.OpenWithStorageFormat(ctx, ref, formatVer)
+}
+
+func (blobs *failingBlobs) Quarantine(ctx context.Context, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) error {
+	return blobs.Blobs.(interface {
+		Quarantine(ctx context.Context, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) error
+	}).Quarantine(ctx, ref, formatVer)
+}
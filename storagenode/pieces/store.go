@@ -399,6 +399,34 @@ func (store *Store) Trash(ctx context.Context, satellite storj.NodeID, pieceID s
 	return Error.Wrap(err)
 }
 
+// quarantiner is implemented by the blob stores, which can move corrupted
+// blobs aside.
+type quarantiner interface {
+	Quarantine(ctx context.Context, ref blobstore.BlobRef, formatVer blobstore.FormatVersion) error
+}
+
+// Quarantine moves the corrupted piece aside, where it isn't served anymore,
+// and removes its expiration record. The piece is deleted, when the blob
+// store can't move blobs aside.
+func (store *Store) Quarantine(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID, formatVersion blobstore.FormatVersion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ref := blobstore.BlobRef{
+		Namespace: satellite.Bytes(),
+		Key:       pieceID.Bytes(),
+	}
+	if q, ok := store.blobs.(quarantiner); ok {
+		err = q.Quarantine(ctx, ref, formatVersion)
+	} else {
+		err = store.blobs.DeleteWithStorageFormat(ctx, ref, formatVersion)
+	}
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(store.DeleteExpired(ctx, satellite, pieceID))
+}
+
 // EmptyTrash deletes pieces in the trash that have been in there longer than trashExpiryInterval.
 func (store *Store) EmptyTrash(ctx context.Context, satelliteID storj.NodeID, trashedBefore time.Time) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -33,6 +33,7 @@ import (
 	"storj.io/drpc"
 	"storj.io/drpc/drpcctx"
 	"storj.io/storj/storagenode/bandwidth"
+	"storj.io/storj/storagenode/blobstore"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/orders"
 	"storj.io/storj/storagenode/orders/ordersfile"
@@ -670,6 +671,10 @@ func (endpoint *Endpoint) Download(stream pb.DRPCPiecestore_DownloadStream) (err
 			endpoint.monitor.VerifyDirReadableLoop.TriggerWait()
 			return rpcstatus.Wrap(rpcstatus.NotFound, err)
 		}
+		if blobstore.ErrUnavailable.Has(err) {
+			// the piece may be on a disk, which is offline.
+			return rpcstatus.Wrap(rpcstatus.Unavailable, err)
+		}
 		return rpcstatus.Wrap(rpcstatus.Internal, err)
 	}
 	defer func() {
@@ -27,6 +27,8 @@ import (
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/blobstore"
 	"storj.io/storj/storagenode/blobstore/filestore"
+	"storj.io/storj/storagenode/blobstore/logstore"
+	"storj.io/storj/storagenode/blobstore/multistore"
 	"storj.io/storj/storagenode/notifications"
 	"storj.io/storj/storagenode/orders"
 	"storj.io/storj/storagenode/payouts"
@@ -84,6 +86,13 @@ type Config struct {
 	Driver    string // if unset, uses sqlite3
 	Pieces    string
 	Filestore filestore.Config
+	Logstore  logstore.Config
+
+	// Allocated is the disk space allocated in Pieces, which is only used
+	// when there are additional storage directories.
+	Allocated int64
+	// ExtraPieces are the additional storage directories.
+	ExtraPieces []multistore.DirConfig
 
 	TestingDisableWAL bool
 }
@@ -130,6 +139,42 @@ type DB struct {
 	SQLDBs map[string]DBContainer
 }
 
+// newPieces returns the configured blob store for the pieces.
+func newPieces(log *zap.Logger, dir *filestore.Dir, config Config) blobstore.Blobs {
+	if config.Logstore.Enabled {
+		return logstore.New(log, dir, config.Logstore)
+	}
+	return filestore.New(log, dir, config.Filestore)
+}
+
+// openPieces returns the blob store for the pieces, which spreads them over
+// the additional storage directories, when there are any.
+func openPieces(log *zap.Logger, dir *filestore.Dir, config Config) (blobstore.Blobs, error) {
+	if len(config.ExtraPieces) == 0 {
+		return newPieces(log, dir, config), nil
+	}
+
+	disks := []multistore.Disk{{
+		Path:      config.Pieces,
+		Allocated: config.Allocated,
+		Blobs:     newPieces(log, dir, config),
+	}}
+	for _, extra := range config.ExtraPieces {
+		// the additional directories are created when needed, so that disks
+		// can be added to an existing node.
+		extraDir, err := filestore.NewDir(log, extra.Path)
+		if err != nil {
+			return nil, errs.Combine(err, multistore.New(log, disks).Close())
+		}
+		disks = append(disks, multistore.Disk{
+			Path:      extra.Path,
+			Allocated: extra.Allocated.Int64(),
+			Blobs:     newPieces(log.With(zap.String("dir", extra.Path)), extraDir, config),
+		})
+	}
+	return multistore.New(log.Named("multistore"), disks), nil
+}
+
 // OpenNew creates a new master database for storage node.
 func OpenNew(ctx context.Context, log *zap.Logger, config Config) (*DB, error) {
 	piecesDir, err := filestore.NewDir(log, config.Pieces)
@@ -137,7 +182,10 @@ func OpenNew(ctx context.Context, log *zap.Logger, config Config) (*DB, error) {
 		return nil, err
 	}
 
-	pieces := filestore.New(log, piecesDir, config.Filestore)
+	pieces, err := openPieces(log, piecesDir, config)
+	if err != nil {
+		return nil, err
+	}
 
 	deprecatedInfoDB := &deprecatedInfoDB{}
 	v0PieceInfoDB := &v0PieceInfoDB{}
@@ -205,7 +253,10 @@ func OpenExisting(ctx context.Context, log *zap.Logger, config Config) (*DB, err
 		return nil, err
 	}
 
-	pieces := filestore.New(log, piecesDir, config.Filestore)
+	pieces, err := openPieces(log, piecesDir, config)
+	if err != nil {
+		return nil, err
+	}
 
 	deprecatedInfoDB := &deprecatedInfoDB{}
 	v0PieceInfoDB := &v0PieceInfoDB{}
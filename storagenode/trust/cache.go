@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/zeebo/errs"
 
@@ -63,6 +64,52 @@ func (cache *Cache) Lookup(key string) (entries []Entry, ok bool) {
 // Set sets the entries in the cache for the provided key.
 func (cache *Cache) Set(key string, entries []Entry) {
 	cache.data.Entries[key] = entries
+	delete(cache.data.Signed, key)
+}
+
+// SetSigned sets the entries in the cache for the provided key and records
+// that their signature was verified.
+func (cache *Cache) SetSigned(key string, entries []Entry) {
+	cache.data.Entries[key] = entries
+	if cache.data.Signed == nil {
+		cache.data.Signed = make(map[string]bool)
+	}
+	cache.data.Signed[key] = true
+}
+
+// Signed returns whether the signature of the entries of the provided key was
+// verified.
+func (cache *Cache) Signed(key string) bool {
+	return cache.data.Signed[key]
+}
+
+// Delete removes the entries of the provided key from the cache.
+func (cache *Cache) Delete(key string) {
+	delete(cache.data.Entries, key)
+	delete(cache.data.Signed, key)
+}
+
+// SetRejection records that the list of the source with the provided key has
+// been rejected.
+func (cache *Cache) SetRejection(key string, rejection Rejection) {
+	if cache.data.Rejections == nil {
+		cache.data.Rejections = make(map[string]Rejection)
+	}
+	cache.data.Rejections[key] = rejection
+}
+
+// ClearRejection removes the rejection of the source with the provided key.
+func (cache *Cache) ClearRejection(key string) {
+	delete(cache.data.Rejections, key)
+}
+
+// Rejections returns the rejections of the sources by their keys.
+func (cache *Cache) Rejections() map[string]Rejection {
+	rejections := make(map[string]Rejection, len(cache.data.Rejections))
+	for key, rejection := range cache.data.Rejections {
+		rejections[key] = rejection
+	}
+	return rejections
 }
 
 // Save persists the cache to disk.
@@ -74,6 +121,15 @@ func (cache *Cache) Save(ctx context.Context) (err error) {
 // CacheData represents the data stored in the cache.
 type CacheData struct {
 	Entries map[string][]Entry `json:"entries"`
+	// Signed contains the keys of the entries, whose signature was verified.
+	Signed     map[string]bool      `json:"signed,omitempty"`
+	Rejections map[string]Rejection `json:"rejections,omitempty"`
+}
+
+// Rejection describes why the list of a source has been rejected.
+type Rejection struct {
+	Reason     string    `json:"reason"`
+	RejectedAt time.Time `json:"rejectedAt"`
 }
 
 // NewCacheData returns an new CacheData.
@@ -14,6 +14,7 @@ import (
 type Config struct {
 	Sources         Sources       `help:"list of trust sources" devDefault:"" releaseDefault:"https://www.storj.io/dcs-satellites"`
 	Exclusions      Exclusions    `help:"list of trust exclusions" devDefault:"" releaseDefault:""`
+	SigningKeys     SigningKeys   `help:"list of base64 encoded ed25519 public keys of the trust list authors; when set, the lists of the http(s) and file sources are only accepted with a valid detached signature next to them with the .sig suffix" default:""`
 	RefreshInterval time.Duration `help:"how often the trust pool should be refreshed" default:"6h"`
 	CachePath       string        `help:"file path where trust lists should be cached" default:"${CONFDIR}/trust-cache.json"`
 }
@@ -4,7 +4,9 @@
 package trust
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"os"
 
 	"github.com/zeebo/errs"
@@ -43,7 +45,41 @@ func (source *FileSource) FetchEntries(ctx context.Context) (_ []Entry, err erro
 	if err != nil {
 		return nil, err
 	}
+	return fileEntries(urls), nil
+}
+
+// FetchSignedEntries implements the SignedSource interface and returns the
+// entries from the file source on disk, when the detached signature, which
+// is read from the path with the signature suffix, is valid for one of the
+// keys. The entries returned are authoritative.
+func (source *FileSource) FetchSignedEntries(ctx context.Context, keys SigningKeys) (_ []Entry, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	list, err := os.ReadFile(source.path)
+	if err != nil {
+		return nil, ErrFileSource.Wrap(err)
+	}
+	signature, err := os.ReadFile(source.path + SignatureSuffix)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrSignature.New("missing signature %q", source.path+SignatureSuffix)
+	}
+	if err != nil {
+		return nil, ErrFileSource.Wrap(err)
+	}
+
+	if err := keys.Verify(list, signature); err != nil {
+		return nil, err
+	}
+
+	urls, err := ParseSatelliteURLList(ctx, bytes.NewReader(list))
+	if err != nil {
+		return nil, ErrFileSource.Wrap(err)
+	}
+	return fileEntries(urls), nil
+}
 
+// fileEntries returns the authoritative entries for the URLs.
+func fileEntries(urls []SatelliteURL) []Entry {
 	var entries []Entry
 	for _, url := range urls {
 		entries = append(entries, Entry{
@@ -51,7 +87,7 @@ func (source *FileSource) FetchEntries(ctx context.Context) (_ []Entry, err erro
 			Authoritative: true,
 		})
 	}
-	return entries, nil
+	return entries
 }
 
 // LoadSatelliteURLList loads a list of Satellite URLs from a path on disk.
@@ -5,7 +5,10 @@ package trust
 
 import (
 	"bufio"
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"net"
 	"net/http"
@@ -20,6 +23,20 @@ var (
 	ErrHTTPSource = errs.Class("HTTP source")
 )
 
+// maxListSize is the maximum size of a trust list or of its signature.
+const maxListSize = 1 << 20
+
+// statusError is returned when the HTTP source responds with an unexpected status code.
+type statusError struct {
+	url        *url.URL
+	statusCode int
+	line       string
+}
+
+func (err *statusError) Error() string {
+	return fmt.Sprintf("%q: unexpected status code %d: %q", err.url, err.statusCode, err.line)
+}
+
 // HTTPSource represents a trust source at a http:// or https:// URL.
 type HTTPSource struct {
 	url *url.URL
@@ -58,7 +75,50 @@ func (source *HTTPSource) Static() bool { return false }
 func (source *HTTPSource) FetchEntries(ctx context.Context) (_ []Entry, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	req, err := http.NewRequestWithContext(ctx, "GET", source.url.String(), nil)
+	list, err := source.fetch(ctx, source.url)
+	if err != nil {
+		return nil, err
+	}
+	return source.parseEntries(ctx, list)
+}
+
+// FetchSignedEntries implements the SignedSource interface and returns the
+// entries parsed from the list retrieved over HTTP(S), when the detached
+// signature, which is retrieved from the source URL with the signature
+// suffix, is valid for one of the keys.
+func (source *HTTPSource) FetchSignedEntries(ctx context.Context, keys SigningKeys) (_ []Entry, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	list, err := source.fetch(ctx, source.url)
+	if err != nil {
+		return nil, err
+	}
+
+	signatureURL := *source.url
+	signatureURL.Path += SignatureSuffix
+	if signatureURL.RawPath != "" {
+		signatureURL.RawPath += SignatureSuffix
+	}
+	signature, err := source.fetch(ctx, &signatureURL)
+	if err != nil {
+		var statusErr *statusError
+		if errors.As(err, &statusErr) && statusErr.statusCode == http.StatusNotFound {
+			return nil, ErrSignature.New("missing signature %q", &signatureURL)
+		}
+		return nil, err
+	}
+
+	if err := keys.Verify(list, signature); err != nil {
+		return nil, err
+	}
+	return source.parseEntries(ctx, list)
+}
+
+// fetch retrieves the contents of the URL.
+func (source *HTTPSource) fetch(ctx context.Context, u *url.URL) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
 	if err != nil {
 		return nil, ErrHTTPSource.Wrap(err)
 	}
@@ -73,10 +133,22 @@ func (source *HTTPSource) FetchEntries(ctx context.Context) (_ []Entry, err erro
 		_ = resp.Body.Close()
 	}()
 	if resp.StatusCode != http.StatusOK {
-		return nil, ErrHTTPSource.New("%q: unexpected status code %d: %q", source.url, resp.StatusCode, tryReadLine(resp.Body))
+		return nil, ErrHTTPSource.Wrap(&statusError{url: u, statusCode: resp.StatusCode, line: tryReadLine(resp.Body)})
 	}
 
-	urls, err := ParseSatelliteURLList(ctx, resp.Body)
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxListSize+1))
+	if err != nil {
+		return nil, ErrHTTPSource.New("%q: %w", u, err)
+	}
+	if len(data) > maxListSize {
+		return nil, ErrHTTPSource.New("%q: larger than %d bytes", u, maxListSize)
+	}
+	return data, nil
+}
+
+// parseEntries parses the entries from the list.
+func (source *HTTPSource) parseEntries(ctx context.Context, list []byte) ([]Entry, error) {
+	urls, err := ParseSatelliteURLList(ctx, bytes.NewReader(list))
 	if err != nil {
 		return nil, ErrHTTPSource.New("cannot parse list at %q: %w", source.url, err)
 	}
@@ -5,6 +5,7 @@ package trust
 
 import (
 	"context"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -16,11 +17,15 @@ type List struct {
 	log     *zap.Logger
 	sources Sources
 	rules   Rules
+	keys    SigningKeys
 	cache   *Cache
 }
 
-// NewList takes one or more sources, optional rules, and a cache and returns a new List.
-func NewList(log *zap.Logger, sources []Source, rules Rules, cache *Cache) (*List, error) {
+// NewList takes one or more sources, optional rules, optional signing keys,
+// and a cache and returns a new List. When signing keys are provided, the
+// lists of the sources, which can be signed, are only accepted with a valid
+// signature.
+func NewList(log *zap.Logger, sources []Source, rules Rules, keys SigningKeys, cache *Cache) (*List, error) {
 	// TODO: ideally we'd ensure there was at least one source configured since
 	// it doesn't make sense to run a storage node that doesn't trust any
 	// satellites, but unfortunately the check causes the backcompat tests to
@@ -35,6 +40,7 @@ func NewList(log *zap.Logger, sources []Source, rules Rules, cache *Cache) (*Lis
 		log:     log,
 		sources: sources,
 		rules:   rules,
+		keys:    keys,
 		cache:   cache,
 	}, nil
 }
@@ -87,18 +93,30 @@ func (list *List) fetchEntries(ctx context.Context) (_ []Entry, err error) {
 	for _, source := range list.sources {
 		sourceLog := list.log.With(zap.String("source", source.String()))
 
-		entries, err := source.FetchEntries(ctx)
+		entries, err := list.fetchSource(ctx, source)
 		if err != nil {
+			if ErrSignature.Has(err) {
+				sourceLog.Error("Rejected the list of the source", zap.Error(err))
+				list.cache.SetRejection(source.String(), Rejection{
+					Reason:     err.Error(),
+					RejectedAt: time.Now().UTC(),
+				})
+			}
+
 			var ok bool
 			entries, ok = list.lookupCache(source)
 			if !ok {
 				sourceLog.Error("Failed to fetch URLs from source", zap.Error(err))
+				if err := list.saveCache(ctx); err != nil {
+					list.log.Warn("Unable to save list cache", zap.Error(err))
+				}
 				return nil, Error.New("failed to fetch from source %q: %w", source.String(), err)
 			}
 			sourceLog.Warn("Failed to fetch URLs from source; used cache", zap.Error(err))
 		} else {
 			sourceLog.Debug("Fetched URLs from source; updating cache", zap.Int("count", len(entries)))
 			list.updateCache(source, entries)
+			list.cache.ClearRejection(source.String())
 		}
 
 		allEntries = append(allEntries, entries...)
@@ -110,11 +128,38 @@ func (list *List) fetchEntries(ctx context.Context) (_ []Entry, err error) {
 	return allEntries, nil
 }
 
+// fetchSource fetches the entries of the source, verifying their signature,
+// when the source can be signed and signing keys are configured.
+func (list *List) fetchSource(ctx context.Context, source Source) ([]Entry, error) {
+	if list.requiresSignature(source) {
+		return source.(SignedSource).FetchSignedEntries(ctx, list.keys)
+	}
+	return source.FetchEntries(ctx)
+}
+
+// requiresSignature returns whether the list of the source is only accepted
+// with a valid signature.
+func (list *List) requiresSignature(source Source) bool {
+	_, ok := source.(SignedSource)
+	return ok && len(list.keys) > 0
+}
+
+// Rejections returns the rejections of the lists of the sources by the
+// source.
+func (list *List) Rejections() map[string]Rejection {
+	return list.cache.Rejections()
+}
+
 func (list *List) lookupCache(source Source) ([]Entry, bool) {
 	// Static sources are not cached
 	if source.Static() {
 		return nil, false
 	}
+	// Entries cached before the signing keys were configured can't be trusted.
+	if list.requiresSignature(source) && !list.cache.Signed(source.String()) {
+		list.cache.Delete(source.String())
+		return nil, false
+	}
 	return list.cache.Lookup(source.String())
 }
 
@@ -123,6 +168,10 @@ func (list *List) updateCache(source Source, entries []Entry) {
 	if source.Static() {
 		return
 	}
+	if list.requiresSignature(source) {
+		list.cache.SetSigned(source.String(), entries)
+		return
+	}
 	list.cache.Set(source.String(), entries)
 }
 
@@ -53,7 +53,7 @@ func TestNewList(t *testing.T) {
 	} {
 		tt := tt // quiet linting
 		t.Run(tt.name, func(t *testing.T) {
-			list, err := trust.NewList(tt.log, nil, nil, tt.cache)
+			list, err := trust.NewList(tt.log, nil, nil, nil, tt.cache)
 			if tt.err != "" {
 				require.EqualError(t, err, tt.err)
 				require.Nil(t, list)
@@ -163,7 +163,7 @@ func TestListAgainstSpec(t *testing.T) {
 	}
 
 	log := zaptest.NewLogger(t)
-	list, err := trust.NewList(log, sources, rules, cache)
+	list, err := trust.NewList(log, sources, rules, nil, cache)
 	require.NoError(t, err)
 
 	urls, err := list.FetchURLs(context.Background())
@@ -300,7 +300,7 @@ func TestListCacheInteraction(t *testing.T) {
 			cache := newTestCache(t, ctx.Dir(), tt.cacheBefore)
 
 			log := zaptest.NewLogger(t)
-			list, err := trust.NewList(log, tt.sources, nil, cache)
+			list, err := trust.NewList(log, tt.sources, nil, nil, cache)
 			require.NoError(t, err)
 
 			if tt.killCacheEarly {
@@ -70,6 +70,11 @@ type Pool struct {
 	listMu sync.Mutex
 	list   *List
 
+	// rejections is a copy of the rejections of the list, so that reading
+	// them doesn't wait for the list to be fetched.
+	rejectionsMu sync.Mutex
+	rejections   map[string]Rejection
+
 	satellitesDB satellites.DB
 
 	satellitesMu sync.RWMutex
@@ -92,7 +97,7 @@ func NewPool(log *zap.Logger, resolver IdentityResolver, config Config, satellit
 		return nil, err
 	}
 
-	list, err := NewList(log, config.Sources, config.Exclusions.Rules, cache)
+	list, err := NewList(log, config.Sources, config.Exclusions.Rules, config.SigningKeys, cache)
 	if err != nil {
 		return nil, err
 	}
@@ -102,6 +107,7 @@ func NewPool(log *zap.Logger, resolver IdentityResolver, config Config, satellit
 		resolver:        resolver,
 		refreshInterval: config.RefreshInterval,
 		list:            list,
+		rejections:      list.Rejections(),
 		satellitesDB:    satellitesDB,
 		satellites:      make(map[storj.NodeID]*satelliteInfoCache),
 	}, nil
@@ -248,7 +254,28 @@ func (pool *Pool) fetchURLs(ctx context.Context) ([]storj.NodeURL, error) {
 	// on the cache, etc).
 	pool.listMu.Lock()
 	defer pool.listMu.Unlock()
-	return pool.list.FetchURLs(ctx)
+
+	urls, err := pool.list.FetchURLs(ctx)
+
+	rejections := pool.list.Rejections()
+	pool.rejectionsMu.Lock()
+	pool.rejections = rejections
+	pool.rejectionsMu.Unlock()
+
+	return urls, err
+}
+
+// Rejections returns the rejections of the lists of the trust sources by the
+// source, as of the last fetch.
+func (pool *Pool) Rejections() map[string]Rejection {
+	pool.rejectionsMu.Lock()
+	defer pool.rejectionsMu.Unlock()
+
+	rejections := make(map[string]Rejection, len(pool.rejections))
+	for source, rejection := range pool.rejections {
+		rejections[source] = rejection
+	}
+	return rejections
 }
 
 func jitter(t time.Duration) time.Duration {
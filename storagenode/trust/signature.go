@@ -0,0 +1,84 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package trust
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+// SignatureSuffix is appended to the location of a trust list to get the
+// location of its detached signature.
+const SignatureSuffix = ".sig"
+
+var (
+	// ErrSignature is an error class for trust list signature errors.
+	ErrSignature = errs.Class("trust list signature")
+)
+
+// SigningKeys is a list of ed25519 public keys of the trust list authors,
+// which implements pflag.Value.
+type SigningKeys []ed25519.PublicKey
+
+// String returns the string representation of the config.
+func (keys SigningKeys) String() string {
+	s := make([]string, 0, len(keys))
+	for _, key := range keys {
+		s = append(s, base64.StdEncoding.EncodeToString(key))
+	}
+	return strings.Join(s, ",")
+}
+
+// Set implements pflag.Value by parsing a comma separated list of base64
+// encoded keys.
+func (keys *SigningKeys) Set(value string) error {
+	var entries []string
+	if value != "" {
+		entries = strings.Split(value, ",")
+	}
+
+	var toSet SigningKeys
+	for _, entry := range entries {
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(entry))
+		if err != nil {
+			return Error.New("invalid signing key %q: %w", entry, err)
+		}
+		if len(key) != ed25519.PublicKeySize {
+			return Error.New("invalid signing key %q: must be %d bytes long", entry, ed25519.PublicKeySize)
+		}
+		toSet = append(toSet, ed25519.PublicKey(key))
+	}
+
+	*keys = toSet
+	return nil
+}
+
+// Type returns the type of the pflag.Value.
+func (keys SigningKeys) Type() string {
+	return "trust-signing-keys"
+}
+
+// Verify checks that the base64 encoded detached signature of the list is
+// valid for one of the keys.
+func (keys SigningKeys) Verify(list, signature []byte) error {
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(signature)))
+	if err != nil {
+		return ErrSignature.New("malformed signature: %w", err)
+	}
+	for _, key := range keys {
+		if ed25519.Verify(key, list, sig) {
+			return nil
+		}
+	}
+	return ErrSignature.New("not signed by any of the signing keys")
+}
+
+// Sign returns the base64 encoded detached signature of the list, which is
+// the format expected at the signature location of the list.
+func Sign(key ed25519.PrivateKey, list []byte) []byte {
+	return []byte(base64.StdEncoding.EncodeToString(ed25519.Sign(key, list)) + "\n")
+}
@@ -0,0 +1,228 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package trust_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/storagenode/trust"
+)
+
+func TestSigningKeys(t *testing.T) {
+	public, private, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	otherPublic, otherPrivate, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	var keys trust.SigningKeys
+	value := base64.StdEncoding.EncodeToString(public) + "," + base64.StdEncoding.EncodeToString(otherPublic)
+	require.NoError(t, keys.Set(value))
+	require.Equal(t, trust.SigningKeys{public, otherPublic}, keys)
+	require.Equal(t, value, keys.String())
+
+	require.NoError(t, keys.Set(""))
+	require.Empty(t, keys)
+
+	require.Error(t, keys.Set("not base64"))
+	require.Error(t, keys.Set(base64.StdEncoding.EncodeToString([]byte("short"))))
+
+	list := []byte("list")
+	keys = trust.SigningKeys{public}
+	require.NoError(t, keys.Verify(list, trust.Sign(private, list)))
+
+	err = keys.Verify(list, trust.Sign(otherPrivate, list))
+	require.True(t, trust.ErrSignature.Has(err))
+	err = keys.Verify([]byte("tampered"), trust.Sign(private, list))
+	require.True(t, trust.ErrSignature.Has(err))
+	err = keys.Verify(list, []byte("!!!"))
+	require.True(t, trust.ErrSignature.Has(err))
+}
+
+func TestSignedSources(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	public, private, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	_, otherPrivate, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	keys := trust.SigningKeys{public}
+
+	url := makeSatelliteURL("domain.test")
+	list := []byte(url.String() + "\n")
+	otherURL := makeSatelliteURL("domain.test")
+
+	files := map[string][]byte{
+		"/good":         list,
+		"/good.sig":     trust.Sign(private, list),
+		"/forged":       list,
+		"/forged.sig":   trust.Sign(otherPrivate, list),
+		"/unsigned":     list,
+		"/tampered":     []byte(otherURL.String() + "\n"),
+		"/tampered.sig": trust.Sign(private, list),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(data)
+	}))
+	defer server.Close()
+
+	for name, data := range files {
+		require.NoError(t, os.WriteFile(ctx.File("lists", name), data, 0644))
+	}
+
+	for _, tt := range []struct {
+		name     string
+		rejected bool
+	}{
+		{name: "good"},
+		{name: "forged", rejected: true},
+		{name: "unsigned", rejected: true},
+		{name: "tampered", rejected: true},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			httpSource, err := trust.NewHTTPSource(server.URL + "/" + tt.name)
+			require.NoError(t, err)
+			fileSource := trust.NewFileSource(ctx.File("lists", tt.name))
+
+			for _, source := range []trust.SignedSource{httpSource, fileSource} {
+				entries, err := source.FetchSignedEntries(ctx, keys)
+				switch {
+				case tt.rejected:
+					require.True(t, trust.ErrSignature.Has(err), "%v", err)
+				default:
+					require.NoError(t, err)
+					require.Len(t, entries, 1)
+					require.Equal(t, url, entries[0].SatelliteURL)
+				}
+			}
+		})
+	}
+}
+
+func TestListRejections(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	public, private, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	_, otherPrivate, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	url := makeSatelliteURL("domain.test")
+	list := []byte(url.String() + "\n")
+	signature := trust.Sign(private, list)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/list":
+			_, _ = w.Write(list)
+		case "/list.sig":
+			_, _ = w.Write(signature)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	source, err := trust.NewHTTPSource(server.URL + "/list")
+	require.NoError(t, err)
+
+	cache := newTestCache(t, ctx.Dir(), nil)
+	trustList, err := trust.NewList(zaptest.NewLogger(t), []trust.Source{source}, nil, trust.SigningKeys{public}, cache)
+	require.NoError(t, err)
+
+	urls, err := trustList.FetchURLs(context.Background())
+	require.NoError(t, err)
+	require.Len(t, urls, 1)
+	require.Empty(t, trustList.Rejections())
+
+	// a forged list is rejected, and the cached entries are used.
+	signature = trust.Sign(otherPrivate, list)
+	urls, err = trustList.FetchURLs(context.Background())
+	require.NoError(t, err)
+	require.Len(t, urls, 1)
+
+	rejections := trustList.Rejections()
+	require.Len(t, rejections, 1)
+	require.Contains(t, rejections[source.String()].Reason, "not signed by any of the signing keys")
+
+	cacheData, err := trust.LoadCacheData(cache.Path())
+	require.NoError(t, err)
+	require.Equal(t, rejections, cacheData.Rejections)
+
+	// the rejection is cleared, once the list is accepted again.
+	signature = trust.Sign(private, list)
+	_, err = trustList.FetchURLs(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, trustList.Rejections())
+
+	cacheData, err = trust.LoadCacheData(cache.Path())
+	require.NoError(t, err)
+	require.Empty(t, cacheData.Rejections)
+}
+
+func TestListDropsUnsignedCache(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	public, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	url := makeSatelliteURL("domain.test")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/list":
+			_, _ = w.Write([]byte(url.String() + "\n"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	source, err := trust.NewHTTPSource(server.URL + "/list")
+	require.NoError(t, err)
+
+	// the entries were cached before the signing keys were configured.
+	cache := newTestCache(t, ctx.Dir(), map[string][]trust.Entry{
+		source.String(): {{SatelliteURL: url}},
+	})
+	trustList, err := trust.NewList(zaptest.NewLogger(t), []trust.Source{source}, nil, trust.SigningKeys{public}, cache)
+	require.NoError(t, err)
+
+	_, err = trustList.FetchURLs(context.Background())
+	require.Error(t, err)
+	require.Contains(t, trustList.Rejections()[source.String()].Reason, "missing signature")
+
+	cacheData, err := trust.LoadCacheData(cache.Path())
+	require.NoError(t, err)
+	require.Empty(t, cacheData.Entries)
+}
+
+func TestHTTPSourceMaxListSize(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(make([]byte, 1<<20+1))
+	}))
+	defer server.Close()
+
+	source, err := trust.NewHTTPSource(server.URL + "/list")
+	require.NoError(t, err)
+
+	_, err = source.FetchEntries(context.Background())
+	require.True(t, trust.ErrHTTPSource.Has(err), "%v", err)
+	require.Contains(t, err.Error(), "larger than")
+}
@@ -33,6 +33,16 @@ type Source interface {
 	FetchEntries(context.Context) ([]Entry, error)
 }
 
+// SignedSource is a trust source, whose list can be verified with a detached
+// signature.
+type SignedSource interface {
+	Source
+
+	// FetchSignedEntries returns the list of trust entries from the source,
+	// when the signature of the list is valid for one of the keys.
+	FetchSignedEntries(context.Context, SigningKeys) ([]Entry, error)
+}
+
 // NewSource takes a configuration string returns a Source for that string.
 func NewSource(config string) (Source, error) {
 	schema, ok := isReserved(config)
@@ -69,6 +69,13 @@ export class Project {
     defaultPlacement: number;
 }
 
+export class ProjectMember {
+    memberId: UUID;
+    projectId: UUID;
+    role: number;
+    createdAt: Time;
+}
+
 export class ResponseUser {
     id: UUID;
     fullName: string;
@@ -86,6 +93,10 @@ export class ResponseUser {
     mfaRecoveryCodeCount: number;
 }
 
+export class UpdateProjectMemberRoleRequest {
+    role: number;
+}
+
 export class UpsertProjectInfo {
     name: string;
     description: string;
@@ -128,6 +139,16 @@ export class projectsHttpApiV0 {
         throw new Error(err.error);
     }
 
+    public async updateProjectMemberRole(request: UpdateProjectMemberRoleRequest, id: UUID, memberID: UUID): Promise<ProjectMember> {
+        const path = `${this.ROOT_PATH}/member-role/${id}/${memberID}`;
+        const response = await this.http.patch(path, JSON.stringify(request));
+        if (response.ok) {
+            return response.json().then((body) => body as ProjectMember);
+        }
+        const err = await response.json();
+        throw new Error(err.error);
+    }
+
     public async getProjects(): Promise<Array<Project>> {
         const path = `${this.ROOT_PATH}/`;
         const response = await this.http.get(path);
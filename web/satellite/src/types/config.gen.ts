@@ -46,6 +46,7 @@ export class FrontendConfig {
     newUploadModalEnabled: boolean;
     galleryViewEnabled: boolean;
     neededTransactionConfirmations: number;
+    ssoEnabled: boolean;
 }
 
 export class MultiCaptchaConfig {